@@ -61,6 +61,13 @@ var (
 	ErrNotGappedAlphabet   = errors.New("align: alphabet does not have gap at position 0")
 	ErrTypeNotHandled      = errors.New("align: sequence type not handled")
 	ErrMatrixNotSquare     = errors.New("align: scoring matrix is not square")
+	ErrBadBand             = errors.New("align: negative band width")
+	ErrBandMissesEnds      = errors.New("align: band does not include alignment ends")
+	ErrBadSeed             = errors.New("align: seed outside sequences")
+	ErrBadDrop             = errors.New("align: negative drop-off")
+	ErrNonUniformGaps      = errors.New("align: gap penalties not uniform")
+	ErrNotReusable         = errors.New("align: aligner does not support table reuse")
+	ErrNotAnnotatable      = errors.New("align: cannot annotate contig piece")
 )
 
 type ErrMatrixWrongSize struct {
@@ -168,3 +175,120 @@ func Format(a, b seq.Slicer, f []feat.Pair, gap alphabet.Letter) [2]alphabet.Sli
 	}
 	return aln
 }
+
+// gappedAlphabet returns the alphabet shared by reference and query, which
+// must have the gap letter at index zero.
+func gappedAlphabet(reference, query AlphabetSlicer) (alphabet.Alphabet, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, ErrMismatchedAlphabets
+	}
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, ErrNotGappedAlphabet
+	}
+	return alpha, nil
+}
+
+// flatten returns the scoring matrix m as a row-major slice after checking
+// that it is square and large enough for alpha.
+func flatten(m Linear, alpha alphabet.Alphabet) ([]int, error) {
+	let := len(m)
+	if let < alpha.Len() {
+		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
+	}
+	la := make([]int, 0, let*let)
+	for _, row := range m {
+		if len(row) != let {
+			return nil, ErrMatrixNotSquare
+		}
+		la = append(la, row...)
+	}
+	return la, nil
+}
+
+// letterIndexes returns the alphabet indexes of the letters of reference and
+// query, which must be both alphabet.Letters or both alphabet.QLetters.
+func letterIndexes(reference, query AlphabetSlicer, alpha alphabet.Alphabet) (r, q []int, err error) {
+	switch reference.Slice().(type) {
+	case alphabet.Letters:
+		if _, ok := query.Slice().(alphabet.Letters); !ok {
+			return nil, nil, ErrMismatchedTypes
+		}
+	case alphabet.QLetters:
+		if _, ok := query.Slice().(alphabet.QLetters); !ok {
+			return nil, nil, ErrMismatchedTypes
+		}
+	default:
+		return nil, nil, ErrTypeNotHandled
+	}
+	r, err = indexes(reference, alpha, "rSeq")
+	if err != nil {
+		return nil, nil, err
+	}
+	q, err = indexes(query, alpha, "qSeq")
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, q, nil
+}
+
+// indexes returns the alphabet indexes of the letters of s, which must be
+// alphabet.Letters or alphabet.QLetters. The name is used in error messages.
+func indexes(s AlphabetSlicer, alpha alphabet.Alphabet, name string) ([]int, error) {
+	index := alpha.LetterIndex()
+	var idx []int
+	switch s := s.Slice().(type) {
+	case alphabet.Letters:
+		idx = make([]int, len(s))
+		for i, l := range s {
+			if idx[i] = index[l]; idx[i] < 0 {
+				return nil, fmt.Errorf("align: illegal letter %q at position %d in %s", l, i, name)
+			}
+		}
+	case alphabet.QLetters:
+		idx = make([]int, len(s))
+		for i, l := range s {
+			if idx[i] = index[l.L]; idx[i] < 0 {
+				return nil, fmt.Errorf("align: illegal letter %q at position %d in %s", l.L, i, name)
+			}
+		}
+	default:
+		return nil, ErrTypeNotHandled
+	}
+	return idx, nil
+}
+
+// opsPairs returns the feature pairs of the alignment of the letter indexes r
+// and q described by the diag, up and left operations in ops, starting at
+// position i of r and j of q. Each run of identical operations forms a pair.
+func opsPairs(ops []byte, r, q []int, i, j int, la []int, let int) []feat.Pair {
+	var aln []feat.Pair
+	for k := 0; k < len(ops); {
+		op := ops[k]
+		si, sj := i, j
+		var score int
+		for ; k < len(ops) && ops[k] == op; k++ {
+			switch op {
+			case diag:
+				score += la[r[i]*let+q[j]]
+				i++
+				j++
+			case up:
+				score += la[r[i]*let]
+				i++
+			case left:
+				score += la[q[j]]
+				j++
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+	return aln
+}
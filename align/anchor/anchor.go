@@ -0,0 +1,341 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package anchor provides anchored global alignment of long nucleic acid sequences.
+//
+// Exact k-mer matches between the sequences are found using a kmerindex.Index and
+// merged into maximal diagonal anchors. The highest scoring colinear chain of
+// anchors is then found, and only the gaps between chained anchors are aligned by
+// dynamic programming, so the cost of aligning similar long sequences depends on
+// the sizes of the regions between anchors rather than the lengths of the
+// sequences.
+package anchor
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/index/kmerindex"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrBadAlphabet = errors.New("anchor: alphabet is not nucleic acid")
+
+// An Anchor is an exact match of Len letters between position Ref of a reference
+// sequence and position Query of a query sequence.
+type Anchor struct {
+	Ref, Query int
+	Len        int
+}
+
+// Seeds returns the anchors formed by the exact k-mer matches between reference and
+// query sorted by reference and query position. Overlapping k-mer matches on the same
+// diagonal are merged into a single anchor. If maxOcc is greater than zero, k-mers
+// occurring more than maxOcc times in the reference are not used. Letters that are not
+// unambiguous bases are not matched.
+func Seeds(reference, query *linear.Seq, k, maxOcc int) ([]Anchor, error) {
+	if reference.Alpha != query.Alpha {
+		return nil, align.ErrMismatchedAlphabets
+	}
+	bases, err := ungapped(reference.Alpha)
+	if err != nil {
+		return nil, err
+	}
+	if k > reference.Len()-1 || k > query.Len() {
+		return nil, nil
+	}
+	ki, err := kmerindex.New(k, linear.NewSeq(reference.ID, reference.Seq, bases))
+	if err != nil {
+		return nil, err
+	}
+	ki.Build()
+
+	var (
+		anchors []Anchor
+
+		// open holds the index of the anchor
+		// on each diagonal that may be extended
+		// by the next k-mer match.
+		open = make(map[int]int)
+	)
+	err = ki.ForEachKmerOf(linear.NewSeq(query.ID, query.Seq, bases), 0, query.Len(), func(ki *kmerindex.Index, j, kmer int) {
+		lo, hi := ki.KmerRange(kmerindex.Kmer(kmer))
+		if maxOcc > 0 && hi-lo > maxOcc {
+			return
+		}
+		for p := lo; p < hi; p++ {
+			i := ki.PosAt(p)
+			if o, ok := open[j-i]; ok && anchors[o].Query+anchors[o].Len == j+k-1 {
+				anchors[o].Len++
+				continue
+			}
+			open[j-i] = len(anchors)
+			anchors = append(anchors, Anchor{Ref: i, Query: j, Len: k})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(byRef(anchors))
+	return anchors, nil
+}
+
+// ungapped returns the unambiguous ungapped alphabet with the molecule type of a.
+func ungapped(a alphabet.Alphabet) (alphabet.Alphabet, error) {
+	switch a.Moltype() {
+	case feat.DNA:
+		return alphabet.DNA, nil
+	case feat.RNA:
+		return alphabet.RNA, nil
+	}
+	return nil, ErrBadAlphabet
+}
+
+type byRef []Anchor
+
+func (a byRef) Len() int { return len(a) }
+func (a byRef) Less(i, j int) bool {
+	if a[i].Ref != a[j].Ref {
+		return a[i].Ref < a[j].Ref
+	}
+	return a[i].Query < a[j].Query
+}
+func (a byRef) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+
+// Chain returns the colinear chain of anchors covering the greatest number of letters.
+// Anchors in the chain do not overlap in either sequence and are in order of position
+// in both. The chain is found in O(n log n) time for n anchors.
+func Chain(anchors []Anchor) []Anchor {
+	n := len(anchors)
+	if n == 0 {
+		return nil
+	}
+	a := append([]Anchor(nil), anchors...)
+	sort.Sort(byRef(a))
+
+	// Anchors become available as predecessors once
+	// the current anchor starts after their reference
+	// end, and are stored by query end in a Fenwick
+	// tree of prefix maximum scores.
+	ends := make([]int, n)
+	qEnds := make([]int, n)
+	for i := range a {
+		ends[i] = i
+		qEnds[i] = a[i].Query + a[i].Len
+	}
+	sort.Sort(byRefEnd{idx: ends, a: a})
+	sort.Ints(qEnds)
+
+	var (
+		score = make([]int, n)
+		prev  = make([]int, n)
+		t     = newFenwick(n)
+	)
+	e := 0
+	for i, c := range a {
+		for e < n && a[ends[e]].Ref+a[ends[e]].Len <= c.Ref {
+			p := ends[e]
+			t.update(sort.SearchInts(qEnds, a[p].Query+a[p].Len), score[p], p)
+			e++
+		}
+		best, from := t.max(sort.SearchInts(qEnds, c.Query+1))
+		score[i], prev[i] = best+c.Len, from
+	}
+
+	last := 0
+	for i, s := range score {
+		if s > score[last] {
+			last = i
+		}
+	}
+	var chain []Anchor
+	for i := last; i >= 0; i = prev[i] {
+		chain = append(chain, a[i])
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain
+}
+
+type byRefEnd struct {
+	idx []int
+	a   []Anchor
+}
+
+func (e byRefEnd) Len() int { return len(e.idx) }
+func (e byRefEnd) Less(i, j int) bool {
+	return e.a[e.idx[i]].Ref+e.a[e.idx[i]].Len < e.a[e.idx[j]].Ref+e.a[e.idx[j]].Len
+}
+func (e byRefEnd) Swap(i, j int) { e.idx[i], e.idx[j] = e.idx[j], e.idx[i] }
+
+// fenwick is a Fenwick tree holding prefix maximum scores and the
+// index of the anchor with the maximum score.
+type fenwick struct {
+	score []int
+	idx   []int
+}
+
+func newFenwick(n int) fenwick {
+	t := fenwick{score: make([]int, n+1), idx: make([]int, n+1)}
+	for i := range t.idx {
+		t.idx[i] = -1
+	}
+	return t
+}
+
+// update records score s for anchor p at position i.
+func (t fenwick) update(i, s, p int) {
+	for i++; i < len(t.score); i += i & -i {
+		if s > t.score[i] || t.idx[i] < 0 {
+			t.score[i], t.idx[i] = s, p
+		}
+	}
+}
+
+// max returns the maximum score and its anchor for positions less than n,
+// or zero and -1 if no anchor has been recorded.
+func (t fenwick) max(n int) (score, idx int) {
+	idx = -1
+	for i := n; i > 0; i -= i & -i {
+		if t.idx[i] >= 0 && (idx < 0 || t.score[i] > score) {
+			score, idx = t.score[i], t.idx[i]
+		}
+	}
+	return score, idx
+}
+
+// Aligner is the anchored global aligner type. The gaps between chained anchors, and
+// before the first and after the last anchor, are aligned using the linear space
+// Needleman-Wunsch aligner, align.Hirschberg, with Matrix.
+type Aligner struct {
+	// K is the length of the k-mers
+	// used to find anchors.
+	K int
+
+	// MaxOcc is the maximum number of
+	// occurrences of a k-mer in the
+	// reference for it to be used to
+	// find anchors. If MaxOcc is zero,
+	// all k-mers are used.
+	MaxOcc int
+
+	Matrix align.Linear
+}
+
+// Align returns the anchored global alignment of query to reference. The sequences
+// must share a gapped nucleic acid alphabet. Align returns an error if the k-mer length
+// is not valid for a kmerindex.Index, the scoring matrix is not square, or the sequence
+// alphabets do not match.
+func (a Aligner) Align(reference, query *linear.Seq) ([]feat.Pair, error) {
+	anchors, err := Seeds(reference, query, a.K, a.MaxOcc)
+	if err != nil {
+		return nil, err
+	}
+	chain := Chain(anchors)
+
+	index := reference.Alpha.LetterIndex()
+	var (
+		aln  []feat.Pair
+		i, j int
+	)
+	for _, c := range append(chain, Anchor{Ref: reference.Len(), Query: query.Len()}) {
+		if i < c.Ref || j < c.Query {
+			sub, err := align.Hirschberg(a.Matrix).Align(
+				linear.NewSeq(reference.ID, reference.Seq[i:c.Ref], reference.Alpha),
+				linear.NewSeq(query.ID, query.Seq[j:c.Query], query.Alpha),
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range sub {
+				f := p.Features()
+				aln = appendPair(aln, &featPair{
+					a:     feature{start: f[0].Start() + i, end: f[0].End() + i, loc: reference},
+					b:     feature{start: f[1].Start() + j, end: f[1].End() + j, loc: query},
+					score: p.(interface{ Score() int }).Score(),
+				})
+			}
+		}
+		if c.Len == 0 {
+			break
+		}
+		var score int
+		for k := 0; k < c.Len; k++ {
+			score += a.Matrix[index[reference.Seq[c.Ref+k]]][index[query.Seq[c.Query+k]]]
+		}
+		aln = appendPair(aln, &featPair{
+			a:     feature{start: c.Ref, end: c.Ref + c.Len, loc: reference},
+			b:     feature{start: c.Query, end: c.Query + c.Len, loc: query},
+			score: score,
+		})
+		i, j = c.Ref+c.Len, c.Query+c.Len
+	}
+	return aln, nil
+}
+
+// appendPair appends p to aln, merging it with the last pair of aln if both
+// are aligned letters or both are gaps in the same sequence.
+func appendPair(aln []feat.Pair, p *featPair) []feat.Pair {
+	if len(aln) != 0 {
+		last := aln[len(aln)-1].(*featPair)
+		if (last.a.Len() == 0) == (p.a.Len() == 0) && (last.b.Len() == 0) == (p.b.Len() == 0) {
+			last.a.end, last.b.end = p.a.end, p.b.end
+			last.score += p.score
+			return aln
+		}
+	}
+	return append(aln, p)
+}
+
+type feature struct {
+	start, end int
+	loc        feat.Feature
+}
+
+func (f feature) Name() string {
+	if f.loc != nil {
+		return f.loc.Name()
+	}
+	return ""
+}
+func (f feature) Description() string {
+	if f.loc != nil {
+		return f.loc.Description()
+	}
+	return ""
+}
+func (f feature) Location() feat.Feature { return f.loc }
+func (f feature) Start() int             { return f.start }
+func (f feature) End() int               { return f.end }
+func (f feature) Len() int               { return f.end - f.start }
+
+type featPair struct {
+	a, b  feature
+	score int
+}
+
+func (fp *featPair) Features() [2]feat.Feature { return [2]feat.Feature{fp.a, fp.b} }
+func (fp *featPair) Score() int                { return fp.score }
+func (fp *featPair) Invert()                   { fp.a, fp.b = fp.b, fp.a }
+func (fp *featPair) String() string {
+	switch {
+	case fp.a.start == fp.a.end:
+		return fmt.Sprintf("-/%s[%d,%d)=%d",
+			fp.b.Name(), fp.b.start, fp.b.end,
+			fp.score)
+	case fp.b.start == fp.b.end:
+		return fmt.Sprintf("%s[%d,%d)/-=%d",
+			fp.a.Name(), fp.a.start, fp.a.end,
+			fp.score)
+	}
+	return fmt.Sprintf("%s[%d,%d)/%s[%d,%d)=%d",
+		fp.a.Name(), fp.a.start, fp.a.end,
+		fp.b.Name(), fp.b.start, fp.b.end,
+		fp.score)
+}
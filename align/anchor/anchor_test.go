@@ -0,0 +1,132 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package anchor
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestSeeds(c *check.C) {
+	ref := linear.NewSeq("ref", alphabet.Letters("ttttacgtacgaggccccc"), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.Letters("acgtacgagnaCGTACG"), alphabet.DNAgapped)
+	anchors, err := Seeds(ref, query, 6, 0)
+	c.Assert(err, check.Equals, nil)
+	c.Check(anchors, check.DeepEquals, []Anchor{
+		{Ref: 4, Query: 0, Len: 9},
+		{Ref: 4, Query: 10, Len: 7},
+	})
+
+	_, err = Seeds(ref, linear.NewSeq("protein", alphabet.Letters("acgtacgagnaCGTACG"), alphabet.Protein), 6, 0)
+	c.Check(err, check.Equals, align.ErrMismatchedAlphabets)
+}
+
+func (s *S) TestChain(c *check.C) {
+	for i, t := range []struct {
+		anchors []Anchor
+		want    []Anchor
+	}{
+		{anchors: nil, want: nil},
+		{
+			anchors: []Anchor{{Ref: 0, Query: 0, Len: 10}},
+			want:    []Anchor{{Ref: 0, Query: 0, Len: 10}},
+		},
+		{
+			// A long anchor out of order with two
+			// colinear anchors with a greater total.
+			anchors: []Anchor{
+				{Ref: 50, Query: 10, Len: 10},
+				{Ref: 0, Query: 0, Len: 10},
+				{Ref: 20, Query: 60, Len: 11},
+				{Ref: 30, Query: 20, Len: 12},
+			},
+			want: []Anchor{
+				{Ref: 0, Query: 0, Len: 10},
+				{Ref: 30, Query: 20, Len: 12},
+			},
+		},
+		{
+			// Overlapping anchors cannot be chained.
+			anchors: []Anchor{
+				{Ref: 0, Query: 0, Len: 10},
+				{Ref: 5, Query: 12, Len: 10},
+				{Ref: 12, Query: 5, Len: 10},
+				{Ref: 20, Query: 20, Len: 3},
+			},
+			want: []Anchor{
+				{Ref: 0, Query: 0, Len: 10},
+				{Ref: 20, Query: 20, Len: 3},
+			},
+		},
+	} {
+		c.Check(Chain(t.anchors), check.DeepEquals, t.want, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestAlign(c *check.C) {
+	m := align.Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 2, -3, -3, -3},
+		{-5, -3, 2, -3, -3},
+		{-5, -3, -3, 2, -3},
+		{-5, -3, -3, -3, 2},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	r := make(alphabet.Letters, 2000)
+	for i := range r {
+		r[i] = alphabet.Letter("acgt"[rnd.Intn(4)])
+	}
+
+	// The query has substitutions, an insertion
+	// and a deletion relative to the reference.
+	var q alphabet.Letters
+	q = append(q, r[:500]...)
+	q = append(q, alphabet.Letters("ggatcc")...)
+	q = append(q, r[500:1200]...)
+	q = append(q, r[1230:]...)
+	for _, p := range []int{100, 800, 1500} {
+		q[p] = map[alphabet.Letter]alphabet.Letter{'a': 'c', 'c': 'g', 'g': 't', 't': 'a'}[q[p]]
+	}
+	ref := linear.NewSeq("ref", r, alphabet.DNAgapped)
+	query := linear.NewSeq("query", q, alphabet.DNAgapped)
+
+	aln, err := Aligner{K: 12, Matrix: m}.Align(ref, query)
+	c.Assert(err, check.Equals, nil)
+	want, err := align.NW(m).Align(ref, query)
+	c.Assert(err, check.Equals, nil)
+	c.Check(totalScore(aln), check.Equals, totalScore(want))
+
+	// The alignment covers both sequences contiguously.
+	var i, j int
+	for _, p := range aln {
+		f := p.Features()
+		c.Check(f[0].Start(), check.Equals, i)
+		c.Check(f[1].Start(), check.Equals, j)
+		i, j = f[0].End(), f[1].End()
+	}
+	c.Check(i, check.Equals, ref.Len())
+	c.Check(j, check.Equals, query.Len())
+}
+
+func totalScore(aln []feat.Pair) int {
+	var s int
+	for _, p := range aln {
+		s += p.(interface{ Score() int }).Score()
+	}
+	return s
+}
@@ -0,0 +1,200 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// NWBanded is the linear gap penalty banded Needleman-Wunsch aligner type.
+// Only cells of the dynamic programming table within Width diagonals of the
+// expected diagonal are considered, so time and memory costs are proportional
+// to the length of the reference times the width of the band. The diagonal of
+// a cell is the query position minus the reference position, so Diagonal is
+// zero for sequences expected to align without an offset.
+type NWBanded struct {
+	Matrix   Linear
+	Diagonal int
+	Width    int
+}
+
+// SWBanded is the linear gap penalty banded Smith-Waterman aligner type. The
+// band is described as for NWBanded.
+type SWBanded struct {
+	Matrix   Linear
+	Diagonal int
+	Width    int
+}
+
+var (
+	_ Aligner = NWBanded{}
+	_ Aligner = SWBanded{}
+)
+
+// Align aligns two sequences using the Needleman-Wunsch algorithm restricted to the band of a. It
+// returns an alignment description or an error if the scoring matrix is not square, the sequence
+// data types or alphabets do not match, or the band does not include the start and end of both
+// sequences.
+func (a NWBanded) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	b, err := newBand(a.Matrix, a.Diagonal, a.Width, reference, query)
+	if err != nil {
+		return nil, err
+	}
+	if abs(b.d) > b.w || abs(len(b.q)-len(b.r)-b.d) > b.w {
+		return nil, ErrBandMissesEnds
+	}
+	b.fill(false)
+	return b.traceback(len(b.r), len(b.q), false), nil
+}
+
+// Align aligns two sequences using the Smith-Waterman algorithm restricted to the band of a. It
+// returns an alignment description or an error if the scoring matrix is not square, or the
+// sequence data types or alphabets do not match.
+func (a SWBanded) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	b, err := newBand(a.Matrix, a.Diagonal, a.Width, reference, query)
+	if err != nil {
+		return nil, err
+	}
+	maxI, maxJ := b.fill(true)
+	return b.traceback(maxI, maxJ, true), nil
+}
+
+// band is a banded dynamic programming table.
+type band struct {
+	la  []int
+	let int
+
+	r, q []int
+
+	// d and w are the diagonal and half width
+	// of the band, and t holds the cells of the
+	// band in rows of 2w+1 cells.
+	d, w int
+	t    []int
+}
+
+func newBand(m Linear, diagonal, width int, reference, query AlphabetSlicer) (*band, error) {
+	if width < 0 {
+		return nil, ErrBadBand
+	}
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(m, alpha)
+	if err != nil {
+		return nil, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return nil, err
+	}
+	return &band{
+		la:  la,
+		let: len(m),
+		r:   r,
+		q:   q,
+		d:   diagonal,
+		w:   width,
+		t:   make([]int, (len(r)+1)*(2*width+1)),
+	}, nil
+}
+
+// lo and hi return the first and last columns of row i within the band.
+func (b *band) lo(i int) int { return max2(0, i+b.d-b.w) }
+func (b *band) hi(i int) int {
+	if h := i + b.d + b.w; h < len(b.q) {
+		return h
+	}
+	return len(b.q)
+}
+
+// at returns the score of cell (i, j), or minInt if it is outside the band.
+func (b *band) at(i, j int) int {
+	if i < 0 || j < 0 || j < b.lo(i) || j > b.hi(i) {
+		return minInt
+	}
+	return b.t[i*(2*b.w+1)+j-(i+b.d-b.w)]
+}
+
+// fill fills the band, with local alignment scoring if local is true. It
+// returns the cell ending the highest scoring local alignment.
+func (b *band) fill(local bool) (maxI, maxJ int) {
+	let, la := b.let, b.la
+	var maxS int
+	for i := 0; i <= len(b.r); i++ {
+		for j := b.lo(i); j <= b.hi(i); j++ {
+			var score, diagScore int
+			switch {
+			case i == 0 && j == 0:
+			case local && (i == 0 || j == 0):
+			default:
+				diagScore = minInt
+				score = minInt
+				if i > 0 && j > 0 {
+					diagScore = add(b.at(i-1, j-1), la[b.r[i-1]*let+b.q[j-1]])
+					score = diagScore
+				}
+				if i > 0 {
+					score = max2(score, add(b.at(i-1, j), la[b.r[i-1]*let]))
+				}
+				if j > 0 {
+					score = max2(score, add(b.at(i, j-1), la[b.q[j-1]]))
+				}
+				if local {
+					if score > 0 {
+						if score >= maxS && score == diagScore {
+							maxS, maxI, maxJ = score, i, j
+						}
+					} else {
+						score = 0
+					}
+				}
+			}
+			b.t[i*(2*b.w+1)+j-(i+b.d-b.w)] = score
+		}
+	}
+	return maxI, maxJ
+}
+
+// traceback returns the alignment ending at cell (i, j). Global alignments
+// are traced to the origin and local alignments to a cell with a zero score.
+func (b *band) traceback(i, j int, local bool) []feat.Pair {
+	let, la := b.let, b.la
+	var ops []byte
+	for i > 0 || j > 0 {
+		s := b.at(i, j)
+		if local && (i == 0 || j == 0 || s == 0) {
+			break
+		}
+		switch {
+		case i > 0 && j > 0 && s == add(b.at(i-1, j-1), la[b.r[i-1]*let+b.q[j-1]]):
+			ops = append(ops, diag)
+			i--
+			j--
+		case i > 0 && s == add(b.at(i-1, j), la[b.r[i-1]*let]):
+			ops = append(ops, up)
+			i--
+		default:
+			ops = append(ops, left)
+			j--
+		}
+	}
+	for l, r := 0, len(ops)-1; l < r; l, r = l+1, r-1 {
+		ops[l], ops[r] = ops[r], ops[l]
+	}
+	aln := opsPairs(ops, b.r, b.q, i, j, la, let)
+	if len(aln) == 0 {
+		aln = append(aln, &featPair{a: feature{start: i, end: i}, b: feature{start: j, end: j}})
+	}
+	return aln
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestBanded(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+
+	// A band covering the whole table gives the unbanded alignment.
+	for i := 0; i < 100; i++ {
+		a, b := randSeq(1+rnd.Intn(40)), randSeq(1+rnd.Intn(40))
+		want, err := NW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		got, err := NWBanded{Matrix: m, Width: 40}.Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want), check.Commentf("Test %d", i))
+
+		want, err = SW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		got, err = SWBanded{Matrix: m, Width: 40}.Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want), check.Commentf("Test %d", i))
+	}
+
+	// A narrow band around the expected diagonal.
+	ref := randSeq(200)
+	query := linear.NewSeq("", append(alphabet.Letters("GG"), ref.Seq[:100]...), alphabet.DNAgapped)
+	query.Seq = append(query.Seq, ref.Seq[101:]...)
+	aln, err := NWBanded{Matrix: m, Diagonal: 2, Width: 3}.Align(ref, query)
+	c.Assert(err, check.Equals, nil)
+	fa := Format(ref, query, aln, '-')
+	c.Check(fa[0].Len(), check.Equals, 202)
+	c.Check(totalScore(aln) > 0, check.Equals, true)
+
+	_, err = NWBanded{Matrix: m, Diagonal: 10, Width: 3}.Align(ref, query)
+	c.Check(err, check.Equals, ErrBandMissesEnds)
+	_, err = SWBanded{Matrix: m, Width: -1}.Align(ref, query)
+	c.Check(err, check.Equals, ErrBadBand)
+
+	local, err := SWBanded{Matrix: m, Diagonal: 2, Width: 3}.Align(ref, query)
+	c.Assert(err, check.Equals, nil)
+	f := local[0].Features()
+	c.Check(f[1].Start()-f[0].Start(), check.Equals, 2)
+}
@@ -0,0 +1,64 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/concurrent"
+	"github.com/biogo/biogo/feat"
+
+	"runtime"
+)
+
+// A SeqPair is a pair of sequences to be aligned.
+type SeqPair struct {
+	Reference, Query AlphabetSlicer
+}
+
+// A Result is the result of the alignment of a SeqPair.
+type Result struct {
+	Alignment []feat.Pair
+	Err       error
+}
+
+// AlignAll aligns each of the pairs using aligner, distributing the alignments over
+// workers goroutines using concurrent.Map. If workers is less than one or greater than
+// GOMAXPROCS, GOMAXPROCS goroutines are used. The results are returned in the order of
+// pairs, with errors returned by aligner held in the Err field of each result. The aligner
+// must be safe for concurrent use; the aligner types provided by this package are. AlignAll
+// returns an error if the concurrent processing fails, for example if aligner panics, in
+// which case the error includes the cause of the panic.
+func AlignAll(pairs []SeqPair, aligner Aligner, workers int) ([]Result, error) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	b := batch{pairs: pairs, results: make([]Result, len(pairs)), aligner: aligner}
+	if len(pairs) == 0 {
+		return b.results, nil
+	}
+	_, err := concurrent.Map(b, workers, 1)
+	if err != nil {
+		return nil, err
+	}
+	return b.results, nil
+}
+
+// batch is a concurrent.Mapper that aligns pairs, storing the results in
+// the corresponding elements of results.
+type batch struct {
+	pairs   []SeqPair
+	results []Result
+	aligner Aligner
+}
+
+func (b batch) Slice(i, j int) concurrent.Mapper {
+	return batch{pairs: b.pairs[i:j], results: b.results[i:j], aligner: b.aligner}
+}
+func (b batch) Len() int { return len(b.pairs) }
+func (b batch) Operation() (interface{}, error) {
+	for i, p := range b.pairs {
+		b.results[i].Alignment, b.results[i].Err = b.aligner.Align(p.Reference, p.Query)
+	}
+	return nil, nil
+}
@@ -0,0 +1,101 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"time"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestAlignAll(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+	var pairs []SeqPair
+	for i := 0; i < 50; i++ {
+		pairs = append(pairs, SeqPair{Reference: randSeq(1 + rnd.Intn(60)), Query: randSeq(1 + rnd.Intn(60))})
+	}
+	// A pair with mismatched alphabets fails.
+	pairs = append(pairs, SeqPair{
+		Reference: randSeq(10),
+		Query:     linear.NewSeq("", alphabet.Letters("ACGT"), alphabet.Protein),
+	})
+
+	for _, workers := range []int{0, 1, 4} {
+		results, err := AlignAll(pairs, NW(m), workers)
+		c.Assert(err, check.Equals, nil)
+		c.Assert(results, check.HasLen, len(pairs))
+		for i, p := range pairs {
+			want, wantErr := NW(m).Align(p.Reference, p.Query)
+			c.Check(results[i].Err, check.Equals, wantErr, check.Commentf("Test %d workers %d", i, workers))
+			c.Check(fmt.Sprint(results[i].Alignment), check.Equals, fmt.Sprint(want), check.Commentf("Test %d workers %d", i, workers))
+		}
+	}
+
+	results, err := AlignAll(nil, NW(m), 2)
+	c.Check(err, check.Equals, nil)
+	c.Check(results, check.HasLen, 0)
+
+	// A panicking aligner is reported with the cause of the panic.
+	_, err = AlignAll(pairs, panicAligner{}, 4)
+	c.Assert(err, check.Not(check.Equals), nil)
+	c.Check(strings.Contains(err.Error(), "panic: boom"), check.Equals, true, check.Commentf("%v", err))
+}
+
+type panicAligner struct{}
+
+func (panicAligner) Align(_, _ AlphabetSlicer) ([]feat.Pair, error) { panic("boom") }
+
+func (s *S) TestAlignAllGoroutines(c *check.C) {
+	m := Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 1, -1, -1, -1},
+		{-1, -1, 1, -1, -1},
+		{-1, -1, -1, 1, -1},
+		{-1, -1, -1, -1, 1},
+	}
+	pairs := []SeqPair{
+		{
+			Reference: linear.NewSeq("", alphabet.BytesToLetters([]byte("ACGTACGT")), alphabet.DNAgapped),
+			Query:     linear.NewSeq("", alphabet.BytesToLetters([]byte("ACGACGT")), alphabet.DNAgapped),
+		},
+	}
+	pairs = append(pairs, pairs[0], pairs[0], pairs[0], pairs[0])
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		_, err := AlignAll(pairs, NW(m), 4)
+		c.Assert(err, check.Equals, nil)
+		_, err = AlignAll(pairs, panicAligner{}, 4)
+		c.Assert(err, check.Not(check.Equals), nil)
+	}
+	// Allow exiting goroutines to be
+	// removed from the scheduler.
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	c.Check(runtime.NumGoroutine() <= before, check.Equals, true, check.Commentf("before %d after %d", before, runtime.NumGoroutine()))
+}
@@ -0,0 +1,109 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// A Buffer holds the dynamic programming tables of an alignment so they can
+// be reused by later alignments. The tables grow to fit the largest alignment
+// performed. A nil *Buffer allocates new tables for each alignment.
+type Buffer struct {
+	la     []int
+	table  []int
+	atable [][3]int
+}
+
+// scores returns an empty slice with capacity for n scoring matrix values.
+func (b *Buffer) scores(n int) []int {
+	if b == nil {
+		return make([]int, 0, n)
+	}
+	if cap(b.la) < n {
+		b.la = make([]int, 0, n)
+	}
+	return b.la[:0]
+}
+
+// linear returns a zeroed table of n cells.
+func (b *Buffer) linear(n int) []int {
+	if b == nil {
+		return make([]int, n)
+	}
+	if cap(b.table) < n {
+		b.table = make([]int, n)
+		return b.table
+	}
+	t := b.table[:n]
+	for i := range t {
+		t[i] = 0
+	}
+	return t
+}
+
+// affine returns a zeroed table of n three layer cells.
+func (b *Buffer) affine(n int) [][3]int {
+	if b == nil {
+		return make([][3]int, n)
+	}
+	if cap(b.atable) < n {
+		b.atable = make([][3]int, n)
+		return b.atable
+	}
+	t := b.atable[:n]
+	for i := range t {
+		t[i] = [3]int{}
+	}
+	return t
+}
+
+// bufferedAligner is an aligner that can perform its alignment using
+// the tables held by a Buffer.
+type bufferedAligner interface {
+	alignBuffered(reference, query AlphabetSlicer, tr Tracer, buf *Buffer) ([]feat.Pair, error)
+}
+
+var (
+	_ bufferedAligner = NW{}
+	_ bufferedAligner = SW{}
+	_ bufferedAligner = Fitted{}
+	_ bufferedAligner = NWAffine{}
+	_ bufferedAligner = SWAffine{}
+	_ bufferedAligner = FittedAffine{}
+)
+
+// Reusable is an Aligner that retains its dynamic programming tables between
+// calls to Align, so repeated alignments do not allocate a new table for each
+// pair of sequences. A Reusable is not safe for concurrent use; concurrent
+// alignment should use a Reusable for each goroutine.
+type Reusable struct {
+	a   bufferedAligner
+	buf Buffer
+}
+
+var _ Aligner = (*Reusable)(nil)
+
+// NewReusable returns a Reusable that aligns using a, which must be an NW, SW,
+// Fitted, NWAffine, SWAffine or FittedAffine aligner.
+func NewReusable(a Aligner) (*Reusable, error) {
+	ba, ok := a.(bufferedAligner)
+	if !ok {
+		return nil, ErrNotReusable
+	}
+	return &Reusable{a: ba}, nil
+}
+
+// Align aligns two sequences using the aligner of r. It returns an alignment description or an
+// error as described for the aligner.
+func (r *Reusable) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	return r.a.alignBuffered(reference, query, nil, &r.buf)
+}
+
+// AlignTrace performs the alignment of Align, reporting the progress of the dynamic programming
+// to tr if it is not nil.
+func (r *Reusable) AlignTrace(reference, query AlphabetSlicer, tr Tracer) ([]feat.Pair, error) {
+	return r.a.alignBuffered(reference, query, tr, &r.buf)
+}
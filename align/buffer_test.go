@@ -0,0 +1,57 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestReusable(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+
+	for _, a := range []Aligner{
+		NW(m), SW(m), Fitted(m),
+		NWAffine{Matrix: m, GapOpen: -3},
+		SWAffine{Matrix: m, GapOpen: -3},
+		FittedAffine{Matrix: m, GapOpen: -3},
+	} {
+		r, err := NewReusable(a)
+		c.Assert(err, check.Equals, nil)
+
+		// Alignments of varying size must not
+		// see the contents of earlier tables.
+		for i := 0; i < 50; i++ {
+			x, y := randSeq(1+rnd.Intn(40)), randSeq(1+rnd.Intn(40))
+			want, err := a.Align(x, y)
+			c.Assert(err, check.Equals, nil)
+			got, err := r.Align(x, y)
+			c.Assert(err, check.Equals, nil)
+			c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want), check.Commentf("%T Test %d", a, i))
+		}
+	}
+
+	_, err := NewReusable(Hirschberg(m))
+	c.Check(err, check.Equals, ErrNotReusable)
+}
@@ -0,0 +1,240 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"errors"
+	"strconv"
+)
+
+var (
+	ErrBadCigar      = errors.New("align: invalid CIGAR string")
+	ErrDiscontiguous = errors.New("align: alignment is not contiguous")
+)
+
+// A CigarOpType is the type of a CIGAR operation, represented by its SAM character.
+type CigarOpType byte
+
+// CIGAR operation types.
+const (
+	CigarMatch       CigarOpType = 'M' // Alignment match, either a sequence match or mismatch.
+	CigarInsertion   CigarOpType = 'I' // Insertion to the reference.
+	CigarDeletion    CigarOpType = 'D' // Deletion from the reference.
+	CigarSkipped     CigarOpType = 'N' // Skipped region of the reference.
+	CigarSoftClipped CigarOpType = 'S' // Soft clipping, clipped letters present in the query.
+	CigarHardClipped CigarOpType = 'H' // Hard clipping, clipped letters not present in the query.
+	CigarPadded      CigarOpType = 'P' // Silent deletion from a padded reference.
+	CigarEqual       CigarOpType = '=' // Sequence match.
+	CigarMismatch    CigarOpType = 'X' // Sequence mismatch.
+)
+
+// consumes returns whether operations of type t consume reference and query letters.
+func (t CigarOpType) consumes() (ref, query bool, ok bool) {
+	switch t {
+	case CigarMatch, CigarEqual, CigarMismatch:
+		return true, true, true
+	case CigarInsertion, CigarSoftClipped:
+		return false, true, true
+	case CigarDeletion, CigarSkipped:
+		return true, false, true
+	case CigarHardClipped, CigarPadded:
+		return false, false, true
+	}
+	return false, false, false
+}
+
+// A CigarOp is a single CIGAR operation.
+type CigarOp struct {
+	Type CigarOpType
+	Len  int
+}
+
+// A Cigar is a SAM CIGAR describing an alignment of a query to a reference.
+type Cigar []CigarOp
+
+// String returns the SAM representation of c, or "*" if c is empty.
+func (c Cigar) String() string {
+	if len(c) == 0 {
+		return "*"
+	}
+	var buf bytes.Buffer
+	for _, op := range c {
+		buf.WriteString(strconv.Itoa(op.Len))
+		buf.WriteByte(byte(op.Type))
+	}
+	return buf.String()
+}
+
+// ParseCigar returns the Cigar represented by the SAM CIGAR string s. The string
+// "*" represents an empty Cigar.
+func ParseCigar(s string) (Cigar, error) {
+	if s == "*" {
+		return nil, nil
+	}
+	var c Cigar
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && '0' <= s[j] && s[j] <= '9' {
+			j++
+		}
+		if j == i || j == len(s) {
+			return nil, ErrBadCigar
+		}
+		n, err := strconv.Atoi(s[i:j])
+		if err != nil || n == 0 {
+			return nil, ErrBadCigar
+		}
+		t := CigarOpType(s[j])
+		if _, _, ok := t.consumes(); !ok {
+			return nil, ErrBadCigar
+		}
+		c = append(c, CigarOp{Type: t, Len: n})
+		i = j + 1
+	}
+	if len(c) == 0 {
+		return nil, ErrBadCigar
+	}
+	return c, nil
+}
+
+// add appends an operation to c, merging it with the last operation if
+// they have the same type.
+func (c Cigar) add(t CigarOpType, n int) Cigar {
+	if n == 0 {
+		return c
+	}
+	if len(c) != 0 && c[len(c)-1].Type == t {
+		c[len(c)-1].Len += n
+		return c
+	}
+	return append(c, CigarOp{Type: t, Len: n})
+}
+
+// NewCigar returns the Cigar of the alignment aln, as returned by an Aligner with the
+// reference as the first feature of each pair, of a query of length qLen. Query letters
+// before or after the alignment are soft clipped. NewCigar returns an error if the
+// alignment is not contiguous.
+func NewCigar(aln []feat.Pair, qLen int) (Cigar, error) {
+	return cigar(aln, qLen, nil)
+}
+
+// NewExtendedCigar returns the Cigar of the alignment aln of query to reference,
+// distinguishing matching and mismatching aligned letters with the '=' and 'X'
+// operations. Letters are compared without regard to case. NewExtendedCigar returns
+// an error if the alignment is not contiguous or the sequence data types are not
+// handled.
+func NewExtendedCigar(aln []feat.Pair, reference, query AlphabetSlicer) (Cigar, error) {
+	r, err := lettersOf(reference)
+	if err != nil {
+		return nil, err
+	}
+	q, err := lettersOf(query)
+	if err != nil {
+		return nil, err
+	}
+	return cigar(aln, len(q), func(i, j int) bool { return upperLetter(r[i]) == upperLetter(q[j]) })
+}
+
+func cigar(aln []feat.Pair, qLen int, match func(i, j int) bool) (Cigar, error) {
+	if len(aln) == 0 {
+		return nil, nil
+	}
+	var c Cigar
+	first := aln[0].Features()
+	rEnd, qEnd := first[0].Start(), first[1].Start()
+	c = c.add(CigarSoftClipped, qEnd)
+	for _, p := range aln {
+		f := p.Features()
+		if f[0].Start() != rEnd || f[1].Start() != qEnd {
+			return nil, ErrDiscontiguous
+		}
+		switch {
+		case f[0].Len() == 0:
+			c = c.add(CigarInsertion, f[1].Len())
+		case f[1].Len() == 0:
+			c = c.add(CigarDeletion, f[0].Len())
+		case match == nil:
+			c = c.add(CigarMatch, f[0].Len())
+		default:
+			for i, j := f[0].Start(), f[1].Start(); i < f[0].End(); i, j = i+1, j+1 {
+				if match(i, j) {
+					c = c.add(CigarEqual, 1)
+				} else {
+					c = c.add(CigarMismatch, 1)
+				}
+			}
+		}
+		rEnd, qEnd = f[0].End(), f[1].End()
+	}
+	return c.add(CigarSoftClipped, qLen-qEnd), nil
+}
+
+// lettersOf returns the letters of s.
+func lettersOf(s AlphabetSlicer) (alphabet.Letters, error) {
+	switch l := s.Slice().(type) {
+	case alphabet.Letters:
+		return l, nil
+	case alphabet.QLetters:
+		return qLetters(l), nil
+	}
+	return nil, ErrTypeNotHandled
+}
+
+// Pairs returns the alignment described by c as feature pairs for an alignment starting
+// at position refStart of the reference. Skipped regions of the reference separate
+// pairs without a pair of their own, and clipping and padding operations give no pairs.
+// The scores of the pairs are zero.
+func (c Cigar) Pairs(refStart int) []feat.Pair {
+	var (
+		aln  []feat.Pair
+		i, j = refStart, 0
+	)
+	for _, op := range c {
+		ref, query, _ := op.Type.consumes()
+		si, sj := i, j
+		if ref {
+			i += op.Len
+		}
+		if query {
+			j += op.Len
+		}
+		switch op.Type {
+		case CigarSoftClipped, CigarSkipped, CigarHardClipped, CigarPadded:
+			continue
+		}
+		aln = append(aln, &featPair{
+			a: feature{start: si, end: i},
+			b: feature{start: sj, end: j},
+		})
+	}
+	return aln
+}
+
+// RefLen returns the number of reference letters spanned by c.
+func (c Cigar) RefLen() int {
+	var n int
+	for _, op := range c {
+		if ref, _, _ := op.Type.consumes(); ref {
+			n += op.Len
+		}
+	}
+	return n
+}
+
+// QueryLen returns the number of query letters described by c, including soft
+// clipped letters.
+func (c Cigar) QueryLen() int {
+	var n int
+	for _, op := range c {
+		if _, query, _ := op.Type.consumes(); query {
+			n += op.Len
+		}
+	}
+	return n
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCigar(c *check.C) {
+	m := Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 2, -1, -1, -1},
+		{-1, -1, 2, -1, -1},
+		{-1, -1, -1, 2, -1},
+		{-1, -1, -1, -1, 2},
+	}
+	seq := func(s string) *linear.Seq {
+		return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.DNAgapped)
+	}
+	for i, t := range []struct {
+		ref, query string
+		local      bool
+		cigar      string
+		extended   string
+	}{
+		{ref: "ACGTACGT", query: "ACGTACGT", cigar: "8M", extended: "8="},
+		{ref: "ACGTACGT", query: "ACGAACGT", cigar: "8M", extended: "3=1X4="},
+		{ref: "ACGTCCACGT", query: "ACGTACGT", cigar: "4M2D4M", extended: "4=2D4="},
+		{ref: "ACGTACGT", query: "ACGTGGACGT", cigar: "4M2I4M", extended: "4=2I4="},
+		{ref: "ACGTACGT", query: "TTTTACGTACGTTT", local: true, cigar: "4S8M2S", extended: "4S8=2S"},
+	} {
+		var a Aligner = NW(m)
+		if t.local {
+			a = SW(m)
+		}
+		r, q := seq(t.ref), seq(t.query)
+		aln, err := a.Align(r, q)
+		c.Assert(err, check.Equals, nil)
+
+		cig, err := NewCigar(aln, q.Len())
+		c.Assert(err, check.Equals, nil)
+		c.Check(cig.String(), check.Equals, t.cigar, check.Commentf("Test %d", i))
+		c.Check(cig.QueryLen(), check.Equals, q.Len(), check.Commentf("Test %d", i))
+		ext, err := NewExtendedCigar(aln, r, q)
+		c.Assert(err, check.Equals, nil)
+		c.Check(ext.String(), check.Equals, t.extended, check.Commentf("Test %d", i))
+
+		p, err := ParseCigar(t.extended)
+		c.Assert(err, check.Equals, nil)
+		c.Check(p, check.DeepEquals, ext, check.Commentf("Test %d", i))
+
+		// The pairs of a CIGAR follow the alignment.
+		start := aln[0].Features()[0].Start()
+		c.Check(p.RefLen(), check.Equals, aln[len(aln)-1].Features()[0].End()-start, check.Commentf("Test %d", i))
+		back, err := NewCigar(p.Pairs(start), q.Len())
+		c.Assert(err, check.Equals, nil)
+		c.Check(back, check.DeepEquals, cig, check.Commentf("Test %d", i))
+	}
+
+	c.Check(fmt.Sprint(Cigar{{CigarMatch, 3}, {CigarSkipped, 10}, {CigarMatch, 2}}.Pairs(5)), check.Equals,
+		"[[5,8)/[0,3)=0 [18,20)/[3,5)=0]")
+	c.Check(Cigar(nil).String(), check.Equals, "*")
+	for _, bad := range []string{"", "M", "3", "3Q", "0M", "3M4"} {
+		_, err := ParseCigar(bad)
+		c.Check(err, check.Equals, ErrBadCigar, check.Commentf("CIGAR %q", bad))
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/codon"
+
+	"errors"
+)
+
+var ErrNotCodons = errors.New("align: sequence length not a multiple of three")
+
+// A Codon is a codon alignment description. Coding nucleotide sequences are translated
+// with the genetic code and aligned in codon space, scoring each aligned pair of codons
+// by the Matrix score of their amino acids and each codon aligned to a gap by the gap
+// penalty of its amino acid, so that gaps always span whole codons. Matrix is indexed
+// by alphabet.Protein letter index, as are the matrices of the align/matrix package,
+// with the first column and first row specifying gap penalties. Stop codons are scored
+// as '*' and codons that cannot be translated as 'X'. If Code is nil, the standard
+// genetic code is used.
+type Codon struct {
+	Matrix Linear
+	Code   *codon.Code
+}
+
+// NWCodon is the linear gap penalty Needleman-Wunsch codon aligner type.
+type NWCodon Codon
+
+// Align aligns two coding nucleotide sequences in codon space using the Needleman-Wunsch
+// algorithm. The returned alignment description is in nucleotide coordinates. Align returns
+// an error if the scoring matrix is not square, a sequence length is not a multiple of three,
+// or the sequence data types or alphabets do not match.
+func (a NWCodon) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	s, err := Codon(a).scorer(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return nucleotidePairs(s.global()), nil
+}
+
+// SWCodon is the linear gap penalty Smith-Waterman codon aligner type.
+type SWCodon Codon
+
+// Align aligns two coding nucleotide sequences in codon space using the Smith-Waterman
+// algorithm. The returned alignment description is in nucleotide coordinates and is empty
+// if no alignment has a positive score. Align returns an error if the scoring matrix is not
+// square, a sequence length is not a multiple of three, or the sequence data types or alphabets
+// do not match.
+func (a SWCodon) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	s, err := Codon(a).scorer(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return nucleotidePairs(s.local()), nil
+}
+
+// scorer returns the scorer for the alignment of the codons of reference
+// and query.
+func (a Codon) scorer(reference, query AlphabetSlicer) (scorer, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return scorer{}, err
+	}
+	la, err := flatten(a.Matrix, alphabet.Protein)
+	if err != nil {
+		return scorer{}, err
+	}
+	code := a.Code
+	if code == nil {
+		code = codon.Standard
+	}
+	var r, q []int
+	switch rSeq := reference.Slice().(type) {
+	case alphabet.Letters:
+		qSeq, ok := query.Slice().(alphabet.Letters)
+		if !ok {
+			return scorer{}, ErrMismatchedTypes
+		}
+		r, err = aminoIndexes(rSeq, code, alpha.Gap())
+		if err != nil {
+			return scorer{}, err
+		}
+		q, err = aminoIndexes(qSeq, code, alpha.Gap())
+	case alphabet.QLetters:
+		qSeq, ok := query.Slice().(alphabet.QLetters)
+		if !ok {
+			return scorer{}, ErrMismatchedTypes
+		}
+		r, err = aminoIndexes(qLetters(rSeq), code, alpha.Gap())
+		if err != nil {
+			return scorer{}, err
+		}
+		q, err = aminoIndexes(qLetters(qSeq), code, alpha.Gap())
+	default:
+		return scorer{}, ErrTypeNotHandled
+	}
+	if err != nil {
+		return scorer{}, err
+	}
+
+	let := len(a.Matrix)
+	return scorer{
+		rLen:  len(r),
+		qLen:  len(q),
+		match: func(i, j int) int { return la[r[i]*let+q[j]] },
+		del:   func(i int) int { return la[r[i]*let] },
+		ins:   func(j int) int { return la[q[j]] },
+	}, nil
+}
+
+// qLetters returns the letters of s without their qualities.
+func qLetters(s alphabet.QLetters) alphabet.Letters {
+	l := make(alphabet.Letters, len(s))
+	for i, ql := range s {
+		l[i] = ql.L
+	}
+	return l
+}
+
+// aminoIndexes returns the alphabet.Protein indexes of the amino acids encoded by s.
+func aminoIndexes(s alphabet.Letters, code *codon.Code, gap alphabet.Letter) ([]int, error) {
+	if len(s)%3 != 0 {
+		return nil, ErrNotCodons
+	}
+	index := alphabet.Protein.LetterIndex()
+	aa := code.Translate(s, gap)
+	idx := make([]int, len(aa))
+	for i, l := range aa {
+		if idx[i] = index[l]; idx[i] < 0 {
+			idx[i] = index['X']
+		}
+	}
+	return idx, nil
+}
+
+// nucleotidePairs converts the codon coordinates of the feature pairs
+// in aln to nucleotide coordinates.
+func nucleotidePairs(aln []feat.Pair) []feat.Pair {
+	for _, p := range aln {
+		fp := p.(*featPair)
+		fp.a.start *= 3
+		fp.a.end *= 3
+		fp.b.start *= 3
+		fp.b.end *= 3
+	}
+	return aln
+}
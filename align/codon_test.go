@@ -0,0 +1,51 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/align/matrix"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCodon(c *check.C) {
+	m := Codon{Matrix: matrix.WithGap(matrix.BLOSUM62, alphabet.Protein, -8)}
+	seq := func(s string) *linear.Seq {
+		return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.DNAgapped)
+	}
+
+	for i, t := range []struct {
+		ref, query string
+		global     string
+		local      string
+	}{
+		{
+			// M K W: synonymous changes score as identities.
+			ref: "ATGAAATGG", query: "ATGAAGTGG",
+			global: "[[0,9)/[0,9)=21]",
+			local:  "[[0,9)/[0,9)=21]",
+		},
+		{
+			// A deleted codon gives a gap of three bases.
+			ref: "ATGAAATGGTGT", query: "ATGTGGTGT",
+			global: "[[0,3)/[0,3)=5 [3,6)/-=-8 [6,12)/[3,9)=20]",
+			local:  "[[6,12)/[3,9)=20]",
+		},
+	} {
+		aln, err := NWCodon(m).Align(seq(t.ref), seq(t.query))
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(aln), check.Equals, t.global, check.Commentf("Test %d", i))
+		aln, err = SWCodon(m).Align(seq(t.ref), seq(t.query))
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(aln), check.Equals, t.local, check.Commentf("Test %d", i))
+	}
+
+	_, err := NWCodon(m).Align(seq("ATGA"), seq("ATG"))
+	c.Check(err, check.Equals, ErrNotCodons)
+}
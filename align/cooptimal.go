@@ -0,0 +1,160 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// Cooptimal returns the optimal global alignments of reference and query. Where Align chooses
+// a single traceback path when more than one move gives the optimal score, Cooptimal follows
+// every such move, so the number and diversity of the returned alignments describe the ambiguity
+// of the optimal alignment. At most limit alignments are returned; if limit is less than one, all
+// optimal alignments are returned. Alignments are returned in order of preference for matches,
+// then insertions then deletions in traceback, so the first alignment follows the same path as
+// Align. Cooptimal returns an error if the scoring matrix is not square, or the sequence data types
+// or alphabets do not match.
+//
+// The number of optimal alignments may be exponential in the length of the sequences.
+func (a NW) Cooptimal(reference, query AlphabetSlicer, limit int) ([][]feat.Pair, error) {
+	t, err := newCooptimal(Linear(a), reference, query)
+	if err != nil {
+		return nil, err
+	}
+	c := t.c
+	for j := 1; j < c; j++ {
+		t.table[j] = t.table[j-1] + t.la[t.q[j-1]]
+	}
+	for i := 1; i <= len(t.r); i++ {
+		t.table[i*c] = t.table[(i-1)*c] + t.la[t.r[i-1]*t.let]
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			t.table[p] = max3(t.diagScore(p, i, j), t.upScore(p, i), t.leftScore(p, j))
+		}
+	}
+	t.limit = limit
+	t.walk(len(t.r), len(t.q), nil)
+	return t.alns, nil
+}
+
+// Cooptimal returns the optimal local alignments of reference and query. Where Align chooses a
+// single traceback path and end point when more than one gives the optimal score, Cooptimal follows
+// every such path from every optimal end point, so the number and diversity of the returned
+// alignments describe the ambiguity of the optimal alignment. At most limit alignments are returned;
+// if limit is less than one, all optimal alignments are returned. Alignments are returned in order of
+// preference for later end points and then for matches, insertions and deletions in traceback, so the
+// first alignment is the alignment returned by Align. Cooptimal returns an error if the scoring matrix
+// is not square, or the sequence data types or alphabets do not match.
+//
+// The number of optimal alignments may be exponential in the length of the sequences.
+func (a SW) Cooptimal(reference, query AlphabetSlicer, limit int) ([][]feat.Pair, error) {
+	t, err := newCooptimal(Linear(a), reference, query)
+	if err != nil {
+		return nil, err
+	}
+	c := t.c
+	var (
+		maxS int
+		ends []int
+	)
+	for i := 1; i <= len(t.r); i++ {
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			diagScore := t.diagScore(p, i, j)
+			score := max3(diagScore, t.upScore(p, i), t.leftScore(p, j))
+			if score <= 0 {
+				continue
+			}
+			t.table[p] = score
+			if score == diagScore {
+				switch {
+				case score > maxS:
+					maxS = score
+					ends = append(ends[:0], p)
+				case score == maxS:
+					ends = append(ends, p)
+				}
+			}
+		}
+	}
+	t.local = true
+	t.limit = limit
+	for k := len(ends) - 1; k >= 0 && !t.full(); k-- {
+		t.walk(ends[k]/c, ends[k]%c, nil)
+	}
+	return t.alns, nil
+}
+
+// cooptimal holds the state of an enumeration of co-optimal alignments.
+type cooptimal struct {
+	la  []int
+	let int
+
+	r, q  []int
+	c     int
+	table []int
+
+	// local specifies that traceback
+	// ends at a zero scoring cell.
+	local bool
+
+	limit int
+	alns  [][]feat.Pair
+}
+
+func newCooptimal(m Linear, reference, query AlphabetSlicer) (*cooptimal, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(m, alpha)
+	if err != nil {
+		return nil, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return nil, err
+	}
+	return &cooptimal{
+		la:    la,
+		let:   len(m),
+		r:     r,
+		q:     q,
+		c:     len(q) + 1,
+		table: make([]int, (len(r)+1)*(len(q)+1)),
+	}, nil
+}
+
+func (t *cooptimal) diagScore(p, i, j int) int {
+	return t.table[p-t.c-1] + t.la[t.r[i-1]*t.let+t.q[j-1]]
+}
+func (t *cooptimal) upScore(p, i int) int   { return t.table[p-t.c] + t.la[t.r[i-1]*t.let] }
+func (t *cooptimal) leftScore(p, j int) int { return t.table[p-1] + t.la[t.q[j-1]] }
+
+// full returns whether the limit on the number of alignments has been reached.
+func (t *cooptimal) full() bool { return t.limit > 0 && len(t.alns) >= t.limit }
+
+// walk follows every optimal traceback path from cell (i, j), where ops holds
+// the reversed operations of the path from its end to (i, j).
+func (t *cooptimal) walk(i, j int, ops []byte) {
+	p := i*t.c + j
+	if (t.local && t.table[p] == 0) || (i == 0 && j == 0) {
+		fwd := make([]byte, len(ops))
+		for k, op := range ops {
+			fwd[len(ops)-1-k] = op
+		}
+		t.alns = append(t.alns, opsPairs(fwd, t.r, t.q, i, j, t.la, t.let))
+		return
+	}
+	if i > 0 && j > 0 && t.table[p] == t.diagScore(p, i, j) {
+		t.walk(i-1, j-1, append(ops, diag))
+	}
+	if i > 0 && !t.full() && t.table[p] == t.upScore(p, i) {
+		t.walk(i-1, j, append(ops, up))
+	}
+	if j > 0 && !t.full() && t.table[p] == t.leftScore(p, j) {
+		t.walk(i, j-1, append(ops, left))
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCooptimal(c *check.C) {
+	m := Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 1, -1, -1, -1},
+		{-1, -1, 1, -1, -1},
+		{-1, -1, -1, 1, -1},
+		{-1, -1, -1, -1, 1},
+	}
+	seq := func(s string) *linear.Seq {
+		return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.DNAgapped)
+	}
+
+	for i, t := range []struct {
+		a, b   string
+		limit  int
+		global []string
+		local  []string
+	}{
+		{
+			a: "AA", b: "A",
+			global: []string{"[[0,1)/-=-1 [1,2)/[0,1)=1]", "[[0,1)/[0,1)=1 [1,2)/-=-1]"},
+			local:  []string{"[[1,2)/[0,1)=1]", "[[0,1)/[0,1)=1]"},
+		},
+		{
+			a: "AA", b: "A", limit: 1,
+			global: []string{"[[0,1)/-=-1 [1,2)/[0,1)=1]"},
+			local:  []string{"[[1,2)/[0,1)=1]"},
+		},
+		{
+			a: "ACGT", b: "ACGT",
+			global: []string{"[[0,4)/[0,4)=4]"},
+			local:  []string{"[[0,4)/[0,4)=4]"},
+		},
+	} {
+		got, err := NW(m).Cooptimal(seq(t.a), seq(t.b), t.limit)
+		c.Assert(err, check.Equals, nil)
+		c.Check(alnStrings(got), check.DeepEquals, t.global, check.Commentf("Test %d", i))
+		got, err = SW(m).Cooptimal(seq(t.a), seq(t.b), t.limit)
+		c.Assert(err, check.Equals, nil)
+		c.Check(alnStrings(got), check.DeepEquals, t.local, check.Commentf("Test %d", i))
+	}
+
+	// All co-optimal alignments are distinct and have the
+	// optimal score, and the first follows the path of Align.
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return seq(string(b))
+	}
+	for i := 0; i < 50; i++ {
+		a, b := randSeq(1+rnd.Intn(20)), randSeq(1+rnd.Intn(20))
+		want, err := SW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		alns, err := SW(m).Cooptimal(a, b, 100)
+		c.Assert(err, check.Equals, nil)
+		if totalScore(want) <= 0 {
+			c.Check(alns, check.HasLen, 0, check.Commentf("Test %d", i))
+			continue
+		}
+		c.Check(fmt.Sprint(alns[0]), check.Equals, fmt.Sprint(want), check.Commentf("Test %d", i))
+		checkCooptimal(c, alns, totalScore(want), i)
+
+		want, err = NW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		alns, err = NW(m).Cooptimal(a, b, 100)
+		c.Assert(err, check.Equals, nil)
+		checkCooptimal(c, alns, totalScore(want), i)
+	}
+}
+
+func alnStrings(alns [][]feat.Pair) []string {
+	var s []string
+	for _, aln := range alns {
+		s = append(s, fmt.Sprint(aln))
+	}
+	return s
+}
+
+func checkCooptimal(c *check.C, alns [][]feat.Pair, score, test int) {
+	c.Check(len(alns) > 0 && len(alns) <= 100, check.Equals, true, check.Commentf("Test %d", test))
+	seen := make(map[string]bool)
+	for _, aln := range alns {
+		c.Check(totalScore(aln), check.Equals, score, check.Commentf("Test %d", test))
+		s := fmt.Sprint(aln)
+		c.Check(seen[s], check.Equals, false, check.Commentf("Test %d", test))
+		seen[s] = true
+	}
+}
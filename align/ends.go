@@ -0,0 +1,119 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// An End is a set of the ends of a global alignment.
+type End uint8
+
+const (
+	RefStart   End = 1 << iota // Reference letters preceding the first query letter.
+	RefEnd                     // Reference letters following the last query letter.
+	QueryStart                 // Query letters preceding the first reference letter.
+	QueryEnd                   // Query letters following the last reference letter.
+
+	AllEnds = RefStart | RefEnd | QueryStart | QueryEnd
+)
+
+// EndGaps specifies the scores of end gaps in a global alignment, gaps aligned
+// to letters of one sequence that precede the first or follow the last letter
+// of the other. Gaps at the ends in Scored are scored per letter by the field
+// for that end in place of the gap penalties of the scoring matrix, so a zero
+// score makes an end free; gaps at other ends are scored as internal gaps. The
+// zero EndGaps therefore scores alignments as NW and NWAffine do. In affine gap
+// penalty alignments a gap at an end in Scored is also scored once by Open in
+// place of the gap open penalty, allowing the terminal gap costs of aligners
+// such as the EMBOSS needle endopen and endextend options to be reproduced.
+type EndGaps struct {
+	// Scored is the set of ends whose
+	// gaps are scored by EndGaps.
+	Scored End
+
+	// RefStart and RefEnd are the scores
+	// of reference letters aligned to gaps
+	// before the start or after the end of
+	// the query.
+	RefStart, RefEnd int
+
+	// QueryStart and QueryEnd are the
+	// scores of query letters aligned to
+	// gaps before the start or after the
+	// end of the reference.
+	QueryStart, QueryEnd int
+
+	// Open is the score for opening
+	// an end gap in affine gap penalty
+	// alignments.
+	Open int
+}
+
+// scorer returns the scorer for the alignment of reference and query using the
+// scoring matrix m and gap open penalty open for internal gaps, and the end gap
+// scores of e with the end gap open penalty endOpen for the ends in e.Scored.
+func (e EndGaps) scorer(m Linear, open, endOpen int, reference, query AlphabetSlicer) (affineScorer, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return affineScorer{}, err
+	}
+	la, err := flatten(m, alpha)
+	if err != nil {
+		return affineScorer{}, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return affineScorer{}, err
+	}
+	let := len(m)
+	return affineScorer{
+		rLen:  len(r),
+		qLen:  len(q),
+		match: func(i, j int) int { return la[r[i]*let+q[j]] },
+		del: func(i, j int) (int, int) {
+			switch {
+			case j == 0 && e.Scored&RefStart != 0:
+				return endOpen, e.RefStart
+			case j == len(q) && e.Scored&RefEnd != 0:
+				return endOpen, e.RefEnd
+			}
+			return open, la[r[i]*let]
+		},
+		ins: func(i, j int) (int, int) {
+			switch {
+			case i == 0 && e.Scored&QueryStart != 0:
+				return endOpen, e.QueryStart
+			case i == len(r) && e.Scored&QueryEnd != 0:
+				return endOpen, e.QueryEnd
+			}
+			return open, la[q[j]]
+		},
+	}, nil
+}
+
+// AlignEnds aligns two sequences using the Needleman-Wunsch algorithm as Align does, but
+// scores gaps at the ends of the alignment as specified by ends; ends.Open is not used. It
+// returns an alignment description or an error if the scoring matrix is not square, or the
+// sequence data types or alphabets do not match.
+func (a NW) AlignEnds(reference, query AlphabetSlicer, ends EndGaps) ([]feat.Pair, error) {
+	s, err := ends.scorer(Linear(a), 0, 0, reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.global(), nil
+}
+
+// AlignEnds aligns two sequences using the Needleman-Wunsch algorithm as Align does, but
+// scores gaps at the ends of the alignment as specified by ends. It returns an alignment
+// description or an error if the scoring matrix is not square, or the sequence data types
+// or alphabets do not match.
+func (a NWAffine) AlignEnds(reference, query AlphabetSlicer, ends EndGaps) ([]feat.Pair, error) {
+	s, err := ends.scorer(a.Matrix, a.GapOpen, ends.Open, reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.global(), nil
+}
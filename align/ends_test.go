@@ -0,0 +1,123 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+var endsMatrix = Linear{
+	{0, -5, -5, -5, -5},
+	{-5, 10, -3, -1, -4},
+	{-5, -3, 9, -5, 0},
+	{-5, -1, -5, 7, -3},
+	{-5, -4, 0, -3, 8},
+}
+
+func (s *S) TestEnds(c *check.C) {
+	for i, t := range []struct {
+		ref, query string
+		ends       EndGaps
+		open       int
+		want       string
+	}{
+		{
+			// The zero EndGaps scores end gaps
+			// as internal gaps.
+			ref: "TTACGT", query: "ACGTGG",
+			want: "[[0,2)/-=-10 [2,6)/[0,4)=34 -/[4,6)=-10]",
+		},
+		{
+			ref: "TTACGT", query: "ACGTGG",
+			ends: EndGaps{Scored: AllEnds, RefStart: -5, RefEnd: -5, QueryStart: -5, QueryEnd: -5},
+			want: "[[0,2)/-=-10 [2,6)/[0,4)=34 -/[4,6)=-10]",
+		},
+		{
+			// Free end gaps give an overlap alignment.
+			ref: "TTACGT", query: "ACGTGG",
+			ends: EndGaps{Scored: AllEnds},
+			want: "[[0,2)/-=0 [2,6)/[0,4)=34 -/[4,6)=0]",
+		},
+		{
+			// Only the ends in Scored are free.
+			ref: "TTACGT", query: "ACGTGG",
+			ends: EndGaps{Scored: RefStart},
+			want: "[[0,2)/-=0 [2,6)/[0,4)=34 -/[4,6)=-10]",
+		},
+		{
+			// Ends are controlled separately.
+			ref: "TTACGT", query: "ACGTGG",
+			ends: EndGaps{Scored: AllEnds, RefStart: -20, RefEnd: -20, QueryStart: -20},
+			want: "[[0,1)/[0,1)=-4 [1,3)/-=-10 [3,6)/[1,4)=24 -/[4,6)=0]",
+		},
+		{
+			// End gaps do not pay the gap open penalty.
+			ref: "AAACGTCCGT", query: "CGTCGT",
+			ends: EndGaps{Scored: AllEnds, RefStart: -1},
+			open: -20,
+			want: "[[0,3)/-=-3 [3,9)/[0,6)=25 [9,10)/-=0]",
+		},
+		{
+			// Terminal gaps may be opened at a
+			// cost, here favouring an internal gap.
+			ref: "AAACGTCCGT", query: "CGTCGT",
+			ends: EndGaps{Scored: AllEnds, RefStart: -1, Open: -10},
+			open: -20,
+			want: "[[0,3)/-=-13 [3,6)/[0,3)=24 [6,7)/-=-25 [7,10)/[3,6)=24]",
+		},
+	} {
+		ref := linear.NewSeq("", alphabet.BytesToLetters([]byte(t.ref)), alphabet.DNAgapped)
+		query := linear.NewSeq("", alphabet.BytesToLetters([]byte(t.query)), alphabet.DNAgapped)
+		var (
+			aln []feat.Pair
+			err error
+		)
+		if t.open == 0 {
+			aln, err = NW(endsMatrix).AlignEnds(ref, query, t.ends)
+		} else {
+			aln, err = NWAffine{Matrix: endsMatrix, GapOpen: t.open}.AlignEnds(ref, query, t.ends)
+		}
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(aln), check.Equals, t.want, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestEndsMatchNW(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		l := make(alphabet.Letters, n)
+		for i := range l {
+			l[i] = alphabet.Letter("acgt"[rnd.Intn(4)])
+		}
+		return linear.NewSeq("", l, alphabet.DNAgapped)
+	}
+	ends := EndGaps{Scored: AllEnds, RefStart: -5, RefEnd: -5, QueryStart: -5, QueryEnd: -5}
+	for i := 0; i < 50; i++ {
+		ref, query := randSeq(rnd.Intn(20)), randSeq(rnd.Intn(20))
+
+		want, err := NW(endsMatrix).Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		got, err := NW(endsMatrix).AlignEnds(ref, query, EndGaps{})
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("Test %d", i))
+		got, err = NW(endsMatrix).AlignEnds(ref, query, ends)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("Test %d", i))
+
+		aff, err := NWAffine{Matrix: endsMatrix}.AlignEnds(ref, query, ends)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(aff), check.Equals, totalScore(got), check.Commentf("Test %d", i))
+		aff, err = NWAffine{Matrix: endsMatrix, GapOpen: -1}.AlignEnds(ref, query, ends)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(aff) <= totalScore(got), check.Equals, true, check.Commentf("Test %d", i))
+	}
+}
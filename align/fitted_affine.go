@@ -9,16 +9,26 @@ import (
 	"github.com/biogo/biogo/feat"
 )
 
-// Setting debugFittedAffine to true gives verbose scoring table output for the dynamic programming.
-const debugFittedAffine = false
-
 // FittedAffine is the affine gap penalty fitted Needleman-Wunsch aligner type.
+// It performs semi-global alignment as described for Fitted.
 type FittedAffine Affine
 
 // Align aligns two sequences using a modified Needleman-Wunsch algorithm that finds a local region of
 // the reference with high similarity to the query. It returns an alignment description or an error if
 // the scoring matrix is not square, or the sequence data types or alphabets do not match.
 func (a FittedAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	return a.AlignTrace(reference, query, nil)
+}
+
+// AlignTrace performs the alignment of Align, reporting the progress of the dynamic programming
+// to tr if it is not nil.
+func (a FittedAffine) AlignTrace(reference, query AlphabetSlicer, tr Tracer) ([]feat.Pair, error) {
+	return a.alignBuffered(reference, query, tr, nil)
+}
+
+// alignBuffered performs the alignment of AlignTrace using the dynamic programming tables
+// held by buf if it is not nil.
+func (a FittedAffine) alignBuffered(reference, query AlphabetSlicer, tr Tracer, buf *Buffer) ([]feat.Pair, error) {
 	alpha := reference.Alphabet()
 	if alpha == nil {
 		return nil, ErrNoAlphabet
@@ -35,13 +45,13 @@ func (a FittedAffine) Align(reference, query AlphabetSlicer) ([]feat.Pair, error
 		if !ok {
 			return nil, ErrMismatchedTypes
 		}
-		return a.alignLetters(rSeq, qSeq, alpha)
+		return a.alignLetters(rSeq, qSeq, alpha, tr, buf)
 	case alphabet.QLetters:
 		qSeq, ok := query.Slice().(alphabet.QLetters)
 		if !ok {
 			return nil, ErrMismatchedTypes
 		}
-		return a.alignQLetters(rSeq, qSeq, alpha)
+		return a.alignQLetters(rSeq, qSeq, alpha, tr, buf)
 	default:
 		return nil, ErrTypeNotHandled
 	}
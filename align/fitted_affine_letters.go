@@ -11,88 +11,12 @@ import (
 	"github.com/biogo/biogo/feat"
 
 	"fmt"
-	"os"
-	"text/tabwriter"
 )
 
-//line fitted_affine_type.got:17
-func drawFittedAffineTableLetters(rSeq, qSeq alphabet.Letters, index alphabet.Index, table [][3]int, a FittedAffine) {
-	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Printf("rSeq: %s\n", rSeq)
-	fmt.Printf("qSeq: %s\n", qSeq)
-	for l := 0; l < 3; l++ {
-		fmt.Fprintf(tw, "%c\tqSeq\t", "MUL"[l])
-		for _, l := range qSeq {
-			fmt.Fprintf(tw, "%c\t", l)
-		}
-		fmt.Fprintln(tw)
-
-		r, c := rSeq.Len()+1, qSeq.Len()+1
-		fmt.Fprint(tw, "rSeq\t")
-		for i := 0; i < r; i++ {
-			if i != 0 {
-				fmt.Fprintf(tw, "%c\t", rSeq[i-1])
-			}
-
-			for j := 0; j < c; j++ {
-				p := pointerFittedAffineLetters(rSeq, qSeq, i, j, l, table, index, a, c)
-				var vi interface{}
-				if vi = table[i*c+j][l]; vi == minInt {
-					vi = "-Inf"
-				}
-				if p != "" {
-					fmt.Fprintf(tw, "%s % 4v\t", p, vi)
-				} else {
-					fmt.Fprintf(tw, "%v\t", vi)
-				}
-			}
-			fmt.Fprintln(tw)
-		}
-		fmt.Fprintln(tw)
-	}
-	tw.Flush()
-}
-
-func pointerFittedAffineLetters(rSeq, qSeq alphabet.Letters, i, j, l int, table [][3]int, index alphabet.Index, a FittedAffine, c int) string {
-	if j == 0 {
-		return ""
-	}
-	if i == 0 {
-		if l == left {
-			return "⬅ l"
-		}
-		return ""
-	}
-	rVal := index[rSeq[i-1]]
-	qVal := index[qSeq[j-1]]
-	if rVal < 0 || qVal < 0 {
-		return ""
-	}
-	switch p := i*c + j; table[p][l] {
-	case table[p-c][up] + a.Matrix[rVal][gap]:
-		return "⬆ u"
-	case table[p-1][left] + a.Matrix[gap][qVal]:
-		return "⬅ l"
-
-	case table[p-c][diag] + a.GapOpen + a.Matrix[rVal][gap]:
-		return "⬆ m"
-	case table[p-1][diag] + a.GapOpen + a.Matrix[gap][qVal]:
-		return "⬅ m"
-
-	case table[p-c-1][up] + a.Matrix[rVal][qVal]:
-		return "⬉ u"
-	case table[p-c-1][left] + a.Matrix[rVal][qVal]:
-		return "⬉ l"
-	case table[p-c-1][diag] + a.Matrix[rVal][qVal]:
-		return "⬉ m"
-	default:
-		return [3]string{"", "⬆ u", "⬅ l"}[l]
-	}
-}
-
-func (a FittedAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+//line fitted_affine_type.got:15
+func (a FittedAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, tr Tracer, buf *Buffer) ([]feat.Pair, error) {
 	let := len(a.Matrix)
-	la := make([]int, 0, let*let)
+	la := buf.scores(let * let)
 	for _, row := range a.Matrix {
 		if len(row) != let {
 			return nil, ErrMatrixNotSquare
@@ -102,7 +26,7 @@ func (a FittedAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.A
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([][3]int, r*c)
+	table := buf.affine(r * c)
 	table[0] = [3]int{
 		diag: 0,
 		up:   minInt,
@@ -160,10 +84,15 @@ func (a FittedAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.A
 				add(table[p-1][diag], a.GapOpen+la[qVal]),
 				add(table[p-1][left], la[qVal]),
 			)
+			if tr != nil {
+				for l, s := range table[p] {
+					tr.Cell(l, i, j, s)
+				}
+			}
 		}
 	}
-	if debugFittedAffine {
-		drawFittedAffineTableLetters(rSeq, qSeq, index, table, a)
+	if tr != nil {
+		tr.Table(r, c, affineLayers(table))
 	}
 
 	var aln []feat.Pair
@@ -182,6 +111,9 @@ func (a FittedAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.A
 	}
 	maxI, maxJ := i, j
 	for i > 0 && j > 0 {
+		if tr != nil {
+			tr.Traceback(layer, i, j)
+		}
 		var (
 			rVal = index[rSeq[i-1]]
 			qVal = index[qSeq[j-1]]
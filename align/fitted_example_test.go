@@ -81,3 +81,62 @@ func ExampleFittedAffine_Align() {
 	// ATTGGCA
 	// ATAGGAA
 }
+
+func ExampleFitted_Align_primer() {
+	template := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("TTGCAGGTCACCGTAAGTCGATCGTACCTTGA"))}
+	template.Alpha = alphabet.DNAgapped
+	primer := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("CCGTAAGCGAT"))}
+	primer.Alpha = alphabet.DNAgapped
+
+	// The primer must align over its whole length while
+	// the flanking template sequence is free.
+	fitted := Fitted{
+		{0, -3, -3, -3, -3},
+		{-3, 2, -2, -2, -2},
+		{-3, -2, 2, -2, -2},
+		{-3, -2, -2, 2, -2},
+		{-3, -2, -2, -2, 2},
+	}
+
+	aln, err := fitted.Align(template, primer)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(template, primer, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[10,17)/[0,7)=14 [17,18)/-=-3 [18,22)/[7,11)=8]
+	// CCGTAAGTCGAT
+	// CCGTAAG-CGAT
+}
+
+func ExampleFittedAffine_Align_motif() {
+	promoter := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GCGCCAGGCTATAAAAGGCGCCTTGAGCAGTCC"))}
+	promoter.Alpha = alphabet.DNAgapped
+	motif := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("TATATAAG"))}
+	motif.Alpha = alphabet.DNAgapped
+
+	// The motif is placed anywhere in the promoter, but
+	// every motif letter must be aligned.
+	fitted := FittedAffine{
+		Matrix: Linear{
+			{0, -1, -1, -1, -1},
+			{-1, 2, -2, -2, -2},
+			{-1, -2, 2, -2, -2},
+			{-1, -2, -2, 2, -2},
+			{-1, -2, -2, -2, 2},
+		},
+		GapOpen: -4,
+	}
+
+	aln, err := fitted.Align(promoter, motif)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(promoter, motif, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[9,17)/[0,8)=12]
+	// TATAAAAG
+	// TATATAAG
+}
@@ -11,65 +11,12 @@ import (
 	"github.com/biogo/biogo/feat"
 
 	"fmt"
-	"os"
-	"text/tabwriter"
 )
 
-//line fitted_type.got:17
-func drawFittedTableLetters(rSeq, qSeq alphabet.Letters, index alphabet.Index, table []int, a [][]int) {
-	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Printf("rSeq: %s\n", rSeq)
-	fmt.Printf("qSeq: %s\n", qSeq)
-	fmt.Fprint(tw, "\tqSeq\t")
-	for _, l := range qSeq {
-		fmt.Fprintf(tw, "%c\t", l)
-	}
-	fmt.Fprintln(tw)
-
-	r, c := rSeq.Len()+1, qSeq.Len()+1
-	fmt.Fprint(tw, "rSeq\t")
-	for i := 0; i < r; i++ {
-		if i != 0 {
-			fmt.Fprintf(tw, "%c\t", rSeq[i-1])
-		}
-
-		for j := 0; j < c; j++ {
-			p := pointerFittedLetters(rSeq, qSeq, i, j, table, index, a, c)
-			if p != "" {
-				fmt.Fprintf(tw, "%s % 3v\t", p, table[i*c+j])
-			} else {
-				fmt.Fprintf(tw, "%v\t", table[i*c+j])
-			}
-		}
-		fmt.Fprintln(tw)
-	}
-	tw.Flush()
-}
-
-func pointerFittedLetters(rSeq, qSeq alphabet.Letters, i, j int, table []int, index alphabet.Index, a [][]int, c int) string {
-	if i == 0 || j == 0 {
-		return ""
-	}
-	rVal := index[rSeq[i-1]]
-	qVal := index[qSeq[j-1]]
-	if rVal < 0 || qVal < 0 {
-		return ""
-	}
-	switch p := i*c + j; table[p] {
-	case table[p-c-1] + a[rVal][qVal]:
-		return "⬉"
-	case table[p-c] + a[rVal][gap]:
-		return "⬆"
-	case table[p-1] + a[gap][qVal]:
-		return "⬅"
-	default:
-		return ""
-	}
-}
-
-func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+//line fitted_type.got:15
+func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, tr Tracer, buf *Buffer) ([]feat.Pair, error) {
 	let := len(a)
-	la := make([]int, 0, let*let)
+	la := buf.scores(let * let)
 	for _, row := range a {
 		if len(row) != let {
 			return nil, ErrMatrixNotSquare
@@ -79,7 +26,7 @@ func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabe
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([]int, r*c)
+	table := buf.linear(r * c)
 	for j := range table[1:c] {
 		table[j+1] = table[j] + la[index[qSeq[j]]]
 	}
@@ -103,10 +50,13 @@ func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabe
 			leftScore := table[p-1] + la[qVal]
 
 			table[p] = max3(diagScore, upScore, leftScore)
+			if tr != nil {
+				tr.Cell(0, i, j, table[p])
+			}
 		}
 	}
-	if debugFitted {
-		drawFittedTableLetters(rSeq, qSeq, index, table, a)
+	if tr != nil {
+		tr.Table(r, c, [][]int{append([]int(nil), table...)})
 	}
 
 	var aln []feat.Pair
@@ -137,6 +87,9 @@ func (a Fitted) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabe
 	}
 	maxI, maxJ := i, j
 	for i > 0 && j > 0 {
+		if tr != nil {
+			tr.Traceback(0, i, j)
+		}
 		var (
 			rVal = index[rSeq[i-1]]
 			qVal = index[qSeq[j-1]]
@@ -0,0 +1,35 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/multi"
+)
+
+// Gapped returns copies of a and b holding the gapped letters of the alignment described
+// by the list of feature pairs in f, with gap used to fill gaps, as described for Format.
+// The copies keep the annotation of a and b, and have an offset of zero so that sequence
+// positions are alignment columns. Only the aligned region of a local alignment is held.
+func Gapped(a, b seq.Sequence, f []feat.Pair, gap alphabet.Letter) [2]seq.Sequence {
+	aln := Format(a, b, f, gap)
+	var g [2]seq.Sequence
+	for i, s := range [2]seq.Sequence{a, b} {
+		g[i] = s.Clone()
+		g[i].SetSlice(aln[i])
+		g[i].SetOffset(0)
+	}
+	return g
+}
+
+// GappedMulti returns the alignment described by the list of feature pairs in f as a
+// two row multiple alignment with the given id and column consensus function, holding
+// the gapped sequences returned by Gapped.
+func GappedMulti(id string, a, b seq.Sequence, f []feat.Pair, gap alphabet.Letter, cons seq.ConsenseFunc) (*multi.Multi, error) {
+	g := Gapped(a, b, f, gap)
+	return multi.NewMulti(id, g[:], cons)
+}
@@ -0,0 +1,43 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleGappedMulti() {
+	a := linear.NewSeq("a", alphabet.BytesToLetters([]byte("AGACTAGTTA")), alphabet.DNAgapped)
+	b := linear.NewSeq("b", alphabet.BytesToLetters([]byte("GACAGACG")), alphabet.DNAgapped)
+
+	needle := NW{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	aln, err := needle.Align(a, b)
+	if err != nil {
+		panic(err)
+	}
+	m, err := GappedMulti("pair", a, b, aln, '-', seq.DefaultConsensus)
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < m.Rows(); i++ {
+		r := m.Row(i)
+		fmt.Printf("%s %-s %d\n", r.Name(), r, r.Len())
+	}
+	fmt.Printf("%-s\n", m.Consensus(false))
+	// Output:
+	// a AGACTAGTTA 10
+	// b -GAC-AGACG 10
+	// -gac-agaca
+}
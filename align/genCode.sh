@@ -11,8 +11,6 @@ echo -e $WARNING\
 cat < sw_type.got \
 | gofmt -r 'alignType -> alignLetters' \
 | gofmt -r 'Type -> alphabet.Letters' \
-| gofmt -r 'drawSWTableType -> drawSWTableLetters' \
-| gofmt -r 'pointerSWType -> pointerSWLetters' \
 >> sw_letters.go
 
 echo -e $WARNING\
@@ -20,8 +18,6 @@ echo -e $WARNING\
 cat < sw_type.got \
 | gofmt -r 'alignType -> alignQLetters' \
 | gofmt -r 'Type -> alphabet.QLetters' \
-| gofmt -r 'drawSWTableType -> drawSWTableQLetters' \
-| gofmt -r 'pointerSWType -> pointerSWQLetters' \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> sw_qletters.go
@@ -31,8 +27,6 @@ echo -e $WARNING\
 cat < nw_type.got \
 | gofmt -r 'alignType -> alignLetters' \
 | gofmt -r 'Type -> alphabet.Letters' \
-| gofmt -r 'drawNWTableType -> drawNWTableLetters' \
-| gofmt -r 'pointerNWType -> pointerNWLetters' \
 >> nw_letters.go
 
 echo -e $WARNING\
@@ -40,8 +34,6 @@ echo -e $WARNING\
 cat < nw_type.got \
 | gofmt -r 'alignType -> alignQLetters' \
 | gofmt -r 'Type -> alphabet.QLetters' \
-| gofmt -r 'drawNWTableType -> drawNWTableQLetters' \
-| gofmt -r 'pointerNWType -> pointerNWQLetters' \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> nw_qletters.go
@@ -51,8 +43,6 @@ echo -e $WARNING\
 cat < fitted_type.got \
 | gofmt -r 'alignType -> alignLetters' \
 | gofmt -r 'Type -> alphabet.Letters' \
-| gofmt -r 'drawFittedTableType -> drawFittedTableLetters' \
-| gofmt -r 'pointerFittedType -> pointerFittedLetters' \
 >> fitted_letters.go
 
 echo -e $WARNING\
@@ -60,8 +50,6 @@ echo -e $WARNING\
 cat < fitted_type.got \
 | gofmt -r 'alignType -> alignQLetters' \
 | gofmt -r 'Type -> alphabet.QLetters' \
-| gofmt -r 'drawFittedTableType -> drawFittedTableQLetters' \
-| gofmt -r 'pointerFittedType -> pointerFittedQLetters' \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> fitted_qletters.go
@@ -71,8 +59,6 @@ echo -e $WARNING\
 cat < fitted_affine_type.got \
 | gofmt -r 'alignType -> alignLetters' \
 | gofmt -r 'Type -> alphabet.Letters' \
-| gofmt -r 'drawFittedAffineTableType -> drawFittedAffineTableLetters' \
-| gofmt -r 'pointerFittedAffineType -> pointerFittedAffineLetters' \
 >> fitted_affine_letters.go
 
 echo -e $WARNING\
@@ -80,8 +66,6 @@ echo -e $WARNING\
 cat < fitted_affine_type.got \
 | gofmt -r 'alignType -> alignQLetters' \
 | gofmt -r 'Type -> alphabet.QLetters' \
-| gofmt -r 'drawFittedAffineTableType -> drawFittedAffineTableQLetters' \
-| gofmt -r 'pointerFittedAffineType -> pointerFittedAffineQLetters' \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> fitted_affine_qletters.go
@@ -91,8 +75,6 @@ echo -e $WARNING\
 cat < sw_affine_type.got \
 | gofmt -r 'alignType -> alignLetters' \
 | gofmt -r 'Type -> alphabet.Letters' \
-| gofmt -r 'drawSWAffineTableType -> drawSWAffineTableLetters' \
-| gofmt -r 'pointerSWAffineType -> pointerSWAffineLetters' \
 >> sw_affine_letters.go
 
 echo -e $WARNING\
@@ -100,8 +82,6 @@ echo -e $WARNING\
 cat < sw_affine_type.got \
 | gofmt -r 'alignType -> alignQLetters' \
 | gofmt -r 'Type -> alphabet.QLetters' \
-| gofmt -r 'drawSWAffineTableType -> drawSWAffineTableQLetters' \
-| gofmt -r 'pointerSWAffineType -> pointerSWAffineQLetters' \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> sw_affine_qletters.go
@@ -111,8 +91,6 @@ echo -e $WARNING\
 cat < nw_affine_type.got \
 | gofmt -r 'alignType -> alignLetters' \
 | gofmt -r 'Type -> alphabet.Letters' \
-| gofmt -r 'drawNWAffineTableType -> drawNWAffineTableLetters' \
-| gofmt -r 'pointerNWAffineType -> pointerNWAffineLetters' \
 >> nw_affine_letters.go
 
 echo -e $WARNING\
@@ -120,8 +98,6 @@ echo -e $WARNING\
 cat < nw_affine_type.got \
 | gofmt -r 'alignType -> alignQLetters' \
 | gofmt -r 'Type -> alphabet.QLetters' \
-| gofmt -r 'drawNWAffineTableType -> drawNWAffineTableQLetters' \
-| gofmt -r 'pointerNWAffineType -> pointerNWAffineQLetters' \
 | gofmt -r 'rSeq[i] -> rSeq[i].L' \
 | gofmt -r 'qSeq[i] -> qSeq[i].L' \
 >> nw_affine_qletters.go
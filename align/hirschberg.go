@@ -0,0 +1,163 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// Hirschberg is the linear gap penalty Needleman-Wunsch aligner type using the
+// linear space divide and conquer algorithm of Hirschberg (Commun ACM 18:341-343,
+// 1975). It finds an optimal global alignment in memory linear in the lengths of
+// the sequences at about twice the time cost of NW, so it is suitable for long
+// sequences that would require an infeasibly large NW table. When a pair of
+// sequences has more than one optimal alignment, the alignment found may differ
+// from that found by NW.
+type Hirschberg Linear
+
+var _ Aligner = Hirschberg{}
+
+// Align aligns two sequences using the Needleman-Wunsch algorithm in linear space. It returns an
+// alignment description or an error if the scoring matrix is not square, or the sequence data
+// types or alphabets do not match.
+func (a Hirschberg) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(Linear(a), alpha)
+	if err != nil {
+		return nil, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	h := hirschberg{
+		la:  la,
+		let: len(a),
+		fwd: make([]int, len(q)+1),
+		rev: make([]int, len(q)+1),
+		ops: make([]byte, 0, len(r)+len(q)),
+	}
+	h.align(r, q)
+
+	return opsPairs(h.ops, r, q, 0, 0, la, len(a)), nil
+}
+
+// hirschberg holds the state of a linear space alignment.
+type hirschberg struct {
+	la  []int
+	let int
+
+	// fwd and rev hold the last rows of the
+	// forward and reverse score tables of the
+	// current division.
+	fwd, rev []int
+
+	// ops holds the alignment operations
+	// found so far in alignment order.
+	ops []byte
+}
+
+// align appends the operations of an optimal global alignment of the letter
+// indexes r and q to h.ops.
+func (h *hirschberg) align(r, q []int) {
+	if len(r) < 2 || len(q) < 2 {
+		h.table(r, q)
+		return
+	}
+
+	mid := len(r) / 2
+	h.lastRow(h.fwd[:len(q)+1], r[:mid], q, false)
+	h.lastRow(h.rev[:len(q)+1], r[mid:], q, true)
+	split, best := 0, minInt
+	for k := 0; k <= len(q); k++ {
+		if s := h.fwd[k] + h.rev[len(q)-k]; s > best {
+			split, best = k, s
+		}
+	}
+
+	h.align(r[:mid], q[:split])
+	h.align(r[mid:], q[split:])
+}
+
+// lastRow fills row with the last row of the NW score table of r and q. If
+// reverse is true, the table is of the reversed sequences, so row[j] holds the
+// score of the alignment of r with the last j letters of q.
+func (h *hirschberg) lastRow(row []int, r, q []int, reverse bool) {
+	let, la := h.let, h.la
+	at := func(s []int, i int) int {
+		if reverse {
+			return s[len(s)-1-i]
+		}
+		return s[i]
+	}
+
+	row[0] = 0
+	for j := 1; j <= len(q); j++ {
+		row[j] = row[j-1] + la[at(q, j-1)]
+	}
+	for i := range r {
+		rVal := at(r, i)
+		diagScore := row[0]
+		row[0] += la[rVal*let]
+		for j := 1; j <= len(q); j++ {
+			qVal := at(q, j-1)
+			upScore := row[j]
+			row[j] = max3(
+				diagScore+la[rVal*let+qVal],
+				upScore+la[rVal*let],
+				row[j-1]+la[qVal],
+			)
+			diagScore = upScore
+		}
+	}
+}
+
+// table appends the operations of an optimal global alignment of r and q found
+// by full dynamic programming to h.ops. It is used when one of the sequences
+// has at most one letter, so the table is linear in size.
+func (h *hirschberg) table(r, q []int) {
+	let, la := h.let, h.la
+	rows, c := len(r)+1, len(q)+1
+	table := make([]int, rows*c)
+	for j := 1; j < c; j++ {
+		table[j] = table[j-1] + la[q[j-1]]
+	}
+	for i := 1; i < rows; i++ {
+		table[i*c] = table[(i-1)*c] + la[r[i-1]*let]
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			table[p] = max3(
+				table[p-c-1]+la[r[i-1]*let+q[j-1]],
+				table[p-c]+la[r[i-1]*let],
+				table[p-1]+la[q[j-1]],
+			)
+		}
+	}
+
+	start := len(h.ops)
+	i, j := len(r), len(q)
+	for i > 0 || j > 0 {
+		p := i*c + j
+		switch {
+		case i > 0 && j > 0 && table[p] == table[p-c-1]+la[r[i-1]*let+q[j-1]]:
+			h.ops = append(h.ops, diag)
+			i--
+			j--
+		case i > 0 && table[p] == table[p-c]+la[r[i-1]*let]:
+			h.ops = append(h.ops, up)
+			i--
+		default:
+			h.ops = append(h.ops, left)
+			j--
+		}
+	}
+	for i, j := start, len(h.ops)-1; i < j; i, j = i+1, j-1 {
+		h.ops[i], h.ops[j] = h.ops[j], h.ops[i]
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleHirschberg_Align() {
+	hsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("AGACTAGTTA"))}
+	hsa.Alpha = alphabet.DNAgapped
+	hsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("GACAGACG"))}
+	hsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	needle := Hirschberg{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+
+	aln, err := needle.Align(hsa, hsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(hsa, hsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	//[[0,1)/-=-5 [1,4)/[0,3)=26 [4,5)/-=-5 [5,10)/[3,8)=12]
+	// AGACTAGTTA
+	// -GAC-AGACG
+}
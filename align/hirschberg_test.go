@@ -0,0 +1,68 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func totalScore(aln []feat.Pair) int {
+	var s int
+	for _, p := range aln {
+		s += p.(*featPair).score
+	}
+	return s
+}
+
+func (s *S) TestHirschberg(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+	for i := 0; i < 200; i++ {
+		a, b := randSeq(1+rnd.Intn(60)), randSeq(1+rnd.Intn(60))
+		want, err := NW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		got, err := Hirschberg(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("Test %d", i))
+
+		fa := Format(a, b, got, '-')
+		c.Check(fa[0].Len(), check.Equals, fa[1].Len(), check.Commentf("Test %d", i))
+		var ai, bi int
+		for _, p := range got {
+			f := p.Features()
+			c.Check(f[0].Start(), check.Equals, ai, check.Commentf("Test %d", i))
+			c.Check(f[1].Start(), check.Equals, bi, check.Commentf("Test %d", i))
+			ai, bi = f[0].End(), f[1].End()
+		}
+		c.Check(ai, check.Equals, a.Len(), check.Commentf("Test %d", i))
+		c.Check(bi, check.Equals, b.Len(), check.Commentf("Test %d", i))
+	}
+
+	q := linear.NewQSeq("", []alphabet.QLetter{{L: 'A'}, {L: 'C'}}, alphabet.DNAgapped, alphabet.Sanger)
+	aln, err := Hirschberg(m).Align(q, q)
+	c.Assert(err, check.Equals, nil)
+	c.Check(totalScore(aln), check.Equals, 19)
+	_, err = Hirschberg(m).Align(q, randSeq(2))
+	c.Check(err, check.Equals, ErrMismatchedTypes)
+}
@@ -0,0 +1,226 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"sort"
+)
+
+var ErrBadSeedLength = errors.New("align: seed length less than one")
+
+// A Masker masks the regions of sequences that match any of a set of contaminant
+// sequences, such as vector, adapter or host sequences. Matches are found by X-drop
+// extension of exact seed matches between the sequence and each contaminant, and of
+// the reverse complement of each contaminant if the alphabet is a Complementor.
+type Masker struct {
+	// XDrop is the aligner used to
+	// extend seed matches.
+	XDrop XDrop
+
+	// K is the length of seed matches.
+	K int
+
+	// MinIdentity is the minimum percent
+	// identity and MinLength the minimum
+	// number of sequence letters of a
+	// match for it to be masked.
+	MinIdentity float64
+	MinLength   int
+
+	// Letter is the letter used to mask
+	// matches. If Letter is zero, the
+	// ambiguous letter of the alphabet
+	// is used.
+	Letter alphabet.Letter
+}
+
+// A Masked describes a region of a sequence masked by a Masker.
+type Masked struct {
+	// Start and End are the masked region
+	// in coordinates relative to the
+	// sequence location.
+	Start, End int
+
+	// Contaminant is the name of the matching
+	// contaminant and Strand the strand of the
+	// contaminant that matched.
+	Contaminant string
+	Strand      seq.Strand
+
+	// Identity is the percent identity and
+	// Score the score of the alignment of
+	// the region to the contaminant.
+	Identity float64
+	Score    int
+}
+
+// Mask masks the regions of s matching any of the contaminants and returns the masked
+// regions sorted by start position. Overlapping matches to different contaminants are
+// all reported. The sequences must share a gapped alphabet. Mask returns an error if
+// the seed length or drop-off is not valid, the scoring matrix is not square, or the
+// sequence data types or alphabets do not match.
+func (m Masker) Mask(s seq.Sequence, contaminants ...seq.Sequence) ([]Masked, error) {
+	hits, err := m.Find(s, contaminants...)
+	if err != nil {
+		return nil, err
+	}
+	mask := m.Letter
+	if mask == 0 {
+		mask = s.Alphabet().Ambiguous()
+	}
+	for _, h := range hits {
+		for i := h.Start; i < h.End; i++ {
+			l := s.At(i)
+			l.L = mask
+			err = s.Set(i, l)
+			if err != nil {
+				return hits, err
+			}
+		}
+	}
+	return hits, nil
+}
+
+// Find returns the regions of s matching any of the contaminants that would be masked
+// by Mask, without altering s.
+func (m Masker) Find(s seq.Sequence, contaminants ...seq.Sequence) ([]Masked, error) {
+	if m.K < 1 {
+		return nil, ErrBadSeedLength
+	}
+	if m.XDrop.X < 0 {
+		return nil, ErrBadDrop
+	}
+	alpha, err := gappedAlphabet(s, s)
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(m.XDrop.Matrix, alpha)
+	if err != nil {
+		return nil, err
+	}
+	q, err := indexes(s, alpha, "qSeq")
+	if err != nil {
+		return nil, err
+	}
+	x := xdrop{la: la, let: len(m.XDrop.Matrix), x: m.XDrop.X, ungapped: m.XDrop.Ungapped}
+
+	var hits []Masked
+	for _, c := range contaminants {
+		strands := []seq.Sequence{c}
+		if _, ok := alpha.(alphabet.Complementor); ok {
+			rc := c.Clone()
+			rc.RevComp()
+			strands = append(strands, rc)
+		}
+		for k, cs := range strands {
+			r, _, err := letterIndexes(cs, s, alpha)
+			if err != nil {
+				return nil, err
+			}
+			strand := seq.Plus
+			if k == 1 {
+				strand = seq.Minus
+			}
+			h, err := m.matches(x, cs, s, r, q)
+			if err != nil {
+				return nil, err
+			}
+			for i := range h {
+				h[i].Contaminant = c.Name()
+				h[i].Strand = strand
+				h[i].Start += s.Start()
+				h[i].End += s.Start()
+			}
+			hits = append(hits, h...)
+		}
+	}
+	sort.Sort(maskedByPos(hits))
+	return hits, nil
+}
+
+// matches returns the matches of the letter indexes q of s to the letter indexes r of
+// the contaminant c that pass the identity and length thresholds. Positions are indexes
+// into q and matches contained within another match are not included.
+func (m Masker) matches(x xdrop, c, s AlphabetSlicer, r, q []int) ([]Masked, error) {
+	seeds := make(map[string][]int)
+	for i := 0; i+m.K <= len(r); i++ {
+		key := kmerKey(r[i : i+m.K])
+		seeds[key] = append(seeds[key], i)
+	}
+
+	// reach holds the end of the furthest
+	// extension along each diagonal so
+	// that seeds within extended matches
+	// are not extended again.
+	reach := make(map[int]int)
+
+	var hits []Masked
+	for j := 0; j+m.K <= len(q); j++ {
+		for _, i := range seeds[kmerKey(q[j:j+m.K])] {
+			if j+m.K <= reach[j-i] {
+				continue
+			}
+			aln, score := x.seed(r, q, i, j, m.K)
+			start, end := aln[0].Features()[1].Start(), aln[len(aln)-1].Features()[1].End()
+			if end > reach[j-i] {
+				reach[j-i] = end
+			}
+			if end-start < m.MinLength {
+				continue
+			}
+			st, err := NewStats(aln, c, s, nil)
+			if err != nil {
+				return nil, err
+			}
+			if st.Identity() < m.MinIdentity {
+				continue
+			}
+			hits = addHit(hits, Masked{Start: start, End: end, Identity: st.Identity(), Score: score})
+		}
+	}
+	return hits, nil
+}
+
+// addHit adds h to hits unless it is contained within an existing hit, removing any
+// existing hits contained within h.
+func addHit(hits []Masked, h Masked) []Masked {
+	for _, o := range hits {
+		if o.Start <= h.Start && h.End <= o.End {
+			return hits
+		}
+	}
+	kept := hits[:0]
+	for _, o := range hits {
+		if h.Start <= o.Start && o.End <= h.End {
+			continue
+		}
+		kept = append(kept, o)
+	}
+	return append(kept, h)
+}
+
+// kmerKey returns a map key for the letter indexes of a seed.
+func kmerKey(idx []int) string {
+	b := make([]byte, len(idx))
+	for i, v := range idx {
+		b[i] = byte(v)
+	}
+	return string(b)
+}
+
+type maskedByPos []Masked
+
+func (m maskedByPos) Len() int { return len(m) }
+func (m maskedByPos) Less(i, j int) bool {
+	if m[i].Start != m[j].Start {
+		return m[i].Start < m[j].Start
+	}
+	return m[i].End < m[j].End
+}
+func (m maskedByPos) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
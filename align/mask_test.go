@@ -0,0 +1,123 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestMasker(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 1, -3, -3, -3},
+		{-5, -3, 1, -3, -3},
+		{-5, -3, -3, 1, -3},
+		{-5, -3, -3, -3, 1},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randLetters := func(n int) alphabet.Letters {
+		b := make(alphabet.Letters, n)
+		for i := range b {
+			b[i] = alphabet.Letter("acgt"[rnd.Intn(4)])
+		}
+		return b
+	}
+
+	vector := linear.NewSeq("vector", randLetters(60), alphabet.DNAgapped)
+	adapter := linear.NewSeq("adapter", randLetters(30), alphabet.DNAgapped)
+	rcAdapter := adapter.Clone().(*linear.Seq)
+	rcAdapter.RevComp()
+
+	// The query holds part of the vector with a
+	// substitution, and the reverse complement of
+	// the adapter.
+	vpart := append(alphabet.Letters(nil), vector.Seq[10:50]...)
+	vpart[20] = map[alphabet.Letter]alphabet.Letter{'a': 'c', 'c': 'g', 'g': 't', 't': 'a'}[vpart[20]]
+	var ql alphabet.Letters
+	ql = append(ql, randLetters(50)...)
+	ql = append(ql, vpart...)
+	ql = append(ql, randLetters(50)...)
+	ql = append(ql, rcAdapter.Seq...)
+	ql = append(ql, randLetters(20)...)
+	query := linear.NewSeq("query", ql, alphabet.DNAgapped)
+
+	masked, err := Masker{
+		XDrop:       XDrop{Matrix: m, X: 6},
+		K:           11,
+		MinIdentity: 90,
+		MinLength:   20,
+	}.Mask(query, vector, adapter)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(masked, check.HasLen, 2)
+
+	c.Check(masked[0].Start, check.Equals, 50)
+	c.Check(masked[0].End, check.Equals, 90)
+	c.Check(masked[0].Contaminant, check.Equals, "vector")
+	c.Check(masked[0].Strand, check.Equals, seq.Plus)
+	c.Check(masked[0].Identity, check.Equals, 97.5)
+	c.Check(masked[0].Score, check.Equals, 39-3)
+
+	c.Check(masked[1].Start, check.Equals, 140)
+	c.Check(masked[1].End, check.Equals, 170)
+	c.Check(masked[1].Contaminant, check.Equals, "adapter")
+	c.Check(masked[1].Strand, check.Equals, seq.Minus)
+	c.Check(masked[1].Identity, check.Equals, 100.)
+
+	for i, l := range query.Seq {
+		inMask := (50 <= i && i < 90) || (140 <= i && i < 170)
+		c.Check(l == 'n', check.Equals, inMask, check.Commentf("Test %d", i))
+		if !inMask {
+			c.Check(l, check.Equals, ql[i])
+		}
+	}
+
+	// Matches below the thresholds are not masked.
+	query = linear.NewSeq("query", append(alphabet.Letters(nil), ql...), alphabet.DNAgapped)
+	masked, err = Masker{
+		XDrop:       XDrop{Matrix: m, X: 6},
+		K:           11,
+		MinIdentity: 99,
+		MinLength:   35,
+	}.Mask(query, vector, adapter)
+	c.Assert(err, check.Equals, nil)
+	c.Check(masked, check.HasLen, 0)
+	c.Check(query.Seq, check.DeepEquals, ql)
+
+	_, err = Masker{XDrop: XDrop{Matrix: m}}.Mask(query, vector)
+	c.Check(err, check.Equals, ErrBadSeedLength)
+}
+
+func (s *S) TestAddHit(c *check.C) {
+	hits := []Masked{{Start: 2, End: 5}, {Start: 6, End: 9}, {Start: 10, End: 12}, {Start: 14, End: 20}}
+	for i, t := range []struct {
+		add    Masked
+		expect []Masked
+	}{
+		{
+			// Contained hits are not added.
+			add:    Masked{Start: 3, End: 4},
+			expect: hits,
+		},
+		{
+			// Partially overlapping hits are kept.
+			add:    Masked{Start: 11, End: 16},
+			expect: append(append([]Masked(nil), hits...), Masked{Start: 11, End: 16}),
+		},
+		{
+			// All contained hits are replaced.
+			add:    Masked{Start: 0, End: 13},
+			expect: []Masked{{Start: 14, End: 20}, {Start: 0, End: 13}},
+		},
+	} {
+		got := addHit(append([]Masked(nil), hits...), t.add)
+		c.Check(got, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+}
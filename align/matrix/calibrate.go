@@ -0,0 +1,218 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"errors"
+	"math"
+)
+
+var (
+	ErrLengthMismatch = errors.New("matrix: aligned sequence lengths differ")
+	ErrNoPairs        = errors.New("matrix: no aligned pairs")
+	ErrNoGaps         = errors.New("matrix: no gaps")
+)
+
+// A Calibration accumulates the aligned letter pairs and gaps of a set of
+// trusted example alignments to estimate log-odds scoring parameters.
+//
+// Substitution scores are the log-odds of the observed frequency of each
+// pair of letters against the frequency expected from the background
+// frequencies of the letters in the examples,
+//
+//	s(a, b) = scale * log2(q(a, b) / (p(a) * p(b))),
+//
+// rounded to the nearest integer, where q is the symmetrized pair frequency
+// and p its marginal. A scale of 2 gives scores in half bits as used by the
+// BLOSUM matrices.
+type Calibration struct {
+	alpha alphabet.Alphabet
+
+	// pairs holds the symmetrized counts of
+	// aligned letter pairs, indexed by the
+	// alphabet index of the letters.
+	pairs [][]float64
+
+	// aligned is the number of aligned pairs,
+	// gapped is the number of columns with a
+	// gap in one sequence and opens is the
+	// number of runs of such columns.
+	aligned, gapped, opens int
+}
+
+// NewCalibration returns a new Calibration for sequences in the alphabet a.
+func NewCalibration(a alphabet.Alphabet) *Calibration {
+	l := a.Len()
+	arr := make([]float64, l*l)
+	pairs := make([][]float64, l)
+	for i := range pairs {
+		pairs[i] = arr[i*l : (i+1)*l]
+	}
+	return &Calibration{alpha: a, pairs: pairs}
+}
+
+// Add adds the pairwise alignment of the gapped sequences a and b to the
+// calibration. Letters that are not in the alphabet of the calibration and
+// columns where both sequences have a gap are ignored.
+func (c *Calibration) Add(a, b alphabet.Letters) error {
+	if len(a) != len(b) {
+		return ErrLengthMismatch
+	}
+	g := c.alpha.IndexOf(c.alpha.Gap())
+	var last [2]bool
+	for i := range a {
+		x, y := c.alpha.IndexOf(a[i]), c.alpha.IndexOf(b[i])
+		if x < 0 || y < 0 || (x == g && y == g) {
+			continue
+		}
+		var in [2]bool
+		switch {
+		case x == g:
+			in[0] = true
+		case y == g:
+			in[1] = true
+		default:
+			c.pairs[x][y]++
+			c.pairs[y][x]++
+			c.aligned++
+			last = in
+			continue
+		}
+		c.gapped++
+		if in != last {
+			c.opens++
+		}
+		last = in
+	}
+	return nil
+}
+
+// frequencies returns the pair and background frequencies of the calibration
+// with pseudo added to the count of each pair of non-gap letters.
+func (c *Calibration) frequencies(pseudo float64) (q [][]float64, p []float64, err error) {
+	g := c.alpha.IndexOf(c.alpha.Gap())
+	l := c.alpha.Len()
+	q = make([][]float64, l)
+	p = make([]float64, l)
+	var total float64
+	for i := range q {
+		q[i] = make([]float64, l)
+		if i == g {
+			continue
+		}
+		for j := range q[i] {
+			if j == g {
+				continue
+			}
+			q[i][j] = c.pairs[i][j] + pseudo
+			total += q[i][j]
+		}
+	}
+	if total == 0 {
+		return nil, nil, ErrNoPairs
+	}
+	for i := range q {
+		for j := range q[i] {
+			q[i][j] /= total
+			p[i] += q[i][j]
+		}
+	}
+	return q, p, nil
+}
+
+// Matrix returns a log-odds substitution matrix estimated from the
+// calibration with pseudo added to the count of each pair of letters, scaled
+// by scale. The gap row and column hold the linear gap score returned by
+// LinearGap, or zero if the examples have no gaps. Scores for pairs of
+// letters that have no observations are the minimum score of the matrix.
+func (c *Calibration) Matrix(scale, pseudo float64) ([][]int, error) {
+	q, p, err := c.frequencies(pseudo)
+	if err != nil {
+		return nil, err
+	}
+	gap, err := c.LinearGap(scale)
+	if err != nil && err != ErrNoGaps {
+		return nil, err
+	}
+	g := c.alpha.IndexOf(c.alpha.Gap())
+	l := c.alpha.Len()
+	m := make([][]int, l)
+	var unseen [][2]int
+	min := math.MaxInt32
+	for i := range m {
+		m[i] = make([]int, l)
+		for j := range m[i] {
+			switch {
+			case i == g, j == g:
+				m[i][j] = gap
+			case q[i][j] == 0:
+				unseen = append(unseen, [2]int{i, j})
+			default:
+				s := score(scale, q[i][j]/(p[i]*p[j]))
+				m[i][j] = s
+				if s < min {
+					min = s
+				}
+			}
+		}
+	}
+	for _, u := range unseen {
+		m[u[0]][u[1]] = min
+	}
+	return m, nil
+}
+
+// MatchMismatch returns match and mismatch scores estimated from the
+// calibration with pseudo added to the count of each pair of letters, scaled
+// by scale. The scores are the log-odds of identical and non-identical pairs
+// of letters, suitable for use with Match.
+func (c *Calibration) MatchMismatch(scale, pseudo float64) (match, mismatch int, err error) {
+	q, p, err := c.frequencies(pseudo)
+	if err != nil {
+		return 0, 0, err
+	}
+	var obs, exp float64
+	for i := range q {
+		obs += q[i][i]
+		exp += p[i] * p[i]
+	}
+	return score(scale, obs/exp), score(scale, (1-obs)/(1-exp)), nil
+}
+
+// LinearGap returns the score of each gapped column of a linear gap
+// penalty model, the scaled log probability of a column of the examples being
+// gapped.
+func (c *Calibration) LinearGap(scale float64) (int, error) {
+	if c.gapped == 0 {
+		return 0, ErrNoGaps
+	}
+	return score(scale, float64(c.gapped)/float64(c.aligned+c.gapped)), nil
+}
+
+// AffineGaps returns the gap open and extension scores of an affine gap
+// penalty model estimated from the calibration, scaled by scale. A gap of
+// length k has score open+k*extend, consistent with the use of the GapOpen
+// field and the gap row and column of an align.Affine. The scores are
+// derived from the probability of a gap starting after an aligned pair and
+// the probability of a gap extending by a further column.
+func (c *Calibration) AffineGaps(scale float64) (open, extend int, err error) {
+	if c.gapped == 0 {
+		return 0, 0, ErrNoGaps
+	}
+	pOpen := float64(c.opens) / float64(c.aligned+c.opens)
+	pExt := float64(c.gapped-c.opens) / float64(c.gapped)
+	if pExt == 0 {
+		// All gaps have length one, so the whole
+		// cost is carried by the extension.
+		return 0, score(scale, pOpen), nil
+	}
+	return score(scale, pOpen*(1-pExt)/pExt), score(scale, pExt), nil
+}
+
+func score(scale, odds float64) int {
+	return int(math.Floor(scale*math.Log2(odds) + 0.5))
+}
@@ -0,0 +1,61 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestCalibration(c *check.C) {
+	l := func(s string) alphabet.Letters { return alphabet.Letters(s) }
+	cal := NewCalibration(alphabet.DNAgapped)
+	_, err := cal.Matrix(2, 0)
+	c.Check(err, check.Equals, ErrNoPairs)
+	c.Check(cal.Add(l("ACGT"), l("ACG")), check.Equals, ErrLengthMismatch)
+
+	for _, p := range [][2]string{
+		{"ACGTACGT", "ACGTACGT"},
+		{"ACGTACGT", "ACGAACGT"},
+		{"ACGT--GT", "ACGTACGT"},
+		{"AC-TACGT", "ACGTAC-T"},
+	} {
+		c.Assert(cal.Add(l(p[0]), l(p[1])), check.Equals, nil)
+	}
+	// 28 aligned pairs, 27 identical with uniform
+	// background, 4 gapped columns in 3 gaps.
+	match, mismatch, err := cal.MatchMismatch(1, 0)
+	c.Assert(err, check.Equals, nil)
+	c.Check(match, check.Equals, 2)     // log2((27/28)/(1/4)) = 1.95
+	c.Check(mismatch, check.Equals, -4) // log2((1/28)/(3/4)) = -4.39
+
+	gap, err := cal.LinearGap(2)
+	c.Assert(err, check.Equals, nil)
+	c.Check(gap, check.Equals, -6) // 2*log2(4/32)
+
+	open, extend, err := cal.AffineGaps(1)
+	c.Assert(err, check.Equals, nil)
+	c.Check(open, check.Equals, -2)   // log2((3/31)*(3/4)/(1/4)) = -1.78
+	c.Check(extend, check.Equals, -2) // log2(1/4)
+
+	m, err := cal.Matrix(2, 0)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(m, check.HasLen, alphabet.DNAgapped.Len())
+	idx := alphabet.DNAgapped.LetterIndex()
+	c.Check(m[0][idx['A']], check.Equals, -6)
+	c.Check(m[idx['A']][idx['A']], check.Equals, 4)
+	c.Check(m[idx['T']][idx['A']], check.Equals, m[idx['A']][idx['T']])
+	c.Check(m[idx['C']][idx['G']], check.Equals, m[idx['A']][idx['T']])
+}
@@ -0,0 +1,108 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	ErrNoHeader  = errors.New("matrix: missing header")
+	ErrBadHeader = errors.New("matrix: header field is not a single letter")
+	ErrBadRow    = errors.New("matrix: row length does not match header")
+)
+
+// Parse reads a scoring matrix in the NCBI matrix file format used by BLAST and
+// EMBOSS from r and returns it organised for lookup using the alphabet a, as
+// are the matrices provided by this package. Lines starting with '#' are
+// comments. The first other line holds the column letters and each following
+// line holds a row letter and its scores. Letters not in a are ignored, and
+// scores for letters of a that are not in the file and for gaps are zero.
+func Parse(r io.Reader, a alphabet.Alphabet) ([][]int, error) {
+	l := a.Len()
+	m := make([][]int, l)
+	arr := make([]int, l*l)
+	for i := range m {
+		m[i] = arr[i*l : (i+1)*l]
+	}
+
+	var (
+		cols []int
+		line int
+	)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		fields := bytes.Fields(sc.Bytes())
+		if len(fields) == 0 || fields[0][0] == '#' {
+			continue
+		}
+		if cols == nil {
+			cols = make([]int, len(fields))
+			for i, f := range fields {
+				if len(f) != 1 {
+					return nil, fmt.Errorf("%v at line %d", ErrBadHeader, line)
+				}
+				cols[i] = a.IndexOf(alphabet.Letter(f[0]))
+			}
+			continue
+		}
+		if len(fields[0]) != 1 {
+			return nil, fmt.Errorf("%v at line %d", ErrBadHeader, line)
+		}
+		if len(fields) != len(cols)+1 {
+			return nil, fmt.Errorf("%v at line %d", ErrBadRow, line)
+		}
+		row := a.IndexOf(alphabet.Letter(fields[0][0]))
+		for i, f := range fields[1:] {
+			s, err := strconv.Atoi(string(f))
+			if err != nil {
+				return nil, fmt.Errorf("matrix: %v at line %d", err, line)
+			}
+			if row >= 0 && cols[i] >= 0 {
+				m[row][cols[i]] = s
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if cols == nil {
+		return nil, ErrNoHeader
+	}
+	if g := a.IndexOf(a.Gap()); g >= 0 {
+		for i := range m {
+			m[i][g], m[g][i] = 0, 0
+		}
+	}
+	return m, nil
+}
+
+// WithGap returns a copy of the scoring matrix m, organised for lookup using
+// the alphabet a, with the score for aligning a letter against a gap set to
+// gap. The score for aligning a gap against a gap is zero.
+func WithGap(m [][]int, a alphabet.Alphabet, gap int) [][]int {
+	l := len(m)
+	arr := make([]int, l*l)
+	c := make([][]int, l)
+	for i := range c {
+		c[i] = arr[i*l : (i+1)*l]
+		copy(c[i], m[i])
+	}
+	if g := a.IndexOf(a.Gap()); 0 <= g && g < l {
+		for i := range c {
+			c[i][g], c[g][i] = gap, gap
+		}
+		c[g][g] = 0
+	}
+	return c
+}
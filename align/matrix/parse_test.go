@@ -0,0 +1,60 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestParse(c *check.C) {
+	for i, t := range []struct {
+		file   string
+		alpha  alphabet.Alphabet
+		expect [][]int
+	}{
+		{"BLOSUM45", alphabet.Protein, BLOSUM45},
+		{"BLOSUM62", alphabet.Protein, BLOSUM62},
+		{"BLOSUM80", alphabet.Protein, BLOSUM80},
+		{"PAM30", alphabet.Protein, PAM30},
+		{"PAM250", alphabet.Protein, PAM250},
+		{"NUC.4.4", alphabet.DNAredundant, NUC_4_4},
+	} {
+		f, err := os.Open(filepath.Join("matrices", t.file))
+		c.Assert(err, check.Equals, nil)
+		m, err := Parse(f, t.alpha)
+		f.Close()
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(m, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+
+	for i, t := range []struct {
+		in  string
+		err string
+	}{
+		{"# comment only\n", "matrix: missing header"},
+		{"  A  C\nA 1 0\nC 0\n", "matrix: row length does not match header at line 3"},
+		{"  A  CG\nA 1 0\n", "matrix: header field is not a single letter at line 1"},
+		{"  A  C\nA 1 x\n", `matrix: strconv.Atoi: parsing "x": invalid syntax at line 2`},
+	} {
+		_, err := Parse(strings.NewReader(t.in), alphabet.DNAgapped)
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestWithGap(c *check.C) {
+	m := WithGap(BLOSUM62, alphabet.Protein, -5)
+	idx := alphabet.Protein.LetterIndex()
+	c.Check(m[0][0], check.Equals, 0)
+	c.Check(m[0][idx['W']], check.Equals, -5)
+	c.Check(m[idx['W']][0], check.Equals, -5)
+	c.Check(m[idx['W']][idx['W']], check.Equals, 11)
+	c.Check(BLOSUM62[0][idx['W']], check.Equals, 0)
+}
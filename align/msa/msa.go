@@ -0,0 +1,427 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package msa provides progressive multiple sequence alignment.
+//
+// Progressive alignment follows the approach of ClustalW (Thompson, Higgins and
+// Gibson, Nucleic Acids Res 22:4673-4680, 1994). All pairs of sequences are
+// aligned and the distances between them, one minus their fractional identity,
+// are used to build a neighbor-joining guide tree. The sequences are then
+// aligned in the order given by the tree, with the profiles of the alignments of
+// each subtree aligned to each other using the mean scores of the letter pairs of
+// their columns. Gaps introduced into a profile are kept in later alignments.
+//
+// Existing alignments may be aligned to each other with Profiles, which also
+// refines alignments by realigning each row to the profile of the others.
+package msa
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/treeio/newick"
+	"github.com/biogo/biogo/phylo"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNoSequences  = errors.New("msa: no sequences")
+	ErrDuplicateID  = errors.New("msa: duplicate sequence id")
+	ErrTreeMismatch = errors.New("msa: guide tree leaves do not match sequences")
+)
+
+// Progressive is the progressive multiple sequence aligner type.
+type Progressive struct {
+	// Matrix is the linear gap penalty scoring
+	// matrix used for all alignments.
+	Matrix align.Linear
+
+	// Tree is the guide tree. If Tree is nil,
+	// a neighbor-joining tree is built from
+	// the pairwise distances between the
+	// sequences. The leaves of the tree must
+	// be named by the sequence IDs. A guide
+	// tree that does not need the pairwise
+	// alignments can be built from k-mer
+	// distances by phylo.KmerTree.
+	Tree *newick.Node
+
+	// Workers is the number of goroutines used
+	// for the pairwise alignments as described
+	// for align.AlignAll.
+	Workers int
+}
+
+// An Alignment is the result of a multiple sequence alignment.
+type Alignment struct {
+	// Multi holds the aligned sequences in
+	// the order they were given.
+	*multi.Multi
+
+	// Scores holds the sum-of-pairs score
+	// of each column of the alignment.
+	// Pairs of gaps score zero.
+	Scores []int
+
+	// Tree is the guide tree used.
+	Tree *newick.Node
+}
+
+// Score returns the sum-of-pairs score of the alignment.
+func (a *Alignment) Score() int { return sum(a.Scores) }
+
+func sum(s []int) int {
+	var t int
+	for _, v := range s {
+		t += v
+	}
+	return t
+}
+
+// Align returns the progressive multiple alignment of seqs with the given id, using cons
+// as the column consensus function of the returned Multi. The sequences must have unique
+// IDs and share a gapped alphabet. Align returns an error if the sequences or guide tree
+// are not valid, or if a pairwise alignment fails.
+func (p Progressive) Align(id string, seqs []*linear.Seq, cons seq.ConsenseFunc) (*Alignment, error) {
+	if len(seqs) == 0 {
+		return nil, ErrNoSequences
+	}
+	alpha := seqs[0].Alpha
+	err := checkMatrix(p.Matrix, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	index := alpha.LetterIndex()
+	names := make([]string, len(seqs))
+	leaf := make(map[string]int, len(seqs))
+	rows := make([][]int, len(seqs))
+	for i, s := range seqs {
+		if s.Alpha != alpha {
+			return nil, align.ErrMismatchedAlphabets
+		}
+		if _, dup := leaf[s.ID]; dup {
+			return nil, ErrDuplicateID
+		}
+		names[i] = s.ID
+		leaf[s.ID] = i
+		rows[i] = make([]int, len(s.Seq))
+		for j, l := range s.Seq {
+			if rows[i][j] = index[l]; rows[i][j] < 0 {
+				return nil, fmt.Errorf("msa: illegal letter %q at position %d in %s", l, j, s.ID)
+			}
+		}
+	}
+
+	tree := p.Tree
+	if tree == nil {
+		d, err := p.distances(seqs)
+		if err != nil {
+			return nil, err
+		}
+		tree, err = phylo.NeighborJoining(names, d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pa := profileAligner{m: p.Matrix, let: alpha.Len()}
+	used := make([]bool, len(seqs))
+	prof, err := pa.alignTree(tree, rows, leaf, used)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range used {
+		if !u {
+			return nil, ErrTreeMismatch
+		}
+	}
+
+	m, err := prof.multi(id, seqs, alpha, cons)
+	if err != nil {
+		return nil, err
+	}
+	return &Alignment{Multi: m, Scores: pa.scores(prof), Tree: tree}, nil
+}
+
+// checkMatrix returns an error if m is not a valid scoring matrix for the
+// gapped alphabet alpha.
+func checkMatrix(m align.Linear, alpha alphabet.Alphabet) error {
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return align.ErrNotGappedAlphabet
+	}
+	if len(m) < alpha.Len() {
+		return align.ErrMatrixWrongSize{Size: len(m), Len: alpha.Len()}
+	}
+	for _, r := range m {
+		if len(r) != len(m) {
+			return align.ErrMatrixNotSquare
+		}
+	}
+	return nil
+}
+
+// distances returns the pairwise distances between seqs, one minus the fractional
+// identity of their global alignment.
+func (p Progressive) distances(seqs []*linear.Seq) ([][]float64, error) {
+	n := len(seqs)
+	var pairs []align.SeqPair
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			pairs = append(pairs, align.SeqPair{Reference: seqs[i], Query: seqs[j]})
+		}
+	}
+	res, err := align.AlignAll(pairs, align.NW(p.Matrix), p.Workers)
+	if err != nil {
+		return nil, err
+	}
+	d := make([][]float64, n)
+	for i := range d {
+		d[i] = make([]float64, n)
+	}
+	k := 0
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if res[k].Err != nil {
+				return nil, res[k].Err
+			}
+			st, err := align.NewStats(res[k].Alignment, seqs[i], seqs[j], nil)
+			if err != nil {
+				return nil, err
+			}
+			d[i][j] = 1 - st.Identity()/100
+			d[j][i] = d[i][j]
+			k++
+		}
+	}
+	return d, nil
+}
+
+// A profile is an alignment of a subset of the sequences being aligned.
+type profile struct {
+	// members holds the indexes of the
+	// sequences of the profile, rows the
+	// letter indexes of each aligned row,
+	// with zero for gaps, and pos the
+	// sequence position of each letter.
+	members []int
+	rows    [][]int
+	pos     [][]int
+}
+
+func (p *profile) len() int {
+	if len(p.rows) == 0 {
+		return 0
+	}
+	return len(p.rows[0])
+}
+
+// multi returns the aligned rows of p as a Multi, taking the letters of each
+// row from the sequence in seqs given by its member index.
+func (p *profile) multi(id string, seqs []*linear.Seq, alpha alphabet.Alphabet, cons seq.ConsenseFunc) (*multi.Multi, error) {
+	aligned := make([]seq.Sequence, len(seqs))
+	for k, i := range p.members {
+		l := make(alphabet.Letters, len(p.rows[k]))
+		for j, v := range p.rows[k] {
+			if v == 0 {
+				l[j] = alpha.Gap()
+			} else {
+				l[j] = seqs[i].Seq[p.pos[k][j]]
+			}
+		}
+		s := linear.NewSeq(seqs[i].ID, l, alpha)
+		s.Desc = seqs[i].Desc
+		aligned[i] = s
+	}
+	return multi.NewMulti(id, aligned, cons)
+}
+
+// profileAligner aligns profiles using the linear gap penalty matrix m over an
+// alphabet of let letters.
+type profileAligner struct {
+	m   align.Linear
+	let int
+}
+
+// alignTree returns the profile of the alignment of the leaves of the tree t.
+func (pa profileAligner) alignTree(t *newick.Node, rows [][]int, leaf map[string]int, used []bool) (*profile, error) {
+	if t.IsLeaf() {
+		i, ok := leaf[t.Name]
+		if !ok || used[i] {
+			return nil, ErrTreeMismatch
+		}
+		used[i] = true
+		pos := make([]int, len(rows[i]))
+		for j := range pos {
+			pos[j] = j
+		}
+		return &profile{members: []int{i}, rows: [][]int{rows[i]}, pos: [][]int{pos}}, nil
+	}
+	var p *profile
+	for _, c := range t.Children {
+		q, err := pa.alignTree(c, rows, leaf, used)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			p = q
+			continue
+		}
+		p = pa.align(p, q)
+	}
+	return p, nil
+}
+
+// counts returns the number of each letter index in each column of p.
+func (pa profileAligner) counts(p *profile) [][]int {
+	c := make([][]int, p.len())
+	for j := range c {
+		c[j] = make([]int, pa.let)
+		for _, r := range p.rows {
+			c[j][r[j]]++
+		}
+	}
+	return c
+}
+
+// scores returns the sum-of-pairs score of each column of p.
+func (pa profileAligner) scores(p *profile) []int {
+	scores := make([]int, p.len())
+	for j := range scores {
+		for a := range p.rows {
+			for b := a + 1; b < len(p.rows); b++ {
+				x, y := p.rows[a][j], p.rows[b][j]
+				if x != 0 || y != 0 {
+					scores[j] += pa.m[x][y]
+				}
+			}
+		}
+	}
+	return scores
+}
+
+// align returns the profile of the global alignment of the profiles a and b.
+func (pa profileAligner) align(a, b *profile) *profile {
+	ca, cb := pa.counts(a), pa.counts(b)
+	na, nb := float64(len(a.rows)), float64(len(b.rows))
+
+	// va holds the score of each column of a
+	// against each letter, and the gap scores
+	// are the mean scores of aligning a column
+	// to a gap column.
+	va := make([][]float64, len(ca))
+	gapA := make([]float64, len(ca))
+	for i, col := range ca {
+		va[i] = make([]float64, pa.let)
+		for x, n := range col {
+			if n == 0 {
+				continue
+			}
+			for y := range va[i] {
+				if x != 0 || y != 0 {
+					va[i][y] += float64(n) * float64(pa.m[x][y])
+				}
+			}
+			if x != 0 {
+				gapA[i] += float64(n) * float64(pa.m[x][0])
+			}
+		}
+		gapA[i] /= na
+	}
+	gapB := make([]float64, len(cb))
+	for j, col := range cb {
+		for y, n := range col {
+			if y != 0 {
+				gapB[j] += float64(n) * float64(pa.m[0][y])
+			}
+		}
+		gapB[j] /= nb
+	}
+	match := func(i, j int) float64 {
+		var s float64
+		for y, n := range cb[j] {
+			if n != 0 {
+				s += float64(n) * va[i][y]
+			}
+		}
+		return s / (na * nb)
+	}
+
+	const (
+		diag = iota
+		up
+		left
+	)
+	r, c := len(ca), len(cb)
+	score := make([][]float64, r+1)
+	trace := make([][]byte, r+1)
+	for i := range score {
+		score[i] = make([]float64, c+1)
+		trace[i] = make([]byte, c+1)
+	}
+	for i := 1; i <= r; i++ {
+		score[i][0] = score[i-1][0] + gapA[i-1]
+		trace[i][0] = up
+	}
+	for j := 1; j <= c; j++ {
+		score[0][j] = score[0][j-1] + gapB[j-1]
+		trace[0][j] = left
+	}
+	for i := 1; i <= r; i++ {
+		for j := 1; j <= c; j++ {
+			s, t := score[i-1][j-1]+match(i-1, j-1), byte(diag)
+			if u := score[i-1][j] + gapA[i-1]; u > s {
+				s, t = u, up
+			}
+			if l := score[i][j-1] + gapB[j-1]; l > s {
+				s, t = l, left
+			}
+			score[i][j], trace[i][j] = s, t
+		}
+	}
+
+	var ops []byte
+	for i, j := r, c; i > 0 || j > 0; {
+		t := trace[i][j]
+		ops = append(ops, t)
+		switch t {
+		case diag:
+			i--
+			j--
+		case up:
+			i--
+		case left:
+			j--
+		}
+	}
+
+	out := &profile{members: append(append([]int(nil), a.members...), b.members...)}
+	for _, src := range []struct {
+		p     *profile
+		gapOp byte
+	}{{a, left}, {b, up}} {
+		for k := range src.p.rows {
+			row := make([]int, 0, len(ops))
+			pos := make([]int, 0, len(ops))
+			j := 0
+			for x := len(ops) - 1; x >= 0; x-- {
+				if ops[x] == src.gapOp {
+					row = append(row, 0)
+					pos = append(pos, -1)
+					continue
+				}
+				row = append(row, src.p.rows[k][j])
+				pos = append(pos, src.p.pos[k][j])
+				j++
+			}
+			out.rows = append(out.rows, row)
+			out.pos = append(out.pos, pos)
+		}
+	}
+	return out
+}
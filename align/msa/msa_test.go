@@ -0,0 +1,152 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msa
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/treeio/newick"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+var m = align.Linear{
+	{0, -5, -5, -5, -5},
+	{-5, 5, -4, -4, -4},
+	{-5, -4, 5, -4, -4},
+	{-5, -4, -4, 5, -4},
+	{-5, -4, -4, -4, 5},
+}
+
+func rows(a *Alignment) []string {
+	var r []string
+	for i := 0; i < a.Rows(); i++ {
+		r = append(r, a.Row(i).(*linear.Seq).Seq.String())
+	}
+	return r
+}
+
+func (s *S) TestProgressive(c *check.C) {
+	var seqs []*linear.Seq
+	for _, t := range []struct{ id, seq string }{
+		{"a", "acgtacgtgacc"},
+		{"b", "acgtacgtacc"},
+		{"c", "acgtcgtgacc"},
+		{"d", "acgtacgtgaccaa"},
+	} {
+		seqs = append(seqs, linear.NewSeq(t.id, alphabet.Letters(t.seq), alphabet.DNAgapped))
+	}
+
+	aln, err := Progressive{Matrix: m}.Align("msa", seqs, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	c.Check(aln.ID, check.Equals, "msa")
+	c.Check(rows(aln), check.DeepEquals, []string{
+		"acgtacgtgacc--",
+		"acgtacgt-acc--",
+		"acgt-cgtgacc--",
+		"acgtacgtgaccaa",
+	})
+	c.Assert(aln.Scores, check.HasLen, 14)
+	c.Check(aln.Scores[0], check.Equals, 6*5)
+	c.Check(aln.Scores[4], check.Equals, 3*5-3*5)
+	c.Check(aln.Scores[13], check.Equals, -3*5)
+	var sum int
+	for _, v := range aln.Scores {
+		sum += v
+	}
+	c.Check(aln.Score(), check.Equals, sum)
+	c.Check(aln.Tree.Leaves(), check.HasLen, 4)
+
+	// The letters of each row are the letters of its
+	// sequence.
+	for i, r := range rows(aln) {
+		var l []byte
+		for _, b := range []byte(r) {
+			if b != '-' {
+				l = append(l, b)
+			}
+		}
+		c.Check(string(l), check.Equals, seqs[i].Seq.String())
+	}
+
+	// A given guide tree is used and must name the
+	// sequences.
+	tree, err := newick.Parse("((a,d),(b,c));")
+	c.Assert(err, check.Equals, nil)
+	aln, err = Progressive{Matrix: m, Tree: tree}.Align("msa", seqs, nil)
+	c.Assert(err, check.Equals, nil)
+	c.Check(aln.Tree, check.Equals, tree)
+	tree, err = newick.Parse("((a,d),(b,e));")
+	c.Assert(err, check.Equals, nil)
+	_, err = Progressive{Matrix: m, Tree: tree}.Align("msa", seqs, nil)
+	c.Check(err, check.Equals, ErrTreeMismatch)
+
+	_, err = Progressive{Matrix: m}.Align("msa", nil, nil)
+	c.Check(err, check.Equals, ErrNoSequences)
+	_, err = Progressive{Matrix: m}.Align("msa", []*linear.Seq{seqs[0], seqs[0]}, nil)
+	c.Check(err, check.Equals, ErrDuplicateID)
+
+	// A single sequence is its own alignment.
+	aln, err = Progressive{Matrix: m}.Align("msa", seqs[:1], nil)
+	c.Assert(err, check.Equals, nil)
+	c.Check(rows(aln), check.DeepEquals, []string{"acgtacgtgacc"})
+}
+
+func aligned(c *check.C, rows ...string) *multi.Multi {
+	var s []seq.Sequence
+	for i, r := range rows {
+		s = append(s, linear.NewSeq(string(rune('a'+i)), alphabet.Letters(r), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("in", s, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+func (s *S) TestProfiles(c *check.C) {
+	a := aligned(c, "acgtacgt", "acg-acgt")
+	b := aligned(c, "acgacgt", "acgacgt", "tcgacgt")
+	aln, err := Profiles{Matrix: m}.Align("pp", a, b, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	c.Check(aln.ID, check.Equals, "pp")
+	c.Check(rows(aln), check.DeepEquals, []string{
+		"acgtacgt",
+		"acg-acgt",
+		"acg-acgt",
+		"acg-acgt",
+		"tcg-acgt",
+	})
+	c.Check(aln.Rows(), check.Equals, 5)
+	c.Check(aln.Scores[3], check.Equals, 4*-5)
+
+	_, err = Profiles{Matrix: m}.Align("pp", a, &multi.Multi{}, nil)
+	c.Check(err, check.Equals, ErrNoSequences)
+}
+
+func (s *S) TestRefine(c *check.C) {
+	// The last row is poorly placed and is
+	// realigned to the others.
+	in := aligned(c, "acgtacgt--", "acgtacgt--", "acgtacgt--", "--acgtacgt")
+	p := Profiles{Matrix: m}
+	before, err := p.Refine("r", in, nil, 0)
+	c.Assert(err, check.Equals, nil)
+	c.Check(rows(before), check.DeepEquals, []string{"acgtacgt--", "acgtacgt--", "acgtacgt--", "--acgtacgt"})
+
+	aln, err := p.Refine("r", in, nil, 5)
+	c.Assert(err, check.Equals, nil)
+	c.Check(rows(aln), check.DeepEquals, []string{"acgtacgt", "acgtacgt", "acgtacgt", "acgtacgt"})
+	c.Check(aln.Score() > before.Score(), check.Equals, true)
+	c.Check(aln.Row(3).Name(), check.Equals, "d")
+}
@@ -0,0 +1,174 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msa
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"fmt"
+)
+
+// Profiles is the profile-profile aligner type. It aligns existing multiple alignments
+// to each other, keeping the columns of each, using the same scoring as the profile
+// alignments of Progressive.
+type Profiles struct {
+	// Matrix is the linear gap penalty
+	// scoring matrix.
+	Matrix align.Linear
+}
+
+// Align returns the alignment of the rows of a and b with the given id, using cons as the
+// column consensus function of the returned Multi. The rows of a are followed by the rows
+// of b. The columns of each alignment are kept, with gap columns inserted to maximise the
+// mean score of the letter pairs of the aligned columns. The alignments must share a
+// gapped alphabet.
+func (p Profiles) Align(id string, a, b *multi.Multi, cons seq.ConsenseFunc) (*Alignment, error) {
+	if a.Rows() == 0 || b.Rows() == 0 {
+		return nil, ErrNoSequences
+	}
+	if a.Alpha != b.Alpha {
+		return nil, align.ErrMismatchedAlphabets
+	}
+	err := checkMatrix(p.Matrix, a.Alpha)
+	if err != nil {
+		return nil, err
+	}
+	seqs, prof, err := p.rows(a, b)
+	if err != nil {
+		return nil, err
+	}
+	n := a.Rows()
+	pa := &profile{members: prof.members[:n], rows: prof.rows[:n], pos: prof.pos[:n]}
+	pb := &profile{members: prof.members[n:], rows: prof.rows[n:], pos: prof.pos[n:]}
+
+	al := profileAligner{m: p.Matrix, let: a.Alpha.Len()}
+	prof = al.align(pa, pb)
+	m, err := prof.multi(id, seqs, a.Alpha, cons)
+	if err != nil {
+		return nil, err
+	}
+	return &Alignment{Multi: m, Scores: al.scores(prof)}, nil
+}
+
+// Refine returns the alignment of the rows of m with the given id after iterative
+// refinement, using cons as the column consensus function of the returned Multi. Each
+// row in turn is removed from the alignment and realigned to the profile of the remaining
+// rows, and the result is kept if it improves the sum-of-pairs score. Refinement stops
+// after rounds passes over the rows, or after a pass with no improvement.
+func (p Profiles) Refine(id string, m *multi.Multi, cons seq.ConsenseFunc, rounds int) (*Alignment, error) {
+	if m.Rows() == 0 {
+		return nil, ErrNoSequences
+	}
+	err := checkMatrix(p.Matrix, m.Alpha)
+	if err != nil {
+		return nil, err
+	}
+	seqs, prof, err := p.rows(m)
+	if err != nil {
+		return nil, err
+	}
+
+	al := profileAligner{m: p.Matrix, let: m.Alpha.Len()}
+	best := sum(al.scores(prof))
+	for r := 0; r < rounds && len(prof.rows) > 1; r++ {
+		var improved bool
+		for _, i := range append([]int(nil), prof.members...) {
+			one, rest := prof.split(i)
+			cand := al.align(one, rest)
+			if s := sum(al.scores(cand)); s > best {
+				prof, best = cand, s
+				improved = true
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+
+	a, err := prof.multi(id, seqs, m.Alpha, cons)
+	if err != nil {
+		return nil, err
+	}
+	return &Alignment{Multi: a, Scores: al.scores(prof)}, nil
+}
+
+// rows returns the rows of the alignments ms as sequences holding their aligned letters,
+// and the profile of those rows.
+func (p Profiles) rows(ms ...*multi.Multi) ([]*linear.Seq, *profile, error) {
+	var (
+		seqs []*linear.Seq
+		prof profile
+	)
+	for _, m := range ms {
+		index := m.Alpha.LetterIndex()
+		start, end := m.Start(), m.End()
+		for i := 0; i < m.Rows(); i++ {
+			r := m.Row(i)
+			l := make(alphabet.Letters, end-start)
+			row := make([]int, len(l))
+			pos := make([]int, len(l))
+			for j := range l {
+				l[j] = m.Alpha.Gap()
+				if c := start + j; r.Start() <= c && c < r.End() {
+					l[j] = r.At(c).L
+				}
+				if row[j] = index[l[j]]; row[j] < 0 {
+					return nil, nil, fmt.Errorf("msa: illegal letter %q at column %d in %s", l[j], j, r.Name())
+				}
+				pos[j] = j
+			}
+			s := linear.NewSeq(r.Name(), l, m.Alpha)
+			s.Desc = r.Description()
+			prof.members = append(prof.members, len(seqs))
+			prof.rows = append(prof.rows, row)
+			prof.pos = append(prof.pos, pos)
+			seqs = append(seqs, s)
+		}
+	}
+	return seqs, &prof, nil
+}
+
+// split returns the profile of the member i of p and the profile of the remaining
+// members, each without columns holding only gaps.
+func (p *profile) split(i int) (one, rest *profile) {
+	one, rest = &profile{}, &profile{}
+	for k, m := range p.members {
+		dst := rest
+		if m == i {
+			dst = one
+		}
+		dst.members = append(dst.members, m)
+		dst.rows = append(dst.rows, p.rows[k])
+		dst.pos = append(dst.pos, p.pos[k])
+	}
+	one.strip()
+	rest.strip()
+	return one, rest
+}
+
+// strip removes the columns of p that hold only gaps.
+func (p *profile) strip() {
+	var keep []int
+	for j := 0; j < p.len(); j++ {
+		for _, r := range p.rows {
+			if r[j] != 0 {
+				keep = append(keep, j)
+				break
+			}
+		}
+	}
+	for k := range p.rows {
+		row := make([]int, len(keep))
+		pos := make([]int, len(keep))
+		for x, j := range keep {
+			row[x], pos[x] = p.rows[k][j], p.pos[k][j]
+		}
+		p.rows[k], p.pos[k] = row, pos
+	}
+}
@@ -0,0 +1,447 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+)
+
+var (
+	ErrDistanceExceeded = errors.New("align: edit distance exceeds maximum")
+	ErrBadMatch         = errors.New("align: match outside text")
+)
+
+// Myers is the bit-parallel unit cost edit distance aligner type. It implements the
+// bit-vector algorithm of Myers (J ACM 46:395-415, 1999), computing a column of the
+// edit distance table for each text letter in time proportional to the pattern length
+// divided by the machine word size. Patterns longer than a word are handled in blocks
+// of 64 rows.
+//
+// If MaxDist is not negative, alignments with edit distances greater than MaxDist are
+// not reported, and only the blocks of rows that can hold cells within MaxDist of the
+// start are computed, Ukkonen's cut-off, so the cost of a search is proportional to
+// MaxDist rather than the pattern length. The zero value of Myers therefore finds only
+// exact matches: Distance and Align return ErrDistanceExceeded for any pair that is not
+// identical, and Find reports only exact occurrences of the pattern. A negative MaxDist
+// is needed for unbounded distances. Letters are compared by alphabet index, so letters
+// differing only in case are equal.
+type Myers struct {
+	MaxDist int
+}
+
+// EditMatch is a match of a pattern in a text found by Myers.Find.
+type EditMatch struct {
+	// Start and End are the positions of
+	// the match in the text.
+	Start, End int
+
+	// Dist is the edit distance between
+	// the pattern and the matched text.
+	Dist int
+}
+
+// Distance returns the edit distance between reference and query. It returns
+// ErrDistanceExceeded if MaxDist is not negative and the distance exceeds it,
+// or an error if the sequence data types or alphabets do not match.
+func (a Myers) Distance(reference, query AlphabetSlicer) (int, error) {
+	r, q, let, err := a.indexes(reference, query)
+	if err != nil {
+		return 0, err
+	}
+	if len(r) == 0 {
+		return a.check(len(q))
+	}
+	if len(q) == 0 {
+		return a.check(len(r))
+	}
+	my := newMyers(r, let)
+	d := -1
+	my.scan(q, true, a.MaxDist, func(j, y int) bool {
+		if j == len(q) && y == my.last() {
+			d = my.score[y]
+		}
+		return true
+	})
+	if d < 0 {
+		return 0, ErrDistanceExceeded
+	}
+	return a.check(d)
+}
+
+// Align aligns reference and query with unit cost for each substitution, insertion and
+// deletion. It returns an alignment description, with each pair scored by the negative
+// of its number of edits, or ErrDistanceExceeded if MaxDist is not
+// negative and the edit distance exceeds it, or an error if the sequence data types or
+// alphabets do not match.
+func (a Myers) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	r, q, let, err := a.indexes(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := a.trace(r, q, let)
+	if err != nil {
+		return nil, err
+	}
+	return editPairs(ops, r, q, 0, 0), nil
+}
+
+// Find returns the matches of pattern in text with edit distances no greater than
+// MaxDist, which must not be negative. Of each run of adjacent match end positions,
+// the end with the lowest edit distance is reported, with the start chosen to give
+// the shortest match with that distance. Matches are returned in order of their ends.
+func (a Myers) Find(pattern, text AlphabetSlicer) ([]EditMatch, error) {
+	if a.MaxDist < 0 {
+		return nil, ErrDistanceExceeded
+	}
+	p, t, let, err := a.indexes(pattern, text)
+	if err != nil {
+		return nil, err
+	}
+	if len(p) == 0 {
+		return nil, nil
+	}
+
+	var (
+		ends []EditMatch
+		run  bool
+	)
+	my := newMyers(p, let)
+	my.scan(t, false, a.MaxDist, func(j, y int) bool {
+		if y != my.last() || my.score[y] > a.MaxDist {
+			run = false
+			return true
+		}
+		d := my.score[y]
+		switch {
+		case !run:
+			ends = append(ends, EditMatch{End: j, Dist: d})
+		case d < ends[len(ends)-1].Dist:
+			ends[len(ends)-1] = EditMatch{End: j, Dist: d}
+		}
+		run = true
+		return true
+	})
+
+	// The start of each match is found by aligning
+	// the reversed pattern to the reversed text
+	// preceding the end of the match.
+	rp := reversed(p)
+	rev := newMyers(rp, let)
+	for i, m := range ends {
+		from := m.End - len(p) - m.Dist
+		if from < 0 {
+			from = 0
+		}
+		rt := reversed(t[from:m.End])
+		ends[i].Start = m.End
+		rev.reset()
+		rev.scan(rt, true, m.Dist, func(j, y int) bool {
+			if y == rev.last() && rev.score[y] == m.Dist {
+				ends[i].Start = m.End - j
+				return false
+			}
+			return true
+		})
+	}
+	return ends, nil
+}
+
+// Trace returns the alignment of pattern to the text of the match m found by Find. The
+// text positions of the alignment are positions in text.
+func (a Myers) Trace(pattern, text AlphabetSlicer, m EditMatch) ([]feat.Pair, error) {
+	p, t, let, err := a.indexes(pattern, text)
+	if err != nil {
+		return nil, err
+	}
+	if m.Start < 0 || m.End < m.Start || len(t) < m.End {
+		return nil, ErrBadMatch
+	}
+	ops, err := Myers{MaxDist: m.Dist}.trace(p, t[m.Start:m.End], let)
+	if err != nil {
+		return nil, err
+	}
+	return editPairs(ops, p, t, 0, m.Start), nil
+}
+
+// check returns d and an error if d exceeds a.MaxDist.
+func (a Myers) check(d int) (int, error) {
+	if a.MaxDist >= 0 && d > a.MaxDist {
+		return 0, ErrDistanceExceeded
+	}
+	return d, nil
+}
+
+// indexes returns the alphabet indexes of the letters of reference and query and
+// the length of their alphabet.
+func (a Myers) indexes(reference, query AlphabetSlicer) (r, q []int, let int, err error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return nil, nil, 0, ErrNoAlphabet
+	}
+	if alpha != query.Alphabet() {
+		return nil, nil, 0, ErrMismatchedAlphabets
+	}
+	r, q, err = letterIndexes(reference, query, alpha)
+	return r, q, alpha.Len(), err
+}
+
+// trace returns the diag, up and left operations of the global unit cost
+// alignment of r and q.
+func (a Myers) trace(r, q []int, let int) ([]byte, error) {
+	if len(r) == 0 {
+		if _, err := a.check(len(q)); err != nil {
+			return nil, err
+		}
+		ops := make([]byte, len(q))
+		for i := range ops {
+			ops[i] = left
+		}
+		return ops, nil
+	}
+
+	// pv and mv hold the vertical delta vectors
+	// of the active blocks of each column.
+	my := newMyers(r, let)
+	pv := [][]uint64{append([]uint64(nil), my.pv...)}
+	mv := [][]uint64{append([]uint64(nil), my.mv...)}
+	d := -1
+	if len(q) == 0 {
+		d = len(r)
+	}
+	my.scan(q, true, a.MaxDist, func(j, y int) bool {
+		pv = append(pv, append([]uint64(nil), my.pv[:y+1]...))
+		mv = append(mv, append([]uint64(nil), my.mv[:y+1]...))
+		if j == len(q) && y == my.last() {
+			d = my.score[y]
+		}
+		return true
+	})
+	if d < 0 {
+		return nil, ErrDistanceExceeded
+	}
+	if _, err := a.check(d); err != nil {
+		return nil, err
+	}
+
+	// dist returns the edit distance of the first i letters
+	// of r and the first j letters of q, or a value greater
+	// than d if the cell was not computed.
+	dist := func(i, j int) int {
+		v := j
+		for b := 0; i > 0; b++ {
+			if b >= len(pv[j]) {
+				return d + len(r) + len(q) + 1
+			}
+			mask := ^uint64(0)
+			if i < wordSize {
+				mask = 1<<uint(i) - 1
+			}
+			v += popcount(pv[j][b]&mask) - popcount(mv[j][b]&mask)
+			i -= wordSize
+		}
+		return v
+	}
+	var ops []byte
+	for i, j := len(r), len(q); i > 0 || j > 0; {
+		v := dist(i, j)
+		switch {
+		case i > 0 && j > 0 && dist(i-1, j-1)+unitCost(r[i-1], q[j-1]) == v:
+			ops = append(ops, diag)
+			i--
+			j--
+		case i > 0 && dist(i-1, j)+1 == v:
+			ops = append(ops, up)
+			i--
+		default:
+			ops = append(ops, left)
+			j--
+		}
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops, nil
+}
+
+func unitCost(a, b int) int {
+	if a == b {
+		return 0
+	}
+	return 1
+}
+
+// editPairs returns the feature pairs of the alignment of the letter indexes r
+// and q described by ops, starting at position i of r and j of q, scored by the
+// negative of their number of edits.
+func editPairs(ops []byte, r, q []int, i, j int) []feat.Pair {
+	var aln []feat.Pair
+	for k := 0; k < len(ops); {
+		op := ops[k]
+		si, sj := i, j
+		var score int
+		for ; k < len(ops) && ops[k] == op; k++ {
+			switch op {
+			case diag:
+				score -= unitCost(r[i], q[j])
+				i++
+				j++
+			case up:
+				score--
+				i++
+			case left:
+				score--
+				j++
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+	return aln
+}
+
+const wordSize = 64
+
+// popcount returns the number of set bits in x.
+func popcount(x uint64) int {
+	x -= (x >> 1) & 0x5555555555555555
+	x = x&0x3333333333333333 + (x>>2)&0x3333333333333333
+	x = (x + x>>4) & 0x0f0f0f0f0f0f0f0f
+	return int(x * 0x0101010101010101 >> 56)
+}
+
+// myers holds the state of a bit-parallel edit distance computation
+// for a pattern.
+type myers struct {
+	m int
+
+	// peq holds the match vectors of each
+	// block for each letter index.
+	peq [][]uint64
+
+	// pv and mv hold the positive and
+	// negative vertical delta vectors of
+	// each block, and score the value of
+	// the last row of each block.
+	pv, mv []uint64
+	score  []int
+}
+
+func newMyers(p []int, let int) *myers {
+	n := (len(p) + wordSize - 1) / wordSize
+	my := &myers{
+		m:     len(p),
+		peq:   make([][]uint64, let),
+		pv:    make([]uint64, n),
+		mv:    make([]uint64, n),
+		score: make([]int, n),
+	}
+	for c := range my.peq {
+		my.peq[c] = make([]uint64, n)
+	}
+	for i, c := range p {
+		my.peq[c][i/wordSize] |= 1 << uint(i%wordSize)
+	}
+	my.reset()
+	return my
+}
+
+// reset sets the state to the first column of the table.
+func (my *myers) reset() {
+	for b := range my.pv {
+		my.pv[b], my.mv[b] = ^uint64(0), 0
+		my.score[b] = my.height(b)
+		if b > 0 {
+			my.score[b] += my.score[b-1]
+		}
+	}
+}
+
+// last returns the index of the last block.
+func (my *myers) last() int { return len(my.pv) - 1 }
+
+// height returns the number of pattern rows in block b.
+func (my *myers) height(b int) int {
+	if b == my.last() && my.m%wordSize != 0 {
+		return my.m % wordSize
+	}
+	return wordSize
+}
+
+// advance advances block b by the text letter index c given the horizontal
+// delta hin at the top of the block, and returns the delta at its bottom.
+func (my *myers) advance(b, c, hin int) int {
+	pv, mv := my.pv[b], my.mv[b]
+	eq := my.peq[c][b]
+	xv := eq | mv
+	if hin < 0 {
+		eq |= 1
+	}
+	xh := (((eq & pv) + pv) ^ pv) | eq
+	ph := mv | ^(xh | pv)
+	mh := pv & xh
+
+	high := uint64(1) << uint(my.height(b)-1)
+	var hout int
+	switch {
+	case ph&high != 0:
+		hout = 1
+	case mh&high != 0:
+		hout = -1
+	}
+	ph <<= 1
+	mh <<= 1
+	switch {
+	case hin < 0:
+		mh |= 1
+	case hin > 0:
+		ph |= 1
+	}
+	my.pv[b] = mh | ^(xv | ph)
+	my.mv[b] = ph & xv
+	return hout
+}
+
+// scan computes the columns of the table for the text letter indexes t. If
+// global is true, the top row of the table holds the number of text letters,
+// and otherwise it is zero. If k is not negative, only blocks that may hold
+// cells no greater than k are computed. After each column j, fn is called with
+// j and the index of the last computed block, and scanning stops if fn returns
+// false.
+func (my *myers) scan(t []int, global bool, k int, fn func(j, y int) bool) {
+	top := 0
+	if global {
+		top = 1
+	}
+	y := my.last()
+	if k >= 0 && k/wordSize < y {
+		y = k / wordSize
+	}
+	for j, c := range t {
+		carry := top
+		for b := 0; b <= y; b++ {
+			carry = my.advance(b, c, carry)
+			my.score[b] += carry
+		}
+		if k >= 0 {
+			if y < my.last() && my.score[y]-carry <= k && (my.peq[c][y+1]&1 != 0 || carry < 0) {
+				y++
+				my.pv[y], my.mv[y] = ^uint64(0), 0
+				my.score[y] = my.score[y-1] - carry + my.height(y) + my.advance(y, c, carry)
+			} else {
+				for y > 0 && my.score[y] >= k+my.height(y) {
+					y--
+				}
+			}
+		}
+		if !fn(j+1, y) {
+			return
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+// editTable returns the unit cost edit distance table of a and b. If free is
+// true, the first row is zero so a may start anywhere in b.
+func editTable(a, b []byte, free bool) [][]int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := 1; j <= len(b); j++ {
+		if !free {
+			d[0][j] = j
+		}
+		for i := 1; i <= len(a); i++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min3(d[i-1][j-1]+cost, d[i-1][j]+1, d[i][j-1]+1)
+		}
+	}
+	return d
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// editDist returns the edit distance of the aligned regions of a and b
+// in the alignment aln.
+func editDist(c *check.C, a, b []byte, aln []feat.Pair) int {
+	var d, score int
+	for _, p := range aln {
+		f := p.Features()
+		switch {
+		case f[0].Len() == 0:
+			d += f[1].Len()
+		case f[1].Len() == 0:
+			d += f[0].Len()
+		default:
+			c.Assert(f[0].Len(), check.Equals, f[1].Len())
+			for k := 0; k < f[0].Len(); k++ {
+				if a[f[0].Start()+k] != b[f[1].Start()+k] {
+					d++
+				}
+			}
+		}
+		score += p.(*featPair).score
+	}
+	c.Check(score, check.Equals, -d)
+	return d
+}
+
+func (s *S) TestMyers(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	randBytes := func(n int) []byte {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "acgt"[rnd.Intn(4)]
+		}
+		return b
+	}
+	mutate := func(b []byte, n int) []byte {
+		b = append([]byte(nil), b...)
+		for i := 0; i < n && len(b) > 0; i++ {
+			p := rnd.Intn(len(b))
+			switch rnd.Intn(3) {
+			case 0:
+				b[p] = "acgt"[rnd.Intn(4)]
+			case 1:
+				b = append(b[:p], b[p+1:]...)
+			case 2:
+				b = append(b[:p], append([]byte{"acgt"[rnd.Intn(4)]}, b[p:]...)...)
+			}
+		}
+		return b
+	}
+	dna := func(b []byte) *linear.Seq {
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNA)
+	}
+
+	for i := 0; i < 200; i++ {
+		a := randBytes(rnd.Intn(200))
+		b := mutate(a, rnd.Intn(30))
+		if rnd.Intn(4) == 0 {
+			b = randBytes(rnd.Intn(200))
+		}
+		want := editTable(a, b, false)[len(a)][len(b)]
+
+		got, err := Myers{MaxDist: -1}.Distance(dna(a), dna(b))
+		c.Assert(err, check.Equals, nil)
+		c.Check(got, check.Equals, want, check.Commentf("Test %d", i))
+		aln, err := Myers{MaxDist: -1}.Align(dna(a), dna(b))
+		c.Assert(err, check.Equals, nil)
+		c.Check(editDist(c, a, b, aln), check.Equals, want, check.Commentf("Test %d", i))
+
+		// Banded computation finds the distance when it
+		// is within the band.
+		for _, k := range []int{want, want + 1 + rnd.Intn(10), want - 1} {
+			if k < 0 {
+				continue
+			}
+			got, err := Myers{MaxDist: k}.Distance(dna(a), dna(b))
+			aln, aerr := Myers{MaxDist: k}.Align(dna(a), dna(b))
+			if k < want {
+				c.Check(err, check.Equals, ErrDistanceExceeded, check.Commentf("Test %d k=%d", i, k))
+				c.Check(aerr, check.Equals, ErrDistanceExceeded, check.Commentf("Test %d k=%d", i, k))
+				continue
+			}
+			c.Assert(err, check.Equals, nil, check.Commentf("Test %d k=%d", i, k))
+			c.Check(got, check.Equals, want, check.Commentf("Test %d k=%d", i, k))
+			c.Assert(aerr, check.Equals, nil, check.Commentf("Test %d k=%d", i, k))
+			c.Check(editDist(c, a, b, aln), check.Equals, want, check.Commentf("Test %d k=%d", i, k))
+		}
+	}
+
+	// Find reports the best end of each run of matching
+	// ends, and Trace recovers its alignment.
+	for i := 0; i < 100; i++ {
+		p := randBytes(1 + rnd.Intn(150))
+		text := randBytes(rnd.Intn(1000))
+		var planted []int
+		for n := rnd.Intn(3); n > 0; n-- {
+			at := rnd.Intn(len(text) + 1)
+			text = append(text[:at], append(mutate(p, rnd.Intn(4)), text[at:]...)...)
+			planted = append(planted, at)
+		}
+		k := rnd.Intn(1 + len(p)/5)
+		d := editTable(p, text, true)
+		var ends []int
+		for j := 1; j <= len(text); j++ {
+			if d[len(p)][j] <= k {
+				ends = append(ends, j)
+			}
+		}
+		got, err := Myers{MaxDist: k}.Find(dna(p), dna(text))
+		c.Assert(err, check.Equals, nil)
+		run := 0
+		for j, e := range ends {
+			if j == 0 || e != ends[j-1]+1 {
+				c.Assert(run < len(got), check.Equals, true, check.Commentf("Test %d", i))
+				run++
+			}
+			m := got[run-1]
+			c.Check(d[len(p)][e] >= m.Dist, check.Equals, true, check.Commentf("Test %d", i))
+		}
+		c.Check(got, check.HasLen, run, check.Commentf("Test %d", i))
+		for _, m := range got {
+			c.Check(m.Dist, check.Equals, d[len(p)][m.End], check.Commentf("Test %d", i))
+			c.Check(editTable(p, text[m.Start:m.End], false)[len(p)][m.End-m.Start], check.Equals, m.Dist, check.Commentf("Test %d", i))
+			aln, err := Myers{}.Trace(dna(p), dna(text), m)
+			c.Assert(err, check.Equals, nil)
+			c.Check(editDist(c, p, text, aln), check.Equals, m.Dist, check.Commentf("Test %d", i))
+			f := aln[0].Features()
+			c.Check(f[1].Start(), check.Equals, m.Start)
+			f = aln[len(aln)-1].Features()
+			c.Check(f[1].End(), check.Equals, m.End)
+		}
+	}
+
+	// Letters are compared case insensitively.
+	d, err := Myers{MaxDist: -1}.Distance(dna([]byte("ACGT")), dna([]byte("acgt")))
+	c.Check(err, check.Equals, nil)
+	c.Check(d, check.Equals, 0)
+	_, err = Myers{}.Find(dna([]byte("acgt")), linear.NewSeq("", nil, alphabet.Protein))
+	c.Check(err, check.Equals, ErrMismatchedAlphabets)
+}
+
+func (s *S) TestPopcount(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		x := uint64(rnd.Int63())<<1 ^ uint64(rnd.Int63())
+		var want int
+		for v := x; v != 0; v >>= 1 {
+			want += int(v & 1)
+		}
+		c.Check(popcount(x), check.Equals, want, check.Commentf("Test %d: %x", i, x))
+	}
+	c.Check(popcount(0), check.Equals, 0)
+	c.Check(popcount(^uint64(0)), check.Equals, 64)
+}
+
+func (s *S) TestMyersZero(c *check.C) {
+	a := linear.NewSeq("", alphabet.BytesToLetters([]byte("ACGT")), alphabet.DNAgapped)
+	b := linear.NewSeq("", alphabet.BytesToLetters([]byte("ACCT")), alphabet.DNAgapped)
+	d, err := Myers{}.Distance(a, a)
+	c.Check(err, check.Equals, nil)
+	c.Check(d, check.Equals, 0)
+	_, err = Myers{}.Distance(a, b)
+	c.Check(err, check.Equals, ErrDistanceExceeded)
+	d, err = Myers{MaxDist: -1}.Distance(a, b)
+	c.Check(err, check.Equals, nil)
+	c.Check(d, check.Equals, 1)
+}
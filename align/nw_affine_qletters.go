@@ -11,96 +11,15 @@ import (
 	"github.com/biogo/biogo/feat"
 
 	"fmt"
-	"os"
-	"text/tabwriter"
 )
 
-//line nw_affine_type.got:17
-func drawNWAffineTableQLetters(rSeq, qSeq alphabet.QLetters, index alphabet.Index, table [][3]int, a NWAffine) {
-	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Printf("rSeq: %s\n", rSeq)
-	fmt.Printf("qSeq: %s\n", qSeq)
-	for l := 0; l < 3; l++ {
-		fmt.Fprintf(tw, "%c\tqSeq\t", "MUL"[l])
-		for _, l := range qSeq {
-			fmt.Fprintf(tw, "%c\t", l)
-		}
-		fmt.Fprintln(tw)
-
-		r, c := rSeq.Len()+1, qSeq.Len()+1
-		fmt.Fprint(tw, "rSeq\t")
-		for i := 0; i < r; i++ {
-			if i != 0 {
-				fmt.Fprintf(tw, "%c\t", rSeq[i-1].L)
-			}
-
-			for j := 0; j < c; j++ {
-				p := pointerNWAffineQLetters(rSeq, qSeq, i, j, l, table, index, a, c)
-				var vi interface{}
-				if vi = table[i*c+j][l]; vi == minInt {
-					vi = "-Inf"
-				}
-				if p != "" {
-					fmt.Fprintf(tw, "%s % 4v\t", p, vi)
-				} else {
-					fmt.Fprintf(tw, "%v\t", vi)
-				}
-			}
-			fmt.Fprintln(tw)
-		}
-		fmt.Fprintln(tw)
-	}
-	tw.Flush()
-}
-
-func pointerNWAffineQLetters(rSeq, qSeq alphabet.QLetters, i, j, l int, table [][3]int, index alphabet.Index, a NWAffine, c int) string {
-	switch {
-	case i == 0 && j == 0:
-		return ""
-	case i == 0:
-		if j == 1 {
-			return "⬅ m"
-		}
-		return "⬅ l"
-	case j == 0:
-		if i == 1 {
-			return "⬆ m"
-		}
-		return "⬆ u"
-	}
-	rVal := index[rSeq[i-1].L]
-	qVal := index[qSeq[j-1].L]
-	if rVal < 0 || qVal < 0 {
-		return ""
-	}
-	switch p := i*c + j; table[p][l] {
-	case table[p-c][up] + a.Matrix[rVal][gap]:
-		return "⬆ u"
-	case table[p-1][left] + a.Matrix[gap][qVal]:
-		return "⬅ l"
-
-	case table[p-c][diag] + a.GapOpen + a.Matrix[rVal][gap]:
-		return "⬆ m"
-	case table[p-1][diag] + a.GapOpen + a.Matrix[gap][qVal]:
-		return "⬅ m"
-
-	case table[p-c-1][up] + a.Matrix[rVal][qVal]:
-		return "⬉ u"
-	case table[p-c-1][left] + a.Matrix[rVal][qVal]:
-		return "⬉ l"
-	case table[p-c-1][diag] + a.Matrix[rVal][qVal]:
-		return "⬉ m"
-	default:
-		return [3]string{"", "⬆ u", "⬅ l"}[l]
-	}
-}
-
-func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+//line nw_affine_type.got:15
+func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alphabet, tr Tracer, buf *Buffer) ([]feat.Pair, error) {
 	let := len(a.Matrix)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
 	}
-	la := make([]int, 0, let*let)
+	la := buf.scores(let * let)
 	for _, row := range a.Matrix {
 		if len(row) != let {
 			return nil, ErrMatrixNotSquare
@@ -110,7 +29,7 @@ func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alp
 
 	index := alpha.LetterIndex()
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([][3]int, r*c)
+	table := buf.affine(r * c)
 	table[0] = [3]int{
 		diag: 0,
 		up:   minInt,
@@ -170,10 +89,15 @@ func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alp
 				add(table[p-1][diag], a.GapOpen+la[qVal]),
 				add(table[p-1][left], la[qVal]),
 			)
+			if tr != nil {
+				for l, s := range table[p] {
+					tr.Cell(l, i, j, s)
+				}
+			}
 		}
 	}
-	if debugNeedleAffine {
-		drawNWAffineTableQLetters(rSeq, qSeq, index, table, a)
+	if tr != nil {
+		tr.Table(r, c, affineLayers(table))
 	}
 
 	var (
@@ -191,6 +115,9 @@ func (a NWAffine) alignQLetters(rSeq, qSeq alphabet.QLetters, alpha alphabet.Alp
 	}
 	maxI, maxJ := i, j
 	for i > 0 && j > 0 {
+		if tr != nil {
+			tr.Traceback(layer, i, j)
+		}
 		var (
 			rVal = index[rSeq[i-1].L]
 			qVal = index[qSeq[j-1].L]
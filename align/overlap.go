@@ -0,0 +1,99 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// Overlap is the linear gap penalty overlap aligner type.
+//
+// Overlap alignment, also known as dovetail alignment, aligns a suffix of the
+// reference with a prefix of the query. Unaligned letters at the start of the
+// reference and at the end of the query are not penalized, so it is suitable
+// for detecting the suffix-prefix overlaps between reads used in assembly.
+// Overlaps in which a prefix of the reference aligns with a suffix of the query
+// are found by exchanging the sequences.
+type Overlap Linear
+
+var _ Aligner = Overlap{}
+
+// Align aligns two sequences using a modified Needleman-Wunsch algorithm that finds the highest
+// scoring overlap of the end of the reference with the start of the query. It returns an alignment
+// description or an error if the scoring matrix is not square, or the sequence data types or
+// alphabets do not match. The unaligned overhangs of the sequences are not included in the
+// alignment description.
+func (a Overlap) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(Linear(a), alpha)
+	if err != nil {
+		return nil, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	let := len(a)
+	rows, c := len(r)+1, len(q)+1
+	table := make([]int, rows*c)
+	// The first column is zero since leading
+	// reference letters are free.
+	for j := 1; j < c; j++ {
+		table[j] = table[j-1] + la[q[j-1]]
+	}
+	for i := 1; i < rows; i++ {
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			table[p] = max3(
+				table[p-c-1]+la[r[i-1]*let+q[j-1]],
+				table[p-c]+la[r[i-1]*let],
+				table[p-1]+la[q[j-1]],
+			)
+		}
+	}
+
+	// The alignment ends in the last row since
+	// trailing query letters are free. Ties are
+	// resolved in favour of longer overlaps.
+	i, j := len(r), len(q)
+	last := table[i*c:]
+	for k := len(q) - 1; k >= 0; k-- {
+		if last[k] > last[j] {
+			j = k
+		}
+	}
+
+	var ops []byte
+	for i > 0 && j > 0 {
+		p := i*c + j
+		switch {
+		case table[p] == table[p-c-1]+la[r[i-1]*let+q[j-1]]:
+			ops = append(ops, diag)
+			i--
+			j--
+		case table[p] == table[p-c]+la[r[i-1]*let]:
+			ops = append(ops, up)
+			i--
+		default:
+			ops = append(ops, left)
+			j--
+		}
+	}
+	for ; j > 0; j-- {
+		ops = append(ops, left)
+	}
+	for l, r := 0, len(ops)-1; l < r; l, r = l+1, r-1 {
+		ops[l], ops[r] = ops[r], ops[l]
+	}
+	aln := opsPairs(ops, r, q, i, j, la, let)
+	if len(aln) == 0 {
+		aln = append(aln, &featPair{a: feature{start: i, end: i}, b: feature{start: j, end: j}})
+	}
+	return aln, nil
+}
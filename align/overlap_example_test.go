@@ -0,0 +1,46 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+func ExampleOverlap_Align() {
+	// Two reads overlapping by 12 bases with one mismatch.
+	fsa := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("TTCAGGATCCGACTAGATTCACG"))}
+	fsa.Alpha = alphabet.DNAgapped
+	fsb := &linear.Seq{Seq: alphabet.BytesToLetters([]byte("ACTAGTTTCACGGGATCCAT"))}
+	fsb.Alpha = alphabet.DNAgapped
+
+	//		   Query letter
+	//  	 -	 A	 C	 G	 T
+	// -	 0	-5	-5	-5	-5
+	// A	-5	10	-3	-1	-4
+	// C	-5	-3	 9	-5	 0
+	// G	-5	-1	-5	 7	-3
+	// T	-5	-4	 0	-3	 8
+	overlap := Overlap{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+
+	aln, err := overlap.Align(fsa, fsb)
+	if err == nil {
+		fmt.Printf("%s\n", aln)
+		fa := Format(fsa, fsb, aln, '-')
+		fmt.Printf("%s\n%s\n", fa[0], fa[1])
+	}
+	// Output:
+	// [[11,23)/[0,12)=91]
+	// ACTAGATTCACG
+	// ACTAGTTTCACG
+}
@@ -0,0 +1,68 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestOverlap(c *check.C) {
+	m := Overlap{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	for i, t := range []struct {
+		ref, query string
+		expect     string
+	}{
+		{
+			// A suffix of the reference overlaps
+			// a prefix of the query.
+			ref: "TTCAGGACTAGT", query: "ACTAGTCCGA",
+			expect: "[[6,12)/[0,6)=52]",
+		},
+		{
+			// A prefix of the reference overlaps a
+			// suffix of the query. The overhangs are
+			// penalized in this orientation.
+			ref: "GATTACAGG", query: "CCTTGGATTACA",
+			expect: "[-/[0,5)=-25 [0,7)/[5,12)=62 [7,9)/-=-10]",
+		},
+		{
+			// The overlap is found when the sequences
+			// are exchanged.
+			ref: "CCTTGGATTACA", query: "GATTACAGG",
+			expect: "[[5,12)/[0,7)=62]",
+		},
+		{
+			// No overlap.
+			ref: "AAAAAAAA", query: "CCCCCCCC",
+			expect: "[-/[0,0)=0]",
+		},
+		{
+			// Empty input.
+			ref: "", query: "ACGT",
+			expect: "[-/[0,0)=0]",
+		},
+		{
+			ref: "ACGT", query: "",
+			expect: "[-/[0,0)=0]",
+		},
+	} {
+		ref := linear.NewSeq("", alphabet.BytesToLetters([]byte(t.ref)), alphabet.DNAgapped)
+		query := linear.NewSeq("", alphabet.BytesToLetters([]byte(t.query)), alphabet.DNAgapped)
+		aln, err := m.Align(ref, query)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(fmt.Sprint(aln), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+}
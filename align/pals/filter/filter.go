@@ -90,11 +90,7 @@ func (f *Filter) Filter(query *linear.Seq, selfAlign, complement bool, morass *m
 
 	var err error
 	err = f.ki.ForEachKmerOf(query, 0, query.Len(), func(ki *kmerindex.Index, position, kmer int) {
-		from := 0
-		if kmer > 0 {
-			from = ki.FingerAt(kmer - 1)
-		}
-		to := ki.FingerAt(kmer)
+		from, to := ki.KmerRange(kmerindex.Kmer(kmer))
 		for i := from; i < to; i++ {
 			f.commonKmer(ki.PosAt(i), position)
 		}
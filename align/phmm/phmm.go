@@ -0,0 +1,443 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package phmm provides alignment of sequences to profile hidden Markov models.
+//
+// A Model is a profile HMM with the state architecture of HMMER's Plan7 core
+// model (Eddy, Bioinformatics 14:755-763, 1998): each node of the profile has a
+// match, an insert and a delete state. Queries are aligned globally to the
+// model, either by the most probable state path, the Viterbi alignment, or by the
+// path maximising the expected number of correctly aligned query letters given
+// the posterior probabilities of the states (Durbin, Eddy, Krogh and Mitchison,
+// Biological Sequence Analysis, 1998). State paths are returned as Segment
+// features on the query.
+package phmm
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrBadModel            = errors.New("phmm: model dimensions do not match")
+	ErrMismatchedAlphabets = errors.New("phmm: query and model alphabets do not match")
+	ErrNoPath              = errors.New("phmm: no state path emits query")
+)
+
+// State is a profile HMM state type.
+type State byte
+
+const (
+	Match State = iota
+	Insert
+	Delete
+)
+
+func (s State) String() string {
+	switch s {
+	case Match:
+		return "match"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	}
+	return fmt.Sprintf("State(%d)", s)
+}
+
+// Transitions holds the natural log transition probabilities from the states of a
+// node of a profile HMM to the states of the following node. For the last node, the
+// transitions to the following match state are the transitions to the end of the
+// model.
+type Transitions struct {
+	MM, MI, MD float64
+	IM, II     float64
+	DM, DD     float64
+}
+
+// A Model is a profile HMM with natural log probability parameters. Nodes of the
+// model are numbered from 1 to M, with node 0 the begin state, which behaves as a
+// match state that emits no letter. Emission probabilities are indexed by the
+// alphabet index of letters.
+type Model struct {
+	Alpha alphabet.Alphabet
+
+	// Match holds the emission probabilities
+	// of match states 1 to M.
+	Match [][]float64
+
+	// Insert holds the emission probabilities
+	// of insert states 0 to M.
+	Insert [][]float64
+
+	// Trans holds the transitions from nodes
+	// 0 to M. Transitions from the delete
+	// state of node 0 are not used.
+	Trans []Transitions
+}
+
+// Len returns the number of nodes of the model, M.
+func (m *Model) Len() int { return len(m.Match) }
+
+func (m *Model) check(s *linear.Seq) ([]int, error) {
+	if len(m.Insert) != len(m.Match)+1 || len(m.Trans) != len(m.Match)+1 {
+		return nil, ErrBadModel
+	}
+	for _, e := range append(append([][]float64(nil), m.Match...), m.Insert...) {
+		if len(e) < m.Alpha.Len() {
+			return nil, ErrBadModel
+		}
+	}
+	if s.Alpha != m.Alpha {
+		return nil, ErrMismatchedAlphabets
+	}
+	index := m.Alpha.LetterIndex()
+	q := make([]int, len(s.Seq))
+	for i, l := range s.Seq {
+		if q[i] = index[l]; q[i] < 0 {
+			return nil, fmt.Errorf("phmm: illegal letter %q at position %d in %s", l, i, s.ID)
+		}
+	}
+	return q, nil
+}
+
+// A Segment is a run of a state type in the state path of a query aligned to a model.
+type Segment struct {
+	State State
+
+	// QueryStart and QueryEnd are the query
+	// positions emitted by the states of the
+	// segment. They are equal for delete
+	// segments.
+	QueryStart, QueryEnd int
+
+	// ModelStart and ModelEnd are the zero-based
+	// model positions of the match and delete
+	// states of the segment, with position k
+	// for node k+1. For insert segments, they
+	// are both the number of model positions
+	// preceding the insertion.
+	ModelStart, ModelEnd int
+
+	// Query is the aligned sequence.
+	Query feat.Feature
+}
+
+func (s *Segment) Start() int             { return s.QueryStart }
+func (s *Segment) End() int               { return s.QueryEnd }
+func (s *Segment) Len() int               { return s.QueryEnd - s.QueryStart }
+func (s *Segment) Name() string           { return s.State.String() }
+func (s *Segment) Description() string    { return "phmm segment" }
+func (s *Segment) Location() feat.Feature { return s.Query }
+
+var _ feat.Feature = (*Segment)(nil)
+
+// Viterbi returns the most probable state path of the query s through the model as
+// Segment features, and its natural log probability.
+func (m *Model) Viterbi(s *linear.Seq) ([]feat.Feature, float64, error) {
+	q, err := m.check(s)
+	if err != nil {
+		return nil, 0, err
+	}
+	p := dp{
+		m: m, n: len(q),
+		match:  func(i, k int) float64 { return m.Match[k-1][q[i-1]] },
+		insert: func(i, k int) float64 { return m.Insert[k][q[i-1]] },
+		trans:  func(t float64) float64 { return t },
+	}
+	path, score := p.align()
+	if math.IsInf(score, -1) {
+		return nil, score, ErrNoPath
+	}
+	return p.segments(path, s), score, nil
+}
+
+// Posterior returns the state path of the query s through the model that maximises the
+// expected number of letters emitted by their most probable states, the optimal accuracy
+// alignment, as Segment features. It also returns the posterior probability that each
+// letter of the query is emitted by the state it is assigned to.
+func (m *Model) Posterior(s *linear.Seq) ([]feat.Feature, []float64, error) {
+	q, err := m.check(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	fwd := m.forward(q)
+	bwd := m.backward(q)
+	total := fwd.end
+	if math.IsInf(total, -1) {
+		return nil, nil, ErrNoPath
+	}
+	post := func(f, b [][]float64, i, k int) float64 {
+		return math.Exp(f[i][k] + b[i][k] - total)
+	}
+	p := dp{
+		m: m, n: len(q),
+		match:  func(i, k int) float64 { return post(fwd.m, bwd.m, i, k) },
+		insert: func(i, k int) float64 { return post(fwd.i, bwd.i, i, k) },
+		trans: func(t float64) float64 {
+			if math.IsInf(t, -1) {
+				return t
+			}
+			return 0
+		},
+	}
+	path, _ := p.align()
+	probs := make([]float64, len(q))
+	for _, st := range path {
+		switch st.state {
+		case Match:
+			probs[st.i-1] = post(fwd.m, bwd.m, st.i, st.k)
+		case Insert:
+			probs[st.i-1] = post(fwd.i, bwd.i, st.i, st.k)
+		}
+	}
+	return p.segments(path, s), probs, nil
+}
+
+// step is a step of a state path, the state of node k after
+// emitting i query letters.
+type step struct {
+	state State
+	i, k  int
+}
+
+// dp is a dynamic programming aligner over the states of a model.
+// The score of a path is the sum of the scores of its transitions
+// and emissions.
+type dp struct {
+	m *Model
+	n int
+
+	match, insert func(i, k int) float64
+	trans         func(t float64) float64
+}
+
+// align returns the highest scoring path through the model emitting
+// the query and its score.
+func (p dp) align() ([]step, float64) {
+	m, n := p.m, p.n
+	M := m.Len()
+	inf := math.Inf(-1)
+	var mat [3][][]float64
+	var from [3][][]State
+	for s := range mat {
+		mat[s] = make([][]float64, n+1)
+		from[s] = make([][]State, n+1)
+		for i := range mat[s] {
+			mat[s][i] = make([]float64, M+1)
+			from[s][i] = make([]State, M+1)
+			for k := range mat[s][i] {
+				mat[s][i][k] = inf
+			}
+		}
+	}
+	mM, mI, mD := mat[Match], mat[Insert], mat[Delete]
+	mM[0][0] = 0
+
+	// best returns the best predecessor of a state
+	// of node k+1 reached from the states of node k
+	// after i letters, using the transitions t.
+	best := func(i, k int, mm, im, dm float64) (float64, State) {
+		v, s := mM[i][k]+p.trans(mm), Match
+		if x := mI[i][k] + p.trans(im); x > v {
+			v, s = x, Insert
+		}
+		if k > 0 {
+			if x := mD[i][k] + p.trans(dm); x > v {
+				v, s = x, Delete
+			}
+		}
+		return v, s
+	}
+	for i := 0; i <= n; i++ {
+		for k := 0; k <= M; k++ {
+			if i > 0 {
+				t := m.Trans[k]
+				v, s := mM[i-1][k]+p.trans(t.MI), Match
+				if x := mI[i-1][k] + p.trans(t.II); x > v {
+					v, s = x, Insert
+				}
+				mI[i][k], from[Insert][i][k] = v+p.insert(i, k), s
+			}
+			if k == 0 {
+				continue
+			}
+			t := m.Trans[k-1]
+			if i > 0 {
+				v, s := best(i-1, k-1, t.MM, t.IM, t.DM)
+				mM[i][k], from[Match][i][k] = v+p.match(i, k), s
+			}
+			v, s := mM[i][k-1]+p.trans(t.MD), Match
+			if k > 1 {
+				if x := mD[i][k-1] + p.trans(t.DD); x > v {
+					v, s = x, Delete
+				}
+			}
+			mD[i][k], from[Delete][i][k] = v, s
+		}
+	}
+
+	t := m.Trans[M]
+	score, state := best(n, M, t.MM, t.IM, t.DM)
+	if math.IsInf(score, -1) {
+		return nil, score
+	}
+	var path []step
+	for i, k := n, M; i > 0 || k > 0; {
+		path = append(path, step{state: state, i: i, k: k})
+		prev := from[state][i][k]
+		switch state {
+		case Match:
+			i--
+			k--
+		case Insert:
+			i--
+		case Delete:
+			k--
+		}
+		state = prev
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, score
+}
+
+// segments returns the path as Segment features on the query s.
+func (p dp) segments(path []step, s *linear.Seq) []feat.Feature {
+	var (
+		segs []feat.Feature
+		cur  *Segment
+	)
+	for _, st := range path {
+		if cur == nil || cur.State != st.state {
+			cur = &Segment{State: st.state, QueryStart: st.i, QueryEnd: st.i, ModelStart: st.k, ModelEnd: st.k, Query: s}
+			if st.state != Delete {
+				cur.QueryStart--
+			}
+			if st.state != Insert {
+				cur.ModelStart--
+			}
+			segs = append(segs, cur)
+		}
+		cur.QueryEnd, cur.ModelEnd = st.i, st.k
+	}
+	return segs
+}
+
+// lattice holds the log probabilities of the states of
+// each node after emitting each number of query letters.
+type lattice struct {
+	m, i, d [][]float64
+	end     float64
+}
+
+func newLattice(n, M int) lattice {
+	inf := math.Inf(-1)
+	var l lattice
+	for _, p := range []*[][]float64{&l.m, &l.i, &l.d} {
+		*p = make([][]float64, n+1)
+		for i := range *p {
+			(*p)[i] = make([]float64, M+1)
+			for k := range (*p)[i] {
+				(*p)[i][k] = inf
+			}
+		}
+	}
+	return l
+}
+
+// logSum returns the log of the sum of the exponentials of v.
+func logSum(v ...float64) float64 {
+	max := math.Inf(-1)
+	for _, x := range v {
+		if x > max {
+			max = x
+		}
+	}
+	if math.IsInf(max, -1) {
+		return max
+	}
+	var s float64
+	for _, x := range v {
+		s += math.Exp(x - max)
+	}
+	return max + math.Log(s)
+}
+
+// forward returns the forward probabilities of the states for the query q.
+func (m *Model) forward(q []int) lattice {
+	n, M := len(q), m.Len()
+	f := newLattice(n, M)
+	inf := math.Inf(-1)
+	f.m[0][0] = 0
+	for i := 0; i <= n; i++ {
+		for k := 0; k <= M; k++ {
+			if i > 0 {
+				t := m.Trans[k]
+				f.i[i][k] = m.Insert[k][q[i-1]] + logSum(f.m[i-1][k]+t.MI, f.i[i-1][k]+t.II)
+			}
+			if k == 0 {
+				continue
+			}
+			t := m.Trans[k-1]
+			if i > 0 {
+				d := inf
+				if k > 1 {
+					d = f.d[i-1][k-1] + t.DM
+				}
+				f.m[i][k] = m.Match[k-1][q[i-1]] + logSum(f.m[i-1][k-1]+t.MM, f.i[i-1][k-1]+t.IM, d)
+			}
+			d := inf
+			if k > 1 {
+				d = f.d[i][k-1] + t.DD
+			}
+			f.d[i][k] = logSum(f.m[i][k-1]+t.MD, d)
+		}
+	}
+	t := m.Trans[M]
+	d := inf
+	if M > 0 {
+		d = f.d[n][M] + t.DM
+	}
+	f.end = logSum(f.m[n][M]+t.MM, f.i[n][M]+t.IM, d)
+	return f
+}
+
+// backward returns the backward probabilities of the states for the query q.
+func (m *Model) backward(q []int) lattice {
+	n, M := len(q), m.Len()
+	b := newLattice(n, M)
+	inf := math.Inf(-1)
+	for i := n; i >= 0; i-- {
+		for k := M; k >= 0; k-- {
+			t := m.Trans[k]
+			var endM, endI, endD = inf, inf, inf
+			if i == n && k == M {
+				endM, endI, endD = t.MM, t.IM, t.DM
+			}
+			nextM, nextI, nextD := inf, inf, inf
+			if i < n && k < M {
+				nextM = m.Match[k][q[i]] + b.m[i+1][k+1]
+			}
+			if i < n {
+				nextI = m.Insert[k][q[i]] + b.i[i+1][k]
+			}
+			if k < M {
+				nextD = b.d[i][k+1]
+			}
+			b.m[i][k] = logSum(endM, t.MM+nextM, t.MI+nextI, t.MD+nextD)
+			b.i[i][k] = logSum(endI, t.IM+nextM, t.II+nextI)
+			if k > 0 {
+				b.d[i][k] = logSum(endD, t.DM+nextM, t.DD+nextD)
+			}
+		}
+	}
+	return b
+}
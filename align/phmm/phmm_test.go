@@ -0,0 +1,151 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phmm
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+// model returns a model of the consensus cons with match states
+// emitting the consensus letter with probability 0.9.
+func model(cons string) *Model {
+	alpha := alphabet.DNA
+	index := alpha.LetterIndex()
+	uniform := make([]float64, alpha.Len())
+	for i := range uniform {
+		uniform[i] = math.Log(0.25)
+	}
+	t := Transitions{
+		MM: math.Log(0.9), MI: math.Log(0.05), MD: math.Log(0.05),
+		IM: math.Log(0.6), II: math.Log(0.4),
+		DM: math.Log(0.6), DD: math.Log(0.4),
+	}
+	m := &Model{Alpha: alpha}
+	for _, l := range []byte(cons) {
+		e := make([]float64, alpha.Len())
+		for i := range e {
+			e[i] = math.Log(0.1 / 3)
+		}
+		e[index[l]] = math.Log(0.9)
+		m.Match = append(m.Match, e)
+	}
+	for k := 0; k <= len(cons); k++ {
+		m.Insert = append(m.Insert, uniform)
+		m.Trans = append(m.Trans, t)
+	}
+	return m
+}
+
+type seg struct {
+	state            State
+	qStart, qEnd     int
+	modStart, modEnd int
+}
+
+func segs(f []feat.Feature) []seg {
+	var s []seg
+	for _, g := range f {
+		g := g.(*Segment)
+		s = append(s, seg{g.State, g.QueryStart, g.QueryEnd, g.ModelStart, g.ModelEnd})
+	}
+	return s
+}
+
+var alignTests = []struct {
+	query string
+	want  []seg
+}{
+	{
+		query: "acgtacgt",
+		want:  []seg{{Match, 0, 8, 0, 8}},
+	},
+	{
+		query: "acgtggacgt",
+		want:  []seg{{Match, 0, 4, 0, 4}, {Insert, 4, 6, 4, 4}, {Match, 6, 10, 4, 8}},
+	},
+	{
+		query: "acgcgt",
+		want:  []seg{{Match, 0, 3, 0, 3}, {Delete, 3, 3, 3, 5}, {Match, 3, 6, 5, 8}},
+	},
+	{
+		query: "cgtacgt",
+		want:  []seg{{Delete, 0, 0, 0, 1}, {Match, 0, 7, 1, 8}},
+	},
+}
+
+func (s *S) TestViterbi(c *check.C) {
+	m := model("acgtacgt")
+	for i, test := range alignTests {
+		q := linear.NewSeq("q", alphabet.Letters(test.query), alphabet.DNA)
+		path, score, err := m.Viterbi(q)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(segs(path), check.DeepEquals, test.want, check.Commentf("Test %d", i))
+		c.Check(score < 0, check.Equals, true, check.Commentf("Test %d", i))
+		c.Check(path[0].Location(), check.Equals, q)
+	}
+
+	// The Viterbi path probability of an exact
+	// match is the product of its transitions
+	// and emissions.
+	path, score, err := m.Viterbi(linear.NewSeq("q", alphabet.Letters("acgtacgt"), alphabet.DNA))
+	c.Assert(err, check.Equals, nil)
+	c.Check(path, check.HasLen, 1)
+	c.Check(math.Abs(score-17*math.Log(0.9)) < 1e-12, check.Equals, true)
+}
+
+func (s *S) TestPosterior(c *check.C) {
+	m := model("acgtacgt")
+	for i, test := range alignTests {
+		q := linear.NewSeq("q", alphabet.Letters(test.query), alphabet.DNA)
+		path, post, err := m.Posterior(q)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(segs(path), check.DeepEquals, test.want, check.Commentf("Test %d", i))
+		c.Assert(post, check.HasLen, len(test.query))
+		for j, p := range post {
+			c.Check(0 < p && p <= 1, check.Equals, true, check.Commentf("Test %d position %d", i, j))
+		}
+	}
+
+	// The forward and backward probabilities
+	// of the query agree.
+	q := []int{0, 1, 2, 2, 3}
+	f, b := m.forward(q), m.backward(q)
+	c.Check(math.Abs(f.end-b.m[0][0]) < 1e-9, check.Equals, true)
+}
+
+func (s *S) TestErrors(c *check.C) {
+	m := model("acgt")
+	_, _, err := m.Viterbi(linear.NewSeq("q", alphabet.Letters("acgt"), alphabet.Protein))
+	c.Check(err, check.Equals, ErrMismatchedAlphabets)
+	_, _, err = m.Viterbi(linear.NewSeq("q", alphabet.Letters("acxt"), alphabet.DNA))
+	c.Check(err, check.ErrorMatches, `phmm: illegal letter 'x' at position 2 in q`)
+	m.Insert = m.Insert[1:]
+	_, _, err = m.Posterior(linear.NewSeq("q", alphabet.Letters("acgt"), alphabet.DNA))
+	c.Check(err, check.Equals, ErrBadModel)
+
+	// A model that cannot emit insertions cannot
+	// align a query longer than the model.
+	m = model("acgt")
+	for k := range m.Trans {
+		m.Trans[k].MI = math.Inf(-1)
+	}
+	_, _, err = m.Viterbi(linear.NewSeq("q", alphabet.Letters("acggt"), alphabet.DNA))
+	c.Check(err, check.Equals, ErrNoPath)
+	_, _, err = m.Posterior(linear.NewSeq("q", alphabet.Letters("acggt"), alphabet.DNA))
+	c.Check(err, check.Equals, ErrNoPath)
+}
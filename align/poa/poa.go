@@ -0,0 +1,365 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package poa provides partial order alignment of sequences to a sequence graph.
+//
+// Partial order alignment follows Lee, Grasso and Sharlow (Bioinformatics
+// 18:452-464, 2002). Sequences are added to a directed acyclic graph of letters
+// by global alignment to the graph, with each letter either merged into the node
+// it is aligned to or added as a new node. The consensus of the graph is the
+// heaviest path through it, weighting each edge by the number of sequences that
+// traverse it (Lee, Bioinformatics 19:999-1008, 2003), giving a consensus of
+// noisy reads of the same sequence.
+package poa
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+)
+
+// A Graph is a partial order alignment graph.
+type Graph struct {
+	matrix align.Linear
+	alpha  alphabet.Alphabet
+	index  alphabet.Index
+
+	nodes []node
+
+	// order holds the indexes of the
+	// nodes in topological order.
+	order []int
+
+	seqs int
+}
+
+// node is a letter node of a graph.
+type node struct {
+	letter alphabet.Letter
+	index  int
+
+	// in and out hold the edges to and
+	// from the node.
+	in, out []edge
+
+	// aligned holds the nodes with other
+	// letters aligned to the node.
+	aligned []int
+}
+
+// edge is a weighted edge between nodes.
+type edge struct {
+	node   int
+	weight int
+}
+
+// New returns an empty Graph for sequences in the gapped alphabet alpha, aligning
+// sequences with the linear gap penalty scoring matrix m.
+func New(m align.Linear, alpha alphabet.Alphabet) (*Graph, error) {
+	if alpha.IndexOf(alpha.Gap()) != 0 {
+		return nil, align.ErrNotGappedAlphabet
+	}
+	if len(m) < alpha.Len() {
+		return nil, align.ErrMatrixWrongSize{Size: len(m), Len: alpha.Len()}
+	}
+	for _, r := range m {
+		if len(r) != len(m) {
+			return nil, align.ErrMatrixNotSquare
+		}
+	}
+	return &Graph{matrix: m, alpha: alpha, index: alpha.LetterIndex()}, nil
+}
+
+// Len returns the number of nodes in the graph.
+func (g *Graph) Len() int { return len(g.nodes) }
+
+// Seqs returns the number of sequences added to the graph.
+func (g *Graph) Seqs() int { return g.seqs }
+
+// Letter returns the letter of node n.
+func (g *Graph) Letter(n int) alphabet.Letter { return g.nodes[n].letter }
+
+// A Step is a step of a sequence-to-graph alignment. Node is the index of a graph
+// node and Pos is the position of a sequence letter. Node is -1 for a sequence letter
+// aligned to a gap and Pos is -1 for a graph node aligned to a gap.
+type Step struct {
+	Node, Pos int
+}
+
+// indexes returns the alphabet indexes of the letters of s.
+func (g *Graph) indexes(s *linear.Seq) ([]int, error) {
+	if s.Alpha != g.alpha {
+		return nil, align.ErrMismatchedAlphabets
+	}
+	q := make([]int, len(s.Seq))
+	for i, l := range s.Seq {
+		if q[i] = g.index[l]; q[i] < 0 {
+			return nil, fmt.Errorf("poa: illegal letter %q at position %d in %s", l, i, s.ID)
+		}
+	}
+	return q, nil
+}
+
+// Align returns the global alignment of s to the graph and its score. The steps of
+// the alignment follow a path through the graph in topological order.
+func (g *Graph) Align(s *linear.Seq) ([]Step, int, error) {
+	q, err := g.indexes(s)
+	if err != nil {
+		return nil, 0, err
+	}
+	steps, score := g.align(q)
+	return steps, score, nil
+}
+
+const (
+	diag = iota
+	up
+	left
+)
+
+// align returns the global alignment of the letter indexes q to the graph and
+// its score.
+func (g *Graph) align(q []int) ([]Step, int) {
+	m := g.matrix
+	n := len(q) + 1
+
+	// start holds the scores of the virtual
+	// start node preceding all the sources.
+	start := make([]int, n)
+	for j := 1; j < n; j++ {
+		start[j] = start[j-1] + m[0][q[j-1]]
+	}
+	if len(g.nodes) == 0 {
+		steps := make([]Step, len(q))
+		for j := range steps {
+			steps[j] = Step{Node: -1, Pos: j}
+		}
+		return steps, start[n-1]
+	}
+
+	// rank holds the position of each node
+	// in the topological order, and score,
+	// move and from the score of each cell,
+	// the move to it and the rank of the node
+	// it was reached from, with -1 for the
+	// virtual start node.
+	rank := make([]int, len(g.nodes))
+	for k, v := range g.order {
+		rank[v] = k
+	}
+	score := make([]int, len(g.order)*n)
+	move := make([]byte, len(score))
+	from := make([]int, len(score))
+	at := func(k, j int) int {
+		if k < 0 {
+			return start[j]
+		}
+		return score[k*n+j]
+	}
+	for k, v := range g.order {
+		nd := &g.nodes[v]
+		preds := []int{-1}
+		if len(nd.in) != 0 {
+			preds = preds[:0]
+			for _, e := range nd.in {
+				preds = append(preds, rank[e.node])
+			}
+		}
+		for j := 0; j < n; j++ {
+			best, mv, fr := 0, byte(up), -2
+			for _, p := range preds {
+				if s := at(p, j) + m[nd.index][0]; fr == -2 || s > best {
+					best, mv, fr = s, up, p
+				}
+				if j == 0 {
+					continue
+				}
+				if s := at(p, j-1) + m[nd.index][q[j-1]]; s > best {
+					best, mv, fr = s, diag, p
+				}
+			}
+			if j > 0 {
+				if s := score[k*n+j-1] + m[0][q[j-1]]; s > best {
+					best, mv, fr = s, left, k
+				}
+			}
+			score[k*n+j], move[k*n+j], from[k*n+j] = best, mv, fr
+		}
+	}
+
+	end := -1
+	for k, v := range g.order {
+		if len(g.nodes[v].out) == 0 && (end < 0 || score[k*n+n-1] > score[end*n+n-1]) {
+			end = k
+		}
+	}
+	best := score[end*n+n-1]
+
+	var steps []Step
+	k, j := end, n-1
+	for k >= 0 {
+		c := k*n + j
+		switch move[c] {
+		case diag:
+			steps = append(steps, Step{Node: g.order[k], Pos: j - 1})
+			j--
+		case up:
+			steps = append(steps, Step{Node: g.order[k], Pos: -1})
+		case left:
+			steps = append(steps, Step{Node: -1, Pos: j - 1})
+			j--
+		}
+		k = from[c]
+	}
+	for ; j > 0; j-- {
+		steps = append(steps, Step{Node: -1, Pos: j - 1})
+	}
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+	return steps, best
+}
+
+// Add aligns s to the graph and adds its letters, merging each letter into the node
+// it is aligned to if the node or a node aligned to it has the same letter, and adding
+// a new node otherwise.
+func (g *Graph) Add(s *linear.Seq) error {
+	q, err := g.indexes(s)
+	if err != nil {
+		return err
+	}
+	steps, _ := g.align(q)
+	prev := -1
+	for _, st := range steps {
+		if st.Pos < 0 {
+			continue
+		}
+		v := -1
+		if st.Node >= 0 {
+			v = g.merge(st.Node, s.Seq[st.Pos], q[st.Pos])
+		} else {
+			v = g.addNode(s.Seq[st.Pos], q[st.Pos])
+		}
+		if prev >= 0 {
+			g.addEdge(prev, v)
+		}
+		prev = v
+	}
+	g.seqs++
+	g.sort()
+	return nil
+}
+
+// merge returns the node for the letter l with index i aligned to node v, adding
+// it if neither v nor the nodes aligned to v have the index i.
+func (g *Graph) merge(v int, l alphabet.Letter, i int) int {
+	if g.nodes[v].index == i {
+		return v
+	}
+	for _, a := range g.nodes[v].aligned {
+		if g.nodes[a].index == i {
+			return a
+		}
+	}
+	u := g.addNode(l, i)
+	group := append([]int{v}, g.nodes[v].aligned...)
+	for _, a := range group {
+		g.nodes[a].aligned = append(g.nodes[a].aligned, u)
+	}
+	g.nodes[u].aligned = group
+	return u
+}
+
+func (g *Graph) addNode(l alphabet.Letter, i int) int {
+	g.nodes = append(g.nodes, node{letter: l, index: i})
+	return len(g.nodes) - 1
+}
+
+// addEdge adds an edge from u to v, or increments its weight if it exists.
+func (g *Graph) addEdge(u, v int) {
+	for i, e := range g.nodes[u].out {
+		if e.node == v {
+			g.nodes[u].out[i].weight++
+			for j, f := range g.nodes[v].in {
+				if f.node == u {
+					g.nodes[v].in[j].weight++
+				}
+			}
+			return
+		}
+	}
+	g.nodes[u].out = append(g.nodes[u].out, edge{node: v, weight: 1})
+	g.nodes[v].in = append(g.nodes[v].in, edge{node: u, weight: 1})
+}
+
+// sort sets the topological order of the nodes.
+func (g *Graph) sort() {
+	deg := make([]int, len(g.nodes))
+	var queue []int
+	for v, nd := range g.nodes {
+		deg[v] = len(nd.in)
+		if deg[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+	g.order = g.order[:0]
+	for len(queue) != 0 {
+		v := queue[0]
+		queue = queue[1:]
+		g.order = append(g.order, v)
+		for _, e := range g.nodes[v].out {
+			deg[e.node]--
+			if deg[e.node] == 0 {
+				queue = append(queue, e.node)
+			}
+		}
+	}
+}
+
+// Consensus returns the heaviest path through the graph as a sequence with the given
+// id. Each node on the path is reached by its heaviest incoming edge, with ties broken
+// by the weight of the path to the preceding node.
+func (g *Graph) Consensus(id string) *linear.Seq {
+	if len(g.nodes) == 0 {
+		return linear.NewSeq(id, nil, g.alpha)
+	}
+	weight := make([]int, len(g.nodes))
+	pred := make([]int, len(g.nodes))
+	end := -1
+	for _, v := range g.order {
+		pred[v] = -1
+		for _, e := range g.nodes[v].in {
+			if pred[v] < 0 || e.weight > g.weightOf(pred[v], v) ||
+				(e.weight == g.weightOf(pred[v], v) && weight[e.node] > weight[pred[v]]) {
+				pred[v] = e.node
+			}
+		}
+		if pred[v] >= 0 {
+			weight[v] = weight[pred[v]] + g.weightOf(pred[v], v)
+		}
+		if end < 0 || weight[v] > weight[end] {
+			end = v
+		}
+	}
+	var l alphabet.Letters
+	for v := end; v >= 0; v = pred[v] {
+		l = append(l, g.nodes[v].letter)
+	}
+	for i, j := 0, len(l)-1; i < j; i, j = i+1, j-1 {
+		l[i], l[j] = l[j], l[i]
+	}
+	return linear.NewSeq(id, l, g.alpha)
+}
+
+// weightOf returns the weight of the edge from u to v.
+func (g *Graph) weightOf(u, v int) int {
+	for _, e := range g.nodes[v].in {
+		if e.node == u {
+			return e.weight
+		}
+	}
+	return 0
+}
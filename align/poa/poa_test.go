@@ -0,0 +1,97 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poa
+
+import (
+	"github.com/biogo/biogo/align"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+var m = align.Linear{
+	{0, -4, -4, -4, -4},
+	{-4, 2, -3, -3, -3},
+	{-4, -3, 2, -3, -3},
+	{-4, -3, -3, 2, -3},
+	{-4, -3, -3, -3, 2},
+}
+
+func dna(id, s string) *linear.Seq {
+	return linear.NewSeq(id, alphabet.Letters(s), alphabet.DNAgapped)
+}
+
+func (s *S) TestGraph(c *check.C) {
+	g, err := New(m, alphabet.DNAgapped)
+	c.Assert(err, check.Equals, nil)
+	c.Check(g.Consensus("empty").Len(), check.Equals, 0)
+
+	c.Assert(g.Add(dna("a", "acgtacgt")), check.Equals, nil)
+	c.Check(g.Len(), check.Equals, 8)
+	c.Check(g.Consensus("c").Seq.String(), check.Equals, "acgtacgt")
+
+	// A substitution adds one node aligned
+	// to the substituted node.
+	c.Assert(g.Add(dna("b", "acgaacgt")), check.Equals, nil)
+	c.Check(g.Len(), check.Equals, 9)
+	steps, score, err := g.Align(dna("q", "acgaacgt"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(score, check.Equals, 8*2)
+	c.Check(steps, check.HasLen, 8)
+	c.Check(g.Letter(steps[3].Node), check.Equals, alphabet.Letter('a'))
+
+	// An insertion and a deletion.
+	c.Assert(g.Add(dna("c", "acgtaccgt")), check.Equals, nil)
+	c.Assert(g.Add(dna("d", "acgacgt")), check.Equals, nil)
+	c.Check(g.Seqs(), check.Equals, 4)
+	c.Check(g.Consensus("c").Seq.String(), check.Equals, "acgtacgt")
+
+	c.Check(g.Add(linear.NewSeq("p", alphabet.Letters("acgt"), alphabet.Protein)), check.Equals, align.ErrMismatchedAlphabets)
+	c.Check(g.Add(dna("x", "acgx")), check.ErrorMatches, `poa: illegal letter 'x' at position 3 in x`)
+	_, err = New(m, alphabet.DNA)
+	c.Check(err, check.Equals, align.ErrNotGappedAlphabet)
+}
+
+func (s *S) TestNoisyConsensus(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	const letters = "acgt"
+	truth := make([]byte, 200)
+	for i := range truth {
+		truth[i] = letters[rnd.Intn(4)]
+	}
+
+	g, err := New(m, alphabet.DNAgapped)
+	c.Assert(err, check.Equals, nil)
+	for r := 0; r < 15; r++ {
+		var read []byte
+		for _, b := range truth {
+			switch x := rnd.Float64(); {
+			case x < 0.03:
+				// Deletion.
+			case x < 0.06:
+				read = append(read, b, letters[rnd.Intn(4)])
+			case x < 0.09:
+				read = append(read, letters[rnd.Intn(4)])
+			default:
+				read = append(read, b)
+			}
+		}
+		c.Assert(g.Add(dna("read", string(read))), check.Equals, nil)
+	}
+	cons := g.Consensus("consensus")
+	c.Check(cons.ID, check.Equals, "consensus")
+	c.Check(cons.Alpha, check.Equals, alphabet.DNAgapped)
+	c.Check(cons.Seq.String(), check.Equals, string(truth))
+}
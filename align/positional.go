@@ -0,0 +1,101 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// A GapPenalty returns the scores for opening and extending a gap aligned to the
+// letter at position pos of a sequence. A gap of length n starting at pos scores
+// the open score of pos and the extension scores of each of its n letters.
+type GapPenalty func(pos int) (open, extend int)
+
+// GapScores holds position-specific gap scores, indexed by sequence position, for
+// use as a GapPenalty through its Penalty method. Open and Extend must be at least
+// as long as the sequence they are used with.
+type GapScores struct {
+	Open, Extend []int
+}
+
+// Penalty returns the open and extension scores at position pos.
+func (g GapScores) Penalty(pos int) (open, extend int) { return g.Open[pos], g.Extend[pos] }
+
+// A Positional is an affine gap penalty alignment description allowing position-specific
+// gap penalties, so that gaps can be made cheaper in regions such as low complexity
+// sequence or likely introns. Ref and Query give the gap scores for letters of the
+// reference and query aligned to gaps. If either is nil, gaps in that sequence are
+// scored by GapOpen and the gap penalties of the Matrix as for an Affine.
+type Positional struct {
+	Matrix  Linear
+	GapOpen int
+
+	Ref, Query GapPenalty
+}
+
+// NWPositional is the Needleman-Wunsch aligner type with position-specific gap penalties.
+type NWPositional Positional
+
+var _ Aligner = NWPositional{}
+
+// Align aligns two sequences using the Needleman-Wunsch algorithm with the position-specific
+// gap penalties of a. It returns an alignment description or an error if the scoring matrix
+// is not square, or the sequence data types or alphabets do not match.
+func (a NWPositional) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	s, err := Positional(a).scorer(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.global(), nil
+}
+
+// SWPositional is the Smith-Waterman aligner type with position-specific gap penalties.
+type SWPositional Positional
+
+var _ Aligner = SWPositional{}
+
+// Align aligns two sequences using the Smith-Waterman algorithm with the position-specific
+// gap penalties of a. It returns an alignment description, which is empty if no alignment
+// has a positive score, or an error if the scoring matrix is not square, or the sequence data
+// types or alphabets do not match.
+func (a SWPositional) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	s, err := Positional(a).scorer(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.local(), nil
+}
+
+// scorer returns the scorer for the alignment of reference and query.
+func (a Positional) scorer(reference, query AlphabetSlicer) (affineScorer, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return affineScorer{}, err
+	}
+	la, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return affineScorer{}, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return affineScorer{}, err
+	}
+	let := len(a.Matrix)
+	del := a.Ref
+	if del == nil {
+		del = func(i int) (int, int) { return a.GapOpen, la[r[i]*let] }
+	}
+	ins := a.Query
+	if ins == nil {
+		ins = func(j int) (int, int) { return a.GapOpen, la[q[j]] }
+	}
+	return affineScorer{
+		rLen:  len(r),
+		qLen:  len(q),
+		match: func(i, j int) int { return la[r[i]*let+q[j]] },
+		del:   func(i, _ int) (int, int) { return del(i) },
+		ins:   func(_, j int) (int, int) { return ins(j) },
+	}, nil
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestPositional(c *check.C) {
+	const (
+		exon1  = "ACGTTGCA"
+		intron = "GTAAGTCCCCTTTTCAG"
+		exon2  = "TGCATCCA"
+	)
+	ref := linear.NewSeq("", alphabet.BytesToLetters([]byte(exon1+intron+exon2)), alphabet.DNAgapped)
+	query := linear.NewSeq("", alphabet.BytesToLetters([]byte(exon1+exon2)), alphabet.DNAgapped)
+
+	// Gaps are free in the intron.
+	g := GapScores{Open: make([]int, ref.Len()), Extend: make([]int, ref.Len())}
+	for i := range g.Open {
+		if i < len(exon1) || len(exon1)+len(intron) <= i {
+			g.Open[i], g.Extend[i] = -20, -5
+		}
+	}
+
+	for i, t := range []struct {
+		aligner Aligner
+		want    string
+	}{
+		{
+			aligner: NWPositional{Matrix: endsMatrix, GapOpen: -20},
+			want:    "[[0,8)/[0,8)=68 [8,25)/-=-105 [25,33)/[8,16)=70]",
+		},
+		{
+			aligner: NWPositional{Matrix: endsMatrix, GapOpen: -20, Ref: g.Penalty},
+			want:    "[[0,8)/[0,8)=68 [8,25)/-=0 [25,33)/[8,16)=70]",
+		},
+		{
+			aligner: SWPositional{Matrix: endsMatrix, GapOpen: -20},
+			want:    "[[17,24)/[1,8)=38 [24,25)/-=-25 [25,33)/[8,16)=70]",
+		},
+		{
+			aligner: SWPositional{Matrix: endsMatrix, GapOpen: -20, Ref: g.Penalty},
+			want:    "[[0,8)/[0,8)=68 [8,25)/-=0 [25,33)/[8,16)=70]",
+		},
+	} {
+		aln, err := t.aligner.Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(aln), check.Equals, t.want, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestPositionalMatchAffine(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		l := make(alphabet.Letters, n)
+		for i := range l {
+			l[i] = alphabet.Letter("acgt"[rnd.Intn(4)])
+		}
+		return linear.NewSeq("", l, alphabet.DNAgapped)
+	}
+	for i := 0; i < 50; i++ {
+		ref, query := randSeq(rnd.Intn(20)), randSeq(rnd.Intn(20))
+
+		want, err := NWAffine{Matrix: endsMatrix, GapOpen: -3}.AlignEnds(ref, query, EndGaps{})
+		c.Assert(err, check.Equals, nil)
+		got, err := NWPositional{Matrix: endsMatrix, GapOpen: -3}.Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("Test %d", i))
+
+		want, err = SW(endsMatrix).Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		got, err = SWPositional{Matrix: endsMatrix}.Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("Test %d", i))
+	}
+}
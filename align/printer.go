@@ -0,0 +1,170 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// A Printer writes pairwise alignments as wrapped text in the style of BLAST and EMBOSS.
+// Each block of the output holds a reference line, a midline and a query line, with the
+// name of each sequence and the one-based positions of its first and last letters in
+// the block in the margins. Where a line has no letters in a block, both positions are
+// the position of the last letter before the block. The midline marks identical letters
+// with '|', and if Matrix is not nil, other pairs with a positive score with ':' and
+// pairs with a zero score with '.'. Letters are compared without regard to case.
+type Printer struct {
+	// Width is the number of alignment columns
+	// in each block. If Width is less than one,
+	// 60 columns are used.
+	Width int
+
+	// Matrix is the scoring matrix used to mark
+	// similar letters. It is indexed by the
+	// alphabet of the reference.
+	Matrix Linear
+
+	// Gap is the letter used to fill gaps. If
+	// Gap is zero, '-' is used.
+	Gap alphabet.Letter
+}
+
+// Fprint writes the alignment aln of query to reference, as returned by an Aligner, to w.
+// If reference or query has a Name method, it is used to label the lines of the sequence.
+func (p Printer) Fprint(w io.Writer, reference, query AlphabetSlicer, aln []feat.Pair) error {
+	r, err := lettersOf(reference)
+	if err != nil {
+		return err
+	}
+	q, err := lettersOf(query)
+	if err != nil {
+		return err
+	}
+	width := p.Width
+	if width < 1 {
+		width = 60
+	}
+	gapLetter := p.Gap
+	if gapLetter == 0 {
+		gapLetter = '-'
+	}
+	index := reference.Alphabet().LetterIndex()
+
+	// Build the aligned rows.
+	var rRow, mRow, qRow []byte
+	var rPos, qPos []int
+	for _, fp := range aln {
+		f := fp.Features()
+		i, j := f[0].Start(), f[1].Start()
+		for i < f[0].End() || j < f[1].End() {
+			rl, ql := gapLetter, gapLetter
+			if i < f[0].End() {
+				rl = r[i]
+				i++
+			}
+			if j < f[1].End() {
+				ql = q[j]
+				j++
+			}
+			rRow = append(rRow, byte(rl))
+			qRow = append(qRow, byte(ql))
+			rPos = append(rPos, i)
+			qPos = append(qPos, j)
+			mRow = append(mRow, p.mark(rl, ql, gapLetter, index))
+		}
+	}
+
+	rName, qName := nameOf(reference, "reference"), nameOf(query, "query")
+	nameWidth := len(rName)
+	if len(qName) > nameWidth {
+		nameWidth = len(qName)
+	}
+	numWidth := len(strconv.Itoa(len(r)))
+	if n := len(strconv.Itoa(len(q))); n > numWidth {
+		numWidth = n
+	}
+
+	var buf bytes.Buffer
+	rLast, qLast := 0, 0
+	if len(aln) != 0 {
+		f := aln[0].Features()
+		rLast, qLast = f[0].Start(), f[1].Start()
+	}
+	for start := 0; start < len(rRow); start += width {
+		end := start + width
+		if end > len(rRow) {
+			end = len(rRow)
+		}
+		if start != 0 {
+			buf.WriteByte('\n')
+		}
+		rLast = p.line(&buf, rName, nameWidth, numWidth, rRow[start:end], rPos[start:end], rLast, byte(gapLetter))
+		fmt.Fprintf(&buf, "%*s %s\n", nameWidth+numWidth+1, "", bytes.TrimRight(mRow[start:end], " "))
+		qLast = p.line(&buf, qName, nameWidth, numWidth, qRow[start:end], qPos[start:end], qLast, byte(gapLetter))
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// line writes a sequence line of a block with letters row ending at the
+// positions pos, after the sequence position last. It returns the position
+// of the last letter of the line.
+func (p Printer) line(buf *bytes.Buffer, name string, nameWidth, numWidth int, row []byte, pos []int, last int, gap byte) int {
+	first := last
+	for k, l := range row {
+		if l != gap {
+			first = pos[k]
+			break
+		}
+	}
+	end := pos[len(pos)-1]
+	fmt.Fprintf(buf, "%-*s %*d %s %d\n", nameWidth, name, numWidth, first, row, end)
+	return end
+}
+
+// mark returns the midline mark for the aligned letters r and q.
+func (p Printer) mark(r, q, gap alphabet.Letter, index alphabet.Index) byte {
+	if r == gap || q == gap {
+		return ' '
+	}
+	if upperLetter(r) == upperLetter(q) {
+		return '|'
+	}
+	if p.Matrix == nil {
+		return ' '
+	}
+	ri, qi := index[r], index[q]
+	if ri < 0 || qi < 0 || ri >= len(p.Matrix) || qi >= len(p.Matrix[ri]) {
+		return ' '
+	}
+	switch s := p.Matrix[ri][qi]; {
+	case s > 0:
+		return ':'
+	case s == 0:
+		return '.'
+	}
+	return ' '
+}
+
+func upperLetter(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l - 'a' + 'A'
+	}
+	return l
+}
+
+// nameOf returns the name of s if it has one, otherwise def.
+func nameOf(s AlphabetSlicer, def string) string {
+	if n, ok := s.(interface{ Name() string }); ok && n.Name() != "" {
+		return n.Name()
+	}
+	return def
+}
@@ -0,0 +1,45 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"os"
+)
+
+func ExamplePrinter_Fprint() {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("AGACTAGTTAGACCATGACA")), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("GACAGACGTTAGCCATCACA")), alphabet.DNAgapped)
+
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, 0, -4},
+		{-5, -3, 9, -5, 1},
+		{-5, 0, -5, 7, -3},
+		{-5, -4, 1, -3, 8},
+	}
+	aln, err := NW(m).Align(ref, query)
+	if err != nil {
+		panic(err)
+	}
+	err = Printer{Width: 10, Matrix: m}.Fprint(os.Stdout, ref, query, aln)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// ref    1 AGAC-TA-GT 8
+	//           |||  | ||
+	// query  1 -GACAGACGT 9
+	//
+	// ref    9 TAGACCATGA 18
+	//          ||| |||| |
+	// query 10 TAG-CCATCA 18
+	//
+	// ref   19 CA 20
+	//          ||
+	// query 19 CA 20
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"math"
+)
+
+var ErrPSSMWrongLength = errors.New("align: scoring profile length does not match reference length")
+
+// A PSSM is a position-specific scoring matrix alignment description. It replaces the
+// reference rows of a Linear with one row for each position of the reference, allowing
+// a sequence to be aligned to a profile. The first row holds the penalties for aligning
+// each letter of the query to a gap, indexed by alphabet index as in a Linear, and row i+1
+// holds the scores of aligning each letter of the query to position i of the reference,
+// with the first column holding the penalty for a gap in the query at that position.
+type PSSM [][]int
+
+// NewPSSM returns the PSSM for the ungapped alignment of rows obtained from the
+// substitution matrix m. Each score is the mean score under m of the query letter
+// against the letters of the column at that position that are not gaps, the average
+// profile method of Gribskov, McLachlan and Eisenberg (PNAS 84:4355-4358, 1987). For a
+// single row the PSSM gives the same scores as m. The rows must have the same length
+// and alphabet.
+func NewPSSM(m Linear, rows ...AlphabetSlicer) (PSSM, error) {
+	if len(rows) == 0 {
+		return nil, ErrPSSMWrongLength
+	}
+	alpha, err := gappedAlphabet(rows[0], rows[0])
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(m, alpha)
+	if err != nil {
+		return nil, err
+	}
+	let := len(m)
+	var idx [][]int
+	for _, r := range rows {
+		if r.Alphabet() != alpha {
+			return nil, ErrMismatchedAlphabets
+		}
+		ri, err := indexes(r, alpha, "row")
+		if err != nil {
+			return nil, err
+		}
+		idx = append(idx, ri)
+	}
+	n := len(idx[0])
+	for _, ri := range idx[1:] {
+		if len(ri) != n {
+			return nil, ErrPSSMWrongLength
+		}
+	}
+
+	p := make(PSSM, n+1)
+	p[0] = append([]int(nil), m[gap]...)
+	for i := 0; i < n; i++ {
+		sum := make([]int, let)
+		var count int
+		for _, ri := range idx {
+			if ri[i] == gap {
+				continue
+			}
+			for q := range sum {
+				sum[q] += la[ri[i]*let+q]
+			}
+			count++
+		}
+		p[i+1] = make([]int, let)
+		if count == 0 {
+			copy(p[i+1], m[gap])
+			continue
+		}
+		for q, s := range sum {
+			p[i+1][q] = int(math.Floor(float64(s)/float64(count) + 0.5))
+		}
+	}
+	return p, nil
+}
+
+// query returns the alphabet indexes of the letters of query after checking
+// that p is valid for aligning query to reference.
+func (p PSSM) query(reference, query AlphabetSlicer) ([]int, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(p) != reference.Slice().Len()+1 {
+		return nil, ErrPSSMWrongLength
+	}
+	for _, row := range p {
+		if len(row) < alpha.Len() {
+			return nil, ErrMatrixWrongSize{Size: len(row), Len: alpha.Len()}
+		}
+	}
+	return indexes(query, alpha, "qSeq")
+}
+
+// scorer returns the scorer for the alignment of the query letter indexes q
+// to the profile.
+func (p PSSM) scorer(q []int) scorer {
+	return scorer{
+		rLen:  len(p) - 1,
+		qLen:  len(q),
+		match: func(i, j int) int { return p[i+1][q[j]] },
+		del:   func(i int) int { return p[i+1][gap] },
+		ins:   func(j int) int { return p[0][q[j]] },
+	}
+}
+
+// NWPSSM is the linear gap penalty Needleman-Wunsch aligner type using a position-specific
+// scoring matrix for the reference.
+type NWPSSM PSSM
+
+// Align aligns the query to the reference profile using the Needleman-Wunsch algorithm. Only
+// the length and alphabet of reference are used. It returns an alignment description or an
+// error if the profile does not match the length of the reference, or the sequence data types
+// or alphabets do not match.
+func (a NWPSSM) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	q, err := PSSM(a).query(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return PSSM(a).scorer(q).global(), nil
+}
+
+// SWPSSM is the linear gap penalty Smith-Waterman aligner type using a position-specific
+// scoring matrix for the reference.
+type SWPSSM PSSM
+
+// Align aligns the query to the reference profile using the Smith-Waterman algorithm. Only
+// the length and alphabet of reference are used. It returns an alignment description, which
+// is empty if no alignment has a positive score, or an error if the profile does not match the
+// length of the reference, or the sequence data types or alphabets do not match.
+func (a SWPSSM) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	q, err := PSSM(a).query(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return PSSM(a).scorer(q).local(), nil
+}
@@ -0,0 +1,95 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestPSSM(c *check.C) {
+	m := Linear{
+		{0, -1, -1, -1, -1},
+		{-1, 1, -1, -1, -1},
+		{-1, -1, 1, -1, -1},
+		{-1, -1, -1, 1, -1},
+		{-1, -1, -1, -1, 1},
+	}
+	seq := func(s string) *linear.Seq {
+		return linear.NewSeq("", alphabet.BytesToLetters([]byte(s)), alphabet.DNAgapped)
+	}
+
+	// A PSSM of a single sequence scores alignments
+	// in the same way as its substitution matrix.
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return seq(string(b))
+	}
+	for i := 0; i < 50; i++ {
+		a, b := randSeq(1+rnd.Intn(20)), randSeq(1+rnd.Intn(20))
+		p, err := NewPSSM(m, a)
+		c.Assert(err, check.Equals, nil)
+
+		want, err := NW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		got, err := NWPSSM(p).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		c.Check(totalScore(got), check.Equals, totalScore(want), check.Commentf("Test %d", i))
+		fa := Format(a, b, got, '-')
+		c.Check(fa[0].Len(), check.Equals, fa[1].Len(), check.Commentf("Test %d", i))
+
+		want, err = SW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		got, err = SWPSSM(p).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		if totalScore(want) <= 0 {
+			c.Check(got, check.HasLen, 0, check.Commentf("Test %d", i))
+			continue
+		}
+		c.Check(fmt.Sprint(got), check.Equals, fmt.Sprint(want), check.Commentf("Test %d", i))
+	}
+
+	// An averaged profile scores columns by their
+	// composition.
+	p, err := NewPSSM(m, seq("ACGT"), seq("ACCT"), seq("A-GT"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(p, check.DeepEquals, PSSM{
+		{0, -1, -1, -1, -1},
+		{-1, 1, -1, -1, -1},
+		{-1, -1, 1, -1, -1},
+		{-1, -1, 0, 0, -1},
+		{-1, -1, -1, -1, 1},
+	})
+	for i, t := range []struct {
+		query  string
+		global string
+		local  string
+	}{
+		{query: "ACGT", global: "[[0,4)/[0,4)=3]", local: "[[0,4)/[0,4)=3]"},
+		{query: "ACCT", global: "[[0,4)/[0,4)=3]", local: "[[0,4)/[0,4)=3]"},
+		{query: "AT", global: "[[0,1)/[0,1)=1 [1,3)/-=-2 [3,4)/[1,2)=1]", local: "[[3,4)/[1,2)=1]"},
+	} {
+		got, err := NWPSSM(p).Align(seq("NNNN"), seq(t.query))
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, t.global, check.Commentf("Test %d", i))
+		got, err = SWPSSM(p).Align(seq("NNNN"), seq(t.query))
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(got), check.Equals, t.local, check.Commentf("Test %d", i))
+	}
+
+	_, err = NWPSSM(p).Align(seq("NNN"), seq("ACGT"))
+	c.Check(err, check.Equals, ErrPSSMWrongLength)
+	_, err = NewPSSM(m, seq("ACGT"), seq("ACG"))
+	c.Check(err, check.Equals, ErrPSSMWrongLength)
+}
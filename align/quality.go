@@ -0,0 +1,93 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"math"
+)
+
+// NWQuality is the linear gap penalty Needleman-Wunsch aligner type with substitution
+// scores weighted by letter quality.
+type NWQuality Linear
+
+// Align aligns two alphabet.QLetters sequences using the Needleman-Wunsch algorithm,
+// weighting the substitution score of each aligned pair of letters by the probability
+// that both letters are correct given their Phred qualities, so that mismatches between
+// low quality letters are penalised less than those between high quality letters and
+// matches are rewarded less. Gap penalties are not weighted. Align returns an alignment
+// description or an error if the scoring matrix is not square, the sequences are not
+// alphabet.QLetters or the alphabets do not match.
+func (a NWQuality) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	s, err := qualityScorer(Linear(a), reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.global(), nil
+}
+
+// SWQuality is the linear gap penalty Smith-Waterman aligner type with substitution
+// scores weighted by letter quality.
+type SWQuality Linear
+
+// Align aligns two alphabet.QLetters sequences using the Smith-Waterman algorithm,
+// weighting substitution scores as described for NWQuality. Align returns an alignment
+// description, which is empty if no alignment has a positive score, or an error if the
+// scoring matrix is not square, the sequences are not alphabet.QLetters or the alphabets
+// do not match.
+func (a SWQuality) Align(reference, query AlphabetSlicer) ([]feat.Pair, error) {
+	s, err := qualityScorer(Linear(a), reference, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.local(), nil
+}
+
+// qualityScorer returns the quality weighted scorer for the alignment of
+// reference and query using the scoring matrix m.
+func qualityScorer(m Linear, reference, query AlphabetSlicer) (scorer, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return scorer{}, err
+	}
+	rSeq, ok := reference.Slice().(alphabet.QLetters)
+	if !ok {
+		return scorer{}, ErrTypeNotHandled
+	}
+	qSeq, ok := query.Slice().(alphabet.QLetters)
+	if !ok {
+		return scorer{}, ErrMismatchedTypes
+	}
+	la, err := flatten(m, alpha)
+	if err != nil {
+		return scorer{}, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return scorer{}, err
+	}
+	rp, qp := probCorrect(rSeq), probCorrect(qSeq)
+	let := len(m)
+	return scorer{
+		rLen: len(r),
+		qLen: len(q),
+		match: func(i, j int) int {
+			return int(math.Floor(float64(la[r[i]*let+q[j]])*rp[i]*qp[j] + 0.5))
+		},
+		del: func(i int) int { return la[r[i]*let] },
+		ins: func(j int) int { return la[q[j]] },
+	}, nil
+}
+
+// probCorrect returns the probabilities that each letter of s is correct.
+func probCorrect(s alphabet.QLetters) []float64 {
+	p := make([]float64, len(s))
+	for i, l := range s {
+		p[i] = 1 - l.Q.ProbE()
+	}
+	return p
+}
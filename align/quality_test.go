@@ -0,0 +1,76 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestQuality(c *check.C) {
+	m := Linear{
+		{0, -2, -2, -2, -2},
+		{-2, 2, -3, -3, -3},
+		{-2, -3, 2, -3, -3},
+		{-2, -3, -3, 2, -3},
+		{-2, -3, -3, -3, 2},
+	}
+	qseq := func(s string, q ...alphabet.Qphred) *linear.QSeq {
+		ql := make([]alphabet.QLetter, len(s))
+		for i := range s {
+			ql[i] = alphabet.QLetter{L: alphabet.Letter(s[i]), Q: 40}
+			if i < len(q) {
+				ql[i].Q = q[i]
+			}
+		}
+		return linear.NewQSeq("", ql, alphabet.DNAgapped, alphabet.Sanger)
+	}
+
+	for i, t := range []struct {
+		ref, query string
+		qual       []alphabet.Qphred
+		global     string
+		local      string
+	}{
+		{
+			// High quality letters are scored by the matrix.
+			ref: "ACGTACGT", query: "ACGAACGT",
+			global: "[[0,8)/[0,8)=11]",
+			local:  "[[0,8)/[0,8)=11]",
+		},
+		{
+			// A low quality mismatch costs little.
+			ref: "ACGTACGT", query: "ACGAACGT",
+			qual:   []alphabet.Qphred{40, 40, 40, 2},
+			global: "[[0,8)/[0,8)=13]",
+			local:  "[[0,8)/[0,8)=13]",
+		},
+		{
+			// Low quality letters do not extend a local alignment.
+			ref: "TTTTACGT", query: "GGGGACGT",
+			qual:   []alphabet.Qphred{0, 0, 0, 0},
+			global: "[[0,8)/[0,8)=8]",
+			local:  "[[4,8)/[4,8)=8]",
+		},
+	} {
+		ref, query := qseq(t.ref), qseq(t.query, t.qual...)
+		aln, err := NWQuality(m).Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(aln), check.Equals, t.global, check.Commentf("Test %d", i))
+		aln, err = SWQuality(m).Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+		c.Check(fmt.Sprint(aln), check.Equals, t.local, check.Commentf("Test %d", i))
+	}
+
+	_, err := NWQuality(m).Align(
+		linear.NewSeq("", alphabet.BytesToLetters([]byte("ACGT")), alphabet.DNAgapped),
+		linear.NewSeq("", alphabet.BytesToLetters([]byte("ACGT")), alphabet.DNAgapped),
+	)
+	c.Check(err, check.Equals, ErrTypeNotHandled)
+}
@@ -0,0 +1,323 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// scorer describes a linear gap penalty alignment of a reference of length
+// rLen and a query of length qLen by functions returning the score of each
+// step of the alignment, allowing scores to depend on sequence position.
+type scorer struct {
+	rLen, qLen int
+
+	// match returns the score of aligning position i
+	// of the reference to position j of the query.
+	match func(i, j int) int
+
+	// del returns the score of aligning position i
+	// of the reference to a gap, and ins the score of
+	// aligning position j of the query to a gap.
+	del func(i int) int
+	ins func(j int) int
+}
+
+// global returns the Needleman-Wunsch alignment described by s.
+func (s scorer) global() []feat.Pair {
+	r, c := s.rLen+1, s.qLen+1
+	table := make([]int, r*c)
+	for j := 1; j < c; j++ {
+		table[j] = table[j-1] + s.ins(j-1)
+	}
+	for i := 1; i < r; i++ {
+		table[i*c] = table[(i-1)*c] + s.del(i-1)
+	}
+	for i := 1; i < r; i++ {
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			table[p] = max3(
+				table[p-c-1]+s.match(i-1, j-1),
+				table[p-c]+s.del(i-1),
+				table[p-1]+s.ins(j-1),
+			)
+		}
+	}
+	ops, i, j := s.traceback(table, r-1, c-1, false)
+	return s.pairs(ops, i, j)
+}
+
+// local returns the Smith-Waterman alignment described by s, or nil if no
+// alignment has a positive score.
+func (s scorer) local() []feat.Pair {
+	r, c := s.rLen+1, s.qLen+1
+	table := make([]int, r*c)
+	var maxS, maxI, maxJ int
+	for i := 1; i < r; i++ {
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			diagScore := table[p-c-1] + s.match(i-1, j-1)
+			score := max3(
+				diagScore,
+				table[p-c]+s.del(i-1),
+				table[p-1]+s.ins(j-1),
+			)
+			switch {
+			case score > 0:
+				if score >= maxS && score == diagScore {
+					maxS, maxI, maxJ = score, i, j
+				}
+			default:
+				score = 0
+			}
+			table[p] = score
+		}
+	}
+	if maxS == 0 {
+		return nil
+	}
+	ops, i, j := s.traceback(table, maxI, maxJ, true)
+	return s.pairs(ops, i, j)
+}
+
+// traceback returns the operations of the alignment ending at cell (i, j)
+// of table and the cell it starts from. If local is true the alignment ends
+// at the first cell with a zero score, otherwise it ends at the origin.
+func (s scorer) traceback(table []int, i, j int, local bool) (ops []byte, si, sj int) {
+	c := s.qLen + 1
+	for i > 0 && j > 0 {
+		p := i*c + j
+		if local && table[p] == 0 {
+			break
+		}
+		switch table[p] {
+		case table[p-c-1] + s.match(i-1, j-1):
+			ops = append(ops, diag)
+			i--
+			j--
+		case table[p-c] + s.del(i-1):
+			ops = append(ops, up)
+			i--
+		case table[p-1] + s.ins(j-1):
+			ops = append(ops, left)
+			j--
+		default:
+			panic("align: internal error: no path")
+		}
+	}
+	if !local {
+		for ; i > 0; i-- {
+			ops = append(ops, up)
+		}
+		for ; j > 0; j-- {
+			ops = append(ops, left)
+		}
+	}
+	for l, r := 0, len(ops)-1; l < r; l, r = l+1, r-1 {
+		ops[l], ops[r] = ops[r], ops[l]
+	}
+	return ops, i, j
+}
+
+// pairs returns the feature pairs of the alignment described by ops,
+// starting at position i of the reference and j of the query.
+func (s scorer) pairs(ops []byte, i, j int) []feat.Pair {
+	var aln []feat.Pair
+	for k := 0; k < len(ops); {
+		op := ops[k]
+		si, sj := i, j
+		var score int
+		for ; k < len(ops) && ops[k] == op; k++ {
+			switch op {
+			case diag:
+				score += s.match(i, j)
+				i++
+				j++
+			case up:
+				score += s.del(i)
+				i++
+			case left:
+				score += s.ins(j)
+				j++
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+	return aln
+}
+
+// affineScorer describes an affine gap penalty alignment of a reference of
+// length rLen and a query of length qLen by functions returning the score of
+// each step of the alignment, allowing gap penalties to depend on the position
+// of the gap in both sequences.
+type affineScorer struct {
+	rLen, qLen int
+
+	// match returns the score of aligning position i
+	// of the reference to position j of the query.
+	match func(i, j int) int
+
+	// del returns the scores for opening and extending
+	// a gap aligned to position i of the reference when
+	// j letters of the query have been aligned, and ins
+	// the scores for a gap aligned to position j of the
+	// query when i letters of the reference have been
+	// aligned. A gap of length n scores open+n*ext.
+	del func(i, j int) (open, ext int)
+	ins func(i, j int) (open, ext int)
+}
+
+// step returns the score of entering state op of cell p at (i, j) of table
+// from state from of the preceding cell.
+func (s affineScorer) step(table [][3]int, op, from byte, i, j int) int {
+	c := s.qLen + 1
+	var p, score, open int
+	switch op {
+	case diag:
+		p, score = (i-1)*c+j-1, s.match(i-1, j-1)
+	case up:
+		p = (i-1)*c + j
+		open, score = s.del(i-1, j)
+	case left:
+		p = i*c + j - 1
+		open, score = s.ins(i, j-1)
+	}
+	if op != diag && from != op {
+		score += open
+	}
+	return add(table[p][from], score)
+}
+
+// fill returns the dynamic programming table for the alignment described by s.
+// If local is true, alignments may start at any pair of letters.
+func (s affineScorer) fill(local bool) [][3]int {
+	r, c := s.rLen+1, s.qLen+1
+	table := make([][3]int, r*c)
+	table[0] = [3]int{diag: 0, up: minInt, left: minInt}
+	if local {
+		table[0][diag] = minInt
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if i == 0 && j == 0 {
+				continue
+			}
+			p := i*c + j
+			for op := byte(diag); op <= left; op++ {
+				table[p][op] = minInt
+				if (op != left && i == 0) || (op != up && j == 0) {
+					continue
+				}
+				if local && op == diag {
+					table[p][op] = s.match(i-1, j-1)
+				}
+				for from := byte(diag); from <= left; from++ {
+					table[p][op] = max2(table[p][op], s.step(table, op, from, i, j))
+				}
+			}
+		}
+	}
+	return table
+}
+
+// global returns the Needleman-Wunsch alignment described by s.
+func (s affineScorer) global() []feat.Pair {
+	table := s.fill(false)
+	i, j := s.rLen, s.qLen
+	p := i*(s.qLen+1) + j
+	var op byte
+	for o := byte(up); o <= left; o++ {
+		if table[p][o] > table[p][op] {
+			op = o
+		}
+	}
+	return s.traceback(table, i, j, op, false)
+}
+
+// local returns the Smith-Waterman alignment described by s, or nil if no
+// alignment has a positive score.
+func (s affineScorer) local() []feat.Pair {
+	table := s.fill(true)
+	var maxS, maxI, maxJ int
+	for i := 1; i <= s.rLen; i++ {
+		for j := 1; j <= s.qLen; j++ {
+			if v := table[i*(s.qLen+1)+j][diag]; v > 0 && v >= maxS {
+				maxS, maxI, maxJ = v, i, j
+			}
+		}
+	}
+	if maxS == 0 {
+		return nil
+	}
+	return s.traceback(table, maxI, maxJ, diag, true)
+}
+
+// traceback returns the feature pairs of the alignment ending in state op at
+// cell (i, j) of table. If local is true the alignment ends at the pair of
+// letters it starts from, otherwise it ends at the origin.
+func (s affineScorer) traceback(table [][3]int, i, j int, op byte, local bool) []feat.Pair {
+	c := s.qLen + 1
+	var (
+		ops    []byte
+		scores []int
+	)
+	for i > 0 || j > 0 {
+		v := table[i*c+j][op]
+		if local && op == diag && v == s.match(i-1, j-1) {
+			ops = append(ops, diag)
+			scores = append(scores, v)
+			i--
+			j--
+			break
+		}
+		from := byte(diag)
+		for ; from < left; from++ {
+			if v == s.step(table, op, from, i, j) {
+				break
+			}
+		}
+		ops = append(ops, op)
+		switch op {
+		case diag:
+			i--
+			j--
+		case up:
+			i--
+		case left:
+			j--
+		}
+		scores = append(scores, v-table[i*c+j][from])
+		op = from
+	}
+
+	var aln []feat.Pair
+	for k := len(ops) - 1; k >= 0; {
+		op := ops[k]
+		si, sj := i, j
+		var score int
+		for ; k >= 0 && ops[k] == op; k-- {
+			score += scores[k]
+			switch op {
+			case diag:
+				i++
+				j++
+			case up:
+				i++
+			case left:
+				j++
+			}
+		}
+		aln = append(aln, &featPair{
+			a:     feature{start: si, end: i},
+			b:     feature{start: sj, end: j},
+			score: score,
+		})
+	}
+	return aln
+}
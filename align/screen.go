@@ -0,0 +1,243 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/seq"
+
+	"fmt"
+	"sort"
+)
+
+// A VectorScreen finds and removes vector contamination from assembled contigs in the
+// manner of the NCBI VecScreen procedure. Vector matches close to the ends of a contig
+// are accepted at relaxed thresholds and trimmed along with the sequence between them
+// and the contig end, while internal matches must meet the stricter thresholds and
+// split the contig.
+type VectorScreen struct {
+	// XDrop and K are the extension aligner
+	// and seed length used to find matches
+	// as described for Masker.
+	XDrop XDrop
+	K     int
+
+	// MinIdentity and MinLength are the
+	// minimum percent identity and length
+	// of internal matches.
+	MinIdentity float64
+	MinLength   int
+
+	// TerminalIdentity and TerminalLength
+	// are the minimum percent identity and
+	// length of matches starting or ending
+	// within TerminalDistance letters of
+	// an end of the contig.
+	TerminalIdentity float64
+	TerminalLength   int
+	TerminalDistance int
+
+	// MinPiece is the minimum length of a
+	// remaining piece of the contig. Shorter
+	// pieces are excluded.
+	MinPiece int
+}
+
+// A ScreenAction describes how a region of a contig is removed by a VectorScreen.
+type ScreenAction int
+
+const (
+	// ScreenTrim indicates a terminal vector
+	// match trimmed to the contig end.
+	ScreenTrim ScreenAction = iota
+
+	// ScreenSplit indicates an internal
+	// vector match that splits the contig.
+	ScreenSplit
+
+	// ScreenExclude indicates a piece of
+	// the contig that is too short to keep.
+	ScreenExclude
+)
+
+func (a ScreenAction) String() string {
+	switch a {
+	case ScreenTrim:
+		return "trim"
+	case ScreenSplit:
+		return "split"
+	case ScreenExclude:
+		return "exclude"
+	}
+	return fmt.Sprintf("ScreenAction(%d)", int(a))
+}
+
+// A ScreenRegion is a region of a contig removed by a VectorScreen, in coordinates
+// relative to the contig location.
+type ScreenRegion struct {
+	Start, End int
+	Action     ScreenAction
+}
+
+// A ScreenReport is the report of the vector screen of a contig.
+type ScreenReport struct {
+	// Name and Length are the name and
+	// length of the screened contig.
+	Name   string
+	Length int
+
+	// Matches holds the accepted vector
+	// matches sorted by start position,
+	// and Removed the regions removed
+	// from the contig.
+	Matches []Masked
+	Removed []ScreenRegion
+
+	// Pieces holds the names of the
+	// pieces of the contig that remain.
+	Pieces []string
+}
+
+// Clean returns whether no vector matches were found.
+func (r ScreenReport) Clean() bool { return len(r.Matches) == 0 }
+
+// An annotator can set its name and description.
+type annotator interface {
+	SetName(string) error
+	SetDescription(string) error
+}
+
+// Screen screens the contig s against the vectors and returns the pieces of s that
+// remain after the removal of vector matches and a report of the screen. If nothing
+// is removed the single piece keeps the name of s, otherwise the pieces are named by
+// the name of s followed by a dot and their ordinal number from one. The sequences
+// created by s.New must allow their name and description to be set, otherwise Screen
+// returns ErrNotAnnotatable. Screen also returns an error under the conditions described
+// for Masker.Mask.
+func (v VectorScreen) Screen(s seq.Sequence, vectors ...seq.Sequence) ([]seq.Sequence, ScreenReport, error) {
+	m := Masker{
+		XDrop:       v.XDrop,
+		K:           v.K,
+		MinIdentity: v.MinIdentity,
+		MinLength:   v.MinLength,
+	}
+	if v.TerminalIdentity < m.MinIdentity {
+		m.MinIdentity = v.TerminalIdentity
+	}
+	if v.TerminalLength < m.MinLength {
+		m.MinLength = v.TerminalLength
+	}
+	hits, err := m.Find(s, vectors...)
+	if err != nil {
+		return nil, ScreenReport{}, err
+	}
+
+	var (
+		off = s.Start()
+		n   = s.Len()
+		rep = ScreenReport{Name: s.Name(), Length: n}
+
+		// lo and hi are the bounds of the contig
+		// remaining after terminal trimming.
+		lo, hi = 0, n
+
+		internal [][2]int
+	)
+	for _, h := range hits {
+		start, end := h.Start-off, h.End-off
+		at5, at3 := start <= v.TerminalDistance, n-end <= v.TerminalDistance
+		if at5 || at3 {
+			if h.Identity < v.TerminalIdentity || end-start < v.TerminalLength {
+				continue
+			}
+			if at5 && end > lo {
+				lo = end
+			}
+			if at3 && start < hi {
+				hi = start
+			}
+		} else {
+			if h.Identity < v.MinIdentity || end-start < v.MinLength {
+				continue
+			}
+			internal = append(internal, [2]int{start, end})
+		}
+		rep.Matches = append(rep.Matches, h)
+	}
+	if hi < lo {
+		hi = lo
+	}
+	if lo > 0 {
+		rep.Removed = append(rep.Removed, ScreenRegion{Start: off, End: off + lo, Action: ScreenTrim})
+	}
+	if hi < n {
+		rep.Removed = append(rep.Removed, ScreenRegion{Start: off + hi, End: off + n, Action: ScreenTrim})
+	}
+
+	// Split the trimmed contig at the merged internal
+	// matches, excluding pieces that are too short.
+	var pieces [][2]int
+	start := lo
+	for k := 0; k < len(internal); {
+		rs, re := internal[k][0], internal[k][1]
+		for k++; k < len(internal) && internal[k][0] <= re; k++ {
+			if internal[k][1] > re {
+				re = internal[k][1]
+			}
+		}
+		if rs < start {
+			rs = start
+		}
+		if re > hi {
+			re = hi
+		}
+		if rs >= re {
+			continue
+		}
+		if rs > start {
+			pieces = append(pieces, [2]int{start, rs})
+		}
+		rep.Removed = append(rep.Removed, ScreenRegion{Start: off + rs, End: off + re, Action: ScreenSplit})
+		start = re
+	}
+	if start < hi {
+		pieces = append(pieces, [2]int{start, hi})
+	}
+	var kept [][2]int
+	for _, p := range pieces {
+		if p[1]-p[0] < v.MinPiece {
+			rep.Removed = append(rep.Removed, ScreenRegion{Start: off + p[0], End: off + p[1], Action: ScreenExclude})
+			continue
+		}
+		kept = append(kept, p)
+	}
+	sort.Sort(regionsByPos(rep.Removed))
+
+	if len(rep.Removed) == 0 {
+		rep.Pieces = []string{s.Name()}
+		return []seq.Sequence{s.Clone()}, rep, nil
+	}
+	sl := s.Slice()
+	out := make([]seq.Sequence, 0, len(kept))
+	for i, p := range kept {
+		c := s.New()
+		a, ok := c.(annotator)
+		if !ok {
+			return nil, rep, ErrNotAnnotatable
+		}
+		name := fmt.Sprintf("%s.%d", s.Name(), i+1)
+		a.SetName(name)
+		a.SetDescription(s.Description())
+		c.SetSlice(sl.Make(0, p[1]-p[0]).Append(sl.Slice(p[0], p[1])))
+		out = append(out, c)
+		rep.Pieces = append(rep.Pieces, name)
+	}
+	return out, rep, nil
+}
+
+type regionsByPos []ScreenRegion
+
+func (r regionsByPos) Len() int           { return len(r) }
+func (r regionsByPos) Less(i, j int) bool { return r[i].Start < r[j].Start }
+func (r regionsByPos) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
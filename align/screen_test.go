@@ -0,0 +1,121 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestVectorScreen(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 1, -3, -3, -3},
+		{-5, -3, 1, -3, -3},
+		{-5, -3, -3, 1, -3},
+		{-5, -3, -3, -3, 1},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randLetters := func(n int) alphabet.Letters {
+		b := make(alphabet.Letters, n)
+		for i := range b {
+			b[i] = alphabet.Letter("acgt"[rnd.Intn(4)])
+		}
+		return b
+	}
+	vector := linear.NewSeq("vector", randLetters(200), alphabet.DNAgapped)
+
+	// The contig has a vector match at its start, an
+	// internal match, and a short match close to its
+	// end. The letters flanking each match differ from
+	// those flanking the vector fragment so that matches
+	// are not extended.
+	differ := map[alphabet.Letter]alphabet.Letter{'a': 'c', 'c': 'g', 'g': 't', 't': 'a'}
+	var l alphabet.Letters
+	fragment := func(start, end int) {
+		if start > 0 && len(l) > 0 {
+			l[len(l)-1] = differ[vector.Seq[start-1]]
+		}
+		l = append(l, vector.Seq[start:end]...)
+		l = append(l, differ[vector.Seq[end]])
+	}
+	fragment(0, 30)
+	l = append(l, randLetters(199)...)
+	fragment(50, 90)
+	l = append(l, randLetters(199)...)
+	fragment(150, 170)
+	l = append(l, randLetters(9)...)
+	contig := linear.NewSeq("contig", l, alphabet.DNAgapped)
+
+	v := VectorScreen{
+		XDrop:            XDrop{Matrix: m, X: 2},
+		K:                11,
+		MinIdentity:      95,
+		MinLength:        30,
+		TerminalIdentity: 90,
+		TerminalLength:   16,
+		TerminalDistance: 25,
+	}
+	pieces, rep, err := v.Screen(contig, vector)
+	c.Assert(err, check.Equals, nil)
+	c.Check(rep.Name, check.Equals, "contig")
+	c.Check(rep.Length, check.Equals, 500)
+	c.Check(rep.Clean(), check.Equals, false)
+	c.Assert(rep.Matches, check.HasLen, 3)
+	for i, want := range [][2]int{{0, 30}, {230, 270}, {470, 490}} {
+		c.Check([2]int{rep.Matches[i].Start, rep.Matches[i].End}, check.Equals, want, check.Commentf("Test %d", i))
+		c.Check(rep.Matches[i].Strand, check.Equals, seq.Plus)
+	}
+	c.Check(rep.Removed, check.DeepEquals, []ScreenRegion{
+		{Start: 0, End: 30, Action: ScreenTrim},
+		{Start: 230, End: 270, Action: ScreenSplit},
+		{Start: 470, End: 500, Action: ScreenTrim},
+	})
+	c.Check(rep.Pieces, check.DeepEquals, []string{"contig.1", "contig.2"})
+	c.Assert(pieces, check.HasLen, 2)
+	c.Check(pieces[0].Name(), check.Equals, "contig.1")
+	c.Check(pieces[0].(*linear.Seq).Seq, check.DeepEquals, l[30:230])
+	c.Check(pieces[1].(*linear.Seq).Seq, check.DeepEquals, l[270:470])
+
+	// Short pieces are excluded and a short internal
+	// match is not removed.
+	v.MinPiece = 201
+	v.MinLength = 41
+	pieces, rep, err = v.Screen(contig, vector)
+	c.Assert(err, check.Equals, nil)
+	c.Check(rep.Removed, check.DeepEquals, []ScreenRegion{
+		{Start: 0, End: 30, Action: ScreenTrim},
+		{Start: 470, End: 500, Action: ScreenTrim},
+	})
+	c.Assert(pieces, check.HasLen, 1)
+	c.Check(pieces[0].(*linear.Seq).Seq, check.DeepEquals, l[30:470])
+
+	v.MinLength = 30
+	pieces, rep, err = v.Screen(contig, vector)
+	c.Assert(err, check.Equals, nil)
+	c.Check(rep.Removed, check.DeepEquals, []ScreenRegion{
+		{Start: 0, End: 30, Action: ScreenTrim},
+		{Start: 30, End: 230, Action: ScreenExclude},
+		{Start: 230, End: 270, Action: ScreenSplit},
+		{Start: 270, End: 470, Action: ScreenExclude},
+		{Start: 470, End: 500, Action: ScreenTrim},
+	})
+	c.Check(pieces, check.HasLen, 0)
+	c.Check(rep.Pieces, check.HasLen, 0)
+
+	// A clean contig is returned unchanged.
+	clean := linear.NewSeq("clean", randLetters(300), alphabet.DNAgapped)
+	pieces, rep, err = v.Screen(clean, vector)
+	c.Assert(err, check.Equals, nil)
+	c.Check(rep.Clean(), check.Equals, true)
+	c.Check(rep.Pieces, check.DeepEquals, []string{"clean"})
+	c.Assert(pieces, check.HasLen, 1)
+	c.Check(pieces[0].(*linear.Seq).Seq, check.DeepEquals, clean.Seq)
+}
@@ -0,0 +1,113 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// Stats holds summary statistics of a pairwise alignment.
+type Stats struct {
+	// Length is the number of alignment columns.
+	Length int
+
+	// Identities is the number of columns with
+	// identical letters, and Similarities the
+	// number with identical letters or letters
+	// with a positive substitution score.
+	Identities   int
+	Similarities int
+
+	// Gaps is the number of gap columns and
+	// GapOpens the number of runs of gap columns
+	// in either sequence.
+	Gaps     int
+	GapOpens int
+
+	// Score is the sum of the scores of the
+	// feature pairs of the alignment that
+	// have a Score method.
+	Score int
+}
+
+// Identity returns the percentage of alignment columns with identical letters.
+func (s Stats) Identity() float64 { return percent(s.Identities, s.Length) }
+
+// Similarity returns the percentage of alignment columns with similar letters.
+func (s Stats) Similarity() float64 { return percent(s.Similarities, s.Length) }
+
+// GapPercent returns the percentage of alignment columns that are gaps.
+func (s Stats) GapPercent() float64 { return percent(s.Gaps, s.Length) }
+
+func percent(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(d)
+}
+
+// NewStats returns the statistics of the alignment aln of query to reference, as returned
+// by an Aligner. Letters are compared without regard to case. Similar letters are those
+// with a positive score in m, which is indexed by the alphabet of the reference; if m is
+// nil, only identical letters are similar. NewStats returns an error if the sequence data
+// types are not handled.
+func NewStats(aln []feat.Pair, reference, query AlphabetSlicer, m Linear) (Stats, error) {
+	r, err := lettersOf(reference)
+	if err != nil {
+		return Stats{}, err
+	}
+	q, err := lettersOf(query)
+	if err != nil {
+		return Stats{}, err
+	}
+	index := reference.Alphabet().LetterIndex()
+
+	var (
+		s    Stats
+		last = -1 // Which sequence held the previous gap, or -1.
+	)
+	for _, p := range aln {
+		if sp, ok := p.(interface{ Score() int }); ok {
+			s.Score += sp.Score()
+		}
+		f := p.Features()
+		switch {
+		case f[0].Len() == 0 && f[1].Len() == 0:
+			// Empty alignments are described by a
+			// single zero length pair.
+			continue
+		case f[0].Len() == 0, f[1].Len() == 0:
+			g := 0
+			if f[0].Len() == 0 {
+				g = 1
+			}
+			n := f[0].Len() + f[1].Len()
+			s.Length += n
+			s.Gaps += n
+			if g != last {
+				s.GapOpens++
+			}
+			last = g
+		default:
+			last = -1
+			for i, j := f[0].Start(), f[1].Start(); i < f[0].End(); i, j = i+1, j+1 {
+				s.Length++
+				if upperLetter(r[i]) == upperLetter(q[j]) {
+					s.Identities++
+					s.Similarities++
+					continue
+				}
+				if m == nil {
+					continue
+				}
+				ri, qi := index[r[i]], index[q[j]]
+				if ri >= 0 && qi >= 0 && ri < len(m) && qi < len(m[ri]) && m[ri][qi] > 0 {
+					s.Similarities++
+				}
+			}
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestStats(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 1},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 1, -3, 8},
+	}
+	a := linear.NewSeq("a", alphabet.BytesToLetters([]byte("AGACTAGTTA")), alphabet.DNAgapped)
+	b := linear.NewSeq("b", alphabet.BytesToLetters([]byte("GACAGACG")), alphabet.DNAgapped)
+	aln, err := NW(m).Align(a, b)
+	c.Assert(err, check.Equals, nil)
+
+	// AGACTAGTTA
+	// -GAC-AGACG
+	st, err := NewStats(aln, a, b, m)
+	c.Assert(err, check.Equals, nil)
+	c.Check(st, check.Equals, Stats{
+		Length:       10,
+		Identities:   5,
+		Similarities: 6,
+		Gaps:         2,
+		GapOpens:     2,
+		Score:        totalScore(aln),
+	})
+	c.Check(st.Identity(), check.Equals, 50.)
+	c.Check(st.Similarity(), check.Equals, 60.)
+	c.Check(st.GapPercent(), check.Equals, 20.)
+
+	st, err = NewStats(aln, a, b, nil)
+	c.Assert(err, check.Equals, nil)
+	c.Check(st.Similarities, check.Equals, 5)
+	c.Check(Stats{}.Identity(), check.Equals, 0.)
+
+	empty := []feat.Pair{&featPair{a: feature{start: 2, end: 2}, b: feature{start: 3, end: 3}}}
+	st, err = NewStats(empty, a, b, m)
+	c.Assert(err, check.Equals, nil)
+	c.Check(st, check.Equals, Stats{})
+}
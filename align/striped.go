@@ -0,0 +1,300 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"math"
+)
+
+// UseSIMD specifies whether striped alignment scoring uses the SIMD kernel when
+// one is available for the architecture. When it is false, or no SIMD kernel
+// is available, a pure Go implementation of the same algorithm is used.
+var UseSIMD = true
+
+// lanes is the number of 16-bit lanes in a striped vector.
+const lanes = 8
+
+// vec is a striped vector of 16-bit scores.
+type vec [lanes]int16
+
+// Striped is the affine gap penalty Smith-Waterman local alignment scorer using
+// the striped algorithm of Farrar (Bioinformatics 23:156-161, 2007). It reports
+// only the score of the best local alignment, so it is suitable for screening
+// large numbers of sequences, with SWAffine used to align the best scoring pairs.
+//
+// The gap penalties given by the first row and column of the scoring matrix must
+// be the same for all letters. A gap of length k scores GapOpen plus k times the
+// gap penalty. Unlike SWAffine, a gap in one sequence may immediately follow a
+// gap in the other.
+type Striped Affine
+
+// Score returns the score of the best local alignment of reference and query, or an error if the
+// scoring matrix is not square or has non-uniform gap penalties, or the sequence data types or
+// alphabets do not match.
+func (a Striped) Score(reference, query AlphabetSlicer) (int, error) {
+	p, err := NewStripedProfile(query, a)
+	if err != nil {
+		return 0, err
+	}
+	return p.Score(reference)
+}
+
+// StripedProfile is a striped query profile for repeated local alignment
+// scoring of a query against many reference sequences. A StripedProfile is not
+// safe for concurrent use.
+type StripedProfile struct {
+	la       []int
+	let      int
+	alpha    alphabet.Alphabet
+	q        []int
+	gapOpen  int
+	gapExt   int
+	maxScore int
+
+	// seg is the number of vectors needed
+	// to hold the query in lanes stripes and
+	// prof holds seg vectors for each letter
+	// of the alphabet.
+	seg  int
+	prof []vec
+
+	hLoad, hStore, e []vec
+}
+
+// NewStripedProfile returns a striped profile of query using the scoring parameters of a. It returns
+// an error if the scoring matrix is not square or has non-uniform gap penalties.
+func NewStripedProfile(query AlphabetSlicer, a Striped) (*StripedProfile, error) {
+	alpha, err := gappedAlphabet(query, query)
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return nil, err
+	}
+	_, q, err := letterIndexes(query, query, alpha)
+	if err != nil {
+		return nil, err
+	}
+	let := len(a.Matrix)
+	ext := la[1]
+	for k := 1; k < let; k++ {
+		if la[k] != ext || la[k*let] != ext {
+			return nil, ErrNonUniformGaps
+		}
+	}
+	if a.GapOpen > 0 || ext > 0 {
+		return nil, ErrNonUniformGaps
+	}
+
+	p := &StripedProfile{
+		la:      la,
+		let:     let,
+		alpha:   alpha,
+		q:       q,
+		gapOpen: -(a.GapOpen + ext),
+		gapExt:  -ext,
+		seg:     (len(q) + lanes - 1) / lanes,
+	}
+	if p.seg == 0 {
+		p.seg = 1
+	}
+	p.prof = make([]vec, let*p.seg)
+	for k := 0; k < let; k++ {
+		for s := 0; s < p.seg; s++ {
+			v := &p.prof[k*p.seg+s]
+			for l := range v {
+				j := l*p.seg + s
+				if j >= len(q) {
+					v[l] = math.MinInt16 / 2
+					continue
+				}
+				v[l] = int16(clamp(la[k*let+q[j]]))
+				if la[k*let+q[j]] > p.maxScore {
+					p.maxScore = la[k*let+q[j]]
+				}
+			}
+		}
+	}
+	p.hLoad = make([]vec, p.seg)
+	p.hStore = make([]vec, p.seg)
+	p.e = make([]vec, p.seg)
+	return p, nil
+}
+
+// Score returns the score of the best local alignment of reference and the profile query. If the
+// score exceeds the range of the 16-bit striped scores, it is recalculated without striping.
+func (p *StripedProfile) Score(reference AlphabetSlicer) (int, error) {
+	alpha := reference.Alphabet()
+	if alpha == nil {
+		return 0, ErrNoAlphabet
+	}
+	if alpha != p.alpha {
+		return 0, ErrMismatchedAlphabets
+	}
+	r, _, err := letterIndexes(reference, reference, alpha)
+	if err != nil {
+		return 0, err
+	}
+	if len(p.q) == 0 {
+		return 0, nil
+	}
+	if p.gapOpen > math.MaxInt16 || p.maxScore > math.MaxInt16/2 {
+		return p.scalarScore(r), nil
+	}
+
+	column := stripedColumnGeneric
+	if UseSIMD && stripedColumnSIMD != nil {
+		column = stripedColumnSIMD
+	}
+	for s := range p.hStore {
+		p.hStore[s] = vec{}
+		p.e[s] = vec{}
+	}
+	var vMax vec
+	gapOpen, gapExt := int16(p.gapOpen), int16(p.gapExt)
+	for _, l := range r {
+		p.hLoad, p.hStore = p.hStore, p.hLoad
+		column(p.prof[l*p.seg:(l+1)*p.seg], p.hLoad, p.hStore, p.e, gapOpen, gapExt, &vMax)
+	}
+	var best int
+	for _, v := range vMax {
+		if int(v) > best {
+			best = int(v)
+		}
+	}
+	if best >= math.MaxInt16-p.maxScore {
+		return p.scalarScore(r), nil
+	}
+	return best, nil
+}
+
+// scalarScore returns the score of the best local alignment of r and the
+// profile query using an unstriped Gotoh algorithm.
+func (p *StripedProfile) scalarScore(r []int) int {
+	c := len(p.q) + 1
+	h := make([]int, c)
+	f := make([]int, c)
+	e := make([]int, c)
+	for j := range e {
+		e[j] = minInt / 2
+	}
+	var best int
+	for _, rVal := range r {
+		diagScore := 0
+		f[0] = minInt / 2
+		for j := 1; j < c; j++ {
+			e[j] = max2(h[j]-p.gapOpen, e[j]-p.gapExt)
+			f[j] = max2(h[j-1]-p.gapOpen, f[j-1]-p.gapExt)
+			s := max2(max3(diagScore+p.la[rVal*p.let+p.q[j-1]], e[j], f[j]), 0)
+			diagScore, h[j] = h[j], s
+			if s > best {
+				best = s
+			}
+		}
+	}
+	return best
+}
+
+// stripedColumnSIMD is the SIMD kernel for the architecture, or nil if
+// none is available.
+var stripedColumnSIMD func(prof, hLoad, hStore, e []vec, gapOpen, gapExt int16, vMax *vec)
+
+// stripedColumnGeneric fills the striped column hStore for the reference letter
+// with query profile prof from the previous column hLoad, updating the gap
+// vectors e and the running maximum vMax.
+func stripedColumnGeneric(prof, hLoad, hStore, e []vec, gapOpen, gapExt int16, vMax *vec) {
+	seg := len(hLoad)
+	gO, gE := int(gapOpen), int(gapExt)
+
+	// Lanes are processed independently with
+	// the same saturating arithmetic as the
+	// SIMD kernels.
+	var vH, vF [lanes]int
+	for l := 1; l < lanes; l++ {
+		vH[l] = int(hLoad[seg-1][l-1])
+	}
+	for s := 0; s < seg; s++ {
+		p, hs, ev, hl := &prof[s], &hStore[s], &e[s], &hLoad[s]
+		for l := 0; l < lanes; l++ {
+			h := clamp(vH[l] + int(p[l]))
+			if int16(h) > vMax[l] {
+				vMax[l] = int16(h)
+			}
+			eScore := int(ev[l])
+			h = max2(max3(h, eScore, vF[l]), 0)
+			hs[l] = int16(h)
+			h = clamp(h - gO)
+			ev[l] = int16(max2(clamp(eScore-gE), h))
+			vF[l] = max2(clamp(vF[l]-gE), h)
+			vH[l] = int(hl[l])
+		}
+	}
+
+	// Lazy evaluation of gaps carried between
+	// stripes. Evaluation stops when a vector of
+	// carried gap scores can no longer improve
+	// the scores of the column.
+	var f vec
+	for l := 1; l < lanes; l++ {
+		f[l] = int16(vF[l-1])
+	}
+	for k := 0; k < lanes; k++ {
+		for s := 0; s < seg; s++ {
+			if !greater(f, subs(hStore[s], gapOpen)) {
+				return
+			}
+			hStore[s] = maxs(hStore[s], f)
+			e[s] = maxs(e[s], subs(hStore[s], gapOpen))
+			f = subs(f, gapExt)
+		}
+		f = shift(f)
+	}
+}
+
+// shift moves each lane of v up by one, filling lane zero with zero.
+func shift(v vec) vec {
+	copy(v[1:], v[:lanes-1])
+	v[0] = 0
+	return v
+}
+
+func subs(a vec, b int16) vec {
+	for i := range a {
+		a[i] = int16(clamp(int(a[i]) - int(b)))
+	}
+	return a
+}
+
+func maxs(a, b vec) vec {
+	for i := range a {
+		if b[i] > a[i] {
+			a[i] = b[i]
+		}
+	}
+	return a
+}
+
+// greater returns whether any lane of a is greater than the same lane of b.
+func greater(a, b vec) bool {
+	for i := range a {
+		if a[i] > b[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func clamp(v int) int {
+	switch {
+	case v > math.MaxInt16:
+		return math.MaxInt16
+	case v < math.MinInt16:
+		return math.MinInt16
+	}
+	return v
+}
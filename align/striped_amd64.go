@@ -0,0 +1,14 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+// SSE2 is part of the amd64 baseline, so the
+// kernel is always available.
+func init() { stripedColumnSIMD = stripedColumnSSE2 }
+
+// stripedColumnSSE2 is an SSE2 implementation of stripedColumnGeneric.
+//
+//go:noescape
+func stripedColumnSSE2(prof, hLoad, hStore, e []vec, gapOpen, gapExt int16, vMax *vec)
@@ -0,0 +1,91 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// func stripedColumnSSE2(prof, hLoad, hStore, e []vec, gapOpen, gapExt int16, vMax *vec)
+TEXT ·stripedColumnSSE2(SB), NOSPLIT, $0-112
+	MOVQ    prof_base+0(FP), SI
+	MOVQ    hLoad_base+24(FP), R8
+	MOVQ    hLoad_len+32(FP), R11
+	MOVQ    hStore_base+48(FP), DI
+	MOVQ    e_base+72(FP), R9
+	MOVWLZX gapOpen+96(FP), AX
+	MOVWLZX gapExt+98(FP), BX
+	MOVQ    vMax+104(FP), R10
+
+	// X6 and X7 hold the broadcast gap open and
+	// extension penalties, X5 is zero, X4 is the
+	// running maximum and X1 is vF.
+	MOVQ       AX, X6
+	PSHUFLW    $0, X6, X6
+	PUNPCKLQDQ X6, X6
+	MOVQ       BX, X7
+	PSHUFLW    $0, X7, X7
+	PUNPCKLQDQ X7, X7
+	PXOR       X5, X5
+	MOVOU      (R10), X4
+	PXOR       X1, X1
+
+	// R11 holds the byte length of the columns
+	// and DX the byte offset of the current vector.
+	SHLQ  $4, R11
+	MOVOU -16(R8)(R11*1), X0
+	PSLLDQ $2, X0
+	XORQ  DX, DX
+
+loop:
+	MOVOU  (SI)(DX*1), X2
+	PADDSW X2, X0
+	PMAXSW X0, X4
+	MOVOU  (R9)(DX*1), X2
+	PMAXSW X2, X0
+	PMAXSW X1, X0
+	PMAXSW X5, X0
+	MOVOU  X0, (DI)(DX*1)
+	PSUBSW X6, X0
+	PSUBSW X7, X2
+	PMAXSW X0, X2
+	MOVOU  X2, (R9)(DX*1)
+	PSUBSW X7, X1
+	PMAXSW X0, X1
+	MOVOU  (R8)(DX*1), X0
+	ADDQ   $16, DX
+	CMPQ   DX, R11
+	JLT    loop
+
+	// Lazy evaluation of gaps carried between
+	// stripes. CX counts the passes.
+	PSLLDQ $2, X1
+	MOVQ   $8, CX
+
+pass:
+	XORQ DX, DX
+
+lazy:
+	MOVOU    (DI)(DX*1), X0
+	MOVO     X0, X2
+	PSUBSW   X6, X2
+	MOVO     X1, X3
+	PCMPGTW  X2, X3
+	PMOVMSKB X3, AX
+	TESTL    AX, AX
+	JZ       done
+	PMAXSW   X1, X0
+	MOVOU    X0, (DI)(DX*1)
+	PSUBSW   X6, X0
+	MOVOU    (R9)(DX*1), X2
+	PMAXSW   X0, X2
+	MOVOU    X2, (R9)(DX*1)
+	PSUBSW   X7, X1
+	ADDQ     $16, DX
+	CMPQ     DX, R11
+	JLT      lazy
+	PSLLDQ   $2, X1
+	DECQ     CX
+	JNZ      pass
+
+done:
+	MOVOU X4, (R10)
+	RET
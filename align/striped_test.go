@@ -0,0 +1,84 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestStriped(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+	defer func(u bool) { UseSIMD = u }(UseSIMD)
+
+	for i := 0; i < 200; i++ {
+		a, b := randSeq(1+rnd.Intn(100)), randSeq(1+rnd.Intn(100))
+		if i%2 == 0 {
+			// Related sequences give long gapped alignments.
+			b.Seq = append(append(alphabet.Letters(nil), a.Seq[:len(a.Seq)/2]...), b.Seq...)
+			b.Seq = append(b.Seq, a.Seq[len(a.Seq)/3:]...)
+		}
+
+		// With no gap open penalty the score is the
+		// linear gap Smith-Waterman score.
+		want, err := SW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		for _, simd := range []bool{false, true} {
+			UseSIMD = simd
+			got, err := Striped{Matrix: m}.Score(a, b)
+			c.Assert(err, check.Equals, nil)
+			c.Check(got, check.Equals, totalScore(want), check.Commentf("Test %d SIMD=%t", i, simd))
+		}
+
+		p, err := NewStripedProfile(b, Striped{Matrix: m, GapOpen: -7})
+		c.Assert(err, check.Equals, nil)
+		r, _, err := letterIndexes(a, a, a.Alpha)
+		c.Assert(err, check.Equals, nil)
+		wantScore := p.scalarScore(r)
+		for _, simd := range []bool{false, true} {
+			UseSIMD = simd
+			got, err := p.Score(a)
+			c.Assert(err, check.Equals, nil)
+			c.Check(got, check.Equals, wantScore, check.Commentf("Test %d SIMD=%t", i, simd))
+		}
+	}
+
+	// Scores beyond the 16-bit range are recalculated.
+	big := make(Linear, len(m))
+	for i, row := range m {
+		big[i] = make([]int, len(row))
+		for j, v := range row {
+			big[i][j] = v * 100
+		}
+	}
+	a := randSeq(500)
+	got, err := Striped{Matrix: big}.Score(a, a)
+	c.Assert(err, check.Equals, nil)
+	self, err := Striped{Matrix: m}.Score(a, a)
+	c.Assert(err, check.Equals, nil)
+	c.Check(got, check.Equals, self*100)
+
+	m[0][1] = -4
+	_, err = Striped{Matrix: m}.Score(a, a)
+	c.Check(err, check.Equals, ErrNonUniformGaps)
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// Suboptimal returns up to k non-overlapping local alignments of reference and query in order of
+// decreasing score, using the declumping method of Waterman and Eggert (J Mol Biol 197:723-728,
+// 1987). Each alignment after the first is the best local alignment that does not align any pair
+// of letters aligned by an earlier alignment, so repeated and shuffled domains are each reported
+// once. Alignments are found until k have been found or no alignment with a positive score remains.
+// The first alignment is the alignment returned by Align. Suboptimal returns an error if the
+// scoring matrix is not square, or the sequence data types or alphabets do not match.
+func (a SW) Suboptimal(reference, query AlphabetSlicer, k int) ([][]feat.Pair, error) {
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return nil, err
+	}
+	la, err := flatten(Linear(a), alpha)
+	if err != nil {
+		return nil, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	w := waterman{
+		la:   la,
+		let:  len(a),
+		r:    r,
+		q:    q,
+		c:    len(q) + 1,
+		used: make([]bool, (len(r)+1)*(len(q)+1)),
+	}
+	w.table = make([]int, len(w.used))
+
+	var alns [][]feat.Pair
+	si, sj := 1, 1
+	for len(alns) < k {
+		w.fill(si, sj)
+		maxI, maxJ, maxS := w.best()
+		if maxS <= 0 {
+			break
+		}
+		ops, i, j := w.traceback(maxI, maxJ)
+		alns = append(alns, opsPairs(ops, r, q, i, j, la, w.let))
+		si, sj = i+1, j+1
+	}
+	return alns, nil
+}
+
+// waterman holds the state of a Waterman-Eggert alignment.
+type waterman struct {
+	la  []int
+	let int
+
+	r, q []int
+	c    int
+
+	// table holds the local alignment scores and
+	// used marks the cells whose letter pairs have
+	// been aligned by an earlier alignment.
+	table []int
+	used  []bool
+}
+
+// diagScore returns the score of the diagonal move into the cell p at row i
+// and column j, or minInt if the move aligns a used pair.
+func (w *waterman) diagScore(p, i, j int) int {
+	if w.used[p] {
+		return minInt
+	}
+	return w.table[p-w.c-1] + w.la[w.r[i-1]*w.let+w.q[j-1]]
+}
+
+// fill recalculates the cells of the table from row si and column sj. Cells
+// above or to the left of the first pair of the last alignment found are not
+// affected by marking its pairs as used.
+func (w *waterman) fill(si, sj int) {
+	let, la, c := w.let, w.la, w.c
+	for i := si; i <= len(w.r); i++ {
+		for j := sj; j < c; j++ {
+			p := i*c + j
+			score := max3(
+				w.diagScore(p, i, j),
+				w.table[p-c]+la[w.r[i-1]*let],
+				w.table[p-1]+la[w.q[j-1]],
+			)
+			if score < 0 {
+				score = 0
+			}
+			w.table[p] = score
+		}
+	}
+}
+
+// best returns the cell ending the best local alignment, breaking ties in
+// the same way as SW.
+func (w *waterman) best() (maxI, maxJ, maxS int) {
+	c := w.c
+	for i := 1; i <= len(w.r); i++ {
+		for j := 1; j < c; j++ {
+			p := i*c + j
+			score := w.table[p]
+			if score > 0 && score >= maxS && score == w.diagScore(p, i, j) {
+				maxS, maxI, maxJ = score, i, j
+			}
+		}
+	}
+	return maxI, maxJ, maxS
+}
+
+// traceback returns the operations of the local alignment ending at cell
+// (i, j) and the cell it starts from, marking its aligned pairs as used.
+func (w *waterman) traceback(i, j int) (ops []byte, si, sj int) {
+	let, la, c := w.let, w.la, w.c
+	for i > 0 && j > 0 {
+		p := i*c + j
+		switch s := w.table[p]; {
+		case s == 0:
+			i, j = -i, -j
+		case s == w.diagScore(p, i, j):
+			w.used[p] = true
+			ops = append(ops, diag)
+			i--
+			j--
+		case s == w.table[p-c]+la[w.r[i-1]*let]:
+			ops = append(ops, up)
+			i--
+		default:
+			ops = append(ops, left)
+			j--
+		}
+	}
+	if i < 0 {
+		i, j = -i, -j
+	}
+	for l, r := 0, len(ops)-1; l < r; l, r = l+1, r-1 {
+		ops[l], ops[r] = ops[r], ops[l]
+	}
+	return ops, i, j
+}
@@ -0,0 +1,91 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestSuboptimal(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randLetters := func(n int) alphabet.Letters {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return alphabet.BytesToLetters(b)
+	}
+
+	// The best suboptimal alignment is the SW alignment,
+	// later alignments share no aligned pairs with earlier
+	// alignments and scores do not increase.
+	for i := 0; i < 50; i++ {
+		a := linear.NewSeq("", randLetters(1+rnd.Intn(60)), alphabet.DNAgapped)
+		b := linear.NewSeq("", randLetters(1+rnd.Intn(60)), alphabet.DNAgapped)
+		want, err := SW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		alns, err := SW(m).Suboptimal(a, b, 5)
+		c.Assert(err, check.Equals, nil)
+		if totalScore(want) <= 0 {
+			c.Check(alns, check.HasLen, 0, check.Commentf("Test %d", i))
+			continue
+		}
+		c.Check(fmt.Sprint(alns[0]), check.Equals, fmt.Sprint(want), check.Commentf("Test %d", i))
+		used := make(map[[2]int]bool)
+		last := totalScore(alns[0])
+		for _, aln := range alns {
+			score := totalScore(aln)
+			c.Check(score > 0, check.Equals, true, check.Commentf("Test %d", i))
+			c.Check(score <= last, check.Equals, true, check.Commentf("Test %d", i))
+			last = score
+			for _, p := range aln {
+				f := p.Features()
+				if f[0].Len() == 0 || f[1].Len() == 0 {
+					continue
+				}
+				for k := 0; k < f[0].Len(); k++ {
+					pair := [2]int{f[0].Start() + k, f[1].Start() + k}
+					c.Check(used[pair], check.Equals, false, check.Commentf("Test %d", i))
+					used[pair] = true
+				}
+			}
+		}
+	}
+
+	// Two copies of a domain in the reference are
+	// each found as a separate alignment.
+	domain := randLetters(30)
+	var rs alphabet.Letters
+	rs = append(rs, alphabet.Letters("CCCCCCCCCC")...)
+	rs = append(rs, domain...)
+	rs = append(rs, alphabet.Letters("CCCCCCCCCCCCCCCCCCCC")...)
+	rs = append(rs, domain...)
+	rs = append(rs, alphabet.Letters("CCCCCCCCCC")...)
+	ref := linear.NewSeq("", rs, alphabet.DNAgapped)
+	query := linear.NewSeq("", domain, alphabet.DNAgapped)
+
+	alns, err := SW(m).Suboptimal(ref, query, 2)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(alns, check.HasLen, 2)
+	starts := []int{alns[0][0].Features()[0].Start(), alns[1][0].Features()[0].Start()}
+	if starts[0] > starts[1] {
+		starts[0], starts[1] = starts[1], starts[0]
+	}
+	c.Check(starts, check.DeepEquals, []int{10, 60})
+	c.Check(totalScore(alns[0]), check.Equals, totalScore(alns[1]))
+}
@@ -11,83 +11,15 @@ import (
 	"github.com/biogo/biogo/feat"
 
 	"fmt"
-	"os"
-	"text/tabwriter"
 )
 
-//line sw_affine_type.got:17
-func drawSWAffineTableLetters(rSeq, qSeq alphabet.Letters, index alphabet.Index, table [][3]int, a SWAffine) {
-	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Printf("rSeq: %s\n", rSeq)
-	fmt.Printf("qSeq: %s\n", qSeq)
-	for l := 0; l < 3; l++ {
-		fmt.Fprintf(tw, "%c\tqSeq\t", "MUL"[l])
-		for _, l := range qSeq {
-			fmt.Fprintf(tw, "%c\t", l)
-		}
-		fmt.Fprintln(tw)
-
-		r, c := rSeq.Len()+1, qSeq.Len()+1
-		fmt.Fprint(tw, "rSeq\t")
-		for i := 0; i < r; i++ {
-			if i != 0 {
-				fmt.Fprintf(tw, "%c\t", rSeq[i-1])
-			}
-
-			for j := 0; j < c; j++ {
-				p := pointerSWAffineLetters(rSeq, qSeq, i, j, l, table, index, a, c)
-				var vi interface{}
-				if vi = table[i*c+j][l]; vi == minInt {
-					vi = "-Inf"
-				}
-				fmt.Fprintf(tw, "%s % 4v\t", p, vi)
-			}
-			fmt.Fprintln(tw)
-		}
-		fmt.Fprintln(tw)
-	}
-	tw.Flush()
-}
-
-func pointerSWAffineLetters(rSeq, qSeq alphabet.Letters, i, j, l int, table [][3]int, index alphabet.Index, a SWAffine, c int) string {
-	if i == 0 || j == 0 {
-		return "   "
-	}
-	rVal := index[rSeq[i-1]]
-	qVal := index[qSeq[j-1]]
-	if rVal < 0 || qVal < 0 {
-		return "   "
-	}
-	switch p := i*c + j; {
-	case table[p][l] == 0:
-		return "   "
-	case table[p-c][up]+a.Matrix[rVal][gap] == table[p][l] && table[(i-1)*c+j-1][l] != 0:
-		return "⬆ u"
-	case table[p-1][left]+a.Matrix[gap][qVal] == table[p][l] && table[(i-1)*c+j-1][l] != 0:
-		return "⬅ l"
-
-	case table[p-c][diag]+a.GapOpen+a.Matrix[rVal][gap] == table[p][l] && table[(i-1)*c+j-1][l] != 0:
-		return "⬆ m"
-	case table[p-1][diag]+a.GapOpen+a.Matrix[gap][qVal] == table[p][l] && table[(i-1)*c+j-1][l] != 0:
-		return "⬅ m"
-
-	case table[p-c-1][diag]+a.Matrix[rVal][qVal] == table[p][l] && table[(i-1)*c+j-1][l] != 0:
-		return "⬉ m"
-	case table[p-c-1][up]+a.Matrix[rVal][qVal] == table[p][l] && table[(i-1)*c+j-1][l] != 0:
-		return "⬉ u"
-	case table[p-c-1][left]+a.Matrix[rVal][qVal] == table[p][l] && table[(i-1)*c+j-1][l] != 0:
-		return "⬉ l"
-	default:
-		return [3]string{"⌜  ", "⬆ u", "⬅ l"}[l]
-	}
-}
-
-func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+//line sw_affine_type.got:15
+func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, tr Tracer, buf *Buffer) ([]feat.Pair, error) {
 	let := len(a.Matrix)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
 	}
-	la := make([]int, 0, let*let)
+	la := buf.scores(let * let)
 	for _, row := range a.Matrix {
 		if len(row) != let {
 			return nil, ErrMatrixNotSquare
@@ -95,7 +27,7 @@ func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alpha
 		la = append(la, row...)
 	}
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([][3]int, r*c)
+	table := buf.affine(r * c)
 
 	var (
 		index = alpha.LetterIndex()
@@ -153,10 +85,15 @@ func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alpha
 				score = 0
 			}
 			table[p][left] = score
+			if tr != nil {
+				for l, s := range table[p] {
+					tr.Cell(l, i, j, s)
+				}
+			}
 		}
 	}
-	if debugSmithAffine {
-		drawSWAffineTableLetters(rSeq, qSeq, index, table, a)
+	if tr != nil {
+		tr.Table(r, c, affineLayers(table))
 	}
 
 	var aln []feat.Pair
@@ -164,6 +101,9 @@ func (a SWAffine) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alpha
 	i, j := maxI, maxJ
 loop:
 	for i > 0 && j > 0 {
+		if tr != nil {
+			tr.Traceback(layer, i, j)
+		}
 		var (
 			rVal = index[rSeq[i-1]]
 			qVal = index[qSeq[j-1]]
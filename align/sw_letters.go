@@ -11,66 +11,15 @@ import (
 	"github.com/biogo/biogo/feat"
 
 	"fmt"
-	"os"
-	"text/tabwriter"
 )
 
-//line sw_type.got:17
-func drawSWTableLetters(rSeq, qSeq alphabet.Letters, index alphabet.Index, table []int, a [][]int) {
-	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Printf("rSeq: %s\n", rSeq)
-	fmt.Printf("qSeq: %s\n", qSeq)
-	fmt.Fprint(tw, "\tqSeq\t")
-	for _, l := range qSeq {
-		fmt.Fprintf(tw, "%c\t", l)
-	}
-	fmt.Fprintln(tw)
-
-	r, c := rSeq.Len()+1, qSeq.Len()+1
-	fmt.Fprint(tw, "rSeq\t")
-	for i := 0; i < r; i++ {
-		if i != 0 {
-			fmt.Fprintf(tw, "%c\t", rSeq[i-1])
-		}
-
-		for j := 0; j < c; j++ {
-			p := pointerSWLetters(rSeq, qSeq, i, j, table, index, a, c)
-			fmt.Fprintf(tw, "%s %3v\t", p, table[i*c+j])
-		}
-		fmt.Fprintln(tw)
-	}
-	tw.Flush()
-}
-
-func pointerSWLetters(rSeq, qSeq alphabet.Letters, i, j int, table []int, index alphabet.Index, a [][]int, c int) string {
-	if i == 0 || j == 0 {
-		return " "
-	}
-	rVal := index[rSeq[i-1]]
-	qVal := index[qSeq[j-1]]
-	if rVal < 0 || qVal < 0 {
-		return " "
-	}
-	switch p := i*c + j; {
-	case table[p] == 0:
-		return " "
-	case table[p-c-1]+a[rVal][qVal] == table[p] && table[p-c-1] != 0:
-		return "⬉"
-	case table[p-c]+a[rVal][gap] == table[p] && table[p-c] != 0:
-		return "⬆"
-	case table[p-1]+a[gap][qVal] == table[p] && table[p-1] != 0:
-		return "⬅"
-	default:
-		return "⌜"
-	}
-}
-
-func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) ([]feat.Pair, error) {
+//line sw_type.got:15
+func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet, tr Tracer, buf *Buffer) ([]feat.Pair, error) {
 	let := len(a)
 	if let < alpha.Len() {
 		return nil, ErrMatrixWrongSize{Size: let, Len: alpha.Len()}
 	}
-	la := make([]int, 0, let*let)
+	la := buf.scores(let * let)
 	for _, row := range a {
 		if len(row) != let {
 			return nil, ErrMatrixNotSquare
@@ -78,7 +27,7 @@ func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) (
 		la = append(la, row...)
 	}
 	r, c := rSeq.Len()+1, qSeq.Len()+1
-	table := make([]int, r*c)
+	table := buf.linear(r * c)
 
 	var (
 		index = alpha.LetterIndex()
@@ -116,10 +65,13 @@ func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) (
 				score = 0
 			}
 			table[p] = score
+			if tr != nil {
+				tr.Cell(0, i, j, table[p])
+			}
 		}
 	}
-	if debugSmith {
-		drawSWTableLetters(rSeq, qSeq, index, table, a)
+	if tr != nil {
+		tr.Table(r, c, [][]int{append([]int(nil), table...)})
 	}
 
 	var aln []feat.Pair
@@ -127,6 +79,9 @@ func (a SW) alignLetters(rSeq, qSeq alphabet.Letters, alpha alphabet.Alphabet) (
 	i, j := maxI, maxJ
 loop:
 	for i > 0 && j > 0 {
+		if tr != nil {
+			tr.Traceback(0, i, j)
+		}
 		var (
 			rVal = index[rSeq[i-1]]
 			qVal = index[qSeq[j-1]]
@@ -0,0 +1,125 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// A Tracer receives the internal state of a dynamic programming alignment for
+// debugging, testing and visualisation. Cells are indexed by row i, the number
+// of reference letters consumed, and column j, the number of query letters
+// consumed. Linear gap penalty aligners use a single table layer, 0. Affine gap
+// penalty aligners use three layers, 0, 1 and 2, holding the scores of paths
+// ending in a match, a gap in the query and a gap in the reference.
+type Tracer interface {
+	// Cell is called when the score of cell (i, j)
+	// of layer l has been computed. Cells of the
+	// first row and column are not reported.
+	Cell(l, i, j, score int)
+
+	// Table is called with the complete tables
+	// before traceback. Each layer holds the scores
+	// of rows by cols cells in row-major order.
+	Table(rows, cols int, layers [][]int)
+
+	// Traceback is called for each cell of layer l
+	// visited during traceback, starting from the
+	// end of the alignment.
+	Traceback(l, i, j int)
+}
+
+// A TraceAligner is an Aligner that can report the progress of its dynamic
+// programming to a Tracer.
+type TraceAligner interface {
+	Aligner
+	AlignTrace(reference, query AlphabetSlicer, tr Tracer) ([]feat.Pair, error)
+}
+
+var (
+	_ TraceAligner = NW{}
+	_ TraceAligner = SW{}
+	_ TraceAligner = Fitted{}
+	_ TraceAligner = NWAffine{}
+	_ TraceAligner = SWAffine{}
+	_ TraceAligner = FittedAffine{}
+)
+
+func affineLayers(table [][3]int) [][]int {
+	layers := make([][]int, 3)
+	for l := range layers {
+		layers[l] = make([]int, len(table))
+		for p, s := range table {
+			layers[l][p] = s[l]
+		}
+	}
+	return layers
+}
+
+// TableTracer is a Tracer that writes the dynamic programming tables of an
+// alignment as text, marking the cells visited during traceback.
+type TableTracer struct {
+	w          io.Writer
+	rows, cols int
+	layers     [][]int
+	path       map[[3]int]bool
+}
+
+// NewTableTracer returns a TableTracer that writes to w.
+func NewTableTracer(w io.Writer) *TableTracer {
+	return &TableTracer{w: w}
+}
+
+// Cell is a no-op satisfying the Tracer interface.
+func (t *TableTracer) Cell(l, i, j, score int) {}
+
+// Table records the tables of an alignment.
+func (t *TableTracer) Table(rows, cols int, layers [][]int) {
+	t.rows, t.cols, t.layers = rows, cols, layers
+	t.path = make(map[[3]int]bool)
+}
+
+// Traceback records a traceback cell.
+func (t *TableTracer) Traceback(l, i, j int) {
+	t.path[[3]int{l, i, j}] = true
+}
+
+// Flush writes the recorded tables, with each traceback cell marked by an
+// asterisk and unreachable cells written as -Inf.
+func (t *TableTracer) Flush() error {
+	tw := tabwriter.NewWriter(t.w, 0, 0, 1, ' ', tabwriter.AlignRight)
+	for l, layer := range t.layers {
+		if len(t.layers) > 1 {
+			fmt.Fprintf(tw, "%c\t", "MUL"[l])
+		} else {
+			fmt.Fprint(tw, "\t")
+		}
+		for j := 0; j < t.cols; j++ {
+			fmt.Fprintf(tw, "%d\t", j)
+		}
+		fmt.Fprintln(tw)
+		for i := 0; i < t.rows; i++ {
+			fmt.Fprintf(tw, "%d\t", i)
+			for j := 0; j < t.cols; j++ {
+				var v interface{} = layer[i*t.cols+j]
+				if v == minInt {
+					v = "-Inf"
+				}
+				mark := ""
+				if t.path[[3]int{l, i, j}] {
+					mark = "*"
+				}
+				fmt.Fprintf(tw, "%s%v\t", mark, v)
+			}
+			fmt.Fprintln(tw)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
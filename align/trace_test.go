@@ -0,0 +1,84 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"bytes"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+	"gopkg.in/check.v1"
+)
+
+type recorder struct {
+	cells      map[[3]int]int
+	rows, cols int
+	layers     [][]int
+	path       [][3]int
+}
+
+func (r *recorder) Cell(l, i, j, score int) {
+	if r.cells == nil {
+		r.cells = make(map[[3]int]int)
+	}
+	r.cells[[3]int{l, i, j}] = score
+}
+func (r *recorder) Table(rows, cols int, layers [][]int) {
+	r.rows, r.cols, r.layers = rows, cols, layers
+}
+func (r *recorder) Traceback(l, i, j int) { r.path = append(r.path, [3]int{l, i, j}) }
+
+var traceMatrix = Linear{
+	{0, -1, -1, -1, -1},
+	{-1, 1, -1, -1, -1},
+	{-1, -1, 1, -1, -1},
+	{-1, -1, -1, 1, -1},
+	{-1, -1, -1, -1, 1},
+}
+
+func (s *S) TestTracer(c *check.C) {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("acgt")), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("agt")), alphabet.DNAgapped)
+
+	for i, t := range []struct {
+		aligner TraceAligner
+		layers  int
+	}{
+		{NW(traceMatrix), 1},
+		{SW(traceMatrix), 1},
+		{Fitted(traceMatrix), 1},
+		{NWAffine{Matrix: traceMatrix, GapOpen: -1}, 3},
+		{SWAffine{Matrix: traceMatrix, GapOpen: -1}, 3},
+		{FittedAffine{Matrix: traceMatrix, GapOpen: -1}, 3},
+	} {
+		want, err := t.aligner.Align(ref, query)
+		c.Assert(err, check.Equals, nil)
+
+		var r recorder
+		got, err := t.aligner.AlignTrace(ref, query, &r)
+		c.Assert(err, check.Equals, nil)
+		c.Check(got, check.DeepEquals, want, check.Commentf("Test %d", i))
+
+		c.Check(r.rows, check.Equals, ref.Len()+1, check.Commentf("Test %d", i))
+		c.Check(r.cols, check.Equals, query.Len()+1, check.Commentf("Test %d", i))
+		c.Assert(r.layers, check.HasLen, t.layers, check.Commentf("Test %d", i))
+		c.Check(r.cells, check.HasLen, t.layers*ref.Len()*query.Len(), check.Commentf("Test %d", i))
+		for k, score := range r.cells {
+			c.Check(r.layers[k[0]][k[1]*r.cols+k[2]], check.Equals, score, check.Commentf("Test %d cell %v", i, k))
+		}
+		c.Check(len(r.path) > 0, check.Equals, true, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestTableTracer(c *check.C) {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("ac")), alphabet.DNAgapped)
+	query := linear.NewSeq("query", alphabet.BytesToLetters([]byte("c")), alphabet.DNAgapped)
+	var buf bytes.Buffer
+	tr := NewTableTracer(&buf)
+	_, err := NW(traceMatrix).AlignTrace(ref, query, tr)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(tr.Flush(), check.Equals, nil)
+	c.Check(buf.String(), check.Equals, "    0  1\n 0  0 -1\n 1 -1 -1\n 2 -2 *0\n\n")
+}
@@ -0,0 +1,201 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/feat"
+)
+
+// XDrop is the linear gap penalty X-drop seed extension aligner type. An
+// alignment is extended from both ends of a seed until the score falls more
+// than X below the best score seen, and is then trimmed back to the best
+// scoring end. Only cells of the dynamic programming table with scores within
+// X of the best score are explored, so the cost of an extension is proportional
+// to the size of the region of similarity rather than the lengths of the
+// sequences. If Ungapped is true, extension is along the seed diagonal only.
+type XDrop struct {
+	Matrix   Linear
+	X        int
+	Ungapped bool
+}
+
+// Extend extends the seed aligning the length letters of reference starting at position i with
+// those of query starting at j. It returns an alignment description including the seed and the
+// score of the alignment, or an error if the scoring matrix is not square, the sequence data types
+// or alphabets do not match, or the seed is not within the sequences.
+func (a XDrop) Extend(reference, query AlphabetSlicer, i, j, length int) ([]feat.Pair, int, error) {
+	if a.X < 0 {
+		return nil, 0, ErrBadDrop
+	}
+	alpha, err := gappedAlphabet(reference, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	la, err := flatten(a.Matrix, alpha)
+	if err != nil {
+		return nil, 0, err
+	}
+	r, q, err := letterIndexes(reference, query, alpha)
+	if err != nil {
+		return nil, 0, err
+	}
+	if i < 0 || j < 0 || length < 0 || i+length > len(r) || j+length > len(q) {
+		return nil, 0, ErrBadSeed
+	}
+
+	x := xdrop{la: la, let: len(a.Matrix), x: a.X, ungapped: a.Ungapped}
+	aln, score := x.seed(r, q, i, j, length)
+	return aln, score, nil
+}
+
+// seed returns the alignment and score of the extension of the seed aligning
+// the length letter indexes of r starting at i with those of q starting at j.
+func (x xdrop) seed(r, q []int, i, j, length int) ([]feat.Pair, int) {
+	var score int
+	for k := 0; k < length; k++ {
+		score += x.la[r[i+k]*x.let+q[j+k]]
+	}
+
+	// The leftward extension is performed on the
+	// reversed sequences and its traceback is in
+	// alignment order. The rightward extension
+	// traceback is reversed.
+	leftOps, li, lj, leftScore := x.extend(reversed(r[:i]), reversed(q[:j]))
+	rightOps, _, _, rightScore := x.extend(r[i+length:], q[j+length:])
+	ops := make([]byte, 0, len(leftOps)+length+len(rightOps))
+	ops = append(ops, leftOps...)
+	for k := 0; k < length; k++ {
+		ops = append(ops, diag)
+	}
+	for k := len(rightOps) - 1; k >= 0; k-- {
+		ops = append(ops, rightOps[k])
+	}
+	score += leftScore + rightScore
+
+	aln := opsPairs(ops, r, q, i-li, j-lj, x.la, x.let)
+	if len(aln) == 0 {
+		aln = append(aln, &featPair{a: feature{start: i, end: i}, b: feature{start: j, end: j}})
+	}
+	return aln, score
+}
+
+func reversed(s []int) []int {
+	r := make([]int, len(s))
+	for i, v := range s {
+		r[len(s)-1-i] = v
+	}
+	return r
+}
+
+// xdrop holds the parameters of an X-drop extension.
+type xdrop struct {
+	la       []int
+	let      int
+	x        int
+	ungapped bool
+}
+
+// xrow is a row of an X-drop dynamic programming table holding
+// the scores of the columns from lo.
+type xrow struct {
+	lo int
+	s  []int
+}
+
+func (r xrow) at(j int) int {
+	if j < r.lo || j >= r.lo+len(r.s) {
+		return minInt
+	}
+	return r.s[j-r.lo]
+}
+
+// extend returns the traceback operations, in reverse alignment order, of
+// the best X-drop extension from the start of r and q, the lengths of r and
+// q covered by the extension, and its score.
+func (x xdrop) extend(r, q []int) (ops []byte, i, j, score int) {
+	let, la := x.let, x.la
+	if x.ungapped {
+		var s int
+		for k := 0; k < len(r) && k < len(q); k++ {
+			s += la[r[k]*let+q[k]]
+			if s > score {
+				score, i = s, k+1
+			} else if s < score-x.x {
+				break
+			}
+		}
+		for k := 0; k < i; k++ {
+			ops = append(ops, diag)
+		}
+		return ops, i, i, score
+	}
+
+	alive := func(s int) bool { return s != minInt && s >= score-x.x }
+
+	row := xrow{s: []int{0}}
+	for c := 1; c <= len(q); c++ {
+		s := row.s[c-1] + la[q[c-1]]
+		if !alive(s) {
+			break
+		}
+		row.s = append(row.s, s)
+	}
+	rows := []xrow{row}
+	for ri := 1; ri <= len(r); ri++ {
+		prev := rows[ri-1]
+		cur := xrow{lo: prev.lo}
+		rVal := r[ri-1]
+		for c := prev.lo; c <= len(q); c++ {
+			s := add(prev.at(c), la[rVal*let])
+			if c > 0 {
+				s = max3(
+					s,
+					add(prev.at(c-1), la[rVal*let+q[c-1]]),
+					add(cur.at(c-1), la[q[c-1]]),
+				)
+			}
+			if !alive(s) {
+				s = minInt
+			}
+			if s == minInt && c >= prev.lo+len(prev.s) {
+				break
+			}
+			cur.s = append(cur.s, s)
+			if s > score {
+				score, i, j = s, ri, c
+			}
+		}
+		// Trim dead cells from the ends of the row.
+		for len(cur.s) != 0 && cur.s[0] == minInt {
+			cur.s = cur.s[1:]
+			cur.lo++
+		}
+		for len(cur.s) != 0 && cur.s[len(cur.s)-1] == minInt {
+			cur.s = cur.s[:len(cur.s)-1]
+		}
+		if len(cur.s) == 0 {
+			break
+		}
+		rows = append(rows, cur)
+	}
+
+	ti, tj := i, j
+	for ti > 0 || tj > 0 {
+		s := rows[ti].at(tj)
+		switch {
+		case ti > 0 && tj > 0 && s == add(rows[ti-1].at(tj-1), la[r[ti-1]*let+q[tj-1]]):
+			ops = append(ops, diag)
+			ti--
+			tj--
+		case ti > 0 && s == add(rows[ti-1].at(tj), la[r[ti-1]*let]):
+			ops = append(ops, up)
+			ti--
+		default:
+			ops = append(ops, left)
+			tj--
+		}
+	}
+	return ops, i, j, score
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package align
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestXDrop(c *check.C) {
+	m := Linear{
+		{0, -5, -5, -5, -5},
+		{-5, 10, -3, -1, -4},
+		{-5, -3, 9, -5, 0},
+		{-5, -1, -5, 7, -3},
+		{-5, -4, 0, -3, 8},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	randSeq := func(n int) *linear.Seq {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ACGT"[rnd.Intn(4)]
+		}
+		return linear.NewSeq("", alphabet.BytesToLetters(b), alphabet.DNAgapped)
+	}
+
+	// Without effective drop-off, extension of a seed on
+	// the best local alignment recovers its score.
+	for i := 0; i < 100; i++ {
+		a, b := randSeq(1+rnd.Intn(40)), randSeq(1+rnd.Intn(40))
+		want, err := SW(m).Align(a, b)
+		c.Assert(err, check.Equals, nil)
+		f := want[0].Features()
+		if f[0].Len() == 0 || f[1].Len() == 0 {
+			continue
+		}
+		got, score, err := XDrop{Matrix: m, X: 1 << 20}.Extend(a, b, f[0].Start(), f[1].Start(), 1)
+		c.Assert(err, check.Equals, nil)
+		c.Check(score, check.Equals, totalScore(want), check.Commentf("Test %d", i))
+		c.Check(totalScore(got), check.Equals, score, check.Commentf("Test %d", i))
+	}
+
+	// A region of similarity with an insertion in
+	// the query is found from a seed within it and
+	// extension stops in the flanking sequence.
+	core := randSeq(60)
+	ref := linear.NewSeq("", append(append(alphabet.Letters("CCCCCCCCCC"), core.Seq...), alphabet.Letters("CCCCCCCCCC")...), alphabet.DNAgapped)
+	qs := append(alphabet.Letters("GGGGGGGGGG"), core.Seq[:30]...)
+	qs = append(qs, 'A', 'A')
+	qs = append(qs, core.Seq[30:]...)
+	qs = append(qs, alphabet.Letters("GGGGGGGGGG")...)
+	query := linear.NewSeq("", qs, alphabet.DNAgapped)
+
+	aln, score, err := XDrop{Matrix: m, X: 20}.Extend(ref, query, 20, 20, 5)
+	c.Assert(err, check.Equals, nil)
+	c.Check(score, check.Equals, totalScore(aln))
+	c.Check(aln[0].Features()[0].Start(), check.Equals, 10)
+	c.Check(aln[len(aln)-1].Features()[0].End(), check.Equals, 70)
+	c.Check(aln[len(aln)-1].Features()[1].End(), check.Equals, 72)
+
+	// Ungapped extension stops at the insertion.
+	aln, _, err = XDrop{Matrix: m, X: 20, Ungapped: true}.Extend(ref, query, 20, 20, 5)
+	c.Assert(err, check.Equals, nil)
+	c.Check(aln, check.HasLen, 1)
+	c.Check(aln[0].Features()[0].Start(), check.Equals, 10)
+	c.Check(aln[0].Features()[0].End() <= 42, check.Equals, true)
+
+	_, _, err = XDrop{Matrix: m, X: 20}.Extend(ref, query, 78, 20, 5)
+	c.Check(err, check.Equals, ErrBadSeed)
+	_, _, err = XDrop{Matrix: m, X: -1}.Extend(ref, query, 20, 20, 5)
+	c.Check(err, check.Equals, ErrBadDrop)
+}
@@ -0,0 +1,112 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+import (
+	"encoding/binary"
+	"unicode"
+)
+
+// Bulk operations on Letters process eight letters at a time as 64-bit words
+// where the operation can be expressed with word arithmetic, falling back to
+// single letters for the tail. Word loads and stores use little endian byte
+// order, which compiles to plain loads and stores on common architectures and
+// does not affect the result since each byte is treated independently.
+
+const (
+	ones  = 0x0101010101010101
+	highs = 0x8080808080808080
+	lows  = 0x7f7f7f7f7f7f7f7f
+)
+
+// inRange returns a word with the high bit of each byte of x set when the byte
+// is in [lo, hi], where lo and hi are ASCII letters.
+func inRange(x uint64, lo, hi byte) uint64 {
+	h := x & lows
+	ge := h + ones*uint64(0x80-lo)
+	gt := h + ones*uint64(0x80-hi-1)
+	return (ge &^ gt) &^ x & highs
+}
+
+// ToUpper converts the lower case ASCII letters in l to upper case in place.
+func (l Letters) ToUpper() {
+	b := LettersToBytes(l)
+	i := 0
+	for ; i+8 <= len(b); i += 8 {
+		x := binary.LittleEndian.Uint64(b[i:])
+		binary.LittleEndian.PutUint64(b[i:], x&^(inRange(x, 'a', 'z')>>2))
+	}
+	for ; i < len(b); i++ {
+		if 'a' <= b[i] && b[i] <= 'z' {
+			b[i] &^= 'a' - 'A'
+		}
+	}
+}
+
+// ToLower converts the upper case ASCII letters in l to lower case in place.
+func (l Letters) ToLower() {
+	b := LettersToBytes(l)
+	i := 0
+	for ; i+8 <= len(b); i += 8 {
+		x := binary.LittleEndian.Uint64(b[i:])
+		binary.LittleEndian.PutUint64(b[i:], x|(inRange(x, 'A', 'Z')>>2))
+	}
+	for ; i < len(b); i++ {
+		if 'A' <= b[i] && b[i] <= 'Z' {
+			b[i] |= 'a' - 'A'
+		}
+	}
+}
+
+// Complement complements the letters of l in place using the complement table of c.
+// Letters without a valid complement in c are left unaltered.
+func (l Letters) Complement(c Complementor) {
+	var t [256]Letter
+	for i, v := range c.ComplementTable() {
+		if v&(unicode.MaxASCII+1) != 0 {
+			v = Letter(i)
+		}
+		t[i] = v
+	}
+	i := 0
+	for ; i+8 <= len(l); i += 8 {
+		s := l[i : i+8 : i+8]
+		s[0], s[1], s[2], s[3] = t[s[0]], t[s[1]], t[s[2]], t[s[3]]
+		s[4], s[5], s[6], s[7] = t[s[4]], t[s[5]], t[s[6]], t[s[7]]
+	}
+	for ; i < len(l); i++ {
+		l[i] = t[l[i]]
+	}
+}
+
+// Counts returns the number of occurrences of each letter in l, indexed by letter.
+func (l Letters) Counts() [256]int {
+	// Four interleaved tables avoid stalls
+	// on runs of the same letter.
+	var c [4][256]int
+	i := 0
+	for ; i+4 <= len(l); i += 4 {
+		s := l[i : i+4 : i+4]
+		c[0][s[0]]++
+		c[1][s[1]]++
+		c[2][s[2]]++
+		c[3][s[3]]++
+	}
+	for ; i < len(l); i++ {
+		c[0][l[i]]++
+	}
+	for j := range c[0] {
+		c[0][j] += c[1][j] + c[2][j] + c[3][j]
+	}
+	return c[0]
+}
+
+// CopyBytes copies the bytes of src into dst as letters, returning the number copied.
+// Unlike BytesToLetters, the letters do not share memory with src.
+func CopyBytes(dst []Letter, src []byte) int { return copy(LettersToBytes(dst), src) }
+
+// CopyLetters copies the letters of src into dst as bytes, returning the number copied.
+// Unlike LettersToBytes, the bytes do not share memory with src.
+func CopyLetters(dst []byte, src []Letter) int { return copy(dst, LettersToBytes(src)) }
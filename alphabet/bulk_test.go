@@ -0,0 +1,97 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func randLetters(rnd *rand.Rand, n int) Letters {
+	l := make(Letters, n)
+	for i := range l {
+		l[i] = Letter(rnd.Intn(256))
+	}
+	return l
+}
+
+func (s *S) TestBulk(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		l := randLetters(rnd, rnd.Intn(100))
+
+		u := append(Letters(nil), l...)
+		u.ToUpper()
+		lo := append(Letters(nil), l...)
+		lo.ToLower()
+		for j, v := range l {
+			want := v
+			if 'a' <= v && v <= 'z' {
+				want = v - 'a' + 'A'
+			}
+			c.Check(u[j], check.Equals, want, check.Commentf("Test %d position %d", i, j))
+			want = v
+			if 'A' <= v && v <= 'Z' {
+				want = v - 'A' + 'a'
+			}
+			c.Check(lo[j], check.Equals, want, check.Commentf("Test %d position %d", i, j))
+		}
+
+		comp := append(Letters(nil), l...)
+		comp.Complement(DNA)
+		t := DNA.ComplementTable()
+		for j, v := range l {
+			want := v
+			if t[v] < 128 {
+				want = t[v]
+			}
+			c.Check(comp[j], check.Equals, want, check.Commentf("Test %d position %d", i, j))
+		}
+
+		var want [256]int
+		for _, v := range l {
+			want[v]++
+		}
+		c.Check(l.Counts(), check.Equals, want, check.Commentf("Test %d", i))
+
+		b := make([]byte, len(l))
+		c.Check(CopyLetters(b, l), check.Equals, len(l))
+		c.Check(bytes.Equal(b, LettersToBytes(l)), check.Equals, true)
+		nl := make(Letters, len(b))
+		c.Check(CopyBytes(nl, b), check.Equals, len(b))
+		c.Check(nl, check.DeepEquals, l)
+	}
+
+	l := Letters("acgtNNacgtRYmk")
+	l.Complement(DNAredundant)
+	c.Check(l.String(), check.Equals, "tgcaNNtgcaYRkm")
+}
+
+func BenchmarkToUpper(b *testing.B) {
+	l := randLetters(rand.New(rand.NewSource(1)), 1<<16)
+	b.SetBytes(int64(len(l)))
+	for i := 0; i < b.N; i++ {
+		l.ToUpper()
+	}
+}
+
+func BenchmarkComplement(b *testing.B) {
+	l := randLetters(rand.New(rand.NewSource(1)), 1<<16)
+	b.SetBytes(int64(len(l)))
+	for i := 0; i < b.N; i++ {
+		l.Complement(DNA)
+	}
+}
+
+func BenchmarkCounts(b *testing.B) {
+	l := randLetters(rand.New(rand.NewSource(1)), 1<<16)
+	b.SetBytes(int64(len(l)))
+	for i := 0; i < b.N; i++ {
+		l.Counts()
+	}
+}
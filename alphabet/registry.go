@@ -0,0 +1,95 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	registryMu sync.Mutex
+	registry   []namedAlphabet
+)
+
+type namedAlphabet struct {
+	name string
+	a    Alphabet
+}
+
+func init() {
+	for _, a := range []namedAlphabet{
+		{"DNA", DNA},
+		{"RNA", RNA},
+		{"DNAgapped", DNAgapped},
+		{"RNAgapped", RNAgapped},
+		{"DNAredundant", DNAredundant},
+		{"RNAredundant", RNAredundant},
+		{"Protein", Protein},
+	} {
+		Register(a.name, a.a)
+	}
+}
+
+// Register registers the Alphabet a with the given name for use by Lookup and
+// Detect. Names are not case sensitive. The alphabets provided by this package
+// are registered with the names of their variables, in order of increasing
+// alphabet size, so third-party packages may register alphabets that are
+// preferred by Detect to Protein but not to the nucleic acid alphabets.
+// Register panics if a is nil, or if an alphabet with the same name is already
+// registered.
+func Register(name string, a Alphabet) {
+	if a == nil {
+		panic("alphabet: register of nil alphabet")
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for _, r := range registry {
+		if strings.EqualFold(r.name, name) {
+			panic("alphabet: alphabet " + name + " registered twice")
+		}
+	}
+	registry = append(registry, namedAlphabet{name: name, a: a})
+}
+
+// Registered returns the names of the registered alphabets in the order they
+// were registered.
+func Registered() []string {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	names := make([]string, len(registry))
+	for i, r := range registry {
+		names[i] = r.name
+	}
+	return names
+}
+
+// Lookup returns the registered Alphabet with the given name, or nil if no
+// alphabet has been registered with the name.
+func Lookup(name string) Alphabet {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for _, r := range registry {
+		if strings.EqualFold(r.name, name) {
+			return r.a
+		}
+	}
+	return nil
+}
+
+// Detect returns the name of the first registered Alphabet for which all the
+// letters in s are valid, and the alphabet. If no registered alphabet is valid
+// for s, Detect returns an empty name and a nil Alphabet.
+func Detect(s []Letter) (string, Alphabet) {
+	registryMu.Lock()
+	rs := registry
+	registryMu.Unlock()
+	for _, r := range rs {
+		if ok, _ := r.a.AllValid(s); ok {
+			return r.name, r.a
+		}
+	}
+	return "", nil
+}
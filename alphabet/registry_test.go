@@ -0,0 +1,41 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestRegistry(c *check.C) {
+	c.Check(Lookup("dna"), check.Equals, DNA)
+	c.Check(Lookup("Protein"), check.Equals, Protein)
+	c.Check(Lookup("morse"), check.Equals, nil)
+
+	for i, t := range []struct {
+		seq  string
+		name string
+	}{
+		{"ACGTacgt", "DNA"},
+		{"ACGU", "RNA"},
+		{"AC-GT", "DNAgapped"},
+		{"ACGTNNRY", "DNAredundant"},
+		{"MKVLAAGIW*", "Protein"},
+		{"Hello, world", ""},
+	} {
+		name, a := Detect(BytesToLetters([]byte(t.seq)))
+		c.Check(name, check.Equals, t.name, check.Commentf("Test %d", i))
+		c.Check(a, check.Equals, Lookup(t.name), check.Commentf("Test %d", i))
+	}
+
+	morse := Must(NewAlphabet(".- ", feat.Undefined, ' ', '.', CaseSensitive))
+	Register("Morse", morse)
+	c.Check(Lookup("morse"), check.Equals, morse)
+	name, _ := Detect(BytesToLetters([]byte("... --- ...")))
+	c.Check(name, check.Equals, "Morse")
+	c.Check(Registered()[len(Registered())-1], check.Equals, "Morse")
+	c.Check(func() { Register("DNA", morse) }, check.PanicMatches, `alphabet: alphabet DNA registered twice`)
+}
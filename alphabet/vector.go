@@ -0,0 +1,238 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+// Counts holds counts of the letters of an alphabet. Counts are stored in the
+// index order of the alphabet so letters of either case in a case insensitive
+// alphabet are counted together. Letters that are not in the alphabet are
+// counted separately.
+type Counts struct {
+	alpha Alphabet
+	index Index
+	n     []int
+	other int
+}
+
+// NewCounts returns a new, empty Counts for the alphabet a.
+func NewCounts(a Alphabet) *Counts {
+	return &Counts{alpha: a, index: a.LetterIndex(), n: make([]int, a.Len())}
+}
+
+// Alphabet returns the alphabet of c.
+func (c *Counts) Alphabet() Alphabet { return c.alpha }
+
+// Add adds one to the count of each of the letters in l.
+func (c *Counts) Add(l ...Letter) {
+	for _, v := range l {
+		if i := c.index[v]; i >= 0 {
+			c.n[i]++
+		} else {
+			c.other++
+		}
+	}
+}
+
+// AddN adds n to the count of l. It returns false if l is not in the
+// alphabet, in which case n is added to the count of other letters.
+func (c *Counts) AddN(l Letter, n int) bool {
+	i := c.index[l]
+	if i < 0 {
+		c.other += n
+		return false
+	}
+	c.n[i] += n
+	return true
+}
+
+// Count returns the count of l, or zero if l is not in the alphabet.
+func (c *Counts) Count(l Letter) int {
+	if i := c.index[l]; i >= 0 {
+		return c.n[i]
+	}
+	return 0
+}
+
+// Other returns the number of letters added that were not in the alphabet.
+func (c *Counts) Other() int { return c.other }
+
+// Total returns the sum of the counts of the letters in the alphabet.
+func (c *Counts) Total() int {
+	var t int
+	for _, v := range c.n {
+		t += v
+	}
+	return t
+}
+
+// Vector returns the counts in alphabet index order. The returned slice is
+// shared with c.
+func (c *Counts) Vector() []int { return c.n }
+
+// Reset sets all counts to zero.
+func (c *Counts) Reset() {
+	for i := range c.n {
+		c.n[i] = 0
+	}
+	c.other = 0
+}
+
+// Freqs returns the relative frequencies of the counts of c with pseudo added
+// to the count of each letter. If the adjusted counts sum to zero, the
+// returned frequencies are uniform.
+func (c *Counts) Freqs(pseudo float64) *Freqs {
+	f := NewFreqs(c.alpha)
+	for i, v := range c.n {
+		f.f[i] = float64(v) + pseudo
+	}
+	f.Normalize()
+	return f
+}
+
+// Freqs holds a frequency or weight for each letter of an alphabet.
+type Freqs struct {
+	alpha Alphabet
+	index Index
+	f     []float64
+}
+
+// NewFreqs returns a new Freqs for the alphabet a with all values zero.
+func NewFreqs(a Alphabet) *Freqs {
+	return &Freqs{alpha: a, index: a.LetterIndex(), f: make([]float64, a.Len())}
+}
+
+// Alphabet returns the alphabet of f.
+func (f *Freqs) Alphabet() Alphabet { return f.alpha }
+
+// Freq returns the value for l, or zero if l is not in the alphabet.
+func (f *Freqs) Freq(l Letter) float64 {
+	if i := f.index[l]; i >= 0 {
+		return f.f[i]
+	}
+	return 0
+}
+
+// Set sets the value for l to v. It returns false if l is not in the alphabet.
+func (f *Freqs) Set(l Letter, v float64) bool {
+	i := f.index[l]
+	if i < 0 {
+		return false
+	}
+	f.f[i] = v
+	return true
+}
+
+// Vector returns the values in alphabet index order. The returned slice is
+// shared with f.
+func (f *Freqs) Vector() []float64 { return f.f }
+
+// Normalize scales the values of f to sum to one. If the values sum to zero,
+// they are set to be uniform.
+func (f *Freqs) Normalize() { normalize(f.f) }
+
+// Clone returns a copy of f.
+func (f *Freqs) Clone() *Freqs {
+	c := *f
+	c.f = append([]float64(nil), f.f...)
+	return &c
+}
+
+// Transitions holds a value for each ordered pair of letters of an alphabet,
+// such as the transition counts or probabilities of a first order Markov
+// chain.
+type Transitions struct {
+	alpha Alphabet
+	index Index
+	t     [][]float64
+}
+
+// NewTransitions returns a new Transitions for the alphabet a with all values
+// zero.
+func NewTransitions(a Alphabet) *Transitions {
+	n := a.Len()
+	arr := make([]float64, n*n)
+	t := make([][]float64, n)
+	for i := range t {
+		t[i] = arr[i*n : (i+1)*n]
+	}
+	return &Transitions{alpha: a, index: a.LetterIndex(), t: t}
+}
+
+// Alphabet returns the alphabet of t.
+func (t *Transitions) Alphabet() Alphabet { return t.alpha }
+
+// Add adds one to the value of each pair of adjacent letters in l. Pairs
+// including a letter that is not in the alphabet are ignored.
+func (t *Transitions) Add(l ...Letter) {
+	last := -1
+	for _, v := range l {
+		i := t.index[v]
+		if last >= 0 && i >= 0 {
+			t.t[last][i]++
+		}
+		last = i
+	}
+}
+
+// Value returns the value for the transition from a to b, or zero if either
+// letter is not in the alphabet.
+func (t *Transitions) Value(a, b Letter) float64 {
+	i, j := t.index[a], t.index[b]
+	if i < 0 || j < 0 {
+		return 0
+	}
+	return t.t[i][j]
+}
+
+// Set sets the value for the transition from a to b to v. It returns false if
+// either letter is not in the alphabet.
+func (t *Transitions) Set(a, b Letter, v float64) bool {
+	i, j := t.index[a], t.index[b]
+	if i < 0 || j < 0 {
+		return false
+	}
+	t.t[i][j] = v
+	return true
+}
+
+// Row returns the values of the transitions from a in alphabet index order,
+// or nil if a is not in the alphabet. The returned slice is shared with t.
+func (t *Transitions) Row(a Letter) []float64 {
+	if i := t.index[a]; i >= 0 {
+		return t.t[i]
+	}
+	return nil
+}
+
+// Matrix returns the values of t indexed by the alphabet indexes of the
+// letters of each transition. The returned slices are shared with t.
+func (t *Transitions) Matrix() [][]float64 { return t.t }
+
+// Normalize adds pseudo to each value of t and scales each row to sum to one,
+// giving the transition probabilities from each letter. Rows summing to zero
+// are set to be uniform.
+func (t *Transitions) Normalize(pseudo float64) {
+	for _, r := range t.t {
+		for j := range r {
+			r[j] += pseudo
+		}
+		normalize(r)
+	}
+}
+
+func normalize(f []float64) {
+	var sum float64
+	for _, v := range f {
+		sum += v
+	}
+	if sum == 0 {
+		for i := range f {
+			f[i] = 1 / float64(len(f))
+		}
+		return
+	}
+	for i := range f {
+		f[i] /= sum
+	}
+}
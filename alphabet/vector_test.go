@@ -0,0 +1,69 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alphabet
+
+import (
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCounts(c *check.C) {
+	n := NewCounts(DNA)
+	n.Add(Letters("ACGTacgtAAx-")...)
+	c.Check(n.Count('A'), check.Equals, 4)
+	c.Check(n.Count('a'), check.Equals, 4)
+	c.Check(n.Count('x'), check.Equals, 0)
+	c.Check(n.Other(), check.Equals, 2)
+	c.Check(n.Total(), check.Equals, 10)
+	c.Check(n.AddN('G', 2), check.Equals, true)
+	c.Check(n.AddN('-', 2), check.Equals, false)
+	c.Check(n.Other(), check.Equals, 4)
+
+	f := n.Freqs(0)
+	c.Check(f.Freq('A'), check.Equals, 4./12)
+	c.Check(f.Freq('g'), check.Equals, 4./12)
+	f = n.Freqs(1)
+	c.Check(f.Freq('g'), check.Equals, 5./16)
+
+	n.Reset()
+	c.Check(n.Total(), check.Equals, 0)
+	c.Check(n.Other(), check.Equals, 0)
+	c.Check(n.Freqs(0).Freq('G'), check.Equals, 1./4)
+}
+
+func (s *S) TestFreqs(c *check.C) {
+	f := NewFreqs(DNA)
+	c.Check(f.Set('a', 2), check.Equals, true)
+	c.Check(f.Set('x', 2), check.Equals, false)
+	c.Check(f.Set('T', 6), check.Equals, true)
+	g := f.Clone()
+	f.Normalize()
+	c.Check(f.Freq('A'), check.Equals, 0.25)
+	c.Check(f.Freq('t'), check.Equals, 0.75)
+	c.Check(g.Freq('A'), check.Equals, 2.)
+	c.Check(f.Vector(), check.HasLen, DNA.Len())
+}
+
+func (s *S) TestTransitions(c *check.C) {
+	t := NewTransitions(DNA)
+	t.Add(Letters("AACxAG")...)
+	c.Check(t.Value('A', 'A'), check.Equals, 1.)
+	c.Check(t.Value('A', 'C'), check.Equals, 1.)
+	c.Check(t.Value('C', 'A'), check.Equals, 0.)
+	c.Check(t.Value('a', 'g'), check.Equals, 1.)
+	c.Check(t.Value('x', 'A'), check.Equals, 0.)
+	c.Check(t.Set('G', 'x', 1), check.Equals, false)
+	c.Check(t.Row('x'), check.IsNil)
+
+	t.Normalize(0)
+	c.Check(t.Value('A', 'G'), check.Equals, 1./3)
+	for _, r := range t.Matrix() {
+		var sum float64
+		for _, v := range r {
+			sum += v
+		}
+		c.Check(sum, check.Equals, 1.)
+	}
+	c.Check(t.Row('T')[0], check.Equals, 1./4)
+}
@@ -0,0 +1,277 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cdna provides preprocessing of full-length long-read cDNA sequences.
+//
+// Reads from template-switching cDNA protocols have the structure
+//
+//	5'-TSO-transcript-poly(A)-3'
+//
+// in the sense orientation, and the reverse complement of that structure in
+// the antisense orientation. The template switch oligo (TSO) and the poly(A)
+// tail are located near the ends of the read, the read orientation is inferred
+// from them, and the read is re-oriented to the sense strand and trimmed of
+// both artifacts.
+package cdna
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/sequtils"
+
+	"errors"
+)
+
+var (
+	ErrBadAlphabet = errors.New("cdna: alphabet is not complementary")
+	ErrNoTSO       = errors.New("cdna: empty template switch oligo")
+)
+
+// SMARTerTSO is the template switch oligo of the SMARTer protocol. The terminal
+// riboguanosines are given as G.
+const SMARTerTSO = "AAGCAGTGGTATCAACGCAGAGTACATGGG"
+
+// Params describes the parameters used to detect cDNA artifacts.
+type Params struct {
+	// TSO is the template switch oligo
+	// sequence in the sense orientation.
+	TSO string
+
+	// TSOSearch is the length of each read end
+	// searched for the TSO, and MaxTSODiff
+	// is the maximum number of edits allowed
+	// in a TSO match.
+	TSOSearch  int
+	MaxTSODiff int
+
+	// TailSearch is the length of each read end
+	// searched for a poly(A) or poly(T) tail.
+	TailSearch int
+
+	// MinTail is the minimum number of A or T
+	// letters in a tail.
+	MinTail int
+
+	// TailMismatch is the penalty applied to each
+	// letter of a tail that is not an A or T when
+	// finding the highest scoring tail segment.
+	// Each A or T scores 1.
+	TailMismatch int
+}
+
+// DefaultParams are suitable for SMARTer full-length cDNA reads.
+var DefaultParams = Params{
+	TSO:          SMARTerTSO,
+	TSOSearch:    150,
+	MaxTSODiff:   5,
+	TailSearch:   150,
+	MinTail:      10,
+	TailMismatch: 4,
+}
+
+// A Result describes the artifacts found in a read.
+type Result struct {
+	// Orient is feat.Forward if the read was found to
+	// be in the sense orientation, feat.Reverse if it
+	// was found to be antisense and feat.NotOriented
+	// if no artifacts were found or the evidence
+	// was conflicting.
+	Orient feat.Orientation
+
+	// TSO and Tail indicate whether a TSO and
+	// a poly(A) tail were found.
+	TSO, Tail bool
+
+	// Start and End are the bounds of the transcript
+	// sequence in the sense oriented read, relative
+	// to the read start.
+	Start, End int
+}
+
+// Analyze returns the artifacts found in s. s is not altered.
+func Analyze(s seq.Sequence, p Params) (Result, error) {
+	if p.TSO == "" {
+		return Result{}, ErrNoTSO
+	}
+	b := make([]byte, s.Len())
+	for i := range b {
+		b[i] = byte(s.At(s.Start() + i).L)
+	}
+	return analyze(b, p), nil
+}
+
+// Trim re-orients s to the sense strand, if it is found to be antisense, and
+// truncates it to the transcript sequence. The alphabet of s must be an
+// alphabet.Complementor.
+func Trim(s seq.Sequence, p Params) (Result, error) {
+	if _, ok := s.Alphabet().(alphabet.Complementor); !ok {
+		return Result{}, ErrBadAlphabet
+	}
+	r, err := Analyze(s, p)
+	if err != nil {
+		return r, err
+	}
+	if r.Orient == feat.Reverse {
+		s.RevComp()
+	}
+	return r, sequtils.Truncate(s, s, s.Start()+r.Start, s.Start()+r.End)
+}
+
+func analyze(b []byte, p Params) Result {
+	tso := []byte(p.TSO)
+	rc := revComp(b)
+
+	// Evidence for each orientation.
+	fTSO, fTSOEnd := findTSO(tso, head(b, p.TSOSearch), p.MaxTSODiff)
+	fTail, fTailStart := findTail(b, p)
+	rTSO, rTSOEnd := findTSO(tso, head(rc, p.TSOSearch), p.MaxTSODiff)
+	rTail, rTailStart := findTail(rc, p)
+
+	var fwd, rev int
+	if fTSO {
+		fwd++
+	}
+	if fTail {
+		fwd++
+	}
+	if rTSO {
+		rev++
+	}
+	if rTail {
+		rev++
+	}
+
+	r := Result{Start: 0, End: len(b)}
+	switch {
+	case fwd > rev:
+		r.Orient = feat.Forward
+		r.TSO, r.Tail = fTSO, fTail
+		if fTSO {
+			r.Start = fTSOEnd
+		}
+		if fTail {
+			r.End = fTailStart
+		}
+	case rev > fwd:
+		r.Orient = feat.Reverse
+		r.TSO, r.Tail = rTSO, rTail
+		if rTSO {
+			r.Start = rTSOEnd
+		}
+		if rTail {
+			r.End = rTailStart
+		}
+	}
+	if r.End < r.Start {
+		r.End = r.Start
+	}
+	return r
+}
+
+func head(b []byte, n int) []byte {
+	if n > len(b) {
+		n = len(b)
+	}
+	return b[:n]
+}
+
+func upper(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	return c
+}
+
+func revComp(b []byte) []byte {
+	rc := make([]byte, len(b))
+	for i, c := range b {
+		switch upper(c) {
+		case 'A':
+			c = 'T'
+		case 'C':
+			c = 'G'
+		case 'G':
+			c = 'C'
+		case 'T', 'U':
+			c = 'A'
+		default:
+			c = 'N'
+		}
+		rc[len(b)-1-i] = c
+	}
+	return rc
+}
+
+// findTSO returns whether the oligo is found in text with at most maxDiff edits
+// and the end position of the best match. The match is found by semi-global
+// edit distance.
+func findTSO(tso, text []byte, maxDiff int) (bool, int) {
+	col := make([]int, len(tso)+1)
+	for i := range col {
+		col[i] = i
+	}
+	best, end := maxDiff+1, 0
+	for j, c := range text {
+		diag := col[0]
+		for i := 1; i <= len(tso); i++ {
+			d := diag
+			if upper(tso[i-1]) != upper(c) {
+				d++
+			}
+			d = min3(d, col[i]+1, col[i-1]+1)
+			diag, col[i] = col[i], d
+		}
+		if col[len(tso)] < best {
+			best, end = col[len(tso)], j+1
+		}
+	}
+	return best <= maxDiff, end
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// findTail returns whether a poly(A) tail is found within the last p.TailSearch
+// letters of b and the start position of the tail.
+func findTail(b []byte, p Params) (bool, int) {
+	from := len(b) - p.TailSearch
+	if from < 0 {
+		from = 0
+	}
+	var (
+		score, best      int
+		start, bestStart int
+		bestEnd          int
+	)
+	start = from
+	for i := from; i < len(b); i++ {
+		if upper(b[i]) == 'A' {
+			score++
+		} else {
+			score -= p.TailMismatch
+		}
+		if score <= 0 {
+			score, start = 0, i+1
+			continue
+		}
+		if score > best {
+			best, bestStart, bestEnd = score, start, i+1
+		}
+	}
+	var n int
+	for _, c := range b[bestStart:bestEnd] {
+		if upper(c) == 'A' {
+			n++
+		}
+	}
+	return best > 0 && n >= p.MinTail, bestStart
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cdna
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const transcript = "ACGTTGCAGCTAGCTAGGCTTACGATCGATCGGCTAGCTAGCATCGACTGACTGCATGCTAGC"
+
+func rc(s string) string { return string(revComp([]byte(s))) }
+
+func (s *S) TestTrim(c *check.C) {
+	sense := SMARTerTSO + transcript + strings.Repeat("A", 30)
+	mutated := []byte(sense)
+	mutated[5] = 'T'
+	mutated[len(SMARTerTSO)+len(transcript)+10] = 'C'
+
+	for i, t := range []struct {
+		read   string
+		orient feat.Orientation
+		tso    bool
+		tail   bool
+		expect string
+	}{
+		{read: sense, orient: feat.Forward, tso: true, tail: true, expect: transcript},
+		{read: rc(sense), orient: feat.Reverse, tso: true, tail: true, expect: transcript},
+		{read: string(mutated), orient: feat.Forward, tso: true, tail: true, expect: transcript},
+		{read: SMARTerTSO + transcript, orient: feat.Forward, tso: true, expect: transcript},
+		{read: rc(transcript + strings.Repeat("a", 20)), orient: feat.Reverse, tail: true, expect: transcript},
+		{read: transcript, orient: feat.NotOriented, expect: transcript},
+		{read: SMARTerTSO + transcript + rc(SMARTerTSO), orient: feat.NotOriented, expect: SMARTerTSO + transcript + rc(SMARTerTSO)},
+	} {
+		sq := linear.NewSeq("read", alphabet.BytesToLetters([]byte(t.read)), alphabet.DNA)
+		r, err := Trim(sq, DefaultParams)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(r.Orient, check.Equals, t.orient, check.Commentf("Test %d", i))
+		c.Check(r.TSO, check.Equals, t.tso, check.Commentf("Test %d", i))
+		c.Check(r.Tail, check.Equals, t.tail, check.Commentf("Test %d", i))
+		c.Check(strings.ToUpper(sq.Seq.String()), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestAnalyze(c *check.C) {
+	read := SMARTerTSO + transcript + strings.Repeat("A", 30)
+	sq := linear.NewSeq("read", alphabet.BytesToLetters([]byte(read)), alphabet.DNA)
+	r, err := Analyze(sq, DefaultParams)
+	c.Assert(err, check.Equals, nil)
+	c.Check(r, check.Equals, Result{
+		Orient: feat.Forward,
+		TSO:    true,
+		Tail:   true,
+		Start:  len(SMARTerTSO),
+		End:    len(SMARTerTSO) + len(transcript),
+	})
+	c.Check(sq.Seq.String(), check.Equals, read)
+
+	p := DefaultParams
+	p.TSO = ""
+	_, err = Analyze(sq, p)
+	c.Check(err, check.Equals, ErrNoTSO)
+
+	_, err = Trim(linear.NewSeq("prot", alphabet.BytesToLetters([]byte("MKV")), alphabet.Protein), DefaultParams)
+	c.Check(err, check.Equals, ErrBadAlphabet)
+}
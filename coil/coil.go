@@ -0,0 +1,232 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package coil provides heptad repeat based coiled-coil prediction for protein
+// sequences.
+//
+// The method follows COILS (Lupas, Van Dyke and Stock, Science 252:1162-1164,
+// 1991). Each window of a sequence is scored in each of the seven heptad
+// registers as the geometric mean of the relative frequencies of its residues
+// at their heptad positions, and each residue is given the best score of the
+// windows containing it. Scores are converted to probabilities by comparison of
+// Gaussian models of the scores of coiled-coil and globular residues.
+package coil
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"math"
+)
+
+var (
+	ErrNotProtein    = errors.New("coil: sequence is not protein")
+	ErrShortSequence = errors.New("coil: sequence shorter than window")
+	ErrBadWindow     = errors.New("coil: invalid window length")
+)
+
+var _ feat.Feature = (*Segment)(nil)
+
+// Heptad positions are labeled a to g.
+const heptad = "abcdefg"
+
+// Gaussian is a normal distribution of residue scores.
+type Gaussian struct {
+	Mean, SD float64
+}
+
+func (g Gaussian) density(x float64) float64 {
+	z := (x - g.Mean) / g.SD
+	return math.Exp(-z*z/2) / g.SD
+}
+
+// Profile is a coiled-coil scoring profile.
+type Profile struct {
+	// Weights holds the relative frequency of each
+	// upper case residue at heptad positions a to g.
+	// Residues not present have a weight of 1.
+	Weights map[byte][7]float64
+
+	// Window is the scoring window length. It
+	// should be a multiple of the heptad length.
+	Window int
+
+	// Coil and Globular are the distributions
+	// of scores of coiled-coil and globular
+	// residues, and Ratio is the prior ratio of
+	// globular to coiled-coil residues.
+	Coil, Globular Gaussian
+	Ratio          float64
+}
+
+// DefaultProfile is a simplified heptad profile reflecting the preference for
+// hydrophobic residues at the core positions a and d, for charged residues
+// forming interhelical salt bridges at e and g, and for polar residues at the
+// exposed positions b, c and f. It is not the COILS MTK matrix and its score
+// distributions were calibrated on synthetic sequences; the published tables
+// and their fitted distributions may be used by constructing a Profile.
+var DefaultProfile = Profile{
+	Weights: map[byte][7]float64{
+		//    a    b    c    d    e    f    g
+		'A': {1.0, 1.2, 1.2, 1.2, 1.2, 1.1, 1.0},
+		'C': {0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5},
+		'D': {0.4, 1.2, 1.2, 0.3, 1.0, 1.2, 0.9},
+		'E': {0.4, 1.6, 1.6, 0.5, 2.5, 1.6, 2.0},
+		'F': {1.0, 0.5, 0.5, 1.0, 0.6, 0.5, 0.6},
+		'G': {0.3, 0.5, 0.5, 0.3, 0.4, 0.6, 0.4},
+		'H': {0.5, 0.8, 0.8, 0.5, 0.8, 0.8, 0.8},
+		'I': {2.8, 0.5, 0.5, 1.5, 0.6, 0.5, 0.6},
+		'K': {0.4, 1.6, 1.6, 0.3, 1.5, 1.6, 2.0},
+		'L': {2.0, 0.5, 0.5, 3.5, 0.6, 0.5, 0.7},
+		'M': {2.0, 0.6, 0.6, 1.8, 0.6, 0.6, 0.7},
+		'N': {1.3, 1.0, 1.0, 0.4, 0.8, 1.0, 0.8},
+		'P': {0.05, 0.1, 0.1, 0.05, 0.05, 0.1, 0.05},
+		'Q': {0.6, 1.4, 1.4, 0.7, 1.5, 1.4, 1.5},
+		'R': {0.4, 1.4, 1.4, 0.4, 1.3, 1.4, 1.5},
+		'S': {0.5, 1.1, 1.1, 0.5, 0.9, 1.1, 1.0},
+		'T': {0.6, 1.0, 1.0, 0.6, 0.8, 1.0, 0.8},
+		'V': {2.4, 0.5, 0.5, 1.0, 0.6, 0.5, 0.6},
+		'W': {0.5, 0.4, 0.4, 0.5, 0.4, 0.4, 0.4},
+		'Y': {1.2, 0.5, 0.5, 1.0, 0.6, 0.5, 0.6},
+	},
+	Window:   28,
+	Coil:     Gaussian{Mean: 1.26, SD: 0.11},
+	Globular: Gaussian{Mean: 0.95, SD: 0.09},
+	Ratio:    30,
+}
+
+// A Prediction is a per-residue coiled-coil prediction.
+type Prediction struct {
+	// Loc is the predicted sequence and Offset
+	// is the position of the first residue.
+	Loc    feat.Feature
+	Offset int
+
+	// Scores holds the best window score of each
+	// residue, Probs the coiled-coil probability
+	// of the score and Registers the heptad position,
+	// 'a' to 'g', of the residue in the best window.
+	Scores    []float64
+	Probs     []float64
+	Registers []byte
+}
+
+// A Segment is a predicted coiled-coil region.
+type Segment struct {
+	Loc      feat.Feature
+	From, To int
+
+	// Probability is the highest residue probability
+	// of the segment and Register is the heptad
+	// register of its first residue.
+	Probability float64
+	Register    byte
+}
+
+func (s *Segment) Start() int             { return s.From }
+func (s *Segment) End() int               { return s.To }
+func (s *Segment) Len() int               { return s.To - s.From }
+func (s *Segment) Name() string           { return s.Loc.Name() }
+func (s *Segment) Description() string    { return "coiled coil" }
+func (s *Segment) Location() feat.Feature { return s.Loc }
+
+// Predict returns the coiled-coil prediction for the protein sequence s using
+// the profile p.
+func Predict(s seq.Sequence, p Profile) (*Prediction, error) {
+	if p.Window < 1 {
+		return nil, ErrBadWindow
+	}
+	if s.Alphabet().Moltype() != feat.Protein {
+		return nil, ErrNotProtein
+	}
+	n := s.Len()
+	if n < p.Window {
+		return nil, ErrShortSequence
+	}
+
+	// sum[f][k] holds the sum of log weights of the
+	// first k residues when residue 0 is at heptad
+	// position f.
+	var sum [7][]float64
+	for f := range sum {
+		sum[f] = make([]float64, n+1)
+	}
+	for k := 0; k < n; k++ {
+		l := upper(s.At(s.Start() + k).L)
+		w, ok := p.Weights[byte(l)]
+		for f := range sum {
+			v := 1.
+			if ok {
+				v = w[(k+f)%7]
+			}
+			sum[f][k+1] = sum[f][k] + math.Log(v)
+		}
+	}
+
+	pred := &Prediction{
+		Loc:       s,
+		Offset:    s.Start(),
+		Scores:    make([]float64, n),
+		Probs:     make([]float64, n),
+		Registers: make([]byte, n),
+	}
+	for i := range pred.Scores {
+		pred.Scores[i] = math.Inf(-1)
+	}
+	for st := 0; st+p.Window <= n; st++ {
+		for f := range sum {
+			score := math.Exp((sum[f][st+p.Window] - sum[f][st]) / float64(p.Window))
+			for i := st; i < st+p.Window; i++ {
+				if score > pred.Scores[i] {
+					pred.Scores[i] = score
+					pred.Registers[i] = heptad[(i+f)%7]
+				}
+			}
+		}
+	}
+	for i, x := range pred.Scores {
+		cc := p.Coil.density(x)
+		pred.Probs[i] = cc / (p.Ratio*p.Globular.density(x) + cc)
+	}
+	return pred, nil
+}
+
+// Segments returns the runs of at least min residues of p with a coiled-coil
+// probability of at least threshold.
+func (p *Prediction) Segments(threshold float64, min int) []*Segment {
+	var segs []*Segment
+	for i := 0; i < len(p.Probs); {
+		if p.Probs[i] < threshold {
+			i++
+			continue
+		}
+		j := i
+		var best float64
+		for ; j < len(p.Probs) && p.Probs[j] >= threshold; j++ {
+			if p.Probs[j] > best {
+				best = p.Probs[j]
+			}
+		}
+		if j-i >= min {
+			segs = append(segs, &Segment{
+				Loc:         p.Loc,
+				From:        p.Offset + i,
+				To:          p.Offset + j,
+				Probability: best,
+				Register:    p.Registers[i],
+			})
+		}
+		i = j
+	}
+	return segs
+}
+
+func upper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l - 'a' + 'A'
+	}
+	return l
+}
@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coil
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestPredict(c *check.C) {
+	// The GCN4 leucine zipper flanked by
+	// non-heptad sequence.
+	const (
+		flank  = "MSTPGNDYWHCFPGSRTPNGWQYDCHPGSTFW"
+		zipper = "RMKQLEDKVEELLSKNYHLENEVARLKKLVGER"
+	)
+	sq := linear.NewSeq("gcn4", alphabet.Letters(flank+zipper+flank), alphabet.Protein)
+	p, err := Predict(sq, DefaultProfile)
+	c.Assert(err, check.Equals, nil)
+	segs := p.Segments(0.5, 21)
+	c.Assert(segs, check.HasLen, 1)
+	// Window scoring extends the segment
+	// a short way into the flanks.
+	c.Check(segs[0].Start() > 24 && segs[0].Start() <= 32, check.Equals, true)
+	c.Check(segs[0].End() >= 65 && segs[0].End() < 73, check.Equals, true)
+	c.Check(segs[0].Probability > 0.99, check.Equals, true)
+
+	// The core Met and Leu residues are at
+	// positions a and d.
+	c.Check(p.Registers[33], check.Equals, byte('a'))
+	c.Check(p.Registers[36], check.Equals, byte('d'))
+	for i := 0; i < 10; i++ {
+		c.Check(p.Probs[i] < 0.1, check.Equals, true, check.Commentf("Residue %d", i))
+	}
+
+	_, err = Predict(linear.NewSeq("short", alphabet.Letters(zipper[:20]), alphabet.Protein), DefaultProfile)
+	c.Check(err, check.Equals, ErrShortSequence)
+	_, err = Predict(linear.NewSeq("dna", alphabet.Letters("acgt"), alphabet.DNA), DefaultProfile)
+	c.Check(err, check.Equals, ErrNotProtein)
+}
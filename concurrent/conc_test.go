@@ -5,7 +5,9 @@
 package concurrent
 
 import (
+	"runtime"
 	"testing"
+	"time"
 
 	"gopkg.in/check.v1"
 )
@@ -18,3 +20,26 @@ type S struct{}
 var _ = check.Suite(&S{})
 
 func (s *S) TestWarning(c *check.C) { c.Log("\nFIXME: Tests only in examples.\n") }
+
+type failer []int
+
+func (f failer) Slice(i, j int) Mapper { return f[i:j] }
+func (f failer) Len() int              { return len(f) }
+func (f failer) Operation() (interface{}, error) {
+	if f[0] == 3 {
+		panic("three")
+	}
+	return f[0], nil
+}
+
+func (s *S) TestMapFailure(c *check.C) {
+	before := runtime.NumGoroutine()
+	for i := 0; i < 20; i++ {
+		_, err := Map(failer{0, 1, 2, 3, 4, 5, 6, 7}, 4, 1)
+		c.Check(err, check.ErrorMatches, "concurrent: map failed: concurrent: processor panic: three")
+	}
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	c.Check(runtime.NumGoroutine() <= before, check.Equals, true)
+}
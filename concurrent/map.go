@@ -23,7 +23,9 @@ type Mapper interface {
 // Chunk size is either the nearest even division of the total array over the chosen concurrent
 // processing goroutines or a specified maximum chunk size, whichever is smaller. Reducing
 // chunk size can reduce the impact of divergence in time for processing chunks, but may add
-// to overhead.
+// to overhead. If an operation returns an error or panics, no further chunks are submitted and
+// the first failure is returned as an error. All goroutines started by Map have completed their
+// work when it returns.
 func Map(set Mapper, threads, maxChunkSize int) (results []interface{}, err error) {
 	queue := make(chan Operator, 1)
 	p := NewProcessor(queue, 0, threads)
@@ -34,23 +36,27 @@ func Map(set Mapper, threads, maxChunkSize int) (results []interface{}, err erro
 	quit := make(chan struct{})
 
 	go func() {
+		defer close(queue)
 		for s := 0; s*chunkSize < set.Len(); s++ {
+			endChunk := util.Min(chunkSize*(s+1), set.Len())
 			select {
 			case <-quit:
-				break
-			default:
-				endChunk := util.Min(chunkSize*(s+1), set.Len())
-				queue <- set.Slice(chunkSize*s, endChunk)
+				return
+			case queue <- set.Slice(chunkSize*s, endChunk):
 			}
 		}
 	}()
 
-	for r := 0; r*chunkSize < set.Len(); r++ {
-		result := <-p.out
+	// Results are read until the processor closes its output
+	// so that no goroutine is left blocked when Map returns.
+	for result := range p.out {
+		if err != nil {
+			continue
+		}
 		if result.Err != nil {
-			err = fmt.Errorf("concurrent: map failed: %v", err)
+			err = fmt.Errorf("concurrent: map failed: %v", result.Err)
 			close(quit)
-			break
+			continue
 		}
 		results = append(results, result.Value)
 	}
@@ -0,0 +1,255 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package consensus provides construction of consensus sequences by applying
+// genotyped variants to a reference sequence, with UCSC chains describing the
+// coordinate changes between the reference and the consensus.
+//
+// There is no VCF reader in this tree; variants are described by the Variant
+// type, which holds the REF, ALT and GT values of a VCF record for a single
+// sample, with the position converted to zero-based.
+package consensus
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/featio/chain"
+	"github.com/biogo/biogo/pedigree"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrRefMismatch = errors.New("consensus: reference allele does not match sequence")
+	ErrBadAllele   = errors.New("consensus: genotype allele out of range")
+	ErrBadHap      = errors.New("consensus: haplotype index out of range")
+)
+
+// A Variant is a genotyped variant of a single sample.
+type Variant struct {
+	Pos  int // Zero-based position of Ref on the reference.
+	Ref  alphabet.Letters
+	Alts []alphabet.Letters
+
+	// Genotype holds the allele indices of the sample, 0
+	// for Ref and i for Alts[i-1]. For phased genotypes the
+	// order of the alleles gives their haplotypes. Missing
+	// alleles leave the reference unchanged.
+	Genotype pedigree.Genotype
+}
+
+// allele returns the letters of allele i of v.
+func (v *Variant) allele(i int) (alphabet.Letters, error) {
+	switch {
+	case i == pedigree.Missing || i == 0:
+		return v.Ref, nil
+	case i < 0 || i > len(v.Alts):
+		return nil, ErrBadAllele
+	}
+	return v.Alts[i-1], nil
+}
+
+// Result holds a consensus sequence and its relationship to the reference.
+type Result struct {
+	Seq *linear.Seq
+
+	// Chain maps reference coordinates to consensus
+	// coordinates. Chain is nil if no reference
+	// positions are retained in the consensus.
+	Chain *chain.Chain
+
+	// Skipped holds the indices of variants that were
+	// not applied because they overlap a variant
+	// already applied.
+	Skipped []int
+}
+
+// Haplotype returns the consensus of ref for haplotype h, 0 or 1, of the
+// variants in vs. Variants are applied in order of position, and a variant that
+// overlaps the reference allele of a previously applied variant is skipped.
+// Variants whose selected allele is the reference allele do not prevent
+// application of overlapping variants, so overlapping indels on different
+// haplotypes are each applied to their own haplotype. For unphased genotypes
+// the haplotype of each allele is taken from genotype order.
+func Haplotype(ref *linear.Seq, vs []Variant, h int) (*Result, error) {
+	if h < 0 || h > 1 {
+		return nil, ErrBadHap
+	}
+	return build(ref, vs, func(v *Variant) (alphabet.Letters, error) {
+		return v.allele(v.Genotype[h])
+	})
+}
+
+// IUPAC returns a single consensus of ref for the variants in vs. Homozygous
+// variants are applied, and heterozygous variants with alleles of equal length
+// are represented by IUPAC ambiguity codes. Other heterozygous variants are
+// applied with the first non-reference allele of the genotype. Overlapping
+// variants are handled as for Haplotype.
+func IUPAC(ref *linear.Seq, vs []Variant) (*Result, error) {
+	return build(ref, vs, func(v *Variant) (alphabet.Letters, error) {
+		a, err := v.allele(v.Genotype[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := v.allele(v.Genotype[1])
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case equal(a, b):
+			return a, nil
+		case len(a) == len(b):
+			amb := make(alphabet.Letters, len(a))
+			for i := range a {
+				amb[i] = ambiguity(a[i], b[i])
+			}
+			return amb, nil
+		case equal(a, v.Ref):
+			return b, nil
+		}
+		return a, nil
+	})
+}
+
+type byPos struct {
+	vs  []Variant
+	idx []int
+}
+
+func (p byPos) Len() int           { return len(p.idx) }
+func (p byPos) Less(i, j int) bool { return p.vs[p.idx[i]].Pos < p.vs[p.idx[j]].Pos }
+func (p byPos) Swap(i, j int)      { p.idx[i], p.idx[j] = p.idx[j], p.idx[i] }
+
+func build(ref *linear.Seq, vs []Variant, choose func(*Variant) (alphabet.Letters, error)) (*Result, error) {
+	idx := make([]int, len(vs))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Stable(byPos{vs: vs, idx: idx})
+
+	var (
+		r      = &Result{}
+		out    alphabet.Letters
+		blocks []chain.Block
+		cur    chain.Block
+		t      int // Next reference position to copy.
+	)
+	for _, i := range idx {
+		v := &vs[i]
+		end := v.Pos + len(v.Ref)
+		if v.Pos < 0 || end > len(ref.Seq) || !equal(ref.Seq[v.Pos:end], v.Ref) {
+			return nil, fmt.Errorf("%v at position %d", ErrRefMismatch, v.Pos)
+		}
+		alt, err := choose(v)
+		if err != nil {
+			return nil, fmt.Errorf("%v at position %d", err, v.Pos)
+		}
+		if equal(alt, v.Ref) {
+			continue
+		}
+		if v.Pos < t {
+			r.Skipped = append(r.Skipped, i)
+			continue
+		}
+
+		out = append(out, ref.Seq[t:v.Pos]...)
+		cur.Size += v.Pos - t
+		out = append(out, alt...)
+		if len(alt) == len(v.Ref) {
+			cur.Size += len(alt)
+		} else {
+			cur.Size += min(len(alt), len(v.Ref))
+			if cur.Size != 0 {
+				blocks = append(blocks, cur)
+			}
+			cur = chain.Block{TStart: end, QStart: len(out)}
+		}
+		t = end
+	}
+	out = append(out, ref.Seq[t:]...)
+	cur.Size += len(ref.Seq) - t
+	if cur.Size != 0 {
+		blocks = append(blocks, cur)
+	}
+	sort.Ints(r.Skipped)
+
+	r.Seq = linear.NewSeq(ref.ID, out, ref.Alpha)
+	r.Seq.Desc = ref.Desc
+	if len(blocks) != 0 {
+		first, last := blocks[0], blocks[len(blocks)-1]
+		c := &chain.Chain{
+			Target:  &chain.Chrom{ChromName: ref.ID, ChromSize: len(ref.Seq)},
+			TStrand: seq.Plus,
+			TStart:  first.TStart,
+			TEnd:    last.TStart + last.Size,
+			Query:   &chain.Chrom{ChromName: r.Seq.ID, ChromSize: len(out)},
+			QStrand: seq.Plus,
+			QStart:  first.QStart,
+			QEnd:    last.QStart + last.Size,
+			ID:      1,
+			Blocks:  blocks,
+		}
+		for _, b := range blocks {
+			c.Score += int64(b.Size)
+		}
+		r.Chain = c
+	}
+	return r, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// equal returns whether a and b hold the same letters, ignoring case.
+func equal(a, b alphabet.Letters) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if upper(a[i]) != upper(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func upper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l - 'a' + 'A'
+	}
+	return l
+}
+
+// iupac maps pairs of nucleotides to their IUPAC ambiguity codes.
+var iupac = map[[2]alphabet.Letter]alphabet.Letter{
+	{'A', 'C'}: 'M',
+	{'A', 'G'}: 'R',
+	{'A', 'T'}: 'W',
+	{'C', 'G'}: 'S',
+	{'C', 'T'}: 'Y',
+	{'G', 'T'}: 'K',
+}
+
+// ambiguity returns the IUPAC ambiguity code for the nucleotides a and b, or N
+// if there is no such code.
+func ambiguity(a, b alphabet.Letter) alphabet.Letter {
+	a, b = upper(a), upper(b)
+	if a == b {
+		return a
+	}
+	if b < a {
+		a, b = b, a
+	}
+	if c, ok := iupac[[2]alphabet.Letter{a, b}]; ok {
+		return c
+	}
+	return 'N'
+}
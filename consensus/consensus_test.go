@@ -0,0 +1,105 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/featio/chain"
+	"github.com/biogo/biogo/pedigree"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func l(s string) alphabet.Letters { return alphabet.BytesToLetters([]byte(s)) }
+
+var (
+	ref = linear.NewSeq("chr1", l("ACGTACGTAC"), alphabet.DNA)
+	vs  = []Variant{
+		{Pos: 1, Ref: l("C"), Alts: []alphabet.Letters{l("T")}, Genotype: pedigree.Genotype{1, 1}},
+		{Pos: 3, Ref: l("T"), Alts: []alphabet.Letters{l("TGG")}, Genotype: pedigree.Genotype{0, 1}},
+		{Pos: 5, Ref: l("CGT"), Alts: []alphabet.Letters{l("C")}, Genotype: pedigree.Genotype{1, 0}},
+		{Pos: 6, Ref: l("G"), Alts: []alphabet.Letters{l("A")}, Genotype: pedigree.Genotype{0, 1}},
+		{Pos: 9, Ref: l("C"), Alts: []alphabet.Letters{l("G")}, Genotype: pedigree.Genotype{0, 1}},
+	}
+)
+
+func (s *S) TestConsensus(c *check.C) {
+	for i, t := range []struct {
+		build   func() (*Result, error)
+		seq     string
+		blocks  []chain.Block
+		skipped []int
+	}{
+		{
+			build:  func() (*Result, error) { return Haplotype(ref, vs, 0) },
+			seq:    "ATGTACAC",
+			blocks: []chain.Block{{TStart: 0, QStart: 0, Size: 6}, {TStart: 8, QStart: 6, Size: 2}},
+		},
+		{
+			build:  func() (*Result, error) { return Haplotype(ref, vs, 1) },
+			seq:    "ATGTGGACATAG",
+			blocks: []chain.Block{{TStart: 0, QStart: 0, Size: 4}, {TStart: 4, QStart: 6, Size: 6}},
+		},
+		{
+			build: func() (*Result, error) { return IUPAC(ref, vs) },
+			seq:   "ATGTGGACAS",
+			blocks: []chain.Block{
+				{TStart: 0, QStart: 0, Size: 4},
+				{TStart: 4, QStart: 6, Size: 2},
+				{TStart: 8, QStart: 8, Size: 2},
+			},
+			skipped: []int{3},
+		},
+	} {
+		r, err := t.build()
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(r.Seq.Seq.String(), check.Equals, t.seq, check.Commentf("Test %d", i))
+		c.Check(r.Chain.Blocks, check.DeepEquals, t.blocks, check.Commentf("Test %d", i))
+		c.Check(r.Skipped, check.DeepEquals, t.skipped, check.Commentf("Test %d", i))
+	}
+
+	r, err := Haplotype(ref, vs, 0)
+	c.Assert(err, check.Equals, nil)
+	var buf bytes.Buffer
+	_, err = chain.NewWriter(&buf).Write(r.Chain)
+	c.Assert(err, check.Equals, nil)
+	c.Check(buf.String(), check.Equals, "chain 8 chr1 10 + 0 10 chr1 8 + 0 8 1\n6\t2\t0\n2\n\n")
+}
+
+func (s *S) TestErrors(c *check.C) {
+	for i, t := range []struct {
+		v   Variant
+		err string
+	}{
+		{
+			v:   Variant{Pos: 0, Ref: l("G"), Alts: []alphabet.Letters{l("T")}, Genotype: pedigree.Genotype{1, 1}},
+			err: "consensus: reference allele does not match sequence at position 0",
+		},
+		{
+			v:   Variant{Pos: 9, Ref: l("CA"), Alts: []alphabet.Letters{l("T")}, Genotype: pedigree.Genotype{1, 1}},
+			err: "consensus: reference allele does not match sequence at position 9",
+		},
+		{
+			v:   Variant{Pos: 0, Ref: l("A"), Alts: []alphabet.Letters{l("T")}, Genotype: pedigree.Genotype{2, 0}},
+			err: "consensus: genotype allele out of range at position 0",
+		},
+	} {
+		_, err := Haplotype(ref, []Variant{t.v}, 0)
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+	_, err := Haplotype(ref, nil, 2)
+	c.Check(err, check.Equals, ErrBadHap)
+}
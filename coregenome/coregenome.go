@@ -0,0 +1,200 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package coregenome provides extraction of the core genome, the regions shared
+// in single copy by all of a set of genomes, from whole genome alignments.
+//
+// A whole genome alignment is given as a set of alignment blocks, as held in MAF
+// or XMFA files, each a multiple alignment of homologous segments. A block is a
+// core block if every genome is represented in it by exactly one segment, and a
+// column of a core block is a core column if no genome has a gap in it. The core
+// columns of all blocks are concatenated, in block order, to give a core
+// alignment suitable for phylogenetic analysis.
+package coregenome
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"errors"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrNoGenomes      = errors.New("coregenome: no genomes")
+	ErrMixedAlphabets = errors.New("coregenome: inconsistent alphabets")
+)
+
+// GenomeName returns the genome of a sequence from its name by taking the text
+// before the first '.', following the MAF convention of naming sequences as
+// genome.chromosome.
+func GenomeName(name string) string {
+	if i := strings.Index(name, "."); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// A Region is a run of core columns from a single alignment block.
+type Region struct {
+	Block      int // Index of the block.
+	Start, End int // Columns of the block.
+	CoreStart  int // Column of the core alignment.
+}
+
+// Result holds an extracted core genome.
+type Result struct {
+	// Genomes holds the genomes in the order of
+	// the rows of Core and of Presence.
+	Genomes []string
+
+	// Core is the core alignment with one row per
+	// genome, named by genome. Core is nil if there
+	// are no core columns.
+	Core *multi.Multi
+
+	// Regions holds the origin of each run of core
+	// columns in the core alignment.
+	Regions []Region
+
+	// Presence holds a mask for each genome giving
+	// whether the genome is present in single copy
+	// in each block.
+	Presence [][]bool
+}
+
+// Extract extracts the core genome of the given genomes from the alignment
+// blocks. The genome of each row is obtained from the row name by calling
+// genome; if genome is nil, GenomeName is used. If genomes is nil, the genomes
+// represented in the blocks are used in sorted order. Rows of blocks must be
+// single sequences and all blocks must share an alphabet.
+func Extract(blocks []*multi.Multi, genomes []string, genome func(string) string) (*Result, error) {
+	if genome == nil {
+		genome = GenomeName
+	}
+	if genomes == nil {
+		seen := make(map[string]bool)
+		for _, b := range blocks {
+			for i := 0; i < b.Rows(); i++ {
+				g := genome(b.Row(i).Name())
+				if !seen[g] {
+					seen[g] = true
+					genomes = append(genomes, g)
+				}
+			}
+		}
+		sort.Strings(genomes)
+	}
+	if len(genomes) == 0 {
+		return nil, ErrNoGenomes
+	}
+	idx := make(map[string]int, len(genomes))
+	for i, g := range genomes {
+		idx[g] = i
+	}
+
+	r := &Result{
+		Genomes:  genomes,
+		Presence: make([][]bool, len(genomes)),
+	}
+	for i := range r.Presence {
+		r.Presence[i] = make([]bool, len(blocks))
+	}
+	var (
+		alpha alphabet.Alphabet
+		core  = make([]alphabet.Letters, len(genomes))
+		rows  = make([]seq.Sequence, len(genomes))
+	)
+	for bi, b := range blocks {
+		if b.Alpha != nil {
+			if alpha != nil && b.Alpha != alpha {
+				return nil, ErrMixedAlphabets
+			}
+			alpha = b.Alpha
+		}
+
+		count := make([]int, len(genomes))
+		for i := range rows {
+			rows[i] = nil
+		}
+		for i := 0; i < b.Rows(); i++ {
+			s := b.Row(i)
+			g, ok := idx[genome(s.Name())]
+			if !ok {
+				continue
+			}
+			count[g]++
+			rows[g] = s
+		}
+		isCore := true
+		for g, n := range count {
+			r.Presence[g][bi] = n == 1
+			isCore = isCore && n == 1
+		}
+		if !isCore {
+			continue
+		}
+
+		run := -1
+		for col := b.Start(); col < b.End(); col++ {
+			if !coreColumn(rows, col) {
+				if run >= 0 {
+					r.addRegion(bi, run, col, len(core[0]))
+					run = -1
+				}
+				continue
+			}
+			if run < 0 {
+				run = col
+			}
+			for g, s := range rows {
+				core[g] = append(core[g], s.At(col).L)
+			}
+		}
+		if run >= 0 {
+			r.addRegion(bi, run, b.End(), len(core[0]))
+		}
+	}
+
+	if len(core[0]) == 0 {
+		return r, nil
+	}
+	seqs := make([]seq.Sequence, len(genomes))
+	for g, l := range core {
+		seqs[g] = linear.NewSeq(genomes[g], l, alpha)
+	}
+	var err error
+	r.Core, err = multi.NewMulti("core", seqs, seq.DefaultConsensus)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// addRegion adds the block columns [start, end) that end at column n of the
+// core alignment.
+func (r *Result) addRegion(block, start, end, n int) {
+	r.Regions = append(r.Regions, Region{
+		Block:     block,
+		Start:     start,
+		End:       end,
+		CoreStart: n - (end - start),
+	})
+}
+
+// coreColumn returns whether every row has a non-gap letter at col.
+func coreColumn(rows []seq.Sequence, col int) bool {
+	for _, s := range rows {
+		if col < s.Start() || col >= s.End() {
+			return false
+		}
+		if s.At(col).L == s.Alphabet().Gap() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coregenome
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func block(c *check.C, rows ...string) *multi.Multi {
+	var s []seq.Sequence
+	for i := 0; i < len(rows); i += 2 {
+		s = append(s, linear.NewSeq(rows[i], alphabet.BytesToLetters([]byte(rows[i+1])), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("", s, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+func (s *S) TestExtract(c *check.C) {
+	blocks := []*multi.Multi{
+		block(c, "A.c1", "ACGT-A", "B.c1", "ATGTTG", "C.c1", "AC-ATA"),
+		block(c, "A.c1", "GGG", "B.c2", "GGG"),
+		block(c, "A.c1", "CC", "A.c2", "CC", "B.c1", "CC", "C.c1", "CC"),
+		block(c, "A.c2", "TT", "B.c1", "TC", "C.c3", "TA", "D.c1", "TG"),
+	}
+	r, err := Extract(blocks, []string{"A", "B", "C"}, nil)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(r.Core, check.NotNil)
+	c.Check(r.Core.Rows(), check.Equals, 3)
+	for i, expect := range []string{"ACTATT", "ATTGTC", "ACAATA"} {
+		row := r.Core.Row(i).(*linear.Seq)
+		c.Check(row.Name(), check.Equals, r.Genomes[i])
+		c.Check(row.Seq.String(), check.Equals, expect)
+	}
+	c.Check(r.Regions, check.DeepEquals, []Region{
+		{Block: 0, Start: 0, End: 2, CoreStart: 0},
+		{Block: 0, Start: 3, End: 4, CoreStart: 2},
+		{Block: 0, Start: 5, End: 6, CoreStart: 3},
+		{Block: 3, Start: 0, End: 2, CoreStart: 4},
+	})
+	c.Check(r.Presence, check.DeepEquals, [][]bool{
+		{true, true, false, true},
+		{true, true, true, true},
+		{true, false, true, true},
+	})
+
+	r, err = Extract(blocks, nil, nil)
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Genomes, check.DeepEquals, []string{"A", "B", "C", "D"})
+	c.Check(r.Core.Len(), check.Equals, 2)
+
+	_, err = Extract(nil, nil, nil)
+	c.Check(err, check.Equals, ErrNoGenomes)
+}
@@ -0,0 +1,353 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package enrich provides differential k-mer analysis between a foreground
+// and a background set of sequences. K-mers are tested for enrichment in the
+// foreground with a one-sided Fisher's exact test, p-values are corrected for
+// multiple testing and enriched k-mers can be assembled into candidate motifs.
+package enrich
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"bytes"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrBadK          = errors.New("enrich: k out of range")
+	ErrNoComplement  = errors.New("enrich: canonical k-mers require a complementing alphabet")
+	ErrNoSeqs        = errors.New("enrich: empty sequence set")
+	ErrBadCorrection = errors.New("enrich: unknown multiple testing correction")
+)
+
+// Correction specifies a multiple testing correction.
+type Correction int
+
+const (
+	// BenjaminiHochberg specifies false discovery rate
+	// adjusted p-values (q-values).
+	BenjaminiHochberg Correction = iota
+	// Bonferroni specifies family-wise error rate
+	// adjusted p-values.
+	Bonferroni
+)
+
+// Params describes the parameters of an enrichment analysis.
+type Params struct {
+	// K is the k-mer length.
+	K int
+
+	// Canonical specifies that k-mers are counted
+	// together with their reverse complements.
+	Canonical bool
+
+	// Presence specifies that the number of sequences
+	// containing each k-mer is tested rather than the
+	// number of occurrences of the k-mer.
+	Presence bool
+
+	// Correction is the multiple testing correction
+	// applied to p-values.
+	Correction Correction
+}
+
+// DefaultParams are the default enrichment analysis parameters.
+var DefaultParams = Params{
+	K:          6,
+	Canonical:  true,
+	Presence:   true,
+	Correction: BenjaminiHochberg,
+}
+
+// A Result is the result of the enrichment test of a k-mer.
+type Result struct {
+	Kmer string
+
+	// Fg and Bg are the counts of the k-mer in the
+	// foreground and background sets, and FgTotal
+	// and BgTotal are the totals the counts are
+	// drawn from, sequences or k-mer positions.
+	Fg, Bg           int
+	FgTotal, BgTotal int
+
+	// LogRatio is the log2 ratio of the foreground
+	// and background proportions, with 0.5 added to
+	// each count.
+	LogRatio float64
+
+	// P is the one-sided Fisher's exact test p-value
+	// for enrichment and Adjusted is P corrected for
+	// multiple testing.
+	P, Adjusted float64
+}
+
+// Kmers tests each k-mer present in the foreground sequences fg for enrichment
+// relative to the background sequences bg. Letters not in alpha are not
+// included in k-mers. The results are returned in order of increasing p-value.
+func Kmers(fg, bg []alphabet.Letters, alpha alphabet.Alphabet, p Params) ([]Result, error) {
+	if p.K < 1 {
+		return nil, ErrBadK
+	}
+	if len(fg) == 0 || len(bg) == 0 {
+		return nil, ErrNoSeqs
+	}
+	if p.Correction != BenjaminiHochberg && p.Correction != Bonferroni {
+		return nil, ErrBadCorrection
+	}
+	var comp alphabet.Complementor
+	if p.Canonical {
+		var ok bool
+		comp, ok = alpha.(alphabet.Complementor)
+		if !ok {
+			return nil, ErrNoComplement
+		}
+	}
+	c := counter{alpha: alpha, comp: comp, k: p.K, presence: p.Presence}
+	fgCounts, fgTotal := c.count(fg)
+	bgCounts, bgTotal := c.count(bg)
+	if fgTotal == 0 || bgTotal == 0 {
+		return nil, ErrNoSeqs
+	}
+
+	rs := make([]Result, 0, len(fgCounts))
+	for kmer, a := range fgCounts {
+		b := bgCounts[kmer]
+		rs = append(rs, Result{
+			Kmer:     kmer,
+			Fg:       a,
+			Bg:       b,
+			FgTotal:  fgTotal,
+			BgTotal:  bgTotal,
+			LogRatio: math.Log2(((float64(a) + 0.5) / float64(fgTotal)) / ((float64(b) + 0.5) / float64(bgTotal))),
+			P:        fisherUpper(a, b, fgTotal, bgTotal),
+		})
+	}
+	sort.Sort(byPKmer(rs))
+
+	// The number of tests includes k-mers that
+	// are only present in the background.
+	m := len(fgCounts)
+	for kmer := range bgCounts {
+		if _, ok := fgCounts[kmer]; !ok {
+			m++
+		}
+	}
+	adjust(rs, m, p.Correction)
+	return rs, nil
+}
+
+// counter counts k-mers.
+type counter struct {
+	alpha    alphabet.Alphabet
+	comp     alphabet.Complementor
+	k        int
+	presence bool
+}
+
+// count returns the counts of the k-mers in seqs and the total number of
+// sequences or k-mer positions counted.
+func (c counter) count(seqs []alphabet.Letters) (map[string]int, int) {
+	index := c.alpha.LetterIndex()
+	counts := make(map[string]int)
+	var total int
+	word := make([]byte, c.k)
+	rc := make([]byte, c.k)
+	for _, s := range seqs {
+		var seen map[string]bool
+		if c.presence {
+			seen = make(map[string]bool)
+			total++
+		}
+		run := 0
+		for i, l := range s {
+			idx := index[l]
+			if idx < 0 {
+				run = 0
+				continue
+			}
+			if run++; run < c.k {
+				continue
+			}
+			for j := range word {
+				word[j] = byte(c.alpha.Letter(index[s[i-c.k+1+j]]))
+			}
+			if !c.alpha.IsCased() {
+				word = bytes.ToUpper(word)
+			}
+			kmer := string(word)
+			if c.comp != nil {
+				if r := c.revComp(rc, word); r < kmer {
+					kmer = r
+				}
+			}
+			if c.presence {
+				if seen[kmer] {
+					continue
+				}
+				seen[kmer] = true
+			} else {
+				total++
+			}
+			counts[kmer]++
+		}
+	}
+	return counts, total
+}
+
+// revComp returns the reverse complement of word using buf as scratch space.
+func (c counter) revComp(buf, word []byte) string {
+	for i, l := range word {
+		cl, _ := c.comp.Complement(alphabet.Letter(l))
+		buf[len(word)-1-i] = byte(cl)
+	}
+	return string(buf)
+}
+
+// fisherUpper returns the one-sided Fisher's exact test p-value for the
+// probability of observing at least a of the a+b occurrences in the first of
+// two groups of sizes n and m.
+func fisherUpper(a, b, n, m int) float64 {
+	k := a + b
+	// P(X = x) for the hypergeometric distribution
+	// of x of the k occurrences falling in n of n+m.
+	lc := lchoose(n+m, k)
+	var p float64
+	hi := k
+	if n < hi {
+		hi = n
+	}
+	for x := a; x <= hi; x++ {
+		if k-x > m {
+			continue
+		}
+		p += math.Exp(lchoose(n, x) + lchoose(m, k-x) - lc)
+	}
+	return math.Min(p, 1)
+}
+
+func lchoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
+
+// adjust sets the adjusted p-values of rs, which are sorted by p-value, for m
+// tests.
+func adjust(rs []Result, m int, c Correction) {
+	switch c {
+	case Bonferroni:
+		for i := range rs {
+			rs[i].Adjusted = math.Min(1, rs[i].P*float64(m))
+		}
+	case BenjaminiHochberg:
+		min := 1.
+		for i := len(rs) - 1; i >= 0; i-- {
+			min = math.Min(min, rs[i].P*float64(m)/float64(i+1))
+			rs[i].Adjusted = min
+		}
+	}
+}
+
+// A Motif is a candidate motif assembled from overlapping enriched k-mers.
+type Motif struct {
+	Consensus string
+	Kmers     []Result
+}
+
+// Assemble greedily assembles the results in rs with adjusted p-values no
+// greater than alpha into candidate motifs. Each motif is seeded with the
+// most significant unused k-mer and extended at either end by k-mers
+// overlapping it by all but one letter. If canonical is true, the reverse
+// complements of k-mers in the DNA alphabet are also considered. Motifs are
+// returned in order of the significance of their seed k-mers.
+func Assemble(rs []Result, alpha float64, canonical bool) []Motif {
+	var pool []Result
+	for _, r := range rs {
+		if r.Adjusted <= alpha {
+			pool = append(pool, r)
+		}
+	}
+	sort.Stable(byP(pool))
+	used := make([]bool, len(pool))
+
+	var ms []Motif
+	for i, seed := range pool {
+		if used[i] {
+			continue
+		}
+		used[i] = true
+		m := Motif{Consensus: seed.Kmer, Kmers: []Result{seed}}
+		for extended := true; extended; {
+			extended = false
+			for j, r := range pool {
+				if used[j] {
+					continue
+				}
+				forms := []string{r.Kmer}
+				if canonical {
+					forms = append(forms, revComp(r.Kmer))
+				}
+				for _, f := range forms {
+					k := len(f)
+					switch {
+					case strings.Contains(m.Consensus, f):
+					case len(m.Consensus) >= k-1 && strings.HasSuffix(m.Consensus, f[:k-1]):
+						m.Consensus += f[k-1:]
+					case len(m.Consensus) >= k-1 && strings.HasPrefix(m.Consensus, f[1:]):
+						m.Consensus = f[:1] + m.Consensus
+					default:
+						continue
+					}
+					used[j] = true
+					m.Kmers = append(m.Kmers, r)
+					extended = true
+					break
+				}
+			}
+		}
+		ms = append(ms, m)
+	}
+	return ms
+}
+
+// revComp returns the reverse complement of the DNA k-mer s.
+func revComp(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i <= j; i, j = i+1, j-1 {
+		b[i], b[j] = comp(b[j]), comp(b[i])
+	}
+	return string(b)
+}
+
+func comp(c byte) byte {
+	l, ok := alphabet.DNA.Complement(alphabet.Letter(c))
+	if !ok {
+		return c
+	}
+	return byte(l)
+}
+
+// byPKmer sorts results by P value and then by k-mer.
+type byPKmer []Result
+
+func (r byPKmer) Len() int { return len(r) }
+func (r byPKmer) Less(i, j int) bool {
+	if r[i].P != r[j].P {
+		return r[i].P < r[j].P
+	}
+	return r[i].Kmer < r[j].Kmer
+}
+func (r byPKmer) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+
+// byP sorts results by P value.
+type byP []Result
+
+func (r byP) Len() int           { return len(r) }
+func (r byP) Less(i, j int) bool { return r[i].P < r[j].P }
+func (r byP) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
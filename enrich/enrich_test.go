@@ -0,0 +1,90 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enrich
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func randSeqs(rnd *rand.Rand, n, l int, insert string) []alphabet.Letters {
+	seqs := make([]alphabet.Letters, n)
+	for i := range seqs {
+		s := make(alphabet.Letters, l)
+		for j := range s {
+			s[j] = alphabet.Letter("ACGT"[rnd.Intn(4)])
+		}
+		if insert != "" {
+			copy(s[rnd.Intn(l-len(insert)+1):], alphabet.Letters(insert))
+		}
+		seqs[i] = s
+	}
+	return seqs
+}
+
+func (s *S) TestFisher(c *check.C) {
+	// Lady tasting tea: 3 of 4 in a group of 4 of 8.
+	c.Check(math.Abs(fisherUpper(3, 1, 4, 4)-17./70) < 1e-12, check.Equals, true)
+	c.Check(math.Abs(fisherUpper(0, 4, 4, 4)-1) < 1e-12, check.Equals, true)
+}
+
+func (s *S) TestEnrichment(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	fg := randSeqs(rnd, 40, 100, "GATTACAGG")
+	bg := randSeqs(rnd, 40, 100, "")
+
+	_, err := Kmers(fg, bg, alphabet.DNA, Params{K: 0})
+	c.Check(err, check.Equals, ErrBadK)
+	_, err = Kmers(fg, nil, alphabet.DNA, DefaultParams)
+	c.Check(err, check.Equals, ErrNoSeqs)
+
+	rs, err := Kmers(fg, bg, alphabet.DNA, DefaultParams)
+	c.Assert(err, check.Equals, nil)
+	var sig []string
+	for _, r := range rs {
+		if r.Adjusted <= 0.01 {
+			sig = append(sig, r.Kmer)
+		}
+		c.Check(r.Adjusted >= r.P, check.Equals, true)
+	}
+	// The four 6-mers of GATTACAGG, in canonical form.
+	c.Check(sig, check.HasLen, 4)
+	for _, r := range rs[:len(sig)] {
+		c.Check(r.Fg, check.Equals, 40, check.Commentf("k-mer %s", r.Kmer))
+	}
+
+	ms := Assemble(rs, 0.01, true)
+	c.Assert(ms, check.HasLen, 1)
+	cons := ms[0].Consensus
+	if cons != "GATTACAGG" {
+		cons = revComp(cons)
+	}
+	c.Check(cons, check.Equals, "GATTACAGG")
+	c.Check(ms[0].Kmers, check.HasLen, 4)
+}
+
+func (s *S) TestCounting(c *check.C) {
+	cnt := counter{alpha: alphabet.DNA, comp: alphabet.DNA, k: 3}
+	counts, total := cnt.count([]alphabet.Letters{alphabet.Letters("aaaNttt"), alphabet.Letters("ACG")})
+	c.Check(total, check.Equals, 3)
+	c.Check(counts, check.DeepEquals, map[string]int{"AAA": 2, "ACG": 1})
+
+	cnt = counter{alpha: alphabet.DNA, k: 2, presence: true}
+	counts, total = cnt.count([]alphabet.Letters{alphabet.Letters("AAAA"), alphabet.Letters("AC")})
+	c.Check(total, check.Equals, 2)
+	c.Check(counts, check.DeepEquals, map[string]int{"AA": 1, "AC": 1})
+}
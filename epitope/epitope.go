@@ -0,0 +1,218 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package epitope provides sliding window antigenicity profiles of protein
+// sequences using published residue propensity scales, and calling of candidate
+// linear B-cell epitopes from the profiles.
+package epitope
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"math"
+)
+
+var (
+	ErrNotProtein    = errors.New("epitope: sequence is not protein")
+	ErrBadWindow     = errors.New("epitope: invalid window length")
+	ErrShortSequence = errors.New("epitope: sequence shorter than window")
+)
+
+var _ feat.Feature = (*Epitope)(nil)
+
+// A Scale is a residue propensity scale.
+type Scale struct {
+	Name string
+
+	// Values holds the value of each upper case
+	// residue. Residues not present are ignored.
+	Values map[byte]float64
+
+	// Window is the window length recommended
+	// by the authors of the scale.
+	Window int
+}
+
+// HoppWoods is the hydrophilicity scale of Hopp and Woods (Proc Natl Acad Sci
+// USA 78:3824-3828, 1981). The highest point of the hydrophilicity profile is
+// expected to lie in or near an antigenic determinant.
+var HoppWoods = Scale{
+	Name: "Hopp-Woods",
+	Values: map[byte]float64{
+		'A': -0.5, 'R': 3.0, 'N': 0.2, 'D': 3.0, 'C': -1.0,
+		'Q': 0.2, 'E': 3.0, 'G': 0.0, 'H': -0.5, 'I': -1.8,
+		'L': -1.8, 'K': 3.0, 'M': -1.3, 'F': -2.5, 'P': 0.0,
+		'S': 0.3, 'T': -0.4, 'W': -3.4, 'Y': -2.3, 'V': -1.5,
+	},
+	Window: 6,
+}
+
+// Parker is the hydrophilicity scale of Parker, Guo and Hodges (Biochemistry
+// 25:5425-5432, 1986) derived from peptide retention times.
+var Parker = Scale{
+	Name: "Parker",
+	Values: map[byte]float64{
+		'A': 2.1, 'R': 4.2, 'N': 7.0, 'D': 10.0, 'C': 1.4,
+		'Q': 6.0, 'E': 7.8, 'G': 5.7, 'H': 2.1, 'I': -8.0,
+		'L': -9.2, 'K': 5.7, 'M': -4.2, 'F': -9.2, 'P': 2.1,
+		'S': 6.5, 'T': 5.2, 'W': -10.0, 'Y': -1.9, 'V': -3.7,
+	},
+	Window: 7,
+}
+
+// KolaskarTongaonkar is the antigenic propensity scale of Kolaskar and
+// Tongaonkar (FEBS Lett 276:172-174, 1990) derived from experimentally
+// determined epitopes. The authors call determinants as runs of at least six
+// residues with a window average above the lesser of 1 and the mean propensity
+// of the protein.
+var KolaskarTongaonkar = Scale{
+	Name: "Kolaskar-Tongaonkar",
+	Values: map[byte]float64{
+		'A': 1.064, 'R': 0.873, 'N': 0.776, 'D': 0.866, 'C': 1.412,
+		'Q': 1.015, 'E': 0.851, 'G': 0.874, 'H': 1.105, 'I': 1.152,
+		'L': 1.250, 'K': 0.930, 'M': 0.826, 'F': 1.091, 'P': 1.064,
+		'S': 1.012, 'T': 0.909, 'W': 0.893, 'Y': 1.161, 'V': 1.383,
+	},
+	Window: 7,
+}
+
+// A Profile is a sliding window propensity profile of a protein sequence.
+type Profile struct {
+	// Loc is the profiled sequence and Offset
+	// is the position of the first residue.
+	Loc    feat.Feature
+	Offset int
+
+	Scale  string
+	Window int
+
+	// Scores holds the mean scale value of the
+	// window centred on each residue. Residues
+	// too close to the ends of the sequence to
+	// be centred in a window have a NaN score.
+	Scores []float64
+
+	// Mean is the mean scale value of the
+	// residues of the sequence.
+	Mean float64
+}
+
+// NewProfile returns the propensity profile of the protein sequence s using
+// the scale sc with the given window length. If window is zero, the window
+// length recommended for the scale is used.
+func NewProfile(s seq.Sequence, sc Scale, window int) (*Profile, error) {
+	if window == 0 {
+		window = sc.Window
+	}
+	if window < 1 {
+		return nil, ErrBadWindow
+	}
+	if s.Alphabet().Moltype() != feat.Protein {
+		return nil, ErrNotProtein
+	}
+	n := s.Len()
+	if n < window {
+		return nil, ErrShortSequence
+	}
+
+	// sum and count hold the cumulative values and
+	// numbers of residues present in the scale.
+	sum := make([]float64, n+1)
+	count := make([]int, n+1)
+	for i := 0; i < n; i++ {
+		l := s.At(s.Start() + i).L
+		if 'a' <= l && l <= 'z' {
+			l -= 'a' - 'A'
+		}
+		v, ok := sc.Values[byte(l)]
+		sum[i+1], count[i+1] = sum[i], count[i]
+		if ok {
+			sum[i+1] += v
+			count[i+1]++
+		}
+	}
+
+	p := &Profile{
+		Loc:    s,
+		Offset: s.Start(),
+		Scale:  sc.Name,
+		Window: window,
+		Scores: make([]float64, n),
+		Mean:   sum[n] / float64(count[n]),
+	}
+	for i := range p.Scores {
+		p.Scores[i] = math.NaN()
+	}
+	half := (window - 1) / 2
+	for st := 0; st+window <= n; st++ {
+		c := count[st+window] - count[st]
+		if c == 0 {
+			continue
+		}
+		p.Scores[st+half] = (sum[st+window] - sum[st]) / float64(c)
+	}
+	return p, nil
+}
+
+// An Epitope is a candidate epitope.
+type Epitope struct {
+	Loc      feat.Feature
+	From, To int
+
+	// Peak is the position of the highest
+	// window score of the epitope and Score
+	// is the score at Peak.
+	Peak  int
+	Score float64
+}
+
+func (e *Epitope) Start() int             { return e.From }
+func (e *Epitope) End() int               { return e.To }
+func (e *Epitope) Len() int               { return e.To - e.From }
+func (e *Epitope) Name() string           { return e.Loc.Name() }
+func (e *Epitope) Description() string    { return "candidate epitope" }
+func (e *Epitope) Location() feat.Feature { return e.Loc }
+
+// Epitopes returns the runs of at least min residues of p with a window score
+// above threshold.
+func (p *Profile) Epitopes(threshold float64, min int) []*Epitope {
+	var eps []*Epitope
+	for i := 0; i < len(p.Scores); {
+		if !(p.Scores[i] > threshold) {
+			i++
+			continue
+		}
+		j, peak := i, i
+		for ; j < len(p.Scores) && p.Scores[j] > threshold; j++ {
+			if p.Scores[j] > p.Scores[peak] {
+				peak = j
+			}
+		}
+		if j-i >= min {
+			eps = append(eps, &Epitope{
+				Loc:   p.Loc,
+				From:  p.Offset + i,
+				To:    p.Offset + j,
+				Peak:  p.Offset + peak,
+				Score: p.Scores[peak],
+			})
+		}
+		i = j
+	}
+	return eps
+}
+
+// KolaskarTongaonkarEpitopes returns the antigenic determinants of the protein
+// sequence s predicted by the method of Kolaskar and Tongaonkar, the runs of at
+// least six residues with a seven residue window score above the lesser of 1
+// and the mean propensity of s.
+func KolaskarTongaonkarEpitopes(s seq.Sequence) ([]*Epitope, error) {
+	p, err := NewProfile(s, KolaskarTongaonkar, 0)
+	if err != nil {
+		return nil, err
+	}
+	return p.Epitopes(math.Min(p.Mean, 1), 6), nil
+}
@@ -0,0 +1,54 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package epitope
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestProfile(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.Letters("LLIVFWDEKRDEKRLLIVFW"), alphabet.Protein)
+	p, err := NewProfile(sq, HoppWoods, 0)
+	c.Assert(err, check.Equals, nil)
+	c.Check(p.Window, check.Equals, 6)
+	c.Check(math.IsNaN(p.Scores[0]), check.Equals, true)
+	c.Check(math.IsNaN(p.Scores[1]), check.Equals, true)
+	c.Check(math.Abs(p.Scores[2]-(-1.8*3-1.5-2.5-3.4)/6) < 1e-12, check.Equals, true)
+	c.Check(math.IsNaN(p.Scores[17]), check.Equals, true)
+
+	eps := p.Epitopes(1, 3)
+	c.Assert(eps, check.HasLen, 1)
+	c.Check(eps[0].Start(), check.Equals, 6)
+	c.Check(eps[0].End(), check.Equals, 13)
+	c.Check(eps[0].Peak, check.Equals, 8)
+	c.Check(eps[0].Score, check.Equals, 3.)
+
+	_, err = NewProfile(sq, HoppWoods, 21)
+	c.Check(err, check.Equals, ErrShortSequence)
+	_, err = NewProfile(linear.NewSeq("dna", alphabet.Letters("acgt"), alphabet.DNA), Parker, 0)
+	c.Check(err, check.Equals, ErrNotProtein)
+}
+
+func (s *S) TestKolaskarTongaonkar(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.Letters("NDKENDGKTE"+"CVLVCYIV"+"NDKENDGKTE"), alphabet.Protein)
+	eps, err := KolaskarTongaonkarEpitopes(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(eps, check.HasLen, 1)
+	c.Check(eps[0].Start() >= 7 && eps[0].End() <= 21, check.Equals, true)
+	c.Check(eps[0].Len() >= 6, check.Equals, true)
+}
@@ -0,0 +1,367 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package altsplice provides classification of alternative splicing events
+// between transcript models of a gene.
+package altsplice
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/gene"
+	"github.com/biogo/biogo/feat/junction"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrNoLocation = errors.New("altsplice: transcript has no location")
+	ErrMixedLoc   = errors.New("altsplice: transcripts on different locations")
+)
+
+var (
+	_ feat.Feature  = (*Event)(nil)
+	_ feat.Orienter = (*Event)(nil)
+)
+
+// Type is the type of an alternative splicing event.
+type Type int
+
+const (
+	SkippedExon Type = iota
+	RetainedIntron
+	Alt5Site
+	Alt3Site
+	MutuallyExclusive
+)
+
+var typeNames = [...]string{
+	SkippedExon:       "SE",
+	RetainedIntron:    "RI",
+	Alt5Site:          "A5SS",
+	Alt3Site:          "A3SS",
+	MutuallyExclusive: "MXE",
+}
+
+// String returns the conventional abbreviation for the event type.
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeNames) {
+		return fmt.Sprintf("Type(%d)", int(t))
+	}
+	return typeNames[t]
+}
+
+// A Span is a half-open interval on the base location of a gene.
+type Span struct {
+	Start, End int
+}
+
+// An Event is an alternative splicing event. Each event has an inclusion form
+// and an exclusion form:
+//
+//   - SkippedExon: the inclusion form contains the skipped exon.
+//   - RetainedIntron: the inclusion form retains the intron.
+//   - Alt5Site and Alt3Site: the inclusion form uses the splice site that
+//     gives the longer exon.
+//   - MutuallyExclusive: the inclusion form contains the upstream exon
+//     and the exclusion form contains the downstream exon.
+//
+// Sites are named relative to the orientation of the transcripts, so on the
+// reverse strand an Alt5Site event lies at the downstream end, in base
+// coordinates, of an intron.
+type Event struct {
+	Type   Type
+	Loc    feat.Feature
+	Orient feat.Orientation
+
+	// EStart and EEnd are the extent of the event from
+	// the end of the flanking upstream exon to the start
+	// of the flanking downstream exon in base coordinates.
+	// For RetainedIntron events they are the intron.
+	EStart, EEnd int
+
+	// Inclusion and Exclusion are the exonic segments
+	// found only in the inclusion and exclusion forms.
+	Inclusion, Exclusion []Span
+
+	// InclusionJunctions and ExclusionJunctions are the
+	// splice junctions specific to each form, suitable
+	// for junction based quantification.
+	InclusionJunctions, ExclusionJunctions []*junction.Junction
+
+	// Included and Excluded are the names of the
+	// transcripts that have each form.
+	Included, Excluded []string
+}
+
+func (e *Event) Start() int { return e.EStart }
+func (e *Event) End() int   { return e.EEnd }
+func (e *Event) Len() int   { return e.EEnd - e.EStart }
+func (e *Event) Name() string {
+	return fmt.Sprintf("%s:%s:[%d,%d)", e.Type, e.Loc.Name(), e.EStart, e.EEnd)
+}
+func (e *Event) Description() string           { return "alternative splicing event" }
+func (e *Event) Location() feat.Feature        { return e.Loc }
+func (e *Event) Orientation() feat.Orientation { return e.Orient }
+
+// model is a transcript in base coordinates.
+type model struct {
+	name  string
+	exons []Span
+}
+
+func (m model) introns() []Span {
+	var in []Span
+	for i := 1; i < len(m.exons); i++ {
+		if m.exons[i-1].End < m.exons[i].Start {
+			in = append(in, Span{m.exons[i-1].End, m.exons[i].Start})
+		}
+	}
+	return in
+}
+
+// exonFrom returns the exon starting at pos.
+func (m model) exonFrom(pos int) (Span, bool) {
+	for _, e := range m.exons {
+		if e.Start == pos {
+			return e, true
+		}
+	}
+	return Span{}, false
+}
+
+// exonTo returns the exon ending at pos.
+func (m model) exonTo(pos int) (Span, bool) {
+	for _, e := range m.exons {
+		if e.End == pos {
+			return e, true
+		}
+	}
+	return Span{}, false
+}
+
+// hasIntron returns whether m has the intron in.
+func (m model) hasIntron(in Span) bool {
+	for _, i := range m.introns() {
+		if i == in {
+			return true
+		}
+	}
+	return false
+}
+
+type spans []Span
+
+func (s spans) Len() int           { return len(s) }
+func (s spans) Less(i, j int) bool { return s[i].Start < s[j].Start }
+func (s spans) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Classify returns the alternative splicing events found between all pairs of
+// the transcripts ts, which must share a base location. Events seen between more
+// than one pair of transcripts are reported once, listing all the transcripts
+// having each form. The orientation of the events is the orientation of the
+// first transcript. Events are sorted by start, end and type.
+func Classify(ts []gene.Transcript) ([]*Event, error) {
+	var (
+		loc    feat.Feature
+		orient feat.Orientation
+		models = make([]model, len(ts))
+	)
+	for i, t := range ts {
+		if t.Location() == nil {
+			return nil, ErrNoLocation
+		}
+		m := model{name: t.Name()}
+		for _, e := range t.Exons() {
+			start, ref := feat.BasePositionOf(e, 0)
+			if loc == nil {
+				loc = ref
+			} else if ref != loc {
+				return nil, ErrMixedLoc
+			}
+			m.exons = append(m.exons, Span{start, start + e.Len()})
+		}
+		if i == 0 {
+			orient, _ = feat.BaseOrientationOf(t)
+		}
+		sort.Sort(spans(m.exons))
+		models[i] = m
+	}
+
+	c := classifier{loc: loc, orient: orient, events: make(map[string]*Event)}
+	for i, a := range models {
+		for j, b := range models {
+			if i != j {
+				c.pair(a, b)
+			}
+		}
+	}
+
+	evs := make([]*Event, 0, len(c.events))
+	for _, e := range c.events {
+		evs = append(evs, e)
+	}
+	sort.Sort(byPosition(evs))
+	return evs, nil
+}
+
+type classifier struct {
+	loc    feat.Feature
+	orient feat.Orientation
+	events map[string]*Event
+}
+
+// pair adds the events for which a has the inclusion form and b has the
+// exclusion form.
+func (c *classifier) pair(a, b model) {
+	aIn, bIn := a.introns(), b.introns()
+
+	// Skipped and mutually exclusive exons.
+	for k := 1; k+1 < len(a.exons); k++ {
+		up, ex, down := a.exons[k-1], a.exons[k], a.exons[k+1]
+		if up.End == ex.Start || ex.End == down.Start {
+			continue
+		}
+		if b.hasIntron(Span{up.End, down.Start}) {
+			c.add(a, b, &Event{
+				Type:               SkippedExon,
+				EStart:             up.End,
+				EEnd:               down.Start,
+				Inclusion:          []Span{ex},
+				InclusionJunctions: c.junctions(Span{up.End, ex.Start}, Span{ex.End, down.Start}),
+				ExclusionJunctions: c.junctions(Span{up.End, down.Start}),
+			})
+		}
+		for l := 1; l+1 < len(b.exons); l++ {
+			bup, bex, bdown := b.exons[l-1], b.exons[l], b.exons[l+1]
+			if bup.End != up.End || bdown.Start != down.Start || bex.End > ex.Start {
+				continue
+			}
+			if bup.End == bex.Start || bex.End == bdown.Start {
+				continue
+			}
+			// b's exon is upstream of a's, so b has the inclusion form.
+			c.add(b, a, &Event{
+				Type:               MutuallyExclusive,
+				EStart:             up.End,
+				EEnd:               down.Start,
+				Inclusion:          []Span{bex},
+				Exclusion:          []Span{ex},
+				InclusionJunctions: c.junctions(Span{up.End, bex.Start}, Span{bex.End, down.Start}),
+				ExclusionJunctions: c.junctions(Span{up.End, ex.Start}, Span{ex.End, down.Start}),
+			})
+		}
+	}
+
+	// Retained introns.
+	for _, in := range bIn {
+		for _, e := range a.exons {
+			if e.Start < in.Start && in.End < e.End {
+				c.add(a, b, &Event{
+					Type:               RetainedIntron,
+					EStart:             in.Start,
+					EEnd:               in.End,
+					Inclusion:          []Span{in},
+					ExclusionJunctions: c.junctions(in),
+				})
+			}
+		}
+	}
+
+	// Alternative splice sites. The introns of a are the shorter.
+	for _, ia := range aIn {
+		for _, ib := range bIn {
+			switch {
+			case ia.Start == ib.Start && ia.End < ib.End:
+				// Alternative site at the downstream end of the intron.
+				if e, ok := a.exonFrom(ia.End); !ok || e.End <= ib.End {
+					continue
+				}
+				if _, ok := b.exonFrom(ib.End); !ok {
+					continue
+				}
+				c.add(a, b, &Event{
+					Type:               c.site(false),
+					EStart:             ia.Start,
+					EEnd:               ib.End,
+					Inclusion:          []Span{{ia.End, ib.End}},
+					InclusionJunctions: c.junctions(ia),
+					ExclusionJunctions: c.junctions(ib),
+				})
+			case ia.End == ib.End && ia.Start > ib.Start:
+				// Alternative site at the upstream end of the intron.
+				if e, ok := a.exonTo(ia.Start); !ok || e.Start >= ib.Start {
+					continue
+				}
+				if _, ok := b.exonTo(ib.Start); !ok {
+					continue
+				}
+				c.add(a, b, &Event{
+					Type:               c.site(true),
+					EStart:             ib.Start,
+					EEnd:               ia.End,
+					Inclusion:          []Span{{ib.Start, ia.Start}},
+					InclusionJunctions: c.junctions(ia),
+					ExclusionJunctions: c.junctions(ib),
+				})
+			}
+		}
+	}
+}
+
+// site returns the type of an alternative site event at the upstream or
+// downstream end of an intron in base coordinates.
+func (c *classifier) site(upstream bool) Type {
+	if upstream == (c.orient != feat.Reverse) {
+		return Alt5Site
+	}
+	return Alt3Site
+}
+
+func (c *classifier) junctions(introns ...Span) []*junction.Junction {
+	js := make([]*junction.Junction, len(introns))
+	for i, in := range introns {
+		js[i] = &junction.Junction{Loc: c.loc, JStart: in.Start, JEnd: in.End, Orient: c.orient}
+	}
+	return js
+}
+
+// add records the event e with inc having the inclusion form and exc having
+// the exclusion form.
+func (c *classifier) add(inc, exc model, e *Event) {
+	key := fmt.Sprint(e.Type, e.EStart, e.EEnd, e.Inclusion, e.Exclusion)
+	if old, ok := c.events[key]; ok {
+		e = old
+	} else {
+		e.Loc = c.loc
+		e.Orient = c.orient
+		c.events[key] = e
+	}
+	e.Included = addName(e.Included, inc.name)
+	e.Excluded = addName(e.Excluded, exc.name)
+}
+
+func addName(names []string, n string) []string {
+	for _, s := range names {
+		if s == n {
+			return names
+		}
+	}
+	return append(names, n)
+}
+
+type byPosition []*Event
+
+func (e byPosition) Len() int { return len(e) }
+func (e byPosition) Less(i, j int) bool {
+	switch {
+	case e[i].EStart != e[j].EStart:
+		return e[i].EStart < e[j].EStart
+	case e[i].EEnd != e[j].EEnd:
+		return e[i].EEnd < e[j].EEnd
+	}
+	return e[i].Type < e[j].Type
+}
+func (e byPosition) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
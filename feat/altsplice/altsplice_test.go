@@ -0,0 +1,138 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package altsplice
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/gene"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type chr string
+
+func (c chr) Start() int             { return 0 }
+func (c chr) End() int               { return 0 }
+func (c chr) Len() int               { return 0 }
+func (c chr) Name() string           { return string(c) }
+func (c chr) Description() string    { return "chrom" }
+func (c chr) Location() feat.Feature { return nil }
+
+func transcript(c *check.C, id string, o feat.Orientation, exons ...Span) gene.Transcript {
+	t := &gene.NonCodingTranscript{ID: id, Loc: chr("1"), Offset: exons[0].Start, Orient: o}
+	var ex []gene.Exon
+	for _, e := range exons {
+		ex = append(ex, gene.Exon{Transcript: t, Offset: e.Start - t.Offset, Length: e.End - e.Start})
+	}
+	c.Assert(t.SetExons(ex...), check.Equals, nil)
+	return t
+}
+
+type event struct {
+	typ                Type
+	start, end         int
+	inc, exc           []Span
+	included, excluded []string
+}
+
+func (s *S) TestClassify(c *check.C) {
+	for i, t := range []struct {
+		a, b   []Span
+		orient feat.Orientation
+		expect []event
+	}{
+		{
+			a:      []Span{{100, 200}, {300, 400}, {500, 600}},
+			b:      []Span{{100, 200}, {500, 600}},
+			orient: feat.Forward,
+			expect: []event{{typ: SkippedExon, start: 200, end: 500, inc: []Span{{300, 400}}, included: []string{"a"}, excluded: []string{"b"}}},
+		},
+		{
+			a:      []Span{{100, 600}},
+			b:      []Span{{100, 200}, {300, 600}},
+			orient: feat.Forward,
+			expect: []event{{typ: RetainedIntron, start: 200, end: 300, inc: []Span{{200, 300}}, included: []string{"a"}, excluded: []string{"b"}}},
+		},
+		{
+			a:      []Span{{100, 200}, {300, 400}},
+			b:      []Span{{100, 200}, {320, 400}},
+			orient: feat.Forward,
+			expect: []event{{typ: Alt3Site, start: 200, end: 320, inc: []Span{{300, 320}}, included: []string{"a"}, excluded: []string{"b"}}},
+		},
+		{
+			a:      []Span{{100, 200}, {300, 400}},
+			b:      []Span{{100, 220}, {300, 400}},
+			orient: feat.Forward,
+			expect: []event{{typ: Alt5Site, start: 200, end: 300, inc: []Span{{200, 220}}, included: []string{"b"}, excluded: []string{"a"}}},
+		},
+		{
+			a:      []Span{{100, 200}, {300, 400}},
+			b:      []Span{{100, 220}, {300, 400}},
+			orient: feat.Reverse,
+			expect: []event{{typ: Alt3Site, start: 200, end: 300, inc: []Span{{200, 220}}, included: []string{"b"}, excluded: []string{"a"}}},
+		},
+		{
+			a:      []Span{{100, 200}, {400, 450}, {500, 600}},
+			b:      []Span{{100, 200}, {300, 350}, {500, 600}},
+			orient: feat.Forward,
+			expect: []event{{typ: MutuallyExclusive, start: 200, end: 500, inc: []Span{{300, 350}}, exc: []Span{{400, 450}}, included: []string{"b"}, excluded: []string{"a"}}},
+		},
+		{
+			a:      []Span{{100, 200}, {300, 400}},
+			b:      []Span{{100, 200}, {300, 400}},
+			orient: feat.Forward,
+			expect: nil,
+		},
+	} {
+		evs, err := Classify([]gene.Transcript{
+			transcript(c, "a", t.orient, t.a...),
+			transcript(c, "b", t.orient, t.b...),
+		})
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		var got []event
+		for _, e := range evs {
+			c.Check(e.Orientation(), check.Equals, t.orient, check.Commentf("Test %d", i))
+			got = append(got, event{
+				typ: e.Type, start: e.Start(), end: e.End(),
+				inc: e.Inclusion, exc: e.Exclusion,
+				included: e.Included, excluded: e.Excluded,
+			})
+		}
+		c.Check(got, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestJunctions(c *check.C) {
+	evs, err := Classify([]gene.Transcript{
+		transcript(c, "a", feat.Forward, Span{100, 200}, Span{300, 400}, Span{500, 600}),
+		transcript(c, "b", feat.Forward, Span{100, 200}, Span{500, 600}),
+		transcript(c, "c", feat.Forward, Span{50, 200}, Span{500, 700}),
+	})
+	c.Assert(err, check.Equals, nil)
+	c.Assert(evs, check.HasLen, 1)
+	e := evs[0]
+	c.Check(e.Name(), check.Equals, "SE:1:[200,500)")
+	c.Check(e.Included, check.DeepEquals, []string{"a"})
+	c.Check(e.Excluded, check.DeepEquals, []string{"b", "c"})
+
+	var inc, exc []Span
+	for _, j := range e.InclusionJunctions {
+		inc = append(inc, Span{j.Start(), j.End()})
+	}
+	for _, j := range e.ExclusionJunctions {
+		exc = append(exc, Span{j.Start(), j.End()})
+	}
+	c.Check(inc, check.DeepEquals, []Span{{200, 300}, {400, 500}})
+	c.Check(exc, check.DeepEquals, []Span{{200, 500}})
+}
@@ -0,0 +1,339 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package isoform provides a simple transcript assembler that builds transcript
+// models from splice junction and read coverage evidence.
+//
+// Covered regions of a locus are partitioned at supported splice sites to form
+// the nodes of a splice graph. Nodes are connected by edges where they abut or
+// where a supported junction joins them, and each path through the graph from a
+// node without predecessors to a node without successors is a candidate isoform.
+package isoform
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/junction"
+	"github.com/biogo/biogo/io/featio/gff"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrNoLocation = errors.New("isoform: locus has no location")
+	ErrTooMany    = errors.New("isoform: too many paths in splice graph")
+)
+
+var (
+	_ feat.Feature  = (*Isoform)(nil)
+	_ feat.Orienter = (*Isoform)(nil)
+)
+
+// Params describes the evidence required to assemble isoforms.
+type Params struct {
+	MinCoverage      int // Minimum read depth for a position to be considered exonic.
+	MinJunctionReads int // Minimum read support for a junction to be used.
+	MinLength        int // Minimum spliced length of a reported isoform.
+	MaxIsoforms      int // Maximum number of isoforms reported for a locus.
+
+	// MaxPaths is the maximum number of paths through the
+	// splice graph that will be considered. If the graph
+	// has more paths, Assemble returns ErrTooMany. If
+	// MaxPaths is zero, the number of paths is unlimited.
+	MaxPaths int
+}
+
+// DefaultParams are reasonable parameters for assembling short read data.
+var DefaultParams = Params{
+	MinCoverage:      1,
+	MinJunctionReads: 2,
+	MinLength:        200,
+	MaxIsoforms:      10,
+	MaxPaths:         10000,
+}
+
+// A Locus holds the read depth over a region of a reference sequence.
+type Locus struct {
+	Loc feat.Feature
+
+	// Depth holds the read depth of each position
+	// of the locus starting at Offset on Loc.
+	Offset int
+	Depth  []int
+}
+
+func (l Locus) depth(pos int) int {
+	i := pos - l.Offset
+	if i < 0 || i >= len(l.Depth) {
+		return 0
+	}
+	return l.Depth[i]
+}
+
+// An Exon is an exon of an assembled isoform in the coordinates of the locus
+// location.
+type Exon struct {
+	Start, End int
+}
+
+// An Isoform is an assembled transcript model.
+type Isoform struct {
+	Loc    feat.Feature
+	Exons  []Exon
+	Orient feat.Orientation
+
+	// Support is the lowest read support of the junctions
+	// used by the isoform or zero for single exon isoforms.
+	Support int
+
+	// Coverage is the mean read depth over the exons.
+	Coverage float64
+}
+
+func (i *Isoform) Start() int { return i.Exons[0].Start }
+func (i *Isoform) End() int   { return i.Exons[len(i.Exons)-1].End }
+func (i *Isoform) Len() int   { return i.End() - i.Start() }
+func (i *Isoform) Name() string {
+	return fmt.Sprintf("%s:[%d,%d)", i.Loc.Name(), i.Start(), i.End())
+}
+func (i *Isoform) Description() string           { return "assembled isoform" }
+func (i *Isoform) Location() feat.Feature        { return i.Loc }
+func (i *Isoform) Orientation() feat.Orientation { return i.Orient }
+
+// SplicedLen returns the sum of the isoform's exon lengths.
+func (i *Isoform) SplicedLen() int {
+	var n int
+	for _, e := range i.Exons {
+		n += e.End - e.Start
+	}
+	return n
+}
+
+type node struct {
+	start, end int
+	in, out    []edge
+}
+
+type edge struct {
+	to      int
+	support int // Junction read support or -1 for abutting nodes.
+}
+
+// Assemble returns the isoforms supported by the coverage of l and the junctions
+// js. Junctions on locations other than l.Loc, junctions with fewer than
+// p.MinJunctionReads supporting reads, and junctions whose splice sites are not
+// covered are ignored. Isoforms are ordered by decreasing support and then by
+// decreasing coverage. The orientation of the returned isoforms is the orientation
+// of the supporting junctions if all junctions agree, and feat.NotOriented
+// otherwise.
+func Assemble(l Locus, js []*junction.Count, p Params) ([]*Isoform, error) {
+	if l.Loc == nil {
+		return nil, ErrNoLocation
+	}
+	covered := func(pos int) bool { return l.depth(pos) >= p.MinCoverage }
+
+	// Collect usable junctions and splice sites.
+	var (
+		used   []*junction.Count
+		cuts   = make(map[int]bool)
+		orient feat.Orientation
+		mixed  bool
+	)
+	for _, j := range js {
+		if j.Loc == nil || j.Loc.Name() != l.Loc.Name() || j.Reads < p.MinJunctionReads {
+			continue
+		}
+		if !covered(j.JStart-1) || !covered(j.JEnd) {
+			continue
+		}
+		if len(used) == 0 {
+			orient = j.Orient
+		} else if j.Orient != orient {
+			mixed = true
+		}
+		used = append(used, j)
+		cuts[j.JStart] = true
+		cuts[j.JEnd] = true
+	}
+	if mixed {
+		orient = feat.NotOriented
+	}
+
+	// Build nodes from covered intervals cut at splice sites.
+	var (
+		nodes   []node
+		byStart = make(map[int]int)
+		byEnd   = make(map[int]int)
+	)
+	start := -1
+	for pos := l.Offset; pos <= l.Offset+len(l.Depth); pos++ {
+		c := pos < l.Offset+len(l.Depth) && covered(pos)
+		if start >= 0 && (!c || cuts[pos]) {
+			byStart[start] = len(nodes)
+			byEnd[pos] = len(nodes)
+			nodes = append(nodes, node{start: start, end: pos})
+			start = -1
+		}
+		if c && start < 0 {
+			start = pos
+		}
+	}
+	for i := 1; i < len(nodes); i++ {
+		if nodes[i-1].end == nodes[i].start {
+			nodes[i-1].out = append(nodes[i-1].out, edge{to: i, support: -1})
+			nodes[i].in = append(nodes[i].in, edge{to: i - 1, support: -1})
+		}
+	}
+	for _, j := range used {
+		from, ok := byEnd[j.JStart]
+		if !ok {
+			continue
+		}
+		to, ok := byStart[j.JEnd]
+		if !ok {
+			continue
+		}
+		nodes[from].out = append(nodes[from].out, edge{to: to, support: j.Reads})
+		nodes[to].in = append(nodes[to].in, edge{to: from, support: j.Reads})
+	}
+
+	// Enumerate source to sink paths.
+	var (
+		isoforms []*Isoform
+		path     []int
+		support  []int
+		err      error
+		walk     func(n int)
+	)
+	walk = func(n int) {
+		if err != nil {
+			return
+		}
+		path = append(path, n)
+		if len(nodes[n].out) == 0 {
+			if p.MaxPaths > 0 && len(isoforms) == p.MaxPaths {
+				err = ErrTooMany
+			} else {
+				isoforms = append(isoforms, l.isoform(nodes, path, support, orient))
+			}
+		}
+		for _, e := range nodes[n].out {
+			support = append(support, e.support)
+			walk(e.to)
+			support = support[:len(support)-1]
+		}
+		path = path[:len(path)-1]
+	}
+	for i, n := range nodes {
+		if len(n.in) == 0 {
+			walk(i)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var keep []*Isoform
+	for _, iso := range isoforms {
+		if iso.SplicedLen() >= p.MinLength {
+			keep = append(keep, iso)
+		}
+	}
+	sort.Stable(bySupport(keep))
+	if p.MaxIsoforms > 0 && len(keep) > p.MaxIsoforms {
+		keep = keep[:p.MaxIsoforms]
+	}
+	return keep, nil
+}
+
+// isoform returns the isoform described by the node path and edge supports.
+func (l Locus) isoform(nodes []node, path, support []int, o feat.Orientation) *Isoform {
+	iso := &Isoform{Loc: l.Loc, Orient: o}
+	for i, n := range path {
+		nd := nodes[n]
+		if i != 0 && support[i-1] < 0 {
+			iso.Exons[len(iso.Exons)-1].End = nd.end
+		} else {
+			iso.Exons = append(iso.Exons, Exon{Start: nd.start, End: nd.end})
+		}
+	}
+	for _, s := range support {
+		if s >= 0 && (iso.Support == 0 || s < iso.Support) {
+			iso.Support = s
+		}
+	}
+	var depth int
+	for _, e := range iso.Exons {
+		for pos := e.Start; pos < e.End; pos++ {
+			depth += l.depth(pos)
+		}
+	}
+	iso.Coverage = float64(depth) / float64(iso.SplicedLen())
+	return iso
+}
+
+type bySupport []*Isoform
+
+func (b bySupport) Len() int { return len(b) }
+func (b bySupport) Less(i, j int) bool {
+	if b[i].Support != b[j].Support {
+		return b[i].Support > b[j].Support
+	}
+	return b[i].Coverage > b[j].Coverage
+}
+func (b bySupport) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+
+// GTF returns GTF transcript and exon records for the isoforms. The isoforms are
+// given the gene ID geneID and transcript IDs formed from geneID and the isoform's
+// index. The coverage of each isoform is recorded as the transcript score.
+func GTF(isoforms []*Isoform, geneID, source string) []*gff.Feature {
+	var fs []*gff.Feature
+	for i, iso := range isoforms {
+		var strand seq.Strand
+		switch iso.Orient {
+		case feat.Forward:
+			strand = seq.Plus
+		case feat.Reverse:
+			strand = seq.Minus
+		}
+		txID := fmt.Sprintf("%s.%d", geneID, i+1)
+		attr := gff.Attributes{
+			{Tag: "gene_id", Value: fmt.Sprintf("%q", geneID)},
+			{Tag: "transcript_id", Value: fmt.Sprintf("%q", txID)},
+		}
+		cov := iso.Coverage
+		fs = append(fs, &gff.Feature{
+			SeqName:        iso.Loc.Name(),
+			Source:         source,
+			Feature:        "transcript",
+			FeatStart:      iso.Start(),
+			FeatEnd:        iso.End(),
+			FeatScore:      &cov,
+			FeatStrand:     strand,
+			FeatFrame:      gff.NoFrame,
+			FeatAttributes: attr,
+		})
+		for j, e := range iso.Exons {
+			n := j + 1
+			if strand == seq.Minus {
+				n = len(iso.Exons) - j
+			}
+			fs = append(fs, &gff.Feature{
+				SeqName:    iso.Loc.Name(),
+				Source:     source,
+				Feature:    "exon",
+				FeatStart:  e.Start,
+				FeatEnd:    e.End,
+				FeatStrand: strand,
+				FeatFrame:  gff.NoFrame,
+				FeatAttributes: append(attr[:len(attr):len(attr)],
+					gff.Attribute{Tag: "exon_number", Value: fmt.Sprintf("%q", fmt.Sprint(n))},
+				),
+			})
+		}
+	}
+	return fs
+}
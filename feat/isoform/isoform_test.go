@@ -0,0 +1,159 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package isoform
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/junction"
+	"github.com/biogo/biogo/io/featio/gff"
+	"github.com/biogo/biogo/seq"
+
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type chr string
+
+func (c chr) Start() int             { return 0 }
+func (c chr) End() int               { return 0 }
+func (c chr) Len() int               { return 0 }
+func (c chr) Name() string           { return string(c) }
+func (c chr) Description() string    { return "chrom" }
+func (c chr) Location() feat.Feature { return nil }
+
+func depth(n int, runs ...[3]int) []int {
+	d := make([]int, n)
+	for _, r := range runs {
+		for i := r[0]; i < r[1]; i++ {
+			d[i] = r[2]
+		}
+	}
+	return d
+}
+
+func junc(start, end, reads int) *junction.Count {
+	return &junction.Count{
+		Junction: &junction.Junction{Loc: chr("1"), JStart: start, JEnd: end, Orient: feat.Forward},
+		Reads:    reads,
+	}
+}
+
+func (s *S) TestAssemble(c *check.C) {
+	p := DefaultParams
+	p.MinLength = 0
+	for i, t := range []struct {
+		depth   []int
+		js      []*junction.Count
+		exons   [][]Exon
+		support []int
+	}{
+		{
+			// Cassette exon.
+			depth: depth(100, [3]int{10, 30, 10}, [3]int{50, 60, 5}, [3]int{80, 95, 10}),
+			js:    []*junction.Count{junc(30, 50, 5), junc(60, 80, 5), junc(30, 80, 3), junc(20, 85, 1)},
+			exons: [][]Exon{
+				{{10, 30}, {50, 60}, {80, 95}},
+				{{10, 30}, {80, 95}},
+			},
+			support: []int{5, 3},
+		},
+		{
+			// Alternative acceptor.
+			depth: depth(100, [3]int{10, 30, 10}, [3]int{50, 60, 2}, [3]int{60, 90, 10}),
+			js:    []*junction.Count{junc(30, 50, 2), junc(30, 60, 8)},
+			exons: [][]Exon{
+				{{10, 30}, {60, 90}},
+				{{10, 30}, {50, 90}},
+			},
+			support: []int{8, 2},
+		},
+		{
+			// Single exon.
+			depth:   depth(100, [3]int{10, 40, 3}),
+			exons:   [][]Exon{{{10, 40}}},
+			support: []int{0},
+		},
+	} {
+		isos, err := Assemble(Locus{Loc: chr("1"), Depth: t.depth}, t.js, p)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		var (
+			exons   [][]Exon
+			support []int
+		)
+		for _, iso := range isos {
+			exons = append(exons, iso.Exons)
+			support = append(support, iso.Support)
+		}
+		c.Check(exons, check.DeepEquals, t.exons, check.Commentf("Test %d", i))
+		c.Check(support, check.DeepEquals, t.support, check.Commentf("Test %d", i))
+	}
+
+	p.MinLength = 40
+	isos, err := Assemble(Locus{Loc: chr("1"), Depth: depth(100, [3]int{10, 40, 3})}, nil, p)
+	c.Check(err, check.Equals, nil)
+	c.Check(isos, check.HasLen, 0)
+
+	_, err = Assemble(Locus{}, nil, p)
+	c.Check(err, check.Equals, ErrNoLocation)
+
+	cassette := Locus{Loc: chr("1"), Depth: depth(100, [3]int{10, 30, 10}, [3]int{50, 60, 5}, [3]int{80, 95, 10})}
+	js := []*junction.Count{junc(30, 50, 5), junc(60, 80, 5), junc(30, 80, 3)}
+	p.MinLength = 0
+	for _, t := range []struct {
+		maxPaths int
+		n        int
+		err      error
+	}{
+		{maxPaths: 0, n: 2},
+		{maxPaths: 2, n: 2},
+		{maxPaths: 1, err: ErrTooMany},
+	} {
+		p.MaxPaths = t.maxPaths
+		isos, err = Assemble(cassette, js, p)
+		c.Check(err, check.Equals, t.err, check.Commentf("MaxPaths %d", t.maxPaths))
+		c.Check(isos, check.HasLen, t.n, check.Commentf("MaxPaths %d", t.maxPaths))
+	}
+}
+
+func (s *S) TestGTF(c *check.C) {
+	p := DefaultParams
+	p.MinLength = 0
+	isos, err := Assemble(Locus{
+		Loc:    chr("1"),
+		Offset: 1000,
+		Depth:  depth(100, [3]int{10, 30, 4}, [3]int{80, 95, 4}),
+	}, []*junction.Count{junc(1030, 1080, 4)}, p)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(isos, check.HasLen, 1)
+	c.Check(isos[0].Name(), check.Equals, "1:[1010,1095)")
+	c.Check(isos[0].Coverage, check.Equals, 4.)
+
+	fs := GTF(isos, "g1", "biogo")
+	c.Assert(fs, check.HasLen, 3)
+	c.Check(fs[0].FeatStrand, check.Equals, seq.Plus)
+
+	var buf bytes.Buffer
+	w := gff.NewWriter(&buf, 60, false)
+	for _, f := range fs {
+		_, err := w.Write(f)
+		c.Assert(err, check.Equals, nil)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	c.Check(lines, check.DeepEquals, []string{
+		"1\tbiogo\ttranscript\t1011\t1095\t4\t+\t.\tgene_id \"g1\"; transcript_id \"g1.1\"",
+		"1\tbiogo\texon\t1011\t1030\t.\t+\t.\tgene_id \"g1\"; transcript_id \"g1.1\"; exon_number \"1\"",
+		"1\tbiogo\texon\t1081\t1095\t.\t+\t.\tgene_id \"g1\"; transcript_id \"g1.1\"; exon_number \"2\"",
+	})
+}
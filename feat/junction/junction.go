@@ -0,0 +1,224 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package junction provides extraction and counting of splice junctions from
+// spliced alignments and gene annotations.
+package junction
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/gene"
+
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+var (
+	ErrBadCigar   = errors.New("junction: invalid CIGAR string")
+	ErrNoLocation = errors.New("junction: feature has no location")
+	ErrMixedLoc   = errors.New("junction: exons on different locations")
+	ErrOverlap    = errors.New("junction: exons overlap")
+)
+
+var (
+	_ feat.Feature  = (*Junction)(nil)
+	_ feat.Orienter = (*Junction)(nil)
+)
+
+// A Junction is a splice junction. The start and end of a junction are the
+// first and one past the last positions of the intron it removes.
+type Junction struct {
+	Loc    feat.Feature
+	JStart int
+	JEnd   int
+	Orient feat.Orientation
+}
+
+func (j *Junction) Start() int { return j.JStart }
+func (j *Junction) End() int   { return j.JEnd }
+func (j *Junction) Len() int   { return j.JEnd - j.JStart }
+func (j *Junction) Name() string {
+	return fmt.Sprintf("%s:[%d,%d)", j.Loc.Name(), j.JStart, j.JEnd)
+}
+func (j *Junction) Description() string           { return "splice junction" }
+func (j *Junction) Location() feat.Feature        { return j.Loc }
+func (j *Junction) Orientation() feat.Orientation { return j.Orient }
+
+// FromCIGAR returns the junctions described by the N operations of a CIGAR string
+// for an alignment starting at pos on loc. The junctions are given orientation o,
+// which should be feat.NotOriented unless the strand of the transcript is known.
+func FromCIGAR(loc feat.Feature, pos int, cigar string, o feat.Orientation) ([]*Junction, error) {
+	if cigar == "*" {
+		return nil, nil
+	}
+	var js []*Junction
+	for i := 0; i < len(cigar); {
+		j := i
+		for j < len(cigar) && '0' <= cigar[j] && cigar[j] <= '9' {
+			j++
+		}
+		if j == i || j == len(cigar) {
+			return nil, ErrBadCigar
+		}
+		n, err := strconv.Atoi(cigar[i:j])
+		if err != nil {
+			return nil, ErrBadCigar
+		}
+		switch cigar[j] {
+		case 'M', '=', 'X', 'D':
+			pos += n
+		case 'N':
+			js = append(js, &Junction{Loc: loc, JStart: pos, JEnd: pos + n, Orient: o})
+			pos += n
+		case 'I', 'S', 'H', 'P':
+		default:
+			return nil, ErrBadCigar
+		}
+		i = j + 1
+	}
+	return js, nil
+}
+
+// FromExons returns the junctions between consecutive exons. The exons may be in
+// any order but must not overlap. Exon coordinates are converted to the coordinates
+// of their base location, which must be the same for all exons, and that location
+// is used as the location of the returned junctions.
+func FromExons(exons []feat.Feature) ([]*Junction, error) {
+	var (
+		loc    feat.Feature
+		orient feat.Orientation
+		spans  = make(spans, 0, len(exons))
+	)
+	for i, e := range exons {
+		if e.Location() == nil {
+			return nil, ErrNoLocation
+		}
+		start, ref := feat.BasePositionOf(e, 0)
+		o, _ := feat.BaseOrientationOf(e)
+		if i == 0 {
+			loc, orient = ref, o
+		} else if ref != loc {
+			return nil, ErrMixedLoc
+		}
+		spans = append(spans, span{start, start + e.Len()})
+	}
+	sort.Sort(spans)
+	var js []*Junction
+	for i := 1; i < len(spans); i++ {
+		if spans[i].start < spans[i-1].end {
+			return nil, ErrOverlap
+		}
+		if spans[i].start == spans[i-1].end {
+			continue
+		}
+		js = append(js, &Junction{Loc: loc, JStart: spans[i-1].end, JEnd: spans[i].start, Orient: orient})
+	}
+	return js, nil
+}
+
+type span struct{ start, end int }
+
+type spans []span
+
+func (s spans) Len() int           { return len(s) }
+func (s spans) Less(i, j int) bool { return s[i].start < s[j].start }
+func (s spans) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// FromTranscript returns the junctions of the transcript t in the coordinates of
+// t's base location.
+func FromTranscript(t gene.Transcript) ([]*Junction, error) {
+	exons := t.Exons()
+	f := make([]feat.Feature, len(exons))
+	for i, e := range exons {
+		f[i] = e
+	}
+	return FromExons(f)
+}
+
+// A Count holds the read support for a junction.
+type Count struct {
+	*Junction
+
+	Reads int  // Number of supporting reads.
+	Known bool // Whether the junction is in the annotation.
+}
+
+type key struct {
+	loc        string
+	start, end int
+}
+
+func keyOf(j *Junction) key { return key{j.Loc.Name(), j.JStart, j.JEnd} }
+
+// A Counter counts read support for junctions and classifies them as known or
+// novel with respect to an annotation. Junctions are compared by location name,
+// start and end; orientation is not considered.
+type Counter struct {
+	counts map[key]*Count
+}
+
+// NewCounter returns a new Counter with the provided annotated junctions.
+func NewCounter(annotation []*Junction) *Counter {
+	c := &Counter{counts: make(map[key]*Count)}
+	for _, j := range annotation {
+		k := keyOf(j)
+		if _, ok := c.counts[k]; !ok {
+			c.counts[k] = &Count{Junction: j, Known: true}
+		}
+	}
+	return c
+}
+
+// Add adds a single read's support for the junctions js.
+func (c *Counter) Add(js ...*Junction) {
+	for _, j := range js {
+		k := keyOf(j)
+		n, ok := c.counts[k]
+		if !ok {
+			n = &Count{Junction: j}
+			c.counts[k] = n
+		}
+		n.Reads++
+	}
+}
+
+// Counts returns the counts for all annotated junctions and all observed novel
+// junctions, sorted by location name, start and end.
+func (c *Counter) Counts() []*Count {
+	return c.filter(func(*Count) bool { return true })
+}
+
+// Novel returns the counts for novel junctions supported by at least minReads reads,
+// sorted by location name, start and end.
+func (c *Counter) Novel(minReads int) []*Count {
+	return c.filter(func(n *Count) bool { return !n.Known && n.Reads >= minReads })
+}
+
+func (c *Counter) filter(keep func(*Count) bool) []*Count {
+	var cs []*Count
+	for _, n := range c.counts {
+		if keep(n) {
+			cs = append(cs, n)
+		}
+	}
+	sort.Sort(byPosition(cs))
+	return cs
+}
+
+type byPosition []*Count
+
+func (c byPosition) Len() int { return len(c) }
+func (c byPosition) Less(i, j int) bool {
+	ki, kj := keyOf(c[i].Junction), keyOf(c[j].Junction)
+	switch {
+	case ki.loc != kj.loc:
+		return ki.loc < kj.loc
+	case ki.start != kj.start:
+		return ki.start < kj.start
+	}
+	return ki.end < kj.end
+}
+func (c byPosition) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
@@ -0,0 +1,96 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package junction
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/gene"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type chr string
+
+func (c chr) Start() int             { return 0 }
+func (c chr) End() int               { return 0 }
+func (c chr) Len() int               { return 0 }
+func (c chr) Name() string           { return string(c) }
+func (c chr) Description() string    { return "chrom" }
+func (c chr) Location() feat.Feature { return nil }
+
+func spansOf(js []*Junction) [][2]int {
+	var s [][2]int
+	for _, j := range js {
+		s = append(s, [2]int{j.Start(), j.End()})
+	}
+	return s
+}
+
+func (s *S) TestFromCIGAR(c *check.C) {
+	for i, t := range []struct {
+		cigar  string
+		expect [][2]int
+		err    error
+	}{
+		{cigar: "50M", expect: nil},
+		{cigar: "10M100N20M5I10M200N5M", expect: [][2]int{{1010, 1110}, {1140, 1340}}},
+		{cigar: "5S10M2D10M50N10M3H", expect: [][2]int{{1022, 1072}}},
+		{cigar: "10M100", err: ErrBadCigar},
+		{cigar: "10Q", err: ErrBadCigar},
+	} {
+		js, err := FromCIGAR(chr("1"), 1000, t.cigar, feat.NotOriented)
+		c.Check(err, check.Equals, t.err, check.Commentf("Test %d", i))
+		c.Check(spansOf(js), check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestCounter(c *check.C) {
+	tr := &gene.NonCodingTranscript{ID: "tx", Loc: chr("1"), Offset: 1000, Orient: feat.Forward}
+	c.Assert(tr.SetExons(
+		gene.Exon{Transcript: tr, Offset: 0, Length: 10},
+		gene.Exon{Transcript: tr, Offset: 110, Length: 30},
+		gene.Exon{Transcript: tr, Offset: 340, Length: 50},
+	), check.Equals, nil)
+	ann, err := FromTranscript(tr)
+	c.Assert(err, check.Equals, nil)
+	c.Check(spansOf(ann), check.DeepEquals, [][2]int{{1010, 1110}, {1140, 1340}})
+	c.Check(ann[0].Location(), check.Equals, feat.Feature(chr("1")))
+
+	cnt := NewCounter(ann)
+	for _, cigar := range []string{"10M100N20M", "10M100N20M", "5M200N5M", "10M100N30M300N10M"} {
+		js, err := FromCIGAR(chr("1"), 1000, cigar, feat.NotOriented)
+		c.Assert(err, check.Equals, nil)
+		cnt.Add(js...)
+	}
+	var got []Count
+	for _, n := range cnt.Counts() {
+		got = append(got, Count{Reads: n.Reads, Known: n.Known})
+	}
+	c.Check(got, check.DeepEquals, []Count{
+		{Reads: 1, Known: false},
+		{Reads: 3, Known: true},
+		{Reads: 0, Known: true},
+		{Reads: 1, Known: false},
+	})
+	c.Check(spansOf(junctions(cnt.Counts())), check.DeepEquals, [][2]int{{1005, 1205}, {1010, 1110}, {1140, 1340}, {1140, 1440}})
+	c.Check(spansOf(junctions(cnt.Novel(1))), check.DeepEquals, [][2]int{{1005, 1205}, {1140, 1440}})
+}
+
+func junctions(cs []*Count) []*Junction {
+	js := make([]*Junction, len(cs))
+	for i, n := range cs {
+		js[i] = n.Junction
+	}
+	return js
+}
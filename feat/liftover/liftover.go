@@ -0,0 +1,195 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package liftover provides remapping of feature coordinates between genome
+// assemblies using UCSC chain alignments.
+package liftover
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio/chain"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrNoLocation = errors.New("liftover: feature has no location")
+	ErrBadFeature = errors.New("liftover: feature end less than start")
+	ErrUnmapped   = errors.New("liftover: feature could not be mapped")
+)
+
+var (
+	_ feat.Feature  = (*Segment)(nil)
+	_ feat.Orienter = (*Segment)(nil)
+)
+
+// A Segment is the part of a lifted feature that is mapped by a single chain.
+type Segment struct {
+	Loc      feat.Feature // The query sequence of the chain.
+	SegStart int
+	SegEnd   int
+	Orient   feat.Orientation
+
+	// Matched is the number of positions of
+	// the source feature that are aligned.
+	Matched int
+
+	Chain  *chain.Chain
+	Source feat.Feature
+}
+
+func (s *Segment) Start() int { return s.SegStart }
+func (s *Segment) End() int   { return s.SegEnd }
+func (s *Segment) Len() int   { return s.SegEnd - s.SegStart }
+func (s *Segment) Name() string {
+	if s.Source != nil {
+		return s.Source.Name()
+	}
+	return fmt.Sprintf("%s:[%d,%d)", s.Loc.Name(), s.SegStart, s.SegEnd)
+}
+func (s *Segment) Description() string           { return "lifted feature" }
+func (s *Segment) Location() feat.Feature        { return s.Loc }
+func (s *Segment) Orientation() feat.Orientation { return s.Orient }
+
+type chains []*chain.Chain
+
+func (c chains) Len() int           { return len(c) }
+func (c chains) Less(i, j int) bool { return c[i].TStart < c[j].TStart }
+func (c chains) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+// A Lifter maps features from the target assembly of a set of chains to the
+// query assembly.
+type Lifter struct {
+	chains map[string]chains
+	maxLen map[string]int
+
+	// MinMatch is the minimum fraction of a feature's
+	// positions that must be aligned by a chain for
+	// a segment to be reported.
+	MinMatch float64
+}
+
+// New returns a new Lifter using the provided chains.
+func New(c ...*chain.Chain) *Lifter {
+	l := &Lifter{
+		chains: make(map[string]chains),
+		maxLen: make(map[string]int),
+	}
+	for _, ch := range c {
+		name := ch.Target.Name()
+		l.chains[name] = append(l.chains[name], ch)
+		if ch.Len() > l.maxLen[name] {
+			l.maxLen[name] = ch.Len()
+		}
+	}
+	for _, c := range l.chains {
+		sort.Sort(c)
+	}
+	return l
+}
+
+// Lift returns the segments of the query assembly that f maps to, one for each chain
+// that aligns any part of f. Segments are ordered by chain score, highest first. The
+// chromosome of f is taken from the name of f's location and coordinates are taken to
+// be relative to that location. Positions within a segment that are not aligned by the
+// chain are included in the segment extent, but not counted in the segment's Matched
+// field. Features that map to the reverse strand of the query give segments with
+// reversed orientation; non-oriented features are treated as forward for this purpose.
+// If no chain aligns at least MinMatch of f, Lift returns ErrUnmapped.
+func (l *Lifter) Lift(f feat.Feature) ([]*Segment, error) {
+	if f.Location() == nil {
+		return nil, ErrNoLocation
+	}
+	start, end := f.Start(), f.End()
+	if end < start {
+		return nil, ErrBadFeature
+	}
+	fo := feat.Forward
+	if o, ok := f.(feat.Orienter); ok && o.Orientation() != feat.NotOriented {
+		fo = o.Orientation()
+	}
+
+	name := f.Location().Name()
+	cs := l.chains[name]
+	var segs []*Segment
+	// Chains are sorted by start, so any chain that overlaps f must start after
+	// the feature start less the length of the longest chain.
+	i := sort.Search(len(cs), func(i int) bool { return cs[i].TStart >= start-l.maxLen[name] })
+	for _, c := range cs[i:] {
+		if c.TStart >= end {
+			break
+		}
+		if c.TEnd <= start {
+			continue
+		}
+		if s := lift(c, start, end); s != nil {
+			if float64(s.Matched) >= l.MinMatch*float64(end-start) {
+				s.Orient *= fo
+				s.Source = f
+				segs = append(segs, s)
+			}
+		}
+	}
+	if len(segs) == 0 {
+		return nil, ErrUnmapped
+	}
+	sort.Stable(byScore(segs))
+
+	return segs, nil
+}
+
+// lift returns the segment of the chain c query that corresponds to [start, end)
+// in the target or nil if no block of c overlaps [start, end).
+func lift(c *chain.Chain, start, end int) *Segment {
+	var (
+		s      *Segment
+		qs, qe int
+	)
+	for _, b := range c.Blocks {
+		bEnd := b.TStart + b.Size
+		if bEnd <= start {
+			continue
+		}
+		if b.TStart >= end {
+			break
+		}
+		ts, te := max(start, b.TStart), min(end, bEnd)
+		bqs := b.QStart + ts - b.TStart
+		bqe := bqs + te - ts
+		if s == nil {
+			s = &Segment{Loc: c.Query, Orient: c.Orientation(), Chain: c}
+			qs, qe = bqs, bqe
+		} else {
+			qs, qe = min(qs, bqs), max(qe, bqe)
+		}
+		s.Matched += te - ts
+	}
+	if s == nil {
+		return nil
+	}
+	s.SegStart, s.SegEnd = c.ForwardQuery(qs, qe)
+	return s
+}
+
+type byScore []*Segment
+
+func (s byScore) Len() int           { return len(s) }
+func (s byScore) Less(i, j int) bool { return s[i].Chain.Score > s[j].Chain.Score }
+func (s byScore) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
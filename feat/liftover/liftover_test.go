@@ -0,0 +1,130 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package liftover
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio/chain"
+
+	"bytes"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type chrom string
+
+func (c chrom) Start() int             { return 0 }
+func (c chrom) End() int               { return 0 }
+func (c chrom) Len() int               { return 0 }
+func (c chrom) Name() string           { return string(c) }
+func (c chrom) Description() string    { return "chrom" }
+func (c chrom) Location() feat.Feature { return nil }
+
+type feature struct {
+	start, end int
+	orient     feat.Orientation
+	chrom      chrom
+}
+
+func (f *feature) Start() int                    { return f.start }
+func (f *feature) End() int                      { return f.end }
+func (f *feature) Len() int                      { return f.end - f.start }
+func (f *feature) Name() string                  { return "test" }
+func (f *feature) Description() string           { return "test feature" }
+func (f *feature) Location() feat.Feature        { return f.chrom }
+func (f *feature) Orientation() feat.Orientation { return f.orient }
+
+const chainData = `chain 1000 chr1 1000 + 100 200 chrA 500 + 0 95 1
+50	10	5
+40
+
+chain 500 chr1 1000 + 120 170 chrB 400 - 10 60 2
+50
+`
+
+func readChains(c *check.C) []*chain.Chain {
+	r := chain.NewReader(bytes.NewBufferString(chainData))
+	var cs []*chain.Chain
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		cs = append(cs, f.(*chain.Chain))
+	}
+	return cs
+}
+
+func (s *S) TestLift(c *check.C) {
+	l := New(readChains(c)...)
+	type seg struct {
+		chrom      string
+		start, end int
+		orient     feat.Orientation
+		matched    int
+	}
+	for i, t := range []struct {
+		f      *feature
+		expect []seg
+		err    error
+	}{
+		{
+			f: &feature{start: 120, end: 170, orient: feat.Forward, chrom: "chr1"},
+			expect: []seg{
+				{chrom: "chrA", start: 20, end: 65, orient: feat.Forward, matched: 40},
+				{chrom: "chrB", start: 340, end: 390, orient: feat.Reverse, matched: 50},
+			},
+		},
+		{
+			f: &feature{start: 100, end: 110, orient: feat.Reverse, chrom: "chr1"},
+			expect: []seg{
+				{chrom: "chrA", start: 0, end: 10, orient: feat.Reverse, matched: 10},
+			},
+		},
+		{
+			f: &feature{start: 130, end: 140, chrom: "chr1"},
+			expect: []seg{
+				{chrom: "chrA", start: 30, end: 40, orient: feat.Forward, matched: 10},
+				{chrom: "chrB", start: 370, end: 380, orient: feat.Reverse, matched: 10},
+			},
+		},
+		{
+			f:   &feature{start: 500, end: 510, chrom: "chr1"},
+			err: ErrUnmapped,
+		},
+		{
+			f:   &feature{start: 100, end: 110, chrom: "chr2"},
+			err: ErrUnmapped,
+		},
+	} {
+		segs, err := l.Lift(t.f)
+		c.Check(err, check.Equals, t.err, check.Commentf("Test %d", i))
+		var got []seg
+		for _, s := range segs {
+			got = append(got, seg{s.Location().Name(), s.Start(), s.End(), s.Orientation(), s.Matched})
+			c.Check(s.Source, check.Equals, feat.Feature(t.f))
+		}
+		c.Check(got, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestMinMatch(c *check.C) {
+	l := New(readChains(c)...)
+	l.MinMatch = 0.9
+	segs, err := l.Lift(&feature{start: 140, end: 170, chrom: "chr1"})
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(segs), check.Equals, 1)
+	c.Check(segs[0].Location().Name(), check.Equals, "chrB")
+}
@@ -0,0 +1,368 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package location provides parsing and evaluation of the feature location
+// expressions used by the GenBank, EMBL and DDBJ flat file formats, such as
+// complement(join(<1..200,300..>450)).
+//
+// A parsed Location is a feat.Set of Spans in the order given by the expression,
+// with complemented spans reversed in order and orientation, so a Location can
+// be passed directly to sequtils.Compose to obtain the sequence it describes,
+// or to sequtils.Stitch to obtain the covered region on the forward strand.
+package location
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	ErrSyntax   = errors.New("location: syntax error")
+	ErrBadRange = errors.New("location: bad range")
+	ErrEmpty    = errors.New("location: empty location")
+)
+
+var (
+	_ feat.Feature    = (*Span)(nil)
+	_ feat.Orienter   = (*Span)(nil)
+	_ feat.Feature    = (*Location)(nil)
+	_ feat.Collection = (*Location)(nil)
+)
+
+// A Span is a contiguous interval of a location. Coordinates are zero-based
+// half-open.
+type Span struct {
+	// Loc is the sequence on which the span lies.
+	// It is nil for spans on a remote sequence.
+	Loc feat.Feature
+
+	// Accession is the accession of the remote
+	// sequence given in the expression, and is
+	// empty for spans on the local sequence.
+	Accession string
+
+	SpanStart, SpanEnd int
+	Orient             feat.Orientation
+
+	// Partial5 and Partial3 indicate that the
+	// span extends beyond its start or end, and
+	// were written as < and > respectively.
+	Partial5, Partial3 bool
+
+	// Site indicates a site between two adjacent
+	// bases, written as a^b, represented by a
+	// zero length span.
+	Site bool
+
+	// Uncertain indicates a single base at an
+	// unknown position within the span, written
+	// as a.b.
+	Uncertain bool
+}
+
+func (s *Span) Start() int                    { return s.SpanStart }
+func (s *Span) End() int                      { return s.SpanEnd }
+func (s *Span) Len() int                      { return s.SpanEnd - s.SpanStart }
+func (s *Span) Name() string                  { return s.String() }
+func (s *Span) Description() string           { return "location span" }
+func (s *Span) Location() feat.Feature        { return s.Loc }
+func (s *Span) Orientation() feat.Orientation { return s.Orient }
+
+// String returns the location expression for the span.
+func (s *Span) String() string {
+	var buf bytes.Buffer
+	if s.Orient == feat.Reverse {
+		buf.WriteString("complement(")
+	}
+	if s.Accession != "" {
+		buf.WriteString(s.Accession)
+		buf.WriteByte(':')
+	}
+	switch {
+	case s.Site:
+		fmt.Fprintf(&buf, "%d^%d", s.SpanStart, s.SpanStart+1)
+	case s.Uncertain:
+		fmt.Fprintf(&buf, "%d.%d", s.SpanStart+1, s.SpanEnd)
+	default:
+		if s.Partial5 {
+			buf.WriteByte('<')
+		}
+		fmt.Fprint(&buf, s.SpanStart+1)
+		if s.SpanEnd-s.SpanStart != 1 || s.Partial5 || s.Partial3 {
+			buf.WriteString("..")
+			if s.Partial3 {
+				buf.WriteByte('>')
+			}
+			fmt.Fprint(&buf, s.SpanEnd)
+		}
+	}
+	if s.Orient == feat.Reverse {
+		buf.WriteByte(')')
+	}
+	return buf.String()
+}
+
+// A Location is an evaluated location expression.
+type Location struct {
+	Loc   feat.Feature
+	Spans []*Span
+
+	// Order indicates that the expression used
+	// the order operator, so the spans are not
+	// known to be joined in the product.
+	Order bool
+}
+
+// Start returns the lowest start of the spans of the location.
+func (l *Location) Start() int {
+	s := l.Spans[0].Start()
+	for _, sp := range l.Spans[1:] {
+		if sp.Start() < s {
+			s = sp.Start()
+		}
+	}
+	return s
+}
+
+// End returns the highest end of the spans of the location.
+func (l *Location) End() int {
+	e := l.Spans[0].End()
+	for _, sp := range l.Spans[1:] {
+		if sp.End() > e {
+			e = sp.End()
+		}
+	}
+	return e
+}
+
+func (l *Location) Len() int               { return l.End() - l.Start() }
+func (l *Location) Name() string           { return l.String() }
+func (l *Location) Description() string    { return "location" }
+func (l *Location) Location() feat.Feature { return l.Loc }
+
+// Features returns the spans of the location in the order they contribute to the
+// described sequence.
+func (l *Location) Features() []feat.Feature {
+	fs := make([]feat.Feature, len(l.Spans))
+	for i, s := range l.Spans {
+		fs[i] = s
+	}
+	return fs
+}
+
+// Remote returns whether any span of the location is on a remote sequence.
+func (l *Location) Remote() bool {
+	for _, s := range l.Spans {
+		if s.Accession != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a location expression for l. The expression is a join or
+// order of the spans of l, each complemented if on the reverse strand.
+func (l *Location) String() string {
+	if len(l.Spans) == 1 {
+		return l.Spans[0].String()
+	}
+	var buf bytes.Buffer
+	if l.Order {
+		buf.WriteString("order(")
+	} else {
+		buf.WriteString("join(")
+	}
+	for i, s := range l.Spans {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(s.String())
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+// Parse parses the location expression s describing a location on the
+// sequence ref, which may be nil. Whitespace in s is ignored.
+func Parse(s string, ref feat.Feature) (*Location, error) {
+	p := &parser{s: stripSpace(s), ref: ref}
+	if len(p.s) == 0 {
+		return nil, ErrEmpty
+	}
+	l := &Location{Loc: ref}
+	spans, err := p.expr(l)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.s) {
+		return nil, p.error()
+	}
+	l.Spans = spans
+	return l, nil
+}
+
+func stripSpace(s string) string {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case ' ', '\t', '\n', '\r':
+		default:
+			b = append(b, s[i])
+		}
+	}
+	return string(b)
+}
+
+type parser struct {
+	s   string
+	pos int
+	ref feat.Feature
+}
+
+func (p *parser) error() error {
+	return fmt.Errorf("%v at offset %d", ErrSyntax, p.pos)
+}
+
+func (p *parser) consume(tok string) bool {
+	if len(p.s)-p.pos >= len(tok) && p.s[p.pos:p.pos+len(tok)] == tok {
+		p.pos += len(tok)
+		return true
+	}
+	return false
+}
+
+// expr parses an operator expression or a span.
+func (p *parser) expr(l *Location) ([]*Span, error) {
+	switch {
+	case p.consume("complement("):
+		spans, err := p.expr(l)
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.error()
+		}
+		for i, j := 0, len(spans)-1; i < j; i, j = i+1, j-1 {
+			spans[i], spans[j] = spans[j], spans[i]
+		}
+		for _, s := range spans {
+			if s.Orient == feat.Reverse {
+				s.Orient = feat.Forward
+			} else {
+				s.Orient = feat.Reverse
+			}
+		}
+		return spans, nil
+	case p.consume("join("):
+		return p.list(l)
+	case p.consume("order("):
+		l.Order = true
+		return p.list(l)
+	}
+	s, err := p.span()
+	if err != nil {
+		return nil, err
+	}
+	return []*Span{s}, nil
+}
+
+// list parses a comma separated list of expressions and the closing
+// parenthesis.
+func (p *parser) list(l *Location) ([]*Span, error) {
+	var spans []*Span
+	for {
+		s, err := p.expr(l)
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, s...)
+		if p.consume(")") {
+			return spans, nil
+		}
+		if !p.consume(",") {
+			return nil, p.error()
+		}
+	}
+}
+
+// span parses a single span with an optional remote accession.
+func (p *parser) span() (*Span, error) {
+	s := &Span{Loc: p.ref, Orient: feat.Forward}
+	for i := p.pos; i < len(p.s); i++ {
+		c := p.s[i]
+		if c == ':' {
+			s.Accession = p.s[p.pos:i]
+			s.Loc = nil
+			p.pos = i + 1
+			break
+		}
+		if c == ',' || c == '(' || c == ')' {
+			break
+		}
+	}
+
+	s.Partial5 = p.consume("<")
+	a, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	start := p.pos
+	switch {
+	case p.consume(".."):
+		s.Partial3 = p.consume(">")
+		b, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		if b < a {
+			p.pos = start
+			return nil, fmt.Errorf("%v at offset %d", ErrBadRange, p.pos)
+		}
+		s.SpanStart, s.SpanEnd = a-1, b
+	case p.consume("^"):
+		b, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		if s.Partial5 || (b != a+1 && b != 1) {
+			p.pos = start
+			return nil, fmt.Errorf("%v at offset %d", ErrBadRange, p.pos)
+		}
+		s.SpanStart, s.SpanEnd, s.Site = a, a, true
+	case p.consume("."):
+		b, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		if s.Partial5 || b < a {
+			p.pos = start
+			return nil, fmt.Errorf("%v at offset %d", ErrBadRange, p.pos)
+		}
+		s.SpanStart, s.SpanEnd, s.Uncertain = a-1, b, true
+	default:
+		s.SpanStart, s.SpanEnd = a-1, a
+	}
+	return s, nil
+}
+
+// number parses a positive decimal integer.
+func (p *parser) number() (int, error) {
+	i := p.pos
+	for i < len(p.s) && '0' <= p.s[i] && p.s[i] <= '9' {
+		i++
+	}
+	if i == p.pos {
+		return 0, p.error()
+	}
+	n, err := strconv.Atoi(p.s[p.pos:i])
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%v at offset %d", ErrBadRange, p.pos)
+	}
+	p.pos = i
+	return n, nil
+}
@@ -0,0 +1,132 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package location
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/sequtils"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type span struct {
+	start, end int
+	orient     feat.Orientation
+}
+
+func (s *S) TestParse(c *check.C) {
+	for i, t := range []struct {
+		expr   string
+		spans  []span
+		order  bool
+		remote bool
+		str    string
+	}{
+		{expr: "467", spans: []span{{466, 467, feat.Forward}}, str: "467"},
+		{expr: "340..565", spans: []span{{339, 565, feat.Forward}}, str: "340..565"},
+		{expr: "<345..500", spans: []span{{344, 500, feat.Forward}}, str: "<345..500"},
+		{expr: "<1..>888", spans: []span{{0, 888, feat.Forward}}, str: "<1..>888"},
+		{expr: "102.110", spans: []span{{101, 110, feat.Forward}}, str: "102.110"},
+		{expr: "123^124", spans: []span{{123, 123, feat.Forward}}, str: "123^124"},
+		{
+			expr:  "join(12..78, 134..202)",
+			spans: []span{{11, 78, feat.Forward}, {133, 202, feat.Forward}},
+			str:   "join(12..78,134..202)",
+		},
+		{
+			expr:  "complement(34..126)",
+			spans: []span{{33, 126, feat.Reverse}},
+			str:   "complement(34..126)",
+		},
+		{
+			expr:  "complement(join(2691..4571,4918..5163))",
+			spans: []span{{4917, 5163, feat.Reverse}, {2690, 4571, feat.Reverse}},
+			str:   "join(complement(4918..5163),complement(2691..4571))",
+		},
+		{
+			expr:  "join(complement(4918..5163),complement(2691..4571))",
+			spans: []span{{4917, 5163, feat.Reverse}, {2690, 4571, feat.Reverse}},
+			str:   "join(complement(4918..5163),complement(2691..4571))",
+		},
+		{
+			expr:  "complement(join(1..5,complement(10..20)))",
+			spans: []span{{9, 20, feat.Forward}, {0, 5, feat.Reverse}},
+			str:   "join(10..20,complement(1..5))",
+		},
+		{
+			expr:  "order(1..10,20..30)",
+			spans: []span{{0, 10, feat.Forward}, {19, 30, feat.Forward}},
+			order: true,
+			str:   "order(1..10,20..30)",
+		},
+		{
+			expr:   "join(1..100,J00194.1:100..202)",
+			spans:  []span{{0, 100, feat.Forward}, {99, 202, feat.Forward}},
+			remote: true,
+			str:    "join(1..100,J00194.1:100..202)",
+		},
+	} {
+		l, err := Parse(t.expr, nil)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		var got []span
+		for _, s := range l.Spans {
+			got = append(got, span{s.Start(), s.End(), s.Orientation()})
+		}
+		c.Check(got, check.DeepEquals, t.spans, check.Commentf("Test %d", i))
+		c.Check(l.Order, check.Equals, t.order, check.Commentf("Test %d", i))
+		c.Check(l.Remote(), check.Equals, t.remote, check.Commentf("Test %d", i))
+		c.Check(l.String(), check.Equals, t.str, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestParseErrors(c *check.C) {
+	for i, t := range []struct {
+		expr string
+		err  string
+	}{
+		{"", "location: empty location"},
+		{"join(1..10", `location: syntax error at offset 10`},
+		{"join(1..10,)", `location: syntax error at offset 11`},
+		{"10..1", `location: bad range at offset 2`},
+		{"0..10", `location: bad range at offset 0`},
+		{"5^7", `location: bad range at offset 1`},
+		{"1..10x", `location: syntax error at offset 5`},
+		{"complement(1..10", `location: syntax error at offset 16`},
+	} {
+		_, err := Parse(t.expr, nil)
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestCompose(c *check.C) {
+	ref := linear.NewSeq("ref", alphabet.BytesToLetters([]byte("AAACCCGGGTTTacgt")), alphabet.DNA)
+	l, err := Parse("complement(join(1..3,7..9))", ref)
+	c.Assert(err, check.Equals, nil)
+	c.Check(l.Location(), check.Equals, ref)
+	c.Check(l.Start(), check.Equals, 0)
+	c.Check(l.End(), check.Equals, 9)
+
+	dst := &linear.Seq{}
+	c.Assert(sequtils.Compose(dst, ref, l), check.Equals, nil)
+	c.Check(dst.String(), check.Equals, "CCCTTT")
+
+	l, err = Parse("join(13..16,1..3)", ref)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(sequtils.Compose(dst, ref, l), check.Equals, nil)
+	c.Check(dst.String(), check.Equals, "acgtAAA")
+	c.Assert(sequtils.Stitch(dst, ref, l), check.Equals, nil)
+	c.Check(dst.String(), check.Equals, "AAAacgt")
+}
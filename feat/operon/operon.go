@@ -0,0 +1,173 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package operon provides heuristic prediction of operons from prokaryotic gene
+// features.
+//
+// Adjacent genes are grouped into a predicted transcription unit when they are
+// on the same strand and the intergenic distance between them is small. The
+// distance threshold may be relaxed for gene pairs that are supported by
+// external evidence, for example conservation of gene order across genomes.
+package operon
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrNotOriented = errors.New("operon: gene feature not oriented")
+	ErrNoLocation  = errors.New("operon: gene feature has no location")
+)
+
+var (
+	_ feat.Feature  = (*Operon)(nil)
+	_ feat.Orienter = (*Operon)(nil)
+	_ feat.Set      = (*Operon)(nil)
+)
+
+// Params describes the criteria used to join adjacent genes into operons.
+type Params struct {
+	// MaxDistance is the maximum intergenic distance between
+	// adjacent genes in the same operon. Overlapping genes have
+	// a negative intergenic distance.
+	MaxDistance int
+
+	// Conserved is an optional function reporting whether
+	// the adjacency of the genes a and b, a upstream of b,
+	// is supported by conservation evidence.
+	Conserved func(a, b feat.Feature) bool
+
+	// MaxConservedDistance is the maximum intergenic distance
+	// between adjacent genes that are reported as conserved.
+	MaxConservedDistance int
+}
+
+// DefaultParams are commonly used thresholds for operon prediction.
+var DefaultParams = Params{
+	MaxDistance:          50,
+	MaxConservedDistance: 300,
+}
+
+// An Operon is a predicted transcription unit.
+type Operon struct {
+	Loc     feat.Feature
+	OpStart int
+	OpEnd   int
+	Orient  feat.Orientation
+
+	// Genes holds the genes of the operon
+	// in order of transcription.
+	Genes []feat.Feature
+}
+
+func (o *Operon) Start() int { return o.OpStart }
+func (o *Operon) End() int   { return o.OpEnd }
+func (o *Operon) Len() int   { return o.OpEnd - o.OpStart }
+func (o *Operon) Name() string {
+	return fmt.Sprintf("%s:[%d,%d)", o.Loc.Name(), o.OpStart, o.OpEnd)
+}
+func (o *Operon) Description() string           { return "predicted operon" }
+func (o *Operon) Location() feat.Feature        { return o.Loc }
+func (o *Operon) Orientation() feat.Orientation { return o.Orient }
+
+// Features returns the genes of the operon.
+func (o *Operon) Features() []feat.Feature { return o.Genes }
+
+type byLocStart []feat.Feature
+
+func (f byLocStart) Len() int { return len(f) }
+func (f byLocStart) Less(i, j int) bool {
+	li, lj := f[i].Location().Name(), f[j].Location().Name()
+	if li != lj {
+		return li < lj
+	}
+	return f[i].Start() < f[j].Start()
+}
+func (f byLocStart) Swap(i, j int) { f[i], f[j] = f[j], f[i] }
+
+// Predict groups genes into predicted operons according to p. Genes must be
+// oriented and located; genes on locations with the same name are considered to
+// be on the same sequence. Every gene is placed in exactly one operon, so genes
+// without suitable neighbours form single gene transcription units. The returned
+// operons are sorted by location name and start position.
+func Predict(genes []feat.Feature, p Params) ([]*Operon, error) {
+	g := make([]feat.Feature, len(genes))
+	for i, f := range genes {
+		if f.Location() == nil {
+			return nil, ErrNoLocation
+		}
+		if orientation(f) == feat.NotOriented {
+			return nil, ErrNotOriented
+		}
+		g[i] = f
+	}
+	sort.Stable(byLocStart(g))
+
+	var (
+		ops []*Operon
+		op  *Operon
+	)
+	for i, f := range g {
+		if op != nil && !p.joins(g[i-1], f) {
+			ops = append(ops, op.finalise())
+			op = nil
+		}
+		if op == nil {
+			op = &Operon{Loc: f.Location(), OpStart: f.Start(), OpEnd: f.End(), Orient: orientation(f)}
+		}
+		op.Genes = append(op.Genes, f)
+		if f.End() > op.OpEnd {
+			op.OpEnd = f.End()
+		}
+	}
+	if op != nil {
+		ops = append(ops, op.finalise())
+	}
+
+	return ops, nil
+}
+
+// joins returns whether the adjacent genes a and b, with a.Start() <= b.Start(),
+// should be placed in the same operon.
+func (p Params) joins(a, b feat.Feature) bool {
+	if a.Location().Name() != b.Location().Name() {
+		return false
+	}
+	o := orientation(a)
+	if o != orientation(b) {
+		return false
+	}
+	d := b.Start() - a.End()
+	if d <= p.MaxDistance {
+		return true
+	}
+	if p.Conserved == nil || d > p.MaxConservedDistance {
+		return false
+	}
+	if o == feat.Reverse {
+		a, b = b, a
+	}
+	return p.Conserved(a, b)
+}
+
+// finalise places the genes of the operon in order of transcription.
+func (o *Operon) finalise() *Operon {
+	if o.Orient == feat.Reverse {
+		for i, j := 0, len(o.Genes)-1; i < j; i, j = i+1, j-1 {
+			o.Genes[i], o.Genes[j] = o.Genes[j], o.Genes[i]
+		}
+	}
+	return o
+}
+
+func orientation(f feat.Feature) feat.Orientation {
+	if o, ok := f.(feat.Orienter); ok {
+		return o.Orientation()
+	}
+	return feat.NotOriented
+}
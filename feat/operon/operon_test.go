@@ -0,0 +1,85 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package operon
+
+import (
+	"github.com/biogo/biogo/feat"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type chrom string
+
+func (c chrom) Start() int             { return 0 }
+func (c chrom) End() int               { return 0 }
+func (c chrom) Len() int               { return 0 }
+func (c chrom) Name() string           { return string(c) }
+func (c chrom) Description() string    { return "chrom" }
+func (c chrom) Location() feat.Feature { return nil }
+
+type gene struct {
+	name       string
+	start, end int
+	orient     feat.Orientation
+	chrom      chrom
+}
+
+func (g *gene) Start() int                    { return g.start }
+func (g *gene) End() int                      { return g.end }
+func (g *gene) Len() int                      { return g.end - g.start }
+func (g *gene) Name() string                  { return g.name }
+func (g *gene) Description() string           { return "gene" }
+func (g *gene) Location() feat.Feature        { return g.chrom }
+func (g *gene) Orientation() feat.Orientation { return g.orient }
+
+var genes = []feat.Feature{
+	&gene{"b", 1020, 2000, feat.Forward, "chr"},
+	&gene{"a", 0, 1000, feat.Forward, "chr"},
+	&gene{"c", 2200, 3000, feat.Forward, "chr"},
+	&gene{"d", 3010, 4000, feat.Reverse, "chr"},
+	&gene{"e", 3990, 5000, feat.Reverse, "chr"},
+	&gene{"f", 0, 500, feat.Forward, "plasmid"},
+}
+
+func names(ops []*Operon) [][]string {
+	var n [][]string
+	for _, o := range ops {
+		var g []string
+		for _, f := range o.Features() {
+			g = append(g, f.Name())
+		}
+		n = append(n, g)
+	}
+	return n
+}
+
+func (s *S) TestPredict(c *check.C) {
+	ops, err := Predict(genes, DefaultParams)
+	c.Assert(err, check.Equals, nil)
+	c.Check(names(ops), check.DeepEquals, [][]string{{"a", "b"}, {"c"}, {"e", "d"}, {"f"}})
+	c.Check(ops[0].Start(), check.Equals, 0)
+	c.Check(ops[0].End(), check.Equals, 2000)
+	c.Check(ops[2].Orientation(), check.Equals, feat.Reverse)
+
+	p := DefaultParams
+	p.Conserved = func(a, b feat.Feature) bool { return a.Name() == "b" && b.Name() == "c" }
+	ops, err = Predict(genes, p)
+	c.Assert(err, check.Equals, nil)
+	c.Check(names(ops), check.DeepEquals, [][]string{{"a", "b", "c"}, {"e", "d"}, {"f"}})
+}
+
+func (s *S) TestErrors(c *check.C) {
+	_, err := Predict([]feat.Feature{&gene{"a", 0, 10, feat.NotOriented, "chr"}}, DefaultParams)
+	c.Check(err, check.Equals, ErrNotOriented)
+}
@@ -14,6 +14,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 )
 
 var (
@@ -28,12 +29,19 @@ var (
 
 var Debug = false // Set Debug to true to prevent recovering from panics in ForEachKmer f Eval function.
 
-// 2-bit per base packed word
-type Kmer uint32 // Sensible size for word type uint64 will double the size of the index (already large for high k)
+// 2-bit per base packed word, holding kmers of length up to 32.
+type Kmer uint64
 
 // Kmer index type
+//
+// Indexes with k up to MaxDenseKmerLen hold a finger table with an entry for every
+// possible Kmer, indexed by Kmer value. Indexes with longer k hold entries only for
+// the Kmers present in the sequence, in Kmer order; the finger table for these is
+// indexed by the rank of the Kmer among them.
 type Index struct {
-	finger  []Kmer
+	finger  []uint32
+	keys    []Kmer // present Kmers in order for sparse indexes
+	sparse  bool
 	pos     []int
 	seq     *linear.Seq
 	lookUp  alphabet.Index
@@ -56,14 +64,19 @@ func New(k int, s *linear.Seq) (*Index, error) {
 	}
 
 	ki := &Index{
-		finger:  make([]Kmer, util.Pow4(k)+1), // Need a Tn+1 finger position so that Tn can be recognised
 		k:       k,
-		kMask:   Kmer(util.Pow4(k) - 1),
+		kMask:   Kmer(1)<<(2*uint(k)) - 1, // Wraps to all bits set for k = 32.
 		seq:     s,
 		lookUp:  s.Alpha.LetterIndex(),
+		sparse:  k > MaxDenseKmerLen,
 		indexed: false,
 	}
-	ki.buildKmerTable()
+	if ki.sparse {
+		ki.buildSparseKmerTable()
+	} else {
+		ki.finger = make([]uint32, util.Pow4(k)+1) // Need a Tn+1 finger position so that Tn can be recognised
+		ki.buildKmerTable()
+	}
 
 	return ki, nil
 }
@@ -76,9 +89,51 @@ func (ki *Index) buildKmerTable() {
 	ki.ForEachKmerOf(ki.seq, 0, ki.seq.Len(), incrementFinger)
 }
 
+type kmerPos struct {
+	kmer Kmer
+	pos  int
+}
+
+type kmerPosByKmer []kmerPos
+
+func (kp kmerPosByKmer) Len() int           { return len(kp) }
+func (kp kmerPosByKmer) Less(i, j int) bool { return kp[i].kmer < kp[j].kmer }
+func (kp kmerPosByKmer) Swap(i, j int)      { kp[i], kp[j] = kp[j], kp[i] }
+
+// Build the table of frequencies of present Kmers and their positions in Kmer order - called by New
+func (ki *Index) buildSparseKmerTable() {
+	var kp []kmerPos
+	ki.ForEachKmerOf(ki.seq, 0, ki.seq.Len(), func(_ *Index, position, kmer int) {
+		kp = append(kp, kmerPos{kmer: Kmer(kmer), pos: position})
+	})
+	sort.Stable(kmerPosByKmer(kp))
+
+	ki.pos = make([]int, len(kp))
+	for i, e := range kp {
+		if i == 0 || e.kmer != kp[i-1].kmer {
+			ki.keys = append(ki.keys, e.kmer)
+			ki.finger = append(ki.finger, 0)
+		}
+		ki.finger[len(ki.finger)-1]++
+		ki.pos[i] = e.pos
+	}
+}
+
 // Build the Kmer position table destructively replacing Kmer frequencies
 func (ki *Index) Build() {
-	var sum Kmer
+	if ki.sparse {
+		// Positions are placed by New, so only the
+		// frequencies need to be made cumulative.
+		var sum uint32
+		for i, v := range ki.finger {
+			sum += v
+			ki.finger[i] = sum
+		}
+		ki.indexed = true
+		return
+	}
+
+	var sum uint32
 	for i, v := range ki.finger {
 		ki.finger[i], sum = sum, sum+v
 	}
@@ -93,6 +148,36 @@ func (ki *Index) Build() {
 	ki.indexed = true
 }
 
+// slot returns the finger table index for kmer and whether kmer has an entry.
+func (ki *Index) slot(kmer Kmer) (int, bool) {
+	if !ki.sparse {
+		return int(kmer), kmer <= ki.kMask
+	}
+	i := sort.Search(len(ki.keys), func(i int) bool { return ki.keys[i] >= kmer })
+	return i, i < len(ki.keys) && ki.keys[i] == kmer
+}
+
+// kmerAt returns the Kmer of the finger table entry at i.
+func (ki *Index) kmerAt(i int) Kmer {
+	if ki.sparse {
+		return ki.keys[i]
+	}
+	return Kmer(i)
+}
+
+// KmerRange returns the range [lo, hi) of positions in the pos table holding the
+// positions of kmer, as returned by PosAt. It is only valid after Build.
+func (ki *Index) KmerRange(kmer Kmer) (lo, hi int) {
+	i, ok := ki.slot(kmer)
+	if !ok {
+		return 0, 0
+	}
+	if i > 0 {
+		lo = int(ki.finger[i-1])
+	}
+	return lo, int(ki.finger[i])
+}
+
 // Return an array of positions for the Kmer string kmertext
 func (ki *Index) KmerPositionsString(kmertext string) (positions []int, err error) {
 	switch {
@@ -116,11 +201,7 @@ func (ki *Index) KmerPositions(kmer Kmer) (positions []int, err error) {
 		return nil, ErrBadKmer
 	}
 
-	i := Kmer(0)
-	if kmer > 0 { // special case: An has no predecessor
-		i = ki.finger[kmer-1]
-	}
-	j := ki.finger[kmer]
+	i, j := ki.KmerRange(kmer)
 	if i == j {
 		return
 	}
@@ -144,7 +225,7 @@ func (ki *Index) KmerFrequencies() (map[Kmer]int, bool) {
 
 	for i, f := range ki.finger {
 		if f > 0 {
-			m[Kmer(i)] = int(f) // not always safe - perhaps check that Kmer <= MaxInt
+			m[ki.kmerAt(i)] = int(f)
 		}
 	}
 
@@ -163,7 +244,7 @@ func (ki *Index) NormalisedKmerFrequencies() (map[Kmer]float64, bool) {
 	l := float64(ki.seq.Len())
 	for i, f := range ki.finger {
 		if f > 0 {
-			m[Kmer(i)] = float64(f) / l
+			m[ki.kmerAt(i)] = float64(f) / l
 		}
 	}
 
@@ -180,8 +261,8 @@ func (ki *Index) KmerIndex() (map[Kmer][]int, bool) {
 	m := make(map[Kmer][]int)
 
 	for i := range ki.finger {
-		if p, _ := ki.KmerPositions(Kmer(i)); len(p) > 0 {
-			m[Kmer(i)] = p
+		if p, _ := ki.KmerPositions(ki.kmerAt(i)); len(p) > 0 {
+			m[ki.kmerAt(i)] = p
 		}
 	}
 
@@ -198,8 +279,8 @@ func (ki *Index) StringKmerIndex() (map[string][]int, bool) {
 	m := make(map[string][]int)
 
 	for i := range ki.finger {
-		if p, _ := ki.KmerPositions(Kmer(i)); len(p) > 0 {
-			m[ki.Format(Kmer(i))] = p
+		if p, _ := ki.KmerPositions(ki.kmerAt(i)); len(p) > 0 {
+			m[ki.Format(ki.kmerAt(i))] = p
 		}
 	}
 
@@ -268,7 +349,9 @@ func (ki *Index) Seq() *linear.Seq {
 }
 
 // Returns the value of the finger slice at p. This signifies the absolute kmer frequency of the Kmer(p)
-// if called before Build() and points to the relevant position lookup if called after.
+// if called before Build() and points to the relevant position lookup if called after. For indexes with
+// k greater than MaxDenseKmerLen, p is the rank of the Kmer among the Kmers present; KmerRange finds
+// the positions of a Kmer for any k.
 func (ki *Index) FingerAt(p int) int {
 	return int(ki.finger[p])
 }
@@ -334,14 +417,14 @@ func Format(kmer Kmer, k int, alpha alphabet.Alphabet) (string, error) {
 
 // Reverse complement a Kmer. Complementation is performed according to letter index:
 //
-//  0, 1, 2, 3 = 3, 2, 1, 0
+//	0, 1, 2, 3 = 3, 2, 1, 0
 func (ki *Index) ComplementOf(kmer Kmer) (c Kmer) {
 	return ComplementOf(ki.k, kmer)
 }
 
 // Reverse complement a Kmer of len k. Complementation is performed according to letter index:
 //
-//  0, 1, 2, 3 = 3, 2, 1, 0
+//	0, 1, 2, 3 = 3, 2, 1, 0
 func ComplementOf(k int, kmer Kmer) (c Kmer) {
 	for i, j := uint(0), uint(k-1)*2; i <= j; i, j = i+2, j-2 {
 		c |= (^(kmer >> (j - i)) & (3 << i)) | (^(kmer>>i)&3)<<j
@@ -388,13 +471,12 @@ func (ki *Index) Check() (ok bool, found int) {
 	ok = true
 	f := func(index *Index, position, kmer int) {
 		hit := false
-		var base Kmer
-		if kmer == 0 {
-			base = 0
-		} else {
-			base = index.finger[kmer-1]
+		if !index.indexed {
+			ok = false
+			return
 		}
-		for j := base; j < index.finger[kmer]; j++ {
+		lo, hi := index.KmerRange(Kmer(kmer))
+		for j := lo; j < hi; j++ {
 			if index.pos[j] == position {
 				found++
 				hit = true
@@ -416,7 +498,9 @@ func (ki *Index) Check() (ok bool, found int) {
 // Return a copy of the internal finger slice.
 func (ki *Index) Finger() (f []Kmer) {
 	f = make([]Kmer, len(ki.finger))
-	copy(f, ki.finger)
+	for i, v := range ki.finger {
+		f[i] = Kmer(v)
+	}
 	return
 }
 
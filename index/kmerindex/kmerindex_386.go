@@ -8,4 +8,8 @@ package kmerindex
 var (
 	MinKmerLen = 4 // default minimum
 	MaxKmerLen = 14
+
+	// Indexes with k up to MaxDenseKmerLen use a
+	// finger table holding every possible Kmer.
+	MaxDenseKmerLen = 14
 )
@@ -7,5 +7,9 @@ package kmerindex
 // Constraints on Kmer length.
 var (
 	MinKmerLen = 4 // default minimum
-	MaxKmerLen = 16
+	MaxKmerLen = 32
+
+	// Indexes with k up to MaxDenseKmerLen use a
+	// finger table holding every possible Kmer.
+	MaxDenseKmerLen = 16
 )
@@ -10,6 +10,7 @@ import (
 	"github.com/biogo/biogo/util"
 
 	"math/rand"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -146,3 +147,76 @@ func (s *S) TestKmerKmerUtilities(c *check.C) {
 		}
 	}
 }
+
+func (s *S) TestSparseKmerIndex(c *check.C) {
+	if MaxDenseKmerLen >= 32 || strconv.IntSize < 64 {
+		c.Skip("no sparse index on this platform")
+	}
+	defer func(max int) { MaxKmerLen = max }(MaxKmerLen)
+	MaxKmerLen = 32
+
+	// Repeat part of the test sequence so that long kmers occur more than once.
+	seq := linear.NewSeq("", nil, alphabet.DNA)
+	for i := 0; i < 3; i++ {
+		seq.Seq = append(seq.Seq, s.Seq.Seq[i*100:i*100+300]...)
+	}
+	seq.Seq = append(seq.Seq, 'n')
+	seq.Seq = append(seq.Seq, s.Seq.Seq[:200]...)
+
+	for _, k := range []int{MaxDenseKmerLen + 1, 20, 32} {
+		i, err := New(k, seq)
+		c.Assert(err, check.Equals, nil)
+		ok, _ := i.Check()
+		c.Check(ok, check.Equals, false)
+
+		hashPos := make(map[string][]int)
+		for p := 0; p+k <= seq.Len(); p++ {
+			kmer := strings.ToLower(string(alphabet.LettersToBytes(seq.Seq[p : p+k])))
+			if strings.Contains(kmer, "n") {
+				continue
+			}
+			hashPos[kmer] = append(hashPos[kmer], p)
+		}
+
+		freqs, ok := i.KmerFrequencies()
+		c.Check(ok, check.Equals, true)
+		c.Check(len(freqs), check.Equals, len(hashPos))
+		for kmer, f := range freqs {
+			c.Check(f, check.Equals, len(hashPos[i.Format(kmer)]))
+		}
+
+		i.Build()
+		ok, found := i.Check()
+		c.Check(ok, check.Equals, true)
+		c.Check(found, check.Equals, seq.Len()-k+1-k)
+		pos, ok := i.StringKmerIndex()
+		c.Check(ok, check.Equals, true)
+		c.Check(pos, check.DeepEquals, hashPos)
+		for kmer, want := range hashPos {
+			got, err := i.KmerPositionsString(kmer)
+			c.Assert(err, check.Equals, nil)
+			c.Check(got, check.DeepEquals, want)
+		}
+		missing, err := i.KmerPositions(i.ComplementOf(0))
+		c.Check(err, check.Equals, nil)
+		c.Check(missing, check.HasLen, 0)
+	}
+}
+
+func (s *S) TestLongKmerUtilities(c *check.C) {
+	if strconv.IntSize < 64 {
+		c.Skip("no long kmers on this platform")
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for _, k := range []int{17, 31, 32} {
+		for n := 0; n < 100; n++ {
+			kmer := Kmer(uint64(rnd.Int63())<<1|uint64(rnd.Int63n(2))) & (Kmer(1)<<(2*uint(k)) - 1)
+			s, err := Format(kmer, k, alphabet.DNA)
+			c.Assert(err, check.Equals, nil)
+			rk, err := KmerOf(k, alphabet.DNA.LetterIndex(), s)
+			c.Assert(err, check.Equals, nil)
+			c.Check(rk, check.Equals, kmer)
+			c.Check(ComplementOf(k, ComplementOf(k, kmer)), check.Equals, kmer)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package alias provides mapping between sequence naming conventions, such as
+// the UCSC, Ensembl and RefSeq names of chromosomes, chr1, 1 and NC_000001.11.
+//
+// An alias Table holds the names of each sequence in a set of conventions and
+// provides a Mapper to rename sequences to one of them. Readers and writers
+// wrapping featio and seqio readers and writers apply a Mapper to the sequence
+// names of the features and sequences that pass through them.
+package alias
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/io/featio/bed"
+	"github.com/biogo/biogo/io/featio/gff"
+	"github.com/biogo/biogo/io/featio/tbl"
+	"github.com/biogo/biogo/io/featio/vcf"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrNoConventions = errors.New("alias: no naming conventions")
+	ErrNoConvention  = errors.New("alias: unknown naming convention")
+	ErrBadRow        = errors.New("alias: row length does not match conventions")
+)
+
+// A Table holds the names of sequences in a set of naming conventions.
+type Table struct {
+	conventions []string
+
+	// rows holds the names of each sequence
+	// in each convention, and index the row
+	// of each name.
+	rows  [][]string
+	index map[string]int
+}
+
+// NewTable returns a Table holding the names in rows in the named conventions. Each row
+// holds the names of a sequence in the order of conventions, with an empty string where a
+// sequence has no name in a convention. It is an error for a name to be used for more than
+// one sequence.
+func NewTable(conventions []string, rows [][]string) (*Table, error) {
+	if len(conventions) == 0 {
+		return nil, ErrNoConventions
+	}
+	t := &Table{conventions: append([]string(nil), conventions...), index: make(map[string]int)}
+	for _, r := range rows {
+		err := t.add(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func (t *Table) add(r []string) error {
+	if len(r) != len(t.conventions) {
+		return ErrBadRow
+	}
+	i := len(t.rows)
+	for _, name := range r {
+		if name == "" {
+			continue
+		}
+		if j, ok := t.index[name]; ok && j != i {
+			return fmt.Errorf("alias: name %q used for more than one sequence", name)
+		}
+		t.index[name] = i
+	}
+	t.rows = append(t.rows, append([]string(nil), r...))
+	return nil
+}
+
+// ReadTable reads a tab-delimited alias table in the format of the UCSC chromAlias
+// files. The first line must be a header starting with "#" naming the conventions of
+// the columns, and each following line holds the names of a sequence in each of the
+// conventions. Blank lines and further lines starting with "#" are ignored.
+func ReadTable(r io.Reader) (*Table, error) {
+	var (
+		t    *Table
+		line int
+	)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		text := strings.TrimRight(sc.Text(), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		if t == nil {
+			if !strings.HasPrefix(text, "#") {
+				return nil, fmt.Errorf("alias: missing header at line %d", line)
+			}
+			conv := strings.Split(strings.TrimSpace(strings.TrimPrefix(text, "#")), "\t")
+			for i := range conv {
+				conv[i] = strings.TrimSpace(conv[i])
+			}
+			var err error
+			t, err = NewTable(conv, nil)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if strings.HasPrefix(text, "#") {
+			continue
+		}
+		err := t.add(strings.Split(text, "\t"))
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, ErrNoConventions
+	}
+	return t, nil
+}
+
+// Conventions returns the names of the naming conventions of the table.
+func (t *Table) Conventions() []string { return append([]string(nil), t.conventions...) }
+
+// Lookup returns the name in the given convention of the sequence with the given
+// name in any convention, and whether the sequence has a name in the convention.
+func (t *Table) Lookup(name, convention string) (string, bool) {
+	c := t.convention(convention)
+	if c < 0 {
+		return "", false
+	}
+	i, ok := t.index[name]
+	if !ok {
+		return "", false
+	}
+	n := t.rows[i][c]
+	return n, n != ""
+}
+
+func (t *Table) convention(name string) int {
+	for i, c := range t.conventions {
+		if c == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// A Mapper returns the name to use for a sequence with the given name.
+type Mapper func(name string) string
+
+// Mapper returns a Mapper that renames sequences to the given convention. Names that
+// are not in the table, or that have no name in the convention, are not changed.
+func (t *Table) Mapper(convention string) (Mapper, error) {
+	c := t.convention(convention)
+	if c < 0 {
+		return nil, ErrNoConvention
+	}
+	return func(name string) string {
+		i, ok := t.index[name]
+		if !ok || t.rows[i][c] == "" {
+			return name
+		}
+		return t.rows[i][c]
+	}, nil
+}
+
+// Rename renames the sequence of the feature f using m, and returns the previous
+// sequence name and whether f is of a type that can be renamed. The types handled are
+// the BED feature types, *gff.Feature, *vcf.Variant and *tbl.Feature.
+func Rename(f feat.Feature, m Mapper) (string, bool) {
+	var name *string
+	switch f := f.(type) {
+	case *bed.Bed3:
+		name = &f.Chrom
+	case *bed.Bed4:
+		name = &f.Chrom
+	case *bed.Bed5:
+		name = &f.Chrom
+	case *bed.Bed6:
+		name = &f.Chrom
+	case *bed.Bed12:
+		name = &f.Chrom
+	case *gff.Feature:
+		name = &f.SeqName
+	case *vcf.Variant:
+		name = &f.Chrom
+	case *tbl.Feature:
+		name = &f.SeqID
+	default:
+		return "", false
+	}
+	old := *name
+	*name = m(old)
+	return old, true
+}
+
+// FeatReader is a featio.Reader that renames the sequences of the features it reads.
+type FeatReader struct {
+	r featio.Reader
+	m Mapper
+}
+
+// NewFeatReader returns a FeatReader that reads from r, renaming the sequences of
+// features using m as described for Rename. Features of other types are returned
+// unaltered.
+func NewFeatReader(r featio.Reader, m Mapper) *FeatReader { return &FeatReader{r: r, m: m} }
+
+// Read reads a feature from the underlying reader and renames its sequence.
+func (r *FeatReader) Read() (feat.Feature, error) {
+	f, err := r.r.Read()
+	if f != nil {
+		Rename(f, r.m)
+	}
+	return f, err
+}
+
+// FeatWriter is a featio.Writer that renames the sequences of the features it writes.
+type FeatWriter struct {
+	w featio.Writer
+	m Mapper
+}
+
+// NewFeatWriter returns a FeatWriter that writes to w, renaming the sequences of
+// features using m as described for Rename. The features passed to Write are renamed
+// while they are written and then restored, so they must not be used concurrently.
+func NewFeatWriter(w featio.Writer, m Mapper) *FeatWriter { return &FeatWriter{w: w, m: m} }
+
+// Write writes f to the underlying writer with its sequence renamed.
+func (w *FeatWriter) Write(f feat.Feature) (int, error) {
+	old, ok := Rename(f, w.m)
+	if ok {
+		defer Rename(f, func(string) string { return old })
+	}
+	return w.w.Write(f)
+}
+
+// A namer is a sequence that can be renamed.
+type namer interface {
+	Name() string
+	SetName(string) error
+}
+
+// SeqReader is a seqio.Reader that renames the sequences it reads.
+type SeqReader struct {
+	r seqio.Reader
+	m Mapper
+}
+
+// NewSeqReader returns a SeqReader that reads from r, renaming sequences that have a
+// SetName method using m.
+func NewSeqReader(r seqio.Reader, m Mapper) *SeqReader { return &SeqReader{r: r, m: m} }
+
+// Read reads a sequence from the underlying reader and renames it.
+func (r *SeqReader) Read() (seq.Sequence, error) {
+	s, err := r.r.Read()
+	if n, ok := s.(namer); ok {
+		if serr := n.SetName(r.m(n.Name())); serr != nil && err == nil {
+			err = serr
+		}
+	}
+	return s, err
+}
+
+// SeqWriter is a seqio.Writer that renames the sequences it writes.
+type SeqWriter struct {
+	w seqio.Writer
+	m Mapper
+}
+
+// NewSeqWriter returns a SeqWriter that writes to w, renaming sequences that have a
+// SetName method using m. The sequences passed to Write are renamed while they are
+// written and then restored, so they must not be used concurrently.
+func NewSeqWriter(w seqio.Writer, m Mapper) *SeqWriter { return &SeqWriter{w: w, m: m} }
+
+// Write writes s to the underlying writer with its name mapped.
+func (w *SeqWriter) Write(s seq.Sequence) (int, error) {
+	n, ok := s.(namer)
+	if !ok {
+		return w.w.Write(s)
+	}
+	old := n.Name()
+	err := n.SetName(w.m(old))
+	if err != nil {
+		return 0, err
+	}
+	defer n.SetName(old)
+	return w.w.Write(s)
+}
+
+var (
+	_ featio.Reader = (*FeatReader)(nil)
+	_ featio.Writer = (*FeatWriter)(nil)
+	_ seqio.Reader  = (*SeqReader)(nil)
+	_ seqio.Writer  = (*SeqWriter)(nil)
+)
@@ -0,0 +1,149 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alias
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/featio/bed"
+	"github.com/biogo/biogo/io/featio/gff"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const aliases = `# ucsc	ensembl	refseq
+chr1	1	NC_000001.11
+chr2	2	NC_000002.12
+chrM	MT	NC_012920.1
+chrUn_KI270302v1	KI270302.1	
+`
+
+func (s *S) TestReadTable(c *check.C) {
+	t, err := ReadTable(strings.NewReader(aliases))
+	c.Assert(err, check.Equals, nil)
+	c.Check(t.Conventions(), check.DeepEquals, []string{"ucsc", "ensembl", "refseq"})
+	for i, test := range []struct {
+		name, conv string
+		want       string
+		ok         bool
+	}{
+		{"chr1", "ensembl", "1", true},
+		{"1", "refseq", "NC_000001.11", true},
+		{"NC_012920.1", "ucsc", "chrM", true},
+		{"MT", "ensembl", "MT", true},
+		{"KI270302.1", "refseq", "", false},
+		{"chr3", "ensembl", "", false},
+		{"chr1", "genbank", "", false},
+	} {
+		got, ok := t.Lookup(test.name, test.conv)
+		c.Check(got, check.Equals, test.want, check.Commentf("Test %d", i))
+		c.Check(ok, check.Equals, test.ok, check.Commentf("Test %d", i))
+	}
+
+	m, err := t.Mapper("ensembl")
+	c.Assert(err, check.Equals, nil)
+	c.Check(m("NC_000002.12"), check.Equals, "2")
+	c.Check(m("chrX"), check.Equals, "chrX")
+	_, err = t.Mapper("genbank")
+	c.Check(err, check.Equals, ErrNoConvention)
+}
+
+func (s *S) TestBadTable(c *check.C) {
+	for i, test := range []string{
+		"chr1\t1\n",
+		"# ucsc\tensembl\nchr1\t1\t2\n",
+		"# ucsc\tensembl\nchr1\t1\nchr2\t1\n",
+		"",
+	} {
+		_, err := ReadTable(strings.NewReader(test))
+		c.Check(err, check.NotNil, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestFeatReader(c *check.C) {
+	t, err := ReadTable(strings.NewReader(aliases))
+	c.Assert(err, check.Equals, nil)
+	m, err := t.Mapper("ucsc")
+	c.Assert(err, check.Equals, nil)
+
+	br, err := bed.NewReader(strings.NewReader("1\t10\t20\nMT\t5\t8\nchrY\t1\t2\n"), 3)
+	c.Assert(err, check.Equals, nil)
+	r := NewFeatReader(br, m)
+	var got []string
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, f.(*bed.Bed3).Chrom)
+	}
+	c.Check(got, check.DeepEquals, []string{"chr1", "chrM", "chrY"})
+}
+
+func (s *S) TestFeatWriter(c *check.C) {
+	t, err := ReadTable(strings.NewReader(aliases))
+	c.Assert(err, check.Equals, nil)
+	m, err := t.Mapper("refseq")
+	c.Assert(err, check.Equals, nil)
+
+	var buf bytes.Buffer
+	bw, err := bed.NewWriter(&buf, 3)
+	c.Assert(err, check.Equals, nil)
+	w := NewFeatWriter(bw, m)
+	f := &bed.Bed3{Chrom: "chr2", ChromStart: 10, ChromEnd: 20}
+	_, err = w.Write(f)
+	c.Assert(err, check.Equals, nil)
+	c.Check(buf.String(), check.Equals, "NC_000002.12\t10\t20\n")
+	c.Check(f.Chrom, check.Equals, "chr2")
+
+	g := &gff.Feature{SeqName: "1"}
+	old, ok := Rename(g, m)
+	c.Check(ok, check.Equals, true)
+	c.Check(old, check.Equals, "1")
+	c.Check(g.SeqName, check.Equals, "NC_000001.11")
+	_, ok = Rename(linear.NewSeq("chr1", nil, alphabet.DNA), m)
+	c.Check(ok, check.Equals, false)
+}
+
+func (s *S) TestSeqReadWrite(c *check.C) {
+	t, err := ReadTable(strings.NewReader(aliases))
+	c.Assert(err, check.Equals, nil)
+	toEnsembl, err := t.Mapper("ensembl")
+	c.Assert(err, check.Equals, nil)
+	toUCSC, err := t.Mapper("ucsc")
+	c.Assert(err, check.Equals, nil)
+
+	fr := fasta.NewReader(strings.NewReader(">chr1\nACGT\n>chrM\nGGCC\n"), linear.NewSeq("", nil, alphabet.DNA))
+	r := NewSeqReader(fr, toEnsembl)
+	var buf bytes.Buffer
+	w := NewSeqWriter(fasta.NewWriter(&buf, 60), toUCSC)
+	var names []string
+	for {
+		s, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		names = append(names, s.Name())
+		_, err = w.Write(s)
+		c.Assert(err, check.Equals, nil)
+		c.Check(s.Name(), check.Equals, names[len(names)-1])
+	}
+	c.Check(names, check.DeepEquals, []string{"1", "MT"})
+	c.Check(buf.String(), check.Equals, ">chr1\nACGT\n>chrM\nGGCC\n")
+}
@@ -0,0 +1,228 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package atomicfile provides output files that appear under their names only
+// when they have been completely written.
+//
+// A File is written through a buffer to a temporary file in the directory of
+// its destination, and is renamed to its destination when it is closed without
+// error. An interrupted or failed write leaves any existing file unaltered and
+// no truncated output, so later stages of a pipeline never consume a partial
+// file. A checksum of the content can be written to a sidecar file in the
+// format of md5sum and sha256sum, and checked by Verify.
+package atomicfile
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrClosed           = errors.New("atomicfile: file already closed")
+	ErrChecksumMismatch = errors.New("atomicfile: checksum mismatch")
+	ErrBadSidecar       = errors.New("atomicfile: bad checksum file")
+)
+
+// bufferSize is the size of the write buffer of a File.
+const bufferSize = 1 << 16
+
+// Perm is the permission of created files, before the umask is applied.
+const Perm os.FileMode = 0666
+
+// A Checksum describes a checksum sidecar file.
+type Checksum struct {
+	// New returns the hash used for the checksum.
+	New func() hash.Hash
+
+	// Ext is the extension appended to the
+	// file name to name the sidecar file.
+	Ext string
+}
+
+var (
+	MD5    = Checksum{New: md5.New, Ext: ".md5"}
+	SHA256 = Checksum{New: sha256.New, Ext: ".sha256"}
+)
+
+// A File is an output file that is renamed to its destination when closed.
+type File struct {
+	name string
+	tmp  *os.File
+	w    *bufio.Writer
+
+	sum  *Checksum
+	hash hash.Hash
+
+	err    error
+	closed bool
+}
+
+// Create returns a File that will be renamed to name when it is closed.
+func Create(name string) (*File, error) {
+	return create(name, nil)
+}
+
+// CreateWithChecksum returns a File that will be renamed to name when it is closed,
+// writing the checksum of its content to a sidecar file described by sum.
+func CreateWithChecksum(name string, sum Checksum) (*File, error) {
+	return create(name, &sum)
+}
+
+func create(name string, sum *Checksum) (*File, error) {
+	dir, base := filepath.Split(name)
+	if dir == "" {
+		dir = "."
+	}
+	tmp, err := ioutil.TempFile(dir, "."+base+".tmp")
+	if err != nil {
+		return nil, err
+	}
+	err = chmod(tmp)
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	f := &File{name: name, tmp: tmp, sum: sum}
+	var w io.Writer = tmp
+	if sum != nil {
+		f.hash = sum.New()
+		w = io.MultiWriter(tmp, f.hash)
+	}
+	f.w = bufio.NewWriterSize(w, bufferSize)
+	return f, nil
+}
+
+// chmod sets the permissions of f to those given to a file created by os.Create,
+// Perm less the umask, determined by creating and removing a probe file.
+func chmod(f *os.File) error {
+	probe := f.Name() + ".perm"
+	p, err := os.OpenFile(probe, os.O_RDWR|os.O_CREATE|os.O_EXCL, Perm)
+	if err != nil {
+		return err
+	}
+	fi, err := p.Stat()
+	p.Close()
+	os.Remove(probe)
+	if err != nil {
+		return err
+	}
+	return f.Chmod(fi.Mode().Perm())
+}
+
+// Name returns the destination name of the file.
+func (f *File) Name() string { return f.name }
+
+// Write writes p to the file. Once a write has failed, all subsequent writes fail
+// and Close discards the file.
+func (f *File) Write(p []byte) (int, error) {
+	if f.closed {
+		return 0, ErrClosed
+	}
+	if f.err != nil {
+		return 0, f.err
+	}
+	n, err := f.w.Write(p)
+	f.err = err
+	return n, err
+}
+
+// Close flushes and syncs the file and renames it to its destination, replacing any
+// existing file, then writes its checksum sidecar if one was requested. If an earlier
+// write failed or any step of closing fails, the temporary file is removed and the
+// destination is not altered.
+func (f *File) Close() error {
+	if f.closed {
+		return ErrClosed
+	}
+	f.closed = true
+	err := f.err
+	if err == nil {
+		err = f.w.Flush()
+	}
+	if err == nil {
+		err = f.tmp.Sync()
+	}
+	if cerr := f.tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil && f.sum != nil {
+		// Remove a stale sidecar so it cannot be
+		// taken to describe the new content.
+		err = os.Remove(f.name + f.sum.Ext)
+		if os.IsNotExist(err) {
+			err = nil
+		}
+	}
+	if err == nil {
+		err = os.Rename(f.tmp.Name(), f.name)
+	}
+	if err != nil {
+		os.Remove(f.tmp.Name())
+		return err
+	}
+	if f.sum == nil {
+		return nil
+	}
+	side, err := create(f.name+f.sum.Ext, nil)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(side, "%x  %s\n", f.hash.Sum(nil), filepath.Base(f.name))
+	return side.Close()
+}
+
+// Abort discards the file without altering its destination. Abort after Close has no
+// effect, so it may be deferred to clean up after failures.
+func (f *File) Abort() error {
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	f.tmp.Close()
+	return os.Remove(f.tmp.Name())
+}
+
+// Verify checks the file name against the checksum in its sidecar file described by
+// sum. It returns ErrChecksumMismatch if the checksum does not match, and an error if
+// either file cannot be read.
+func Verify(name string, sum Checksum) error {
+	b, err := ioutil.ReadFile(name + sum.Ext)
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) < 1 {
+		return ErrBadSidecar
+	}
+	want, err := hex.DecodeString(fields[0])
+	if err != nil {
+		return ErrBadSidecar
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sum.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(h.Sum(nil), want) {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package atomicfile
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func entries(c *check.C, dir string) []string {
+	fis, err := ioutil.ReadDir(dir)
+	c.Assert(err, check.Equals, nil)
+	var names []string
+	for _, fi := range fis {
+		names = append(names, fi.Name())
+	}
+	return names
+}
+
+func (s *S) TestClose(c *check.C) {
+	dir := c.MkDir()
+	name := filepath.Join(dir, "out.fa")
+	c.Assert(ioutil.WriteFile(name, []byte("old\n"), 0644), check.Equals, nil)
+
+	f, err := Create(name)
+	c.Assert(err, check.Equals, nil)
+	c.Check(f.Name(), check.Equals, name)
+	fmt.Fprint(f, ">a\nacgt\n")
+
+	// The destination is unaltered until close.
+	b, err := ioutil.ReadFile(name)
+	c.Assert(err, check.Equals, nil)
+	c.Check(string(b), check.Equals, "old\n")
+	c.Check(len(entries(c, dir)), check.Equals, 2)
+
+	c.Check(f.Close(), check.Equals, nil)
+	b, err = ioutil.ReadFile(name)
+	c.Assert(err, check.Equals, nil)
+	c.Check(string(b), check.Equals, ">a\nacgt\n")
+	c.Check(entries(c, dir), check.DeepEquals, []string{"out.fa"})
+
+	c.Check(f.Close(), check.Equals, ErrClosed)
+	_, err = f.Write([]byte("x"))
+	c.Check(err, check.Equals, ErrClosed)
+	c.Check(f.Abort(), check.Equals, nil)
+}
+
+func (s *S) TestAbort(c *check.C) {
+	dir := c.MkDir()
+	name := filepath.Join(dir, "out.vcf")
+
+	f, err := Create(name)
+	c.Assert(err, check.Equals, nil)
+	fmt.Fprint(f, "##fileformat=VCFv4.1\n")
+	c.Check(f.Abort(), check.Equals, nil)
+	c.Check(entries(c, dir), check.DeepEquals, []string(nil))
+	c.Check(f.Close(), check.Equals, ErrClosed)
+}
+
+var errFail = errors.New("fail")
+
+func (s *S) TestWriteError(c *check.C) {
+	dir := c.MkDir()
+	name := filepath.Join(dir, "out.fa")
+
+	f, err := Create(name)
+	c.Assert(err, check.Equals, nil)
+	fmt.Fprint(f, ">a\n")
+	f.err = errFail
+	_, err = f.Write([]byte("acgt\n"))
+	c.Check(err, check.Equals, errFail)
+	c.Check(f.Close(), check.Equals, errFail)
+	c.Check(entries(c, dir), check.DeepEquals, []string(nil))
+}
+
+func (s *S) TestChecksum(c *check.C) {
+	dir := c.MkDir()
+	name := filepath.Join(dir, "out.fa")
+	c.Assert(ioutil.WriteFile(name+".md5", []byte("stale\n"), 0644), check.Equals, nil)
+
+	f, err := CreateWithChecksum(name, MD5)
+	c.Assert(err, check.Equals, nil)
+	fmt.Fprint(f, ">a\nacgt\n")
+	c.Check(f.Close(), check.Equals, nil)
+	c.Check(entries(c, dir), check.DeepEquals, []string{"out.fa", "out.fa.md5"})
+
+	b, err := ioutil.ReadFile(name + ".md5")
+	c.Assert(err, check.Equals, nil)
+	c.Check(string(b), check.Equals, "30b5b54f8e4f9371b80bdbb1beee3ea4  out.fa\n")
+	c.Check(Verify(name, MD5), check.Equals, nil)
+
+	c.Assert(ioutil.WriteFile(name, []byte(">a\nacg"), 0644), check.Equals, nil)
+	c.Check(Verify(name, MD5), check.Equals, ErrChecksumMismatch)
+
+	c.Assert(ioutil.WriteFile(name+".md5", []byte("zz  out.fa\n"), 0644), check.Equals, nil)
+	c.Check(Verify(name, MD5), check.Equals, ErrBadSidecar)
+
+	_, err = os.Stat(name + ".sha256")
+	c.Check(os.IsNotExist(err), check.Equals, true)
+	c.Check(os.IsNotExist(Verify(name, SHA256)), check.Equals, true)
+}
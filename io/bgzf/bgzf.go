@@ -0,0 +1,344 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bgzf provides reading and writing of BGZF, the blocked gzip format
+// used by BAM and tabix.
+//
+// A BGZF file is a series of gzip members, each holding at most 64kB of
+// uncompressed data and carrying its compressed size in a gzip extra field, and
+// terminated by an empty member. Positions in the uncompressed data are given by
+// virtual file offsets, combining the file offset of a block with an offset into
+// the uncompressed data of that block, which allows random access to the
+// compressed data.
+//
+// The specification can be found at https://samtools.github.io/hts-specs/SAMv1.pdf.
+package bgzf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+var (
+	ErrNotBGZF    = errors.New("bgzf: not a BGZF block")
+	ErrCorrupt    = errors.New("bgzf: corrupt block")
+	ErrNoSeek     = errors.New("bgzf: underlying reader cannot seek")
+	ErrBadOffset  = errors.New("bgzf: offset beyond end of block")
+	ErrClosed     = errors.New("bgzf: write to closed writer")
+	ErrBlockLarge = errors.New("bgzf: compressed block too large")
+)
+
+const (
+	// BlockSize is the maximum number of bytes
+	// of uncompressed data written to a block.
+	BlockSize = 0xff00
+
+	// MaxBlockSize is the maximum size of a
+	// compressed block.
+	MaxBlockSize = 0x10000
+
+	headerSize  = 18
+	trailerSize = 8
+)
+
+// eof is the empty block that terminates a BGZF file.
+var eof = []byte{
+	0x1f, 0x8b, 0x08, 0x04, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0xff, 0x06, 0x00, 0x42, 0x43, 0x02, 0x00,
+	0x1b, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00,
+}
+
+// An Offset is a position in the uncompressed data of a BGZF file.
+type Offset struct {
+	File  int64  // Offset of the block in the compressed file.
+	Block uint16 // Offset into the uncompressed data of the block.
+}
+
+// Virtual returns the virtual file offset representation of o.
+func (o Offset) Virtual() uint64 { return uint64(o.File)<<16 | uint64(o.Block) }
+
+// OffsetOf returns the Offset of the virtual file offset v.
+func OffsetOf(v uint64) Offset { return Offset{File: int64(v >> 16), Block: uint16(v)} }
+
+// HasEOF returns whether the BGZF data in r of the given size ends with the
+// empty terminating block.
+func HasEOF(r io.ReaderAt, size int64) (bool, error) {
+	if size < int64(len(eof)) {
+		return false, nil
+	}
+	b := make([]byte, len(eof))
+	_, err := r.ReadAt(b, size-int64(len(eof)))
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	return bytes.Equal(b, eof), nil
+}
+
+// Writer is a BGZF writer.
+type Writer struct {
+	w      io.Writer
+	fw     *flate.Writer
+	buf    []byte
+	cbuf   bytes.Buffer
+	block  []byte
+	offset int64
+	closed bool
+}
+
+// NewWriter returns a new BGZF writer using w with the default compression
+// level.
+func NewWriter(w io.Writer) *Writer {
+	bw, _ := NewWriterLevel(w, flate.DefaultCompression)
+	return bw
+}
+
+// NewWriterLevel returns a new BGZF writer using w with the given compression
+// level, which may be any valid compress/flate level.
+func NewWriterLevel(w io.Writer, level int) (*Writer, error) {
+	bw := &Writer{w: w, buf: make([]byte, 0, BlockSize)}
+	var err error
+	bw.fw, err = flate.NewWriter(&bw.cbuf, level)
+	if err != nil {
+		return nil, err
+	}
+	return bw, nil
+}
+
+// Offset returns the Offset of the next byte to be written.
+func (w *Writer) Offset() Offset {
+	return Offset{File: w.offset, Block: uint16(len(w.buf))}
+}
+
+// Write writes p to the BGZF stream, writing compressed blocks as they are
+// filled.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, ErrClosed
+	}
+	var n int
+	for len(p) > 0 {
+		c := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+c]
+		p = p[c:]
+		n += c
+		if len(w.buf) == cap(w.buf) {
+			if err := w.Flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush writes any buffered data as a block. Flush ends the current block, so
+// data written after a call to Flush starts a new block.
+func (w *Writer) Flush() error {
+	if w.closed {
+		return ErrClosed
+	}
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.writeBlock(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+func (w *Writer) writeBlock(p []byte) error {
+	w.cbuf.Reset()
+	w.fw.Reset(&w.cbuf)
+	if _, err := w.fw.Write(p); err != nil {
+		return err
+	}
+	if err := w.fw.Close(); err != nil {
+		return err
+	}
+	size := headerSize + w.cbuf.Len() + trailerSize
+	if size > MaxBlockSize {
+		return ErrBlockLarge
+	}
+	if cap(w.block) < size {
+		w.block = make([]byte, size)
+	}
+	b := w.block[:size]
+	copy(b, eof[:headerSize-2])
+	binary.LittleEndian.PutUint16(b[16:], uint16(size-1))
+	copy(b[headerSize:], w.cbuf.Bytes())
+	binary.LittleEndian.PutUint32(b[size-8:], crc32.ChecksumIEEE(p))
+	binary.LittleEndian.PutUint32(b[size-4:], uint32(len(p)))
+	n, err := w.w.Write(b)
+	w.offset += int64(n)
+	return err
+}
+
+// Close flushes any buffered data and writes the terminating empty block. It
+// does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	w.closed = true
+	n, err := w.w.Write(eof)
+	w.offset += int64(n)
+	return err
+}
+
+// Reader is a BGZF reader.
+type Reader struct {
+	r  io.Reader
+	br *bufio.Reader
+	fr io.ReadCloser
+
+	// offset is the file offset of the current
+	// block and next the offset of the block
+	// following it.
+	offset, next int64
+
+	header [headerSize]byte
+	cdata  []byte
+	data   bytes.Buffer
+	block  []byte
+	pos    int
+}
+
+// NewReader returns a new BGZF reader using r. If r is an io.Seeker, the
+// returned Reader can seek to virtual file offsets.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := &Reader{r: r, br: bufio.NewReader(r)}
+	if err := br.readBlock(); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return br, nil
+}
+
+// readBlock reads the next block from the underlying reader.
+func (r *Reader) readBlock() error {
+	r.offset = r.next
+	r.block, r.pos = nil, 0
+	_, err := io.ReadFull(r.br, r.header[:12])
+	if err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = ErrCorrupt
+		}
+		return err
+	}
+	h := r.header[:]
+	if h[0] != 0x1f || h[1] != 0x8b || h[2] != 8 || h[3]&4 == 0 {
+		return ErrNotBGZF
+	}
+	xlen := int(binary.LittleEndian.Uint16(h[10:]))
+	extra := make([]byte, xlen)
+	if _, err = io.ReadFull(r.br, extra); err != nil {
+		return ErrCorrupt
+	}
+	size := -1
+	for len(extra) >= 4 {
+		slen := int(binary.LittleEndian.Uint16(extra[2:]))
+		if len(extra) < 4+slen {
+			break
+		}
+		if extra[0] == 'B' && extra[1] == 'C' && slen == 2 {
+			size = int(binary.LittleEndian.Uint16(extra[4:])) + 1
+		}
+		extra = extra[4+slen:]
+	}
+	if size < 0 {
+		return ErrNotBGZF
+	}
+	n := size - 12 - xlen
+	if n < trailerSize {
+		return ErrCorrupt
+	}
+	if cap(r.cdata) < n {
+		r.cdata = make([]byte, n)
+	}
+	r.cdata = r.cdata[:n]
+	if _, err = io.ReadFull(r.br, r.cdata); err != nil {
+		return ErrCorrupt
+	}
+	r.next = r.offset + int64(size)
+
+	cdata, trailer := r.cdata[:n-trailerSize], r.cdata[n-trailerSize:]
+	if r.fr == nil {
+		r.fr = flate.NewReader(bytes.NewReader(cdata))
+	} else {
+		r.fr.(flate.Resetter).Reset(bytes.NewReader(cdata), nil)
+	}
+	r.data.Reset()
+	if _, err = r.data.ReadFrom(r.fr); err != nil {
+		return ErrCorrupt
+	}
+	r.block = r.data.Bytes()
+	if crc32.ChecksumIEEE(r.block) != binary.LittleEndian.Uint32(trailer) ||
+		uint32(len(r.block)) != binary.LittleEndian.Uint32(trailer[4:]) {
+		return ErrCorrupt
+	}
+	return nil
+}
+
+// Read reads uncompressed data into p.
+func (r *Reader) Read(p []byte) (int, error) {
+	var n int
+	for n < len(p) {
+		if r.pos == len(r.block) {
+			err := r.readBlock()
+			if err != nil {
+				if err == io.EOF && n != 0 {
+					err = nil
+				}
+				return n, err
+			}
+			continue
+		}
+		c := copy(p[n:], r.block[r.pos:])
+		r.pos += c
+		n += c
+	}
+	return n, nil
+}
+
+// Offset returns the Offset of the next byte to be read. When the current block
+// has been exhausted the returned Offset is the start of the following block.
+func (r *Reader) Offset() Offset {
+	if r.pos == len(r.block) {
+		return Offset{File: r.next}
+	}
+	return Offset{File: r.offset, Block: uint16(r.pos)}
+}
+
+// Seek moves the Reader to the given Offset. The underlying reader must be an
+// io.Seeker.
+func (r *Reader) Seek(off Offset) error {
+	s, ok := r.r.(io.Seeker)
+	if !ok {
+		return ErrNoSeek
+	}
+	_, err := s.Seek(off.File, 0)
+	if err != nil {
+		return err
+	}
+	r.br.Reset(r.r)
+	r.next = off.File
+	err = r.readBlock()
+	if err == io.EOF && off.Block == 0 {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if int(off.Block) > len(r.block) {
+		return ErrBadOffset
+	}
+	r.pos = int(off.Block)
+	return nil
+}
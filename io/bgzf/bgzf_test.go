@@ -0,0 +1,123 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bgzf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestOffset(c *check.C) {
+	o := Offset{File: 123456, Block: 789}
+	c.Check(o.Virtual(), check.Equals, uint64(123456<<16|789))
+	c.Check(OffsetOf(o.Virtual()), check.Equals, o)
+}
+
+func (s *S) TestRoundTrip(c *check.C) {
+	var (
+		buf  bytes.Buffer
+		data bytes.Buffer
+		offs []Offset
+	)
+	w := NewWriter(&buf)
+	for i := 0; i < 20000; i++ {
+		offs = append(offs, w.Offset())
+		line := fmt.Sprintf("record %d\t%x\n", i, i*i)
+		data.WriteString(line)
+		_, err := io.WriteString(w, line)
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Assert(w.Close(), check.Equals, nil)
+	c.Check(w.Offset().File, check.Equals, int64(buf.Len()))
+	_, err := w.Write([]byte("x"))
+	c.Check(err, check.Equals, ErrClosed)
+
+	ok, err := HasEOF(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	c.Assert(err, check.Equals, nil)
+	c.Check(ok, check.Equals, true)
+	ok, err = HasEOF(bytes.NewReader(buf.Bytes()), int64(buf.Len()-1))
+	c.Assert(err, check.Equals, nil)
+	c.Check(ok, check.Equals, false)
+
+	// BGZF is valid multi-member gzip.
+	gz, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, check.Equals, nil)
+	got, err := ioutil.ReadAll(gz)
+	c.Assert(err, check.Equals, nil)
+	c.Check(bytes.Equal(got, data.Bytes()), check.Equals, true)
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, check.Equals, nil)
+	got, err = ioutil.ReadAll(r)
+	c.Assert(err, check.Equals, nil)
+	c.Check(bytes.Equal(got, data.Bytes()), check.Equals, true)
+
+	for _, i := range []int{0, 1, 7000, 19999, 12345} {
+		c.Assert(r.Seek(offs[i]), check.Equals, nil)
+		line, err := readLine(r)
+		c.Assert(err, check.Equals, nil)
+		c.Check(line, check.Equals, fmt.Sprintf("record %d\t%x\n", i, i*i), check.Commentf("Record %d", i))
+	}
+	c.Check(r.Seek(Offset{File: offs[1].File, Block: BlockSize + 1}), check.Equals, ErrBadOffset)
+}
+
+func readLine(r io.Reader) (string, error) {
+	var (
+		b   bytes.Buffer
+		one = make([]byte, 1)
+	)
+	for {
+		_, err := r.Read(one)
+		if err != nil {
+			return b.String(), err
+		}
+		b.Write(one)
+		if one[0] == '\n' {
+			return b.String(), nil
+		}
+	}
+}
+
+func (s *S) TestReaderOffset(c *check.C) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	io.WriteString(w, "hello")
+	c.Assert(w.Flush(), check.Equals, nil)
+	second := w.Offset()
+	io.WriteString(w, "world")
+	c.Assert(w.Close(), check.Equals, nil)
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, check.Equals, nil)
+	p := make([]byte, 3)
+	_, err = io.ReadFull(r, p)
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Offset(), check.Equals, Offset{File: 0, Block: 3})
+	_, err = io.ReadFull(r, p[:2])
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Offset(), check.Equals, second)
+
+	_, err = NewReader(bytes.NewReader([]byte("not gzip data at all")))
+	c.Check(err, check.Equals, ErrNotBGZF)
+	_, err = NewReader(bytes.NewReader(buf.Bytes()[:30]))
+	c.Check(err, check.Equals, ErrCorrupt)
+	r, err = NewReader(bytes.NewReader(nil))
+	c.Assert(err, check.Equals, nil)
+	_, err = r.Read(p)
+	c.Check(err, check.Equals, io.EOF)
+}
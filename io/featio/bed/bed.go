@@ -70,6 +70,57 @@ var (
 	_ feat.Orienter = (*Bed12)(nil)
 )
 
+// peekLen is the number of bytes inspected to determine the BED type.
+const peekLen = 4096
+
+func init() {
+	featio.RegisterFormat(featio.Format{
+		Name: "bed",
+		Detect: func(data []byte) bool {
+			f := firstFields(data)
+			if len(f) < 3 {
+				return false
+			}
+			for _, v := range f[startField : endField+1] {
+				if _, err := strconv.Atoi(string(v)); err != nil {
+					return false
+				}
+			}
+			return true
+		},
+		NewReader: func(r io.Reader) (featio.Reader, error) {
+			br := bufio.NewReaderSize(r, peekLen)
+			data, _ := br.Peek(peekLen)
+			b := len(firstFields(data))
+			switch {
+			case b >= 12:
+				b = 12
+			case b > 6:
+				b = 6
+			}
+			return NewReader(br, b)
+		},
+	})
+}
+
+// firstFields returns the tab-separated fields of the first non-blank line
+// of data.
+func firstFields(data []byte) [][]byte {
+	for len(data) != 0 {
+		var line []byte
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			line, data = data, nil
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) != 0 {
+			return bytes.Split(line, []byte{'\t'})
+		}
+	}
+	return nil
+}
+
 type Bed interface {
 	feat.Feature
 	canBed(int) bool
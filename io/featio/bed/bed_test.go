@@ -11,6 +11,7 @@ import (
 	"bytes"
 	"fmt"
 	"image/color"
+	"io"
 	"strings"
 	"testing"
 
@@ -328,3 +329,34 @@ func (s *S) TestWriteFeature(c *check.C) {
 		c.Check(buf.String(), check.Equals, f.line, check.Commentf("Test: %d type: Bed%d", i, f.typ))
 	}
 }
+
+func (s *S) TestBedGraph(c *check.C) {
+	const in = "track type=bedGraph name=\"test\"\n# comment\nchr1\t0\t100\t1.5\nchr1\t100\t200\t-0.25\n"
+	r := NewGraphReader(bytes.NewBufferString(in))
+	var got []feat.Feature
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, f)
+	}
+	c.Assert(got, check.HasLen, 2)
+	c.Check(got[1], check.DeepEquals, feat.Feature(&BedGraph{Chrom: "chr1", ChromStart: 100, ChromEnd: 200, DataValue: -0.25}))
+
+	b := &bytes.Buffer{}
+	w := NewGraphWriter(b)
+	_, err := w.WriteTrack("test")
+	c.Assert(err, check.Equals, nil)
+	for _, f := range got {
+		_, err = w.Write(f)
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Check(b.String(), check.Equals, "track type=bedGraph name=\"test\"\nchr1\t0\t100\t1.5\nchr1\t100\t200\t-0.25\n")
+
+	_, err = w.Write(&Bed3{Chrom: "chr1"})
+	c.Check(err, check.Equals, ErrNoValue)
+	_, err = NewGraphReader(bytes.NewBufferString("chr1\t0\t100\n")).Read()
+	c.Check(err, check.ErrorMatches, "bed: bad bedGraph line at line 1")
+}
@@ -0,0 +1,143 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bed
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	_ featio.Reader = (*GraphReader)(nil)
+	_ featio.Writer = (*GraphWriter)(nil)
+
+	_ feat.Feature = (*BedGraph)(nil)
+	_ Valuer       = (*BedGraph)(nil)
+)
+
+var (
+	ErrBadGraphLine = errors.New("bed: bad bedGraph line")
+	ErrNoValue      = errors.New("bed: feature has no value")
+)
+
+// A Valuer is a feature with a signal value.
+type Valuer interface {
+	Value() float64
+}
+
+// BedGraph is a bedGraph format signal track interval.
+//
+// The specification can be found at http://genome.ucsc.edu/goldenPath/help/bedgraph.html.
+type BedGraph struct {
+	Chrom      string
+	ChromStart int
+	ChromEnd   int
+	DataValue  float64
+}
+
+func (b *BedGraph) Start() int             { return b.ChromStart }
+func (b *BedGraph) End() int               { return b.ChromEnd }
+func (b *BedGraph) Len() int               { return b.ChromEnd - b.ChromStart }
+func (b *BedGraph) Name() string           { return fmt.Sprintf("%s:[%d,%d)", b.Chrom, b.ChromStart, b.ChromEnd) }
+func (b *BedGraph) Description() string    { return "bedGraph interval" }
+func (b *BedGraph) Location() feat.Feature { return Chrom(b.Chrom) }
+func (b *BedGraph) Value() float64         { return b.DataValue }
+
+// GraphReader is a bedGraph format reader.
+type GraphReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// NewGraphReader returns a new bedGraph format reader using r.
+func NewGraphReader(r io.Reader) *GraphReader {
+	return &GraphReader{r: bufio.NewReader(r)}
+}
+
+// Read reads a single interval and returns it or an error. Track, browser and
+// comment lines are skipped.
+func (r *GraphReader) Read() (feat.Feature, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 ||
+			line[0] == '#' ||
+			bytes.HasPrefix(line, []byte("track")) ||
+			bytes.HasPrefix(line, []byte("browser")) {
+			continue
+		}
+		fields := bytes.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("%v at line %d", ErrBadGraphLine, r.line)
+		}
+		b := &BedGraph{Chrom: string(fields[chromField])}
+		b.ChromStart, err = strconv.Atoi(string(fields[startField]))
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		b.ChromEnd, err = strconv.Atoi(string(fields[endField]))
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		b.DataValue, err = strconv.ParseFloat(string(fields[3]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		return b, nil
+	}
+}
+
+// Line returns the current line number.
+func (r *GraphReader) Line() int { return r.line }
+
+// GraphWriter is a bedGraph format writer.
+type GraphWriter struct {
+	w io.Writer
+
+	// Precision is the number of digits after the decimal
+	// point used to format values. A negative precision
+	// uses the minimum number of digits needed to represent
+	// the value exactly.
+	Precision int
+}
+
+// NewGraphWriter returns a new bedGraph format writer using w.
+func NewGraphWriter(w io.Writer) *GraphWriter {
+	return &GraphWriter{w: w, Precision: -1}
+}
+
+// WriteTrack writes a track definition line with the given name.
+func (w *GraphWriter) WriteTrack(name string) (int, error) {
+	return fmt.Fprintf(w.w, "track type=bedGraph name=%q\n", name)
+}
+
+// Write writes a single feature and returns the number of bytes written and
+// any error. The feature must be a Valuer and have a location, whose name is
+// used as the chromosome name.
+func (w *GraphWriter) Write(f feat.Feature) (int, error) {
+	v, ok := f.(Valuer)
+	if !ok {
+		return 0, ErrNoValue
+	}
+	loc := f.Location()
+	if loc == nil {
+		return 0, ErrNoChromField
+	}
+	return fmt.Fprintf(w.w, "%s\t%d\t%d\t%s\n",
+		loc.Name(), f.Start(), f.End(),
+		strconv.FormatFloat(v.Value(), 'f', w.Precision, 64),
+	)
+}
@@ -0,0 +1,376 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blast provides types to read NCBI BLAST tabular and XML output.
+//
+// Tabular output is read in the standard twelve column form written by BLAST+
+// with -outfmt 6 or -outfmt 7. XML output is read in the form written with
+// -outfmt 5.
+package blast
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrBadFields      = errors.New("blast: wrong number of fields")
+	ErrBadCoordinates = errors.New("blast: bad coordinates")
+)
+
+var (
+	_ featio.Reader = (*TabularReader)(nil)
+	_ featio.Reader = (*XMLReader)(nil)
+
+	_ feat.Feature  = (*Record)(nil)
+	_ feat.Orienter = (*Record)(nil)
+	_ feat.Pair     = (*Record)(nil)
+)
+
+const numFields = 12
+
+// Seq is a sequence named in a BLAST record. SeqSize is zero if the length of
+// the sequence is not known.
+type Seq struct {
+	SeqName string
+	SeqDesc string
+	SeqSize int
+}
+
+func (s *Seq) Start() int             { return 0 }
+func (s *Seq) End() int               { return s.SeqSize }
+func (s *Seq) Len() int               { return s.SeqSize }
+func (s *Seq) Name() string           { return s.SeqName }
+func (s *Seq) Description() string    { return s.SeqDesc }
+func (s *Seq) Location() feat.Feature { return nil }
+
+// Record is a BLAST high-scoring segment pair. All coordinates are zero-based
+// half-open and on the forward strand of their sequences; the strand of each
+// aligned segment is given by QStrand and SStrand.
+type Record struct {
+	Query   *Seq
+	QStart  int
+	QEnd    int
+	QStrand seq.Strand
+
+	Subject *Seq
+	SStart  int
+	SEnd    int
+	SStrand seq.Strand
+
+	// Identity is the percentage of identical
+	// positions in the alignment.
+	Identity   float64
+	AlnLen     int
+	Mismatches int
+	GapOpens   int
+
+	EValue   float64
+	BitScore float64
+
+	// The following fields are only set
+	// for records read from XML output.
+	Score      int
+	Identities int
+	Positives  int
+	Gaps       int
+	QSeq       string
+	SSeq       string
+	Midline    string
+}
+
+func (r *Record) Start() int             { return r.SStart }
+func (r *Record) End() int               { return r.SEnd }
+func (r *Record) Len() int               { return r.SEnd - r.SStart }
+func (r *Record) Name() string           { return r.Query.Name() }
+func (r *Record) Description() string    { return "blast hsp" }
+func (r *Record) Location() feat.Feature { return r.Subject }
+
+// Orientation returns the relative orientation of the query and subject.
+func (r *Record) Orientation() feat.Orientation {
+	return feat.Orientation(r.QStrand) * feat.Orientation(r.SStrand)
+}
+
+// Features returns the subject and query extents of the alignment. The order of
+// features matches the reference, query order used by the align package.
+func (r *Record) Features() [2]feat.Feature {
+	return [2]feat.Feature{
+		&Segment{Loc: r.Subject, SegStart: r.SStart, SegEnd: r.SEnd, Orient: feat.Orientation(r.SStrand)},
+		&Segment{Loc: r.Query, SegStart: r.QStart, SegEnd: r.QEnd, Orient: feat.Orientation(r.QStrand)},
+	}
+}
+
+// A Segment is an interval on a BLAST sequence.
+type Segment struct {
+	Loc      feat.Feature
+	SegStart int
+	SegEnd   int
+	Orient   feat.Orientation
+}
+
+func (s *Segment) Start() int                    { return s.SegStart }
+func (s *Segment) End() int                      { return s.SegEnd }
+func (s *Segment) Len() int                      { return s.SegEnd - s.SegStart }
+func (s *Segment) Name() string                  { return fmt.Sprintf("%s:[%d,%d)", s.Loc.Name(), s.SegStart, s.SegEnd) }
+func (s *Segment) Description() string           { return "blast segment" }
+func (s *Segment) Location() feat.Feature        { return s.Loc }
+func (s *Segment) Orientation() feat.Orientation { return s.Orient }
+
+// interval converts one-based inclusive BLAST coordinates to a zero-based
+// half-open interval and the strand implied by their order.
+func interval(from, to int) (start, end int, strand seq.Strand, err error) {
+	if from < 1 || to < 1 {
+		return 0, 0, seq.None, ErrBadCoordinates
+	}
+	if from > to {
+		return to - 1, from, seq.Minus, nil
+	}
+	return from - 1, to, seq.Plus, nil
+}
+
+func parseRecord(line []byte) (*Record, error) {
+	f := bytes.Split(line, []byte{'\t'})
+	if len(f) != numFields {
+		return nil, ErrBadFields
+	}
+	var (
+		n   [numFields]int
+		err error
+	)
+	for _, i := range []int{3, 4, 5, 6, 7, 8, 9} {
+		n[i], err = strconv.Atoi(string(f[i]))
+		if err != nil {
+			return nil, err
+		}
+	}
+	r := &Record{
+		Query:      &Seq{SeqName: string(f[0])},
+		Subject:    &Seq{SeqName: string(f[1])},
+		AlnLen:     n[3],
+		Mismatches: n[4],
+		GapOpens:   n[5],
+	}
+	r.Identity, err = strconv.ParseFloat(string(f[2]), 64)
+	if err != nil {
+		return nil, err
+	}
+	r.EValue, err = strconv.ParseFloat(string(f[10]), 64)
+	if err != nil {
+		return nil, err
+	}
+	r.BitScore, err = strconv.ParseFloat(string(bytes.TrimSpace(f[11])), 64)
+	if err != nil {
+		return nil, err
+	}
+	r.QStart, r.QEnd, r.QStrand, err = interval(n[6], n[7])
+	if err != nil {
+		return nil, err
+	}
+	r.SStart, r.SEnd, r.SStrand, err = interval(n[8], n[9])
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// TabularReader is a BLAST tabular format reader.
+type TabularReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// NewTabularReader returns a new BLAST tabular format reader using r. Comment
+// lines, as written with -outfmt 7, are skipped.
+func NewTabularReader(r io.Reader) *TabularReader {
+	return &TabularReader{r: bufio.NewReader(r)}
+}
+
+// Read reads a single BLAST tabular record and returns it or an error. The
+// returned feat.Feature is a *Record.
+func (r *TabularReader) Read() (feat.Feature, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 || line[0] == '#' {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rec, err := parseRecord(line)
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		return rec, nil
+	}
+}
+
+// Line returns the current line number.
+func (r *TabularReader) Line() int { return r.line }
+
+type iteration struct {
+	QueryID  string `xml:"Iteration_query-ID"`
+	QueryDef string `xml:"Iteration_query-def"`
+	QueryLen int    `xml:"Iteration_query-len"`
+	Hits     []hit  `xml:"Iteration_hits>Hit"`
+}
+
+type hit struct {
+	ID   string `xml:"Hit_id"`
+	Def  string `xml:"Hit_def"`
+	Len  int    `xml:"Hit_len"`
+	HSPs []hsp  `xml:"Hit_hsps>Hsp"`
+}
+
+type hsp struct {
+	BitScore   float64 `xml:"Hsp_bit-score"`
+	Score      int     `xml:"Hsp_score"`
+	EValue     float64 `xml:"Hsp_evalue"`
+	QueryFrom  int     `xml:"Hsp_query-from"`
+	QueryTo    int     `xml:"Hsp_query-to"`
+	HitFrom    int     `xml:"Hsp_hit-from"`
+	HitTo      int     `xml:"Hsp_hit-to"`
+	QueryFrame int     `xml:"Hsp_query-frame"`
+	HitFrame   int     `xml:"Hsp_hit-frame"`
+	Identity   int     `xml:"Hsp_identity"`
+	Positive   int     `xml:"Hsp_positive"`
+	Gaps       int     `xml:"Hsp_gaps"`
+	AlignLen   int     `xml:"Hsp_align-len"`
+	QSeq       string  `xml:"Hsp_qseq"`
+	HSeq       string  `xml:"Hsp_hseq"`
+	Midline    string  `xml:"Hsp_midline"`
+}
+
+// XMLReader is a BLAST XML format reader.
+type XMLReader struct {
+	d    *xml.Decoder
+	recs []*Record
+}
+
+// NewXMLReader returns a new BLAST XML format reader using r.
+func NewXMLReader(r io.Reader) *XMLReader {
+	return &XMLReader{d: xml.NewDecoder(r)}
+}
+
+// Read reads a single BLAST HSP and returns it or an error. The returned
+// feat.Feature is a *Record. HSPs are returned in the order they appear in the
+// input.
+func (r *XMLReader) Read() (feat.Feature, error) {
+	for len(r.recs) == 0 {
+		t, err := r.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		se, ok := t.(xml.StartElement)
+		if !ok || se.Name.Local != "Iteration" {
+			continue
+		}
+		var it iteration
+		err = r.d.DecodeElement(&it, &se)
+		if err != nil {
+			return nil, err
+		}
+		r.recs, err = it.records()
+		if err != nil {
+			return nil, err
+		}
+	}
+	rec := r.recs[0]
+	r.recs = r.recs[1:]
+	return rec, nil
+}
+
+// splitDef splits a definition line into its first word and the remainder.
+func splitDef(def string) (name, desc string) {
+	def = strings.TrimSpace(def)
+	i := strings.IndexAny(def, " \t")
+	if i < 0 {
+		return def, ""
+	}
+	return def[:i], strings.TrimSpace(def[i:])
+}
+
+// seqName returns the name and description of a sequence from its BLAST
+// identifier and definition line. Identifiers generated by BLAST when the
+// definition lines were not parsed are replaced by the first word of the
+// definition line.
+func seqName(id, def string) (name, desc string) {
+	if id == "" || strings.HasPrefix(id, "Query_") || strings.HasPrefix(id, "lcl|Query_") || strings.HasPrefix(id, "gnl|BL_ORD_ID|") {
+		return splitDef(def)
+	}
+	return id, strings.TrimSpace(def)
+}
+
+func (it *iteration) records() ([]*Record, error) {
+	qName, qDesc := seqName(it.QueryID, it.QueryDef)
+	query := &Seq{SeqName: qName, SeqDesc: qDesc, SeqSize: it.QueryLen}
+	var recs []*Record
+	for _, h := range it.Hits {
+		sName, sDesc := seqName(h.ID, h.Def)
+		subject := &Seq{SeqName: sName, SeqDesc: sDesc, SeqSize: h.Len}
+		for _, p := range h.HSPs {
+			rec := &Record{
+				Query:      query,
+				Subject:    subject,
+				AlnLen:     p.AlignLen,
+				Mismatches: p.AlignLen - p.Identity - p.Gaps,
+				GapOpens:   gapOpens(p.QSeq) + gapOpens(p.HSeq),
+				EValue:     p.EValue,
+				BitScore:   p.BitScore,
+				Score:      p.Score,
+				Identities: p.Identity,
+				Positives:  p.Positive,
+				Gaps:       p.Gaps,
+				QSeq:       p.QSeq,
+				SSeq:       p.HSeq,
+				Midline:    p.Midline,
+			}
+			if p.AlignLen != 0 {
+				rec.Identity = 100 * float64(p.Identity) / float64(p.AlignLen)
+			}
+			var err error
+			rec.QStart, rec.QEnd, rec.QStrand, err = interval(p.QueryFrom, p.QueryTo)
+			if err != nil {
+				return nil, err
+			}
+			if p.QueryFrame < 0 {
+				rec.QStrand = seq.Minus
+			}
+			rec.SStart, rec.SEnd, rec.SStrand, err = interval(p.HitFrom, p.HitTo)
+			if err != nil {
+				return nil, err
+			}
+			if p.HitFrame < 0 {
+				rec.SStrand = seq.Minus
+			}
+			recs = append(recs, rec)
+		}
+	}
+	return recs, nil
+}
+
+// gapOpens returns the number of runs of gap characters in s.
+func gapOpens(s string) int {
+	var n int
+	for i := 0; i < len(s); i++ {
+		if s[i] == '-' && (i == 0 || s[i-1] != '-') {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,169 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blast
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/seq"
+
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const tabular = `# BLASTN 2.12.0+
+# Query: q1 test query
+# Database: db
+# Fields: query acc.ver, subject acc.ver, % identity, alignment length, mismatches, gap opens, q. start, q. end, s. start, s. end, evalue, bit score
+# 2 hits found
+q1	s1	98.50	200	3	0	1	200	1001	1200	1e-100	370
+q1	s2	90.00	50	5	0	11	60	500	451	2.5e-10	 80.5
+# BLAST processed 1 queries
+`
+
+func readAll(c *check.C, r featio.Reader) []*Record {
+	var recs []*Record
+	sc := featio.NewScanner(r)
+	for sc.Next() {
+		recs = append(recs, sc.Feat().(*Record))
+	}
+	c.Assert(sc.Error(), check.Equals, nil)
+	return recs
+}
+
+func (s *S) TestTabular(c *check.C) {
+	recs := readAll(c, NewTabularReader(strings.NewReader(tabular)))
+	c.Assert(recs, check.HasLen, 2)
+
+	r := recs[0]
+	c.Check(r.Name(), check.Equals, "q1")
+	c.Check(r.Location().Name(), check.Equals, "s1")
+	c.Check([4]int{r.QStart, r.QEnd, r.Start(), r.End()}, check.Equals, [4]int{0, 200, 1000, 1200})
+	c.Check(r.Identity, check.Equals, 98.5)
+	c.Check(r.Mismatches, check.Equals, 3)
+	c.Check(r.EValue, check.Equals, 1e-100)
+	c.Check(r.BitScore, check.Equals, 370.)
+	c.Check(r.Orientation(), check.Equals, feat.Forward)
+
+	r = recs[1]
+	c.Check([4]int{r.QStart, r.QEnd, r.Start(), r.End()}, check.Equals, [4]int{10, 60, 450, 500})
+	c.Check(r.SStrand, check.Equals, seq.Minus)
+	c.Check(r.Orientation(), check.Equals, feat.Reverse)
+	c.Check(r.BitScore, check.Equals, 80.5)
+	fs := r.Features()
+	c.Check(fs[0].Name(), check.Equals, "s2:[450,500)")
+	c.Check(fs[1].Name(), check.Equals, "q1:[10,60)")
+
+	for i, t := range []struct {
+		in  string
+		err string
+	}{
+		{in: "q1\ts1\t98.50\t200", err: "blast: wrong number of fields at line 1"},
+		{in: "#\nq1\ts1\t98.50\t200\t3\t0\t0\t200\t1001\t1200\t1e-100\t370", err: "blast: bad coordinates at line 2"},
+		{in: "q1\ts1\tx\t200\t3\t0\t1\t200\t1001\t1200\t1e-100\t370", err: `strconv.ParseFloat: parsing "x": invalid syntax at line 1`},
+	} {
+		_, err := NewTabularReader(strings.NewReader(t.in)).Read()
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+const blastXML = `<?xml version="1.0"?>
+<!DOCTYPE BlastOutput PUBLIC "-//NCBI//NCBI BlastOutput/EN" "http://www.ncbi.nlm.nih.gov/dtd/NCBI_BlastOutput.dtd">
+<BlastOutput>
+  <BlastOutput_program>blastn</BlastOutput_program>
+  <BlastOutput_iterations>
+    <Iteration>
+      <Iteration_iter-num>1</Iteration_iter-num>
+      <Iteration_query-ID>Query_1</Iteration_query-ID>
+      <Iteration_query-def>q1 test query</Iteration_query-def>
+      <Iteration_query-len>120</Iteration_query-len>
+      <Iteration_hits>
+        <Hit>
+          <Hit_num>1</Hit_num>
+          <Hit_id>gnl|BL_ORD_ID|0</Hit_id>
+          <Hit_def>s1 subject one</Hit_def>
+          <Hit_len>5000</Hit_len>
+          <Hit_hsps>
+            <Hsp>
+              <Hsp_num>1</Hsp_num>
+              <Hsp_bit-score>20.1</Hsp_bit-score>
+              <Hsp_score>10</Hsp_score>
+              <Hsp_evalue>0.002</Hsp_evalue>
+              <Hsp_query-from>1</Hsp_query-from>
+              <Hsp_query-to>10</Hsp_query-to>
+              <Hsp_hit-from>101</Hsp_hit-from>
+              <Hsp_hit-to>111</Hsp_hit-to>
+              <Hsp_query-frame>1</Hsp_query-frame>
+              <Hsp_hit-frame>1</Hsp_hit-frame>
+              <Hsp_identity>9</Hsp_identity>
+              <Hsp_positive>9</Hsp_positive>
+              <Hsp_gaps>1</Hsp_gaps>
+              <Hsp_align-len>11</Hsp_align-len>
+              <Hsp_qseq>ACGTA-CGTAC</Hsp_qseq>
+              <Hsp_hseq>ACGTAGCGTTC</Hsp_hseq>
+              <Hsp_midline>||||| ||| |</Hsp_midline>
+            </Hsp>
+            <Hsp>
+              <Hsp_num>2</Hsp_num>
+              <Hsp_bit-score>16.4</Hsp_bit-score>
+              <Hsp_score>8</Hsp_score>
+              <Hsp_evalue>0.05</Hsp_evalue>
+              <Hsp_query-from>50</Hsp_query-from>
+              <Hsp_query-to>57</Hsp_query-to>
+              <Hsp_hit-from>908</Hsp_hit-from>
+              <Hsp_hit-to>901</Hsp_hit-to>
+              <Hsp_query-frame>1</Hsp_query-frame>
+              <Hsp_hit-frame>-1</Hsp_hit-frame>
+              <Hsp_identity>8</Hsp_identity>
+              <Hsp_positive>8</Hsp_positive>
+              <Hsp_gaps>0</Hsp_gaps>
+              <Hsp_align-len>8</Hsp_align-len>
+              <Hsp_qseq>GGGGCCCC</Hsp_qseq>
+              <Hsp_hseq>GGGGCCCC</Hsp_hseq>
+              <Hsp_midline>||||||||</Hsp_midline>
+            </Hsp>
+          </Hit_hsps>
+        </Hit>
+      </Iteration_hits>
+    </Iteration>
+    <Iteration>
+      <Iteration_iter-num>2</Iteration_iter-num>
+      <Iteration_query-ID>Query_2</Iteration_query-ID>
+      <Iteration_query-def>q2</Iteration_query-def>
+      <Iteration_query-len>80</Iteration_query-len>
+      <Iteration_hits></Iteration_hits>
+      <Iteration_message>No hits found</Iteration_message>
+    </Iteration>
+  </BlastOutput_iterations>
+</BlastOutput>
+`
+
+func (s *S) TestXML(c *check.C) {
+	recs := readAll(c, NewXMLReader(strings.NewReader(blastXML)))
+	c.Assert(recs, check.HasLen, 2)
+
+	r := recs[0]
+	c.Check(r.Query, check.DeepEquals, &Seq{SeqName: "q1", SeqDesc: "test query", SeqSize: 120})
+	c.Check(r.Subject, check.DeepEquals, &Seq{SeqName: "s1", SeqDesc: "subject one", SeqSize: 5000})
+	c.Check([4]int{r.QStart, r.QEnd, r.Start(), r.End()}, check.Equals, [4]int{0, 10, 100, 111})
+	c.Check([4]int{r.AlnLen, r.Mismatches, r.GapOpens, r.Score}, check.Equals, [4]int{11, 1, 1, 10})
+	c.Check(r.EValue, check.Equals, 0.002)
+	c.Check(r.Identity, check.Equals, 100*9./11)
+
+	r = recs[1]
+	c.Check(r.Query, check.Equals, recs[0].Query)
+	c.Check([4]int{r.QStart, r.QEnd, r.Start(), r.End()}, check.Equals, [4]int{49, 57, 900, 908})
+	c.Check(r.Orientation(), check.Equals, feat.Reverse)
+	c.Check(r.Identity, check.Equals, 100.)
+}
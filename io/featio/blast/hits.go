@@ -0,0 +1,217 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blast
+
+import (
+	"sort"
+)
+
+// pair identifies the query and subject of a record by name.
+type pair struct{ query, subject string }
+
+func pairOf(r *Record) pair { return pair{r.Query.Name(), r.Subject.Name()} }
+
+// overlap returns the length of the overlap of [as, ae) and [bs, be).
+func overlap(as, ae, bs, be int) int {
+	if bs > as {
+		as = bs
+	}
+	if be < ae {
+		ae = be
+	}
+	if ae < as {
+		return 0
+	}
+	return ae - as
+}
+
+// better returns whether a is a better HSP than b, with a at index i and b
+// at index j of the input.
+func better(a, b *Record, i, j int) bool {
+	if a.BitScore != b.BitScore {
+		return a.BitScore > b.BitScore
+	}
+	return i < j
+}
+
+// byScore returns the indexes of recs in order of decreasing bit score, with
+// ties in input order.
+func byScore(recs []*Record) []int {
+	idx := make([]int, len(recs))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Stable(scoreOrder{idx: idx, recs: recs})
+	return idx
+}
+
+// scoreOrder sorts indexes of recs by decreasing bit score.
+type scoreOrder struct {
+	idx  []int
+	recs []*Record
+}
+
+func (o scoreOrder) Len() int           { return len(o.idx) }
+func (o scoreOrder) Less(i, j int) bool { return o.recs[o.idx[i]].BitScore > o.recs[o.idx[j]].BitScore }
+func (o scoreOrder) Swap(i, j int)      { o.idx[i], o.idx[j] = o.idx[j], o.idx[i] }
+
+// RemoveShadowed returns the HSPs of recs that are not shadowed by a better HSP
+// between the same query and subject, in input order. An HSP is shadowed if at
+// least the fraction frac of both its query and subject extents are covered by
+// a single HSP with a higher bit score, or an equal bit score and an earlier
+// position in recs. Duplicate HSPs are shadowed by their first occurrence. If
+// frac is not in (0, 1], 1 is used, so only contained HSPs are removed.
+func RemoveShadowed(recs []*Record, frac float64) []*Record {
+	if frac <= 0 || frac > 1 {
+		frac = 1
+	}
+	groups := make(map[pair][]int)
+	for i, r := range recs {
+		p := pairOf(r)
+		groups[p] = append(groups[p], i)
+	}
+	shadowed := make([]bool, len(recs))
+	for _, g := range groups {
+		for _, i := range g {
+			r := recs[i]
+			for _, j := range g {
+				s := recs[j]
+				if i == j || shadowed[j] || !better(s, r, j, i) {
+					continue
+				}
+				q := overlap(r.QStart, r.QEnd, s.QStart, s.QEnd)
+				t := overlap(r.SStart, r.SEnd, s.SStart, s.SEnd)
+				if float64(q) >= frac*float64(r.QEnd-r.QStart) && float64(t) >= frac*float64(r.SEnd-r.SStart) {
+					shadowed[i] = true
+					break
+				}
+			}
+		}
+	}
+	var kept []*Record
+	for i, r := range recs {
+		if !shadowed[i] {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// Tile returns a tiling of the HSPs of recs for each query and subject, in input
+// order. HSPs are added to the tiling of their query and subject in order of
+// decreasing bit score if they have the same relative orientation as the best HSP
+// of the tiling and do not overlap any HSP already in the tiling on the query, so
+// each tiling covers the query with non-overlapping HSPs.
+func Tile(recs []*Record) []*Record {
+	var (
+		tiles = make(map[pair][]*Record)
+		kept  = make([]bool, len(recs))
+	)
+	for _, i := range byScore(recs) {
+		r := recs[i]
+		p := pairOf(r)
+		t := tiles[p]
+		if len(t) != 0 && t[0].Orientation() != r.Orientation() {
+			continue
+		}
+		ok := true
+		for _, s := range t {
+			if overlap(r.QStart, r.QEnd, s.QStart, s.QEnd) != 0 {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			tiles[p] = append(t, r)
+			kept[i] = true
+		}
+	}
+	var tiled []*Record
+	for i, r := range recs {
+		if kept[i] {
+			tiled = append(tiled, r)
+		}
+	}
+	return tiled
+}
+
+// A Summary summarises the HSPs of a query.
+type Summary struct {
+	Query *Seq
+
+	// Best is the first HSP with the
+	// highest bit score.
+	Best *Record
+
+	// Subjects is the number of distinct
+	// subjects hit by the query.
+	Subjects int
+
+	// Covered is the number of query
+	// positions covered by any HSP, and
+	// Coverage is Covered as a fraction of
+	// the query length, or zero if the query
+	// length is not known.
+	Covered  int
+	Coverage float64
+}
+
+// Summarize returns a summary of the HSPs of each query in recs, in order of the
+// first HSP of each query. Queries are identified by name.
+func Summarize(recs []*Record) []Summary {
+	var (
+		sums     []Summary
+		index    = make(map[string]int)
+		subjects = make(map[pair]bool)
+		spans    = make(map[string][][2]int)
+	)
+	for _, r := range recs {
+		name := r.Query.Name()
+		k, ok := index[name]
+		if !ok {
+			k = len(sums)
+			index[name] = k
+			sums = append(sums, Summary{Query: r.Query, Best: r})
+		}
+		s := &sums[k]
+		if r.BitScore > s.Best.BitScore {
+			s.Best = r
+		}
+		if s.Query.SeqSize == 0 && r.Query.SeqSize != 0 {
+			s.Query = r.Query
+		}
+		if p := pairOf(r); !subjects[p] {
+			subjects[p] = true
+			s.Subjects++
+		}
+		spans[name] = append(spans[name], [2]int{r.QStart, r.QEnd})
+	}
+	for k := range sums {
+		s := &sums[k]
+		sp := spans[s.Query.Name()]
+		sort.Sort(spansByStart(sp))
+		end := 0
+		for _, v := range sp {
+			if v[0] > end {
+				end = v[0]
+			}
+			if v[1] > end {
+				s.Covered += v[1] - end
+				end = v[1]
+			}
+		}
+		if s.Query.SeqSize != 0 {
+			s.Coverage = float64(s.Covered) / float64(s.Query.SeqSize)
+		}
+	}
+	return sums
+}
+
+// spansByStart sorts query spans by start position.
+type spansByStart [][2]int
+
+func (s spansByStart) Len() int           { return len(s) }
+func (s spansByStart) Less(i, j int) bool { return s[i][0] < s[j][0] }
+func (s spansByStart) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,51 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blast
+
+import (
+	"strings"
+
+	"gopkg.in/check.v1"
+)
+
+const hits = `q1	s1	100.00	100	0	0	1	100	1	100	1e-50	200
+q1	s1	100.00	50	0	0	21	70	21	70	1e-20	90
+q1	s1	100.00	100	0	0	1	100	1	100	1e-50	200
+q1	s1	95.00	60	3	0	91	150	301	360	1e-10	60
+q1	s1	95.00	40	2	0	151	190	400	361	1e-8	50
+q1	s2	90.00	80	8	0	101	180	1	80	1e-30	120
+q2	s3	99.00	30	0	0	5	34	1	30	1e-5	40
+`
+
+func (s *S) TestHits(c *check.C) {
+	recs := readAll(c, NewTabularReader(strings.NewReader(hits)))
+	c.Assert(recs, check.HasLen, 7)
+	recs[0].Query.SeqSize = 200
+
+	kept := RemoveShadowed(recs, 0)
+	c.Check(kept, check.DeepEquals, []*Record{recs[0], recs[3], recs[4], recs[5], recs[6]})
+	c.Check(RemoveShadowed(recs, 0.5), check.DeepEquals, []*Record{recs[0], recs[3], recs[4], recs[5], recs[6]})
+
+	// The second s1 HSP overlaps the best on the query, and
+	// the reverse HSP does not match the orientation of the
+	// best.
+	c.Check(Tile(kept), check.DeepEquals, []*Record{recs[0], recs[5], recs[6]})
+	c.Check(Tile([]*Record{recs[0], recs[1], recs[3]}), check.DeepEquals, []*Record{recs[0]})
+	recs[3].QStart = 100
+	c.Check(Tile([]*Record{recs[0], recs[3]}), check.DeepEquals, []*Record{recs[0], recs[3]})
+	recs[3].QStart = 90
+
+	sums := Summarize(kept)
+	c.Assert(sums, check.HasLen, 2)
+	c.Check(sums[0].Query.Name(), check.Equals, "q1")
+	c.Check(sums[0].Best, check.Equals, recs[0])
+	c.Check(sums[0].Subjects, check.Equals, 2)
+	c.Check(sums[0].Covered, check.Equals, 190)
+	c.Check(sums[0].Coverage, check.Equals, 0.95)
+	c.Check(sums[1].Query.Name(), check.Equals, "q2")
+	c.Check(sums[1].Subjects, check.Equals, 1)
+	c.Check(sums[1].Covered, check.Equals, 30)
+	c.Check(sums[1].Coverage, check.Equals, 0.)
+}
@@ -0,0 +1,289 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package chain provides types to read and write UCSC chain format files.
+//
+// The specification can be found at http://genome.ucsc.edu/goldenPath/help/chain.html.
+package chain
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	ErrBadHeader     = errors.New("chain: bad header line")
+	ErrBadBlock      = errors.New("chain: bad alignment data line")
+	ErrBadStrand     = errors.New("chain: invalid strand")
+	ErrMissingBlocks = errors.New("chain: missing final alignment data line")
+	ErrBadLength     = errors.New("chain: alignment data does not match header")
+	ErrNotHandled    = errors.New("chain: type not handled")
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+	_ featio.Writer = (*Writer)(nil)
+
+	_ feat.Feature  = (*Chain)(nil)
+	_ feat.Orienter = (*Chain)(nil)
+	_ feat.Pair     = (*Chain)(nil)
+)
+
+// Chrom is a chromosome or scaffold named in a chain header.
+type Chrom struct {
+	ChromName string
+	ChromSize int
+}
+
+func (c *Chrom) Start() int             { return 0 }
+func (c *Chrom) End() int               { return c.ChromSize }
+func (c *Chrom) Len() int               { return c.ChromSize }
+func (c *Chrom) Name() string           { return c.ChromName }
+func (c *Chrom) Description() string    { return "chain chrom" }
+func (c *Chrom) Location() feat.Feature { return nil }
+
+// A Block is an ungapped aligned block of a chain. TStart is the position of the
+// block on the target (reference) sequence and QStart is the position of the block
+// on the query sequence in the coordinates of the query strand.
+type Block struct {
+	TStart int
+	QStart int
+	Size   int
+}
+
+// Chain is a UCSC chain record. Target coordinates are always on the forward
+// strand; query coordinates are given relative to the query strand, so when
+// QStrand is seq.Minus, positions count from the end of the query sequence.
+type Chain struct {
+	Score   int64
+	Target  *Chrom
+	TStrand seq.Strand
+	TStart  int
+	TEnd    int
+	Query   *Chrom
+	QStrand seq.Strand
+	QStart  int
+	QEnd    int
+	ID      int64
+	Blocks  []Block
+}
+
+func (c *Chain) Start() int             { return c.TStart }
+func (c *Chain) End() int               { return c.TEnd }
+func (c *Chain) Len() int               { return c.TEnd - c.TStart }
+func (c *Chain) Name() string           { return fmt.Sprintf("chain%d", c.ID) }
+func (c *Chain) Description() string    { return "chain" }
+func (c *Chain) Location() feat.Feature { return c.Target }
+
+// Orientation returns the relative orientation of the query and target.
+func (c *Chain) Orientation() feat.Orientation {
+	return feat.Orientation(c.TStrand) * feat.Orientation(c.QStrand)
+}
+
+// Features returns the target and query extents of the chain.
+func (c *Chain) Features() [2]feat.Feature {
+	return [2]feat.Feature{
+		&span{loc: c.Target, start: c.TStart, end: c.TEnd, strand: c.TStrand},
+		&span{loc: c.Query, start: c.QStart, end: c.QEnd, strand: c.QStrand},
+	}
+}
+
+// ForwardQuery returns the start and end of the query interval [start, end),
+// given in query strand coordinates, in forward strand coordinates.
+func (c *Chain) ForwardQuery(start, end int) (int, int) {
+	if c.QStrand == seq.Minus {
+		return c.Query.ChromSize - end, c.Query.ChromSize - start
+	}
+	return start, end
+}
+
+type span struct {
+	loc        feat.Feature
+	start, end int
+	strand     seq.Strand
+}
+
+func (s *span) Start() int                    { return s.start }
+func (s *span) End() int                      { return s.end }
+func (s *span) Len() int                      { return s.end - s.start }
+func (s *span) Name() string                  { return fmt.Sprintf("%s:[%d,%d)", s.loc.Name(), s.start, s.end) }
+func (s *span) Description() string           { return "chain span" }
+func (s *span) Location() feat.Feature        { return s.loc }
+func (s *span) Orientation() feat.Orientation { return feat.Orientation(s.strand) }
+
+func parseStrand(b []byte) (seq.Strand, error) {
+	if len(b) == 1 {
+		switch b[0] {
+		case '+':
+			return seq.Plus, nil
+		case '-':
+			return seq.Minus, nil
+		}
+	}
+	return seq.None, ErrBadStrand
+}
+
+func parseHeader(line []byte) (*Chain, error) {
+	f := bytes.Fields(line)
+	if len(f) != 13 || string(f[0]) != "chain" {
+		return nil, ErrBadHeader
+	}
+	var (
+		c   = &Chain{Target: &Chrom{}, Query: &Chrom{}}
+		err error
+		n   [6]int
+	)
+	c.Score, err = strconv.ParseInt(string(f[1]), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := range []int{3, 5, 6, 8, 10, 11} {
+		n[i], err = strconv.Atoi(string(f[j]))
+		if err != nil {
+			return nil, err
+		}
+	}
+	c.Target.ChromName, c.Target.ChromSize, c.TStart, c.TEnd = string(f[2]), n[0], n[1], n[2]
+	c.Query.ChromName, c.Query.ChromSize, c.QStart, c.QEnd = string(f[7]), n[3], n[4], n[5]
+	if c.TStrand, err = parseStrand(f[4]); err != nil {
+		return nil, err
+	}
+	if c.QStrand, err = parseStrand(f[9]); err != nil {
+		return nil, err
+	}
+	if c.ID, err = strconv.ParseInt(string(f[12]), 10, 64); err != nil {
+		return nil, err
+	}
+	if c.TStart > c.TEnd || c.QStart > c.QEnd {
+		return nil, ErrBadHeader
+	}
+	return c, nil
+}
+
+// Reader is a chain format reader.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// NewReader returns a new chain format reader using r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+func (r *Reader) readLine() ([]byte, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return line, nil
+	}
+}
+
+// Read reads a single chain and returns it or an error. The returned feat.Feature
+// is a *Chain.
+func (r *Reader) Read() (feat.Feature, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	c, err := parseHeader(line)
+	if err != nil {
+		return nil, fmt.Errorf("%v at line %d", err, r.line)
+	}
+
+	t, q := c.TStart, c.QStart
+	for {
+		line, err = r.readLine()
+		if err != nil {
+			if err == io.EOF {
+				err = ErrMissingBlocks
+			}
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		f := bytes.Fields(line)
+		if len(f) != 1 && len(f) != 3 {
+			return nil, fmt.Errorf("%v at line %d", ErrBadBlock, r.line)
+		}
+		var n [3]int
+		for i := range f {
+			n[i], err = strconv.Atoi(string(f[i]))
+			if err != nil || n[i] < 0 {
+				return nil, fmt.Errorf("%v at line %d", ErrBadBlock, r.line)
+			}
+		}
+		c.Blocks = append(c.Blocks, Block{TStart: t, QStart: q, Size: n[0]})
+		t += n[0] + n[1]
+		q += n[0] + n[2]
+		if len(f) == 1 {
+			break
+		}
+	}
+	if t != c.TEnd || q != c.QEnd {
+		return nil, fmt.Errorf("%v at line %d", ErrBadLength, r.line)
+	}
+
+	return c, nil
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+// Writer is a chain format writer.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a new chain format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes a single chain and returns the number of bytes written and any error.
+// Only *Chain features are handled.
+func (w *Writer) Write(f feat.Feature) (n int, err error) {
+	c, ok := f.(*Chain)
+	if !ok {
+		return 0, ErrNotHandled
+	}
+	n, err = fmt.Fprintf(w.w, "chain %d %s %d %s %d %d %s %d %s %d %d %d\n",
+		c.Score,
+		c.Target.ChromName, c.Target.ChromSize, c.TStrand, c.TStart, c.TEnd,
+		c.Query.ChromName, c.Query.ChromSize, c.QStrand, c.QStart, c.QEnd,
+		c.ID,
+	)
+	if err != nil {
+		return n, err
+	}
+	var _n int
+	for i, b := range c.Blocks {
+		if i == len(c.Blocks)-1 {
+			_n, err = fmt.Fprintf(w.w, "%d\n\n", b.Size)
+		} else {
+			next := c.Blocks[i+1]
+			_n, err = fmt.Fprintf(w.w, "%d\t%d\t%d\n", b.Size, next.TStart-(b.TStart+b.Size), next.QStart-(b.QStart+b.Size))
+		}
+		if n += _n; err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
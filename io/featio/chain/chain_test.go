@@ -0,0 +1,83 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"bytes"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const chainData = `chain 1000 chr1 1000 + 100 200 chrA 500 + 0 95 1
+50	10	5
+40
+
+chain 500 chr1 1000 + 300 350 chrB 400 - 10 60 2
+50
+
+`
+
+func (s *S) TestReadWrite(c *check.C) {
+	r := NewReader(bytes.NewBufferString(chainData))
+	var got []*Chain
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, f.(*Chain))
+	}
+	c.Assert(len(got), check.Equals, 2)
+
+	c.Check(got[0].Score, check.Equals, int64(1000))
+	c.Check(got[0].Target.Name(), check.Equals, "chr1")
+	c.Check(got[0].Query.Name(), check.Equals, "chrA")
+	c.Check(got[0].Blocks, check.DeepEquals, []Block{
+		{TStart: 100, QStart: 0, Size: 50},
+		{TStart: 160, QStart: 55, Size: 40},
+	})
+	c.Check(got[1].QStrand, check.Equals, seq.Minus)
+	c.Check(got[1].Orientation(), check.Equals, feat.Reverse)
+	qs, qe := got[1].ForwardQuery(got[1].QStart, got[1].QEnd)
+	c.Check(qs, check.Equals, 340)
+	c.Check(qe, check.Equals, 390)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, ch := range got {
+		_, err := w.Write(ch)
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, chainData)
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	for _, t := range []struct {
+		data string
+		err  string
+	}{
+		{"chain 1 chr1 10 + 0 5 chrA 10 + 0 5\n5\n", "chain: bad header line at line 1"},
+		{"chain 1 chr1 10 + 0 5 chrA 10 * 0 5 1\n5\n", "chain: invalid strand at line 1"},
+		{"chain 1 chr1 10 + 0 5 chrA 10 + 0 5 1\n3 1\n", "chain: bad alignment data line at line 2"},
+		{"chain 1 chr1 10 + 0 5 chrA 10 + 0 5 1\n3 1 1\n", "chain: missing final alignment data line at line 2"},
+		{"chain 1 chr1 10 + 0 5 chrA 10 + 0 5 1\n4\n", "chain: alignment data does not match header at line 2"},
+	} {
+		_, err := NewReader(bytes.NewBufferString(t.data)).Read()
+		c.Check(err, check.ErrorMatches, t.err)
+	}
+}
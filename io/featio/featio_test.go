@@ -6,10 +6,12 @@ package featio_test
 
 import (
 	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/io/featio/bed"
 	"github.com/biogo/biogo/io/featio/gff"
 	"github.com/biogo/biogo/seq"
 
 	"bytes"
+	"sort"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -108,3 +110,48 @@ SEQ2	grail	ATG	17	19	2.1	-	0
 		c.Check(j, check.Equals, len(g.feat))
 	}
 }
+
+func (s *S) TestNewReaderDetect(c *check.C) {
+	formats := featio.Formats()
+	sort.Strings(formats)
+	c.Check(formats, check.DeepEquals, []string{"bed", "gff"})
+	for i, t := range []struct {
+		in     string
+		format string
+		expect []string
+	}{
+		{"##gff-version 2\nSEQ1\tEMBL\texon\t103\t172\t.\t+\t0\n", "gff", []string{"exon"}},
+		{"# comment\nSEQ1\tEMBL\tatg\t103\t105\t.\t+\t0\nSEQ1\tEMBL\texon\t103\t172\t.\t+\t0\n", "gff", []string{"atg", "exon"}},
+		{"chr1\t10\t20\n", "bed", []string{"chr1:[10,20)"}},
+		{"chr1\t10\t20\tfeat1\t0\t+\t10\t20\t0\n", "bed", []string{"feat1"}},
+	} {
+		r, format, err := featio.NewReader(bytes.NewBufferString(t.in))
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(format, check.Equals, t.format, check.Commentf("Test %d", i))
+		var obtain []string
+		sc := featio.NewScanner(r)
+		for sc.Next() {
+			f := sc.Feat()
+			if g, ok := f.(*gff.Feature); ok {
+				obtain = append(obtain, g.Feature)
+			} else {
+				obtain = append(obtain, f.Name())
+			}
+		}
+		c.Check(sc.Error(), check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(obtain, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+
+	for i, in := range []string{"", ">seq\nACGT\n", "chr1 10 20\n"} {
+		_, _, err := featio.NewReader(bytes.NewBufferString(in))
+		c.Check(err, check.Equals, featio.ErrUnknownFormat, check.Commentf("Test %d", i))
+	}
+
+	r, err := featio.NewReaderFormat(bytes.NewBufferString("chr1\t10\t20\tfeat1\n"), "bed")
+	c.Assert(err, check.Equals, nil)
+	f, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(f.(*bed.Bed4).FeatName, check.Equals, "feat1")
+	_, err = featio.NewReaderFormat(bytes.NewBufferString(""), "genbank")
+	c.Check(err, check.Equals, featio.ErrUnknownFormat)
+}
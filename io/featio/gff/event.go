@@ -0,0 +1,134 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gff
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+
+	"bytes"
+	"encoding/csv"
+	"io"
+)
+
+// A Handler receives the parts of a GFF file as they are parsed by Parse.
+// Returning a non-nil error from any method stops parsing.
+type Handler interface {
+	// Meta is called for each meta line other
+	// than sequence-region lines and embedded
+	// sequences, after it has been applied to
+	// the Reader's Metadata, with the line
+	// without its leading "##".
+	Meta(line string) error
+
+	// Feature is called for each feature with
+	// a *Feature, and for each sequence-region
+	// line with a *Region.
+	Feature(f feat.Feature) error
+
+	// Sequence is called for each line of an
+	// embedded sequence with the sequence name
+	// and molecule type and the zero-based
+	// position of the first letter of the line.
+	// The letters are only valid until Sequence
+	// returns.
+	Sequence(id string, moltype feat.Moltype, pos int, letters alphabet.Letters) error
+}
+
+// Parse parses the remaining lines read by r, passing their content to h. Embedded
+// sequences are passed in parts rather than as complete sequences, so files of any
+// size can be processed in constant memory. Parse returns nil at the end of the input.
+func (r *Reader) Parse(h Handler) (err error) {
+	var f feat.Feature
+	defer handlePanic(&f, &err)
+
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return &csv.ParseError{Line: r.line, Err: err}
+		}
+		r.line++
+		line = bytes.TrimSpace(line)
+		switch {
+		case len(line) == 0:
+			continue
+		case bytes.HasPrefix(line, []byte("##")):
+			fields := bytes.Split(line[2:], []byte{' '})
+			switch unsafeString(fields[0]) {
+			case "DNA", "RNA", "Protein", "dna", "rna", "protein":
+				if len(fields) <= 1 {
+					return &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
+				}
+				err = r.parseSeq(h, string(fields[0]), string(fields[1]))
+			default:
+				f, err = r.metaline(fields)
+				switch {
+				case err != nil:
+				case f != nil:
+					err = h.Feature(f)
+				default:
+					err = h.Meta(string(line[2:]))
+				}
+			}
+		case line[0] == '#':
+			continue
+		default:
+			f, err = r.feature(line)
+			if err == nil {
+				err = h.Feature(f)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parseSeq passes the lines of an embedded sequence to h.
+func (r *Reader) parseSeq(h Handler, moltype, id string) error {
+	mt := feat.ParseMoltype(moltype)
+	if mt != feat.DNA && mt != feat.RNA && mt != feat.Protein {
+		return ErrBadMoltype
+	}
+	var (
+		pos     int
+		letters alphabet.Letters
+	)
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return &csv.ParseError{Line: r.line, Err: err}
+		}
+		r.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if !bytes.HasPrefix(line, []byte("##")) {
+			return &csv.ParseError{Line: r.line, Err: ErrBadSequence}
+		}
+		line = bytes.TrimSpace(line[2:])
+		if unsafeString(line) == "end-"+moltype {
+			return nil
+		}
+		letters = letters[:0]
+		for _, b := range line {
+			if b != ' ' && b != '\t' {
+				letters = append(letters, alphabet.Letter(b))
+			}
+		}
+		err = h.Sequence(id, mt, pos, letters)
+		if err != nil {
+			return err
+		}
+		pos += len(letters)
+	}
+}
@@ -6,6 +6,9 @@
 // files according to the Sanger Institute specification.
 //
 // The specification can be found at http://www.sanger.ac.uk/resources/software/gff/spec.html.
+//
+// Features may also be written with GFF3 attribute syntax for use with tools
+// and genome browsers that require GFF3.
 package gff
 
 import (
@@ -34,6 +37,49 @@ var (
 	_ featio.Writer = (*Writer)(nil)
 )
 
+func init() {
+	featio.RegisterFormat(featio.Format{
+		Name:   "gff",
+		Detect: detect,
+		NewReader: func(r io.Reader) (featio.Reader, error) {
+			return NewReader(r), nil
+		},
+	})
+}
+
+// detect returns whether data starts with a GFF version line or its first
+// feature line has tab-separated GFF fields with integer coordinates.
+func detect(data []byte) bool {
+	for len(data) != 0 {
+		var line []byte
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			line, data = data, nil
+		}
+		line = bytes.TrimSpace(line)
+		switch {
+		case len(line) == 0:
+			continue
+		case bytes.HasPrefix(line, []byte("##gff-version")):
+			return true
+		case line[0] == '#':
+			continue
+		}
+		fields := bytes.Split(line, []byte{'\t'})
+		if len(fields) < frameField+1 {
+			return false
+		}
+		for _, f := range [...]int{startField, endField} {
+			if _, err := strconv.Atoi(string(fields[f])); err != nil {
+				return false
+			}
+		}
+		return len(fields[strandField]) == 1 && bytes.IndexByte([]byte("+-.?"), fields[strandField][0]) >= 0
+	}
+	return false
+}
+
 // Version is the GFF version that is read and written.
 const Version = 2
 
@@ -57,6 +103,7 @@ var (
 	ErrEmptyMetaLine  = Error{"gff: empty comment metaline"}
 	ErrBadMetaLine    = Error{"gff: incomplete metaline"}
 	ErrBadSequence    = Error{"gff: corrupt metasequence"}
+	ErrNoLocation     = Error{"gff: feature has no location"}
 )
 
 const (
@@ -375,6 +422,23 @@ func (r *Reader) commentMetaline(line []byte) (f feat.Feature, err error) {
 	if len(fields) < 1 {
 		return nil, &csv.ParseError{Line: r.line, Err: ErrEmptyMetaLine}
 	}
+	switch unsafeString(fields[0]) {
+	case "DNA", "RNA", "Protein", "dna", "rna", "protein":
+		if len(fields) <= 1 {
+			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
+		}
+		return r.metaSeq(fields[0], fields[1])
+	}
+	f, err = r.metaline(fields)
+	if f != nil || err != nil {
+		return f, err
+	}
+	return r.Read()
+}
+
+// metaline applies the meta line fields to the Reader's Metadata. It returns a
+// *Region for sequence-region lines, and nil for other lines.
+func (r *Reader) metaline(fields [][]byte) (f feat.Feature, err error) {
 	switch unsafeString(fields[0]) {
 	case "gff-version":
 		v := mustAtoi(fields, 1, r.line)
@@ -382,13 +446,13 @@ func (r *Reader) commentMetaline(line []byte) (f feat.Feature, err error) {
 			return nil, &csv.ParseError{Line: r.line, Err: ErrNotHandled}
 		}
 		r.Version = Version
-		return r.Read()
+		return nil, nil
 	case "source-version":
 		if len(fields) <= 1 {
 			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
 		}
 		r.SourceVersion = string(bytes.Join(fields[1:], []byte{' '}))
-		return r.Read()
+		return nil, nil
 	case "date":
 		if len(fields) <= 1 {
 			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
@@ -399,7 +463,7 @@ func (r *Reader) commentMetaline(line []byte) (f feat.Feature, err error) {
 				return nil, err
 			}
 		}
-		return r.Read()
+		return nil, nil
 	case "Type", "type":
 		if len(fields) <= 1 {
 			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
@@ -408,7 +472,7 @@ func (r *Reader) commentMetaline(line []byte) (f feat.Feature, err error) {
 		if len(fields) > 2 {
 			r.Name = string(fields[2])
 		}
-		return r.Read()
+		return nil, nil
 	case "sequence-region":
 		if len(fields) <= 3 {
 			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
@@ -418,11 +482,6 @@ func (r *Reader) commentMetaline(line []byte) (f feat.Feature, err error) {
 			RegionStart: feat.OneToZero(mustAtoi(fields, 2, r.line)),
 			RegionEnd:   mustAtoi(fields, 3, r.line),
 		}, nil
-	case "DNA", "RNA", "Protein", "dna", "rna", "protein":
-		if len(fields) <= 1 {
-			return nil, &csv.ParseError{Line: r.line, Err: ErrBadMetaLine}
-		}
-		return r.metaSeq(fields[0], fields[1])
 	default:
 		return nil, &csv.ParseError{Line: r.line, Err: ErrNotHandled}
 	}
@@ -499,6 +558,12 @@ func (r *Reader) Read() (f feat.Feature, err error) {
 		}
 	}
 
+	return r.feature(line)
+}
+
+// feature returns the feature described by a feature line. It panics with an
+// error if a field cannot be parsed.
+func (r *Reader) feature(line []byte) (f feat.Feature, err error) {
 	fields := bytes.SplitN(line, []byte{'\t'}, lastField)
 	if len(fields) < frameField {
 		return nil, &csv.ParseError{Line: r.line, Column: len(fields), Err: ErrFieldMissing}
@@ -537,6 +602,13 @@ type Writer struct {
 	Precision  int
 	Width      int
 	header     bool
+
+	// Version is the GFF version used to format attributes. If
+	// Version is 3 or greater, attributes are written in GFF3
+	// tag=value syntax with reserved characters escaped, otherwise
+	// in GFF2 syntax. Writing a version header with WriteMetaData
+	// sets Version.
+	Version int
 }
 
 // Returns a new GFF format writer using w. When header is true,
@@ -547,6 +619,7 @@ func NewWriter(w io.Writer, width int, header bool) *Writer {
 		Width:      width,
 		TimeFormat: Astronomical,
 		Precision:  -1,
+		Version:    Version,
 	}
 
 	if header {
@@ -615,7 +688,11 @@ func (w *Writer) Write(f feat.Feature) (n int, err error) {
 			return n, err
 		}
 		if f.FeatAttributes != nil {
-			_n, err = fmt.Fprintf(w.w, "\t%v", f.FeatAttributes)
+			if w.Version >= 3 {
+				_n, err = fmt.Fprintf(w.w, "\t%s", gff3Attributes(f.FeatAttributes))
+			} else {
+				_n, err = fmt.Fprintf(w.w, "\t%v", f.FeatAttributes)
+			}
 			if err != nil {
 				return n, err
 			}
@@ -682,6 +759,7 @@ func (w *Writer) WriteMetaData(d interface{}) (n int, err error) {
 		if w.header {
 			return 0, ErrCannotHeader
 		}
+		w.Version = d
 		return fmt.Fprintf(w.w, "##gff-version %d\n", d)
 	case feat.Moltype:
 		return fmt.Fprintf(w.w, "##Type %s\n", d)
@@ -701,3 +779,117 @@ func (w *Writer) WriteMetaData(d interface{}) (n int, err error) {
 func (w *Writer) WriteComment(c string) (n int, err error) {
 	return fmt.Fprintf(w.w, "# %s\n", c)
 }
+
+// gff3Attributes returns the GFF3 representation of a. Double quoted values
+// are unquoted and reserved characters are percent encoded.
+func gff3Attributes(a Attributes) string {
+	var buf bytes.Buffer
+	for i, tv := range a {
+		if i != 0 {
+			buf.WriteByte(';')
+		}
+		buf.WriteString(gff3Escape(tv.Tag))
+		buf.WriteByte('=')
+		v := tv.Value
+		if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+			if u, err := strconv.Unquote(v); err == nil {
+				v = u
+			}
+		}
+		buf.WriteString(gff3Escape(v))
+	}
+	return buf.String()
+}
+
+func gff3Escape(s string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		switch b := s[i]; {
+		case b < ' ', b == 0x7f, b == ';', b == '=', b == '&', b == ',', b == '%':
+			fmt.Fprintf(&buf, "%%%02X", b)
+		default:
+			buf.WriteByte(b)
+		}
+	}
+	return buf.String()
+}
+
+// A Framer is a feature that has a coding frame.
+type Framer interface {
+	Frame() Frame
+}
+
+// An Attributer is a feature that has GFF attributes.
+type Attributer interface {
+	Attributes() Attributes
+}
+
+// NewFeature returns a GFF feature with the given source and feature type
+// describing f. The sequence name is the name of the location of f, which must
+// not be nil. The strand is taken from f if it is a feat.Orienter, the score
+// if f has a Score method returning an int or a float64 and the frame if f is a
+// Framer. The attributes are those of an Attributer, or otherwise a Name
+// attribute holding the name of f if it is not empty.
+func NewFeature(f feat.Feature, source, typ string) (*Feature, error) {
+	if f.Location() == nil {
+		return nil, ErrNoLocation
+	}
+	g := &Feature{
+		SeqName:   f.Location().Name(),
+		Source:    source,
+		Feature:   typ,
+		FeatStart: f.Start(),
+		FeatEnd:   f.End(),
+		FeatFrame: NoFrame,
+	}
+	if o, ok := f.(feat.Orienter); ok {
+		switch o.Orientation() {
+		case feat.Forward:
+			g.FeatStrand = seq.Plus
+		case feat.Reverse:
+			g.FeatStrand = seq.Minus
+		}
+	}
+	switch sf := f.(type) {
+	case interface {
+		Score() int
+	}:
+		score := float64(sf.Score())
+		g.FeatScore = &score
+	case interface {
+		Score() float64
+	}:
+		score := sf.Score()
+		g.FeatScore = &score
+	}
+	if fr, ok := f.(Framer); ok {
+		g.FeatFrame = fr.Frame()
+	}
+	if a, ok := f.(Attributer); ok {
+		g.FeatAttributes = a.Attributes()
+	} else if name := f.Name(); name != "" {
+		g.FeatAttributes = Attributes{{Tag: "Name", Value: strconv.Quote(name)}}
+	}
+	return g, nil
+}
+
+// WriteSet writes the features of s and returns the number of bytes written and
+// any error. Features that are not *Feature are converted with NewFeature using
+// the given source and feature type.
+func (w *Writer) WriteSet(s feat.Set, source, typ string) (n int, err error) {
+	for _, f := range s.Features() {
+		if _, ok := f.(*Feature); !ok {
+			f, err = NewFeature(f, source, typ)
+			if err != nil {
+				return n, err
+			}
+		}
+		var _n int
+		_n, err = w.Write(f)
+		n += _n
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
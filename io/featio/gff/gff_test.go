@@ -11,6 +11,7 @@ import (
 	"github.com/biogo/biogo/seq/linear"
 
 	"bytes"
+	"fmt"
 	"io"
 	"strings"
 	"testing"
@@ -200,3 +201,127 @@ func (s *S) TestWriteMetadata(c *check.C) {
 		c.Check(buf.String(), check.Equals, g.gff, check.Commentf("Test: %d", i))
 	}
 }
+
+type chrom string
+
+func (c chrom) Start() int             { return 0 }
+func (c chrom) End() int               { return 1000 }
+func (c chrom) Len() int               { return 1000 }
+func (c chrom) Name() string           { return string(c) }
+func (c chrom) Description() string    { return "chromosome" }
+func (c chrom) Location() feat.Feature { return nil }
+
+type orf struct {
+	name       string
+	start, end int
+	orient     feat.Orientation
+	frame      Frame
+	score      int
+	loc        feat.Feature
+}
+
+func (o orf) Start() int                    { return o.start }
+func (o orf) End() int                      { return o.end }
+func (o orf) Len() int                      { return o.end - o.start }
+func (o orf) Name() string                  { return o.name }
+func (o orf) Description() string           { return "orf" }
+func (o orf) Location() feat.Feature        { return o.loc }
+func (o orf) Orientation() feat.Orientation { return o.orient }
+func (o orf) Frame() Frame                  { return o.frame }
+func (o orf) Score() int                    { return o.score }
+
+type set []feat.Feature
+
+func (s set) Features() []feat.Feature { return s }
+
+func (s *S) TestWriteSet(c *check.C) {
+	fs := set{
+		orf{name: "orf1", start: 10, end: 40, orient: feat.Forward, frame: Frame0, score: 30, loc: chrom("chr1")},
+		orf{name: "orf 2", start: 100, end: 130, orient: feat.Reverse, frame: Frame1, score: 12, loc: chrom("chr1")},
+		&Feature{
+			SeqName: "chr2", Source: "EMBL", Feature: "repeat", FeatStart: 4, FeatEnd: 9, FeatFrame: NoFrame,
+			FeatAttributes: Attributes{{Tag: "Note", Value: `"A;B=C"`}, {Tag: "Class", Value: "LINE"}},
+		},
+	}
+	for i, t := range []struct {
+		version int
+		gff     string
+	}{
+		{
+			version: 2,
+			gff: `##gff-version 2
+chr1	orfs	ORF	11	40	30	+	0	Name "orf1"
+chr1	orfs	ORF	101	130	12	-	1	Name "orf 2"
+chr2	EMBL	repeat	5	9	.	.	.	Note "A;B=C"; Class LINE
+`,
+		},
+		{
+			version: 3,
+			gff: `##gff-version 3
+chr1	orfs	ORF	11	40	30	+	0	Name=orf1
+chr1	orfs	ORF	101	130	12	-	1	Name=orf 2
+chr2	EMBL	repeat	5	9	.	.	.	Note=A%3BB%3DC;Class=LINE
+`,
+		},
+	} {
+		buf := &bytes.Buffer{}
+		w := NewWriter(buf, width, false)
+		n, err := w.WriteMetaData(t.version)
+		c.Assert(err, check.Equals, nil)
+		_n, err := w.WriteSet(fs, "orfs", "ORF")
+		c.Check(err, check.Equals, nil)
+		c.Check(buf.Len(), check.Equals, n+_n)
+		c.Check(buf.String(), check.Equals, t.gff, check.Commentf("Test: %d", i))
+	}
+
+	_, err := NewWriter(&bytes.Buffer{}, width, false).WriteSet(set{chrom("chr1")}, "orfs", "ORF")
+	c.Check(err, check.Equals, ErrNoLocation)
+}
+
+// events is a Handler that records the events it receives.
+type events []string
+
+func (e *events) Meta(line string) error {
+	*e = append(*e, "meta "+line)
+	return nil
+}
+
+func (e *events) Feature(f feat.Feature) error {
+	*e = append(*e, fmt.Sprintf("feature %T %s %d %d", f, f.Location().Name(), f.Start(), f.End()))
+	return nil
+}
+
+func (e *events) Sequence(id string, moltype feat.Moltype, pos int, l alphabet.Letters) error {
+	*e = append(*e, fmt.Sprintf("sequence %s %v %d %s", id, moltype, pos, l))
+	return nil
+}
+
+func (s *S) TestParse(c *check.C) {
+	var e events
+	r := NewReader(strings.NewReader(`##gff-version 2
+##date 1997-11-08
+##DNA seq1
+##acggctcgga ttggcgctgg
+##atgatag
+##end-DNA
+# a comment
+##sequence-region seq1 1 5
+seq1	EMBL	atg	2	4	.	+	0
+`))
+	err := r.Parse(&e)
+	c.Assert(err, check.Equals, nil)
+	c.Check([]string(e), check.DeepEquals, []string{
+		"meta gff-version 2",
+		"meta date 1997-11-08",
+		"sequence seq1 DNA 0 acggctcggattggcgctgg",
+		"sequence seq1 DNA 20 atgatag",
+		"feature *gff.Region seq1 0 5",
+		"feature *gff.Feature seq1 1 4",
+	})
+	c.Check(r.Date, check.Equals, mustTime(time.Parse(Astronomical, "1997-11-08")))
+
+	err = NewReader(strings.NewReader("##DNA seq1\n##acgt\n")).Parse(&e)
+	c.Check(err, check.ErrorMatches, ".*unexpected EOF")
+	err = NewReader(strings.NewReader("seq1\tEMBL\tatg\tx\t4\t.\t+\t0\n")).Parse(&e)
+	c.Check(err, check.NotNil)
+}
@@ -0,0 +1,360 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hmmer provides types to read HMMER3 tabular output files written by
+// hmmsearch and hmmscan with the --tblout and --domtblout options.
+package hmmer
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	ErrBadFields      = errors.New("hmmer: too few fields")
+	ErrBadCoordinates = errors.New("hmmer: bad coordinates")
+)
+
+var (
+	_ featio.Reader = (*DomainReader)(nil)
+
+	_ feat.Feature = (*Seq)(nil)
+	_ feat.Feature = (*Domain)(nil)
+	_ feat.Pair    = (*Domain)(nil)
+)
+
+const (
+	numTableFields  = 18
+	numDomainFields = 22
+)
+
+// Seq is a sequence or profile HMM named in a HMMER record. SeqSize is zero
+// if the length is not given by the record.
+type Seq struct {
+	SeqName   string
+	Accession string
+	SeqDesc   string
+	SeqSize   int
+}
+
+func (s *Seq) Start() int             { return 0 }
+func (s *Seq) End() int               { return s.SeqSize }
+func (s *Seq) Len() int               { return s.SeqSize }
+func (s *Seq) Name() string           { return s.SeqName }
+func (s *Seq) Description() string    { return s.SeqDesc }
+func (s *Seq) Location() feat.Feature { return nil }
+
+// Hit is a per-sequence hit from a --tblout file.
+type Hit struct {
+	Seq   *Seq
+	Model *Seq
+
+	// EValue, Score and Bias are the full
+	// sequence statistics.
+	EValue, Score, Bias float64
+
+	// DomEValue, DomScore and DomBias are the
+	// statistics of the best scoring domain.
+	DomEValue, DomScore, DomBias float64
+
+	// Exp is the expected number of domains and
+	// Reg, Clu, Ov, Env, Dom, Rep and Inc are the
+	// domain number estimation counts.
+	Exp                              float64
+	Reg, Clu, Ov, Env, Dom, Rep, Inc int
+}
+
+// Domain is a per-domain hit from a --domtblout file. All coordinates are
+// zero-based half-open. Domain satisfies feat.Feature with the aligned region
+// of the sequence.
+type Domain struct {
+	Seq   *Seq
+	Model *Seq
+
+	// EValue, Score and Bias are the full
+	// sequence statistics.
+	EValue, Score, Bias float64
+
+	// Num is the index of the domain in the
+	// sequence and Of is the number of domains
+	// in the sequence.
+	Num, Of int
+
+	CEValue, IEValue float64 // Conditional and independent E-values.
+	DomScore         float64
+	DomBias          float64
+
+	HMMStart, HMMEnd int // Aligned region of the model.
+	AliStart, AliEnd int // Aligned region of the sequence.
+	EnvStart, EnvEnd int // Envelope of the domain on the sequence.
+
+	// Acc is the mean posterior probability
+	// of the aligned residues.
+	Acc float64
+}
+
+func (d *Domain) Start() int             { return d.AliStart }
+func (d *Domain) End() int               { return d.AliEnd }
+func (d *Domain) Len() int               { return d.AliEnd - d.AliStart }
+func (d *Domain) Name() string           { return d.Model.Name() }
+func (d *Domain) Description() string    { return "hmmer domain" }
+func (d *Domain) Location() feat.Feature { return d.Seq }
+
+// Features returns the aligned regions of the sequence and model.
+func (d *Domain) Features() [2]feat.Feature {
+	return [2]feat.Feature{
+		&Segment{Loc: d.Seq, SegStart: d.AliStart, SegEnd: d.AliEnd},
+		&Segment{Loc: d.Model, SegStart: d.HMMStart, SegEnd: d.HMMEnd},
+	}
+}
+
+// Envelope returns the envelope of the domain on the sequence.
+func (d *Domain) Envelope() *Segment {
+	return &Segment{Loc: d.Seq, SegStart: d.EnvStart, SegEnd: d.EnvEnd}
+}
+
+// A Segment is an interval on a HMMER sequence or model.
+type Segment struct {
+	Loc      feat.Feature
+	SegStart int
+	SegEnd   int
+}
+
+func (s *Segment) Start() int             { return s.SegStart }
+func (s *Segment) End() int               { return s.SegEnd }
+func (s *Segment) Len() int               { return s.SegEnd - s.SegStart }
+func (s *Segment) Name() string           { return fmt.Sprintf("%s:[%d,%d)", s.Loc.Name(), s.SegStart, s.SegEnd) }
+func (s *Segment) Description() string    { return "hmmer segment" }
+func (s *Segment) Location() feat.Feature { return s.Loc }
+
+// split splits line into n whitespace separated fields and the remainder of
+// the line, which holds the free text target description.
+func split(line []byte, n int) ([][]byte, string, error) {
+	f := make([][]byte, n)
+	for i := range f {
+		line = bytes.TrimLeft(line, " \t")
+		j := bytes.IndexAny(line, " \t")
+		if j < 0 {
+			j = len(line)
+		}
+		if j == 0 {
+			return nil, "", ErrBadFields
+		}
+		f[i], line = line[:j], line[j:]
+	}
+	desc := string(bytes.TrimSpace(line))
+	if desc == "-" {
+		desc = ""
+	}
+	return f, desc, nil
+}
+
+func accession(b []byte) string {
+	if string(b) == "-" {
+		return ""
+	}
+	return string(b)
+}
+
+// seqs returns the sequence and model of a record given its target and query.
+func seqs(target, query *Seq, scan bool) (sequence, model *Seq) {
+	if scan {
+		return query, target
+	}
+	return target, query
+}
+
+// interval converts one-based inclusive coordinates to a zero-based half-open
+// interval.
+func interval(from, to []byte) (start, end int, err error) {
+	f, err := strconv.Atoi(string(from))
+	if err != nil {
+		return 0, 0, err
+	}
+	t, err := strconv.Atoi(string(to))
+	if err != nil {
+		return 0, 0, err
+	}
+	if f < 1 || f > t {
+		return 0, 0, ErrBadCoordinates
+	}
+	return f - 1, t, nil
+}
+
+func parseFloats(f [][]byte, idx []int, dst ...*float64) error {
+	for i, j := range idx {
+		var err error
+		*dst[i], err = strconv.ParseFloat(string(f[j]), 64)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseInts(f [][]byte, idx []int, dst ...*int) error {
+	for i, j := range idx {
+		var err error
+		*dst[i], err = strconv.Atoi(string(f[j]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseHit(line []byte, scan bool) (*Hit, error) {
+	f, desc, err := split(line, numTableFields)
+	if err != nil {
+		return nil, err
+	}
+	h := &Hit{}
+	h.Seq, h.Model = seqs(
+		&Seq{SeqName: string(f[0]), Accession: accession(f[1]), SeqDesc: desc},
+		&Seq{SeqName: string(f[2]), Accession: accession(f[3])},
+		scan,
+	)
+	err = parseFloats(f, []int{4, 5, 6, 7, 8, 9, 10},
+		&h.EValue, &h.Score, &h.Bias, &h.DomEValue, &h.DomScore, &h.DomBias, &h.Exp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = parseInts(f, []int{11, 12, 13, 14, 15, 16, 17},
+		&h.Reg, &h.Clu, &h.Ov, &h.Env, &h.Dom, &h.Rep, &h.Inc,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func parseDomain(line []byte, scan bool) (*Domain, error) {
+	f, desc, err := split(line, numDomainFields)
+	if err != nil {
+		return nil, err
+	}
+	var tlen, qlen int
+	err = parseInts(f, []int{2, 5}, &tlen, &qlen)
+	if err != nil {
+		return nil, err
+	}
+	d := &Domain{}
+	d.Seq, d.Model = seqs(
+		&Seq{SeqName: string(f[0]), Accession: accession(f[1]), SeqDesc: desc, SeqSize: tlen},
+		&Seq{SeqName: string(f[3]), Accession: accession(f[4]), SeqSize: qlen},
+		scan,
+	)
+	err = parseFloats(f, []int{6, 7, 8, 11, 12, 13, 14, 21},
+		&d.EValue, &d.Score, &d.Bias, &d.CEValue, &d.IEValue, &d.DomScore, &d.DomBias, &d.Acc,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = parseInts(f, []int{9, 10}, &d.Num, &d.Of)
+	if err != nil {
+		return nil, err
+	}
+	if d.HMMStart, d.HMMEnd, err = interval(f[15], f[16]); err != nil {
+		return nil, err
+	}
+	if d.AliStart, d.AliEnd, err = interval(f[17], f[18]); err != nil {
+		return nil, err
+	}
+	if d.EnvStart, d.EnvEnd, err = interval(f[19], f[20]); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+type reader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// next returns the next record line, skipping comments and blank lines.
+func (r *reader) next() ([]byte, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimRight(line, "\r\n")
+		if len(bytes.TrimSpace(line)) == 0 || line[0] == '#' {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return line, nil
+	}
+}
+
+// Line returns the current line number.
+func (r *reader) Line() int { return r.line }
+
+// TableReader is a HMMER --tblout format reader.
+type TableReader struct {
+	// Scan indicates that the input was written
+	// by hmmscan, where the query is the sequence
+	// and the targets are profile HMMs.
+	Scan bool
+
+	reader
+}
+
+// NewTableReader returns a new HMMER --tblout format reader using r.
+func NewTableReader(r io.Reader) *TableReader {
+	return &TableReader{reader: reader{r: bufio.NewReader(r)}}
+}
+
+// Read reads a single per-sequence hit and returns it or an error.
+func (r *TableReader) Read() (*Hit, error) {
+	line, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+	h, err := parseHit(line, r.Scan)
+	if err != nil {
+		return nil, fmt.Errorf("%v at line %d", err, r.line)
+	}
+	return h, nil
+}
+
+// DomainReader is a HMMER --domtblout format reader.
+type DomainReader struct {
+	// Scan indicates that the input was written
+	// by hmmscan, where the query is the sequence
+	// and the targets are profile HMMs.
+	Scan bool
+
+	reader
+}
+
+// NewDomainReader returns a new HMMER --domtblout format reader using r.
+func NewDomainReader(r io.Reader) *DomainReader {
+	return &DomainReader{reader: reader{r: bufio.NewReader(r)}}
+}
+
+// Read reads a single domain and returns it or an error. The returned
+// feat.Feature is a *Domain.
+func (r *DomainReader) Read() (feat.Feature, error) {
+	line, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+	d, err := parseDomain(line, r.Scan)
+	if err != nil {
+		return nil, fmt.Errorf("%v at line %d", err, r.line)
+	}
+	return d, nil
+}
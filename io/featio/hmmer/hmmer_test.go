@@ -0,0 +1,105 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hmmer
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const tblout = `#                                                               --- full sequence ---- --- best 1 domain ---- --- domain number estimation ----
+# target name        accession  query name           accession    E-value  score  bias   E-value  score  bias   exp reg clu  ov env dom rep inc description of target
+#------------------- ---------- -------------------- ---------- --------- ------ ----- --------- ------ -----   --- --- --- --- --- --- --- --- ---------------------
+Pkinase              PF00069.28 sp|P00533|EGFR_HUMAN -            1.2e-45  155.3   0.0   2.1e-45  154.5   0.0   1.4   1   0   0   1   1   1   1 Protein kinase domain
+Furin-like           PF00757.23 sp|P00533|EGFR_HUMAN -            3.4e-12   45.1  11.2   5.6e-07   27.9   2.1   2.5   2   0   0   2   2   2   2 -
+#
+# Program:         hmmscan
+`
+
+const domtblout = `#                                                                            --- full sequence --- -------------- this domain -------------   hmm coord   ali coord   env coord
+# target name        accession   tlen query name           accession   qlen   E-value  score  bias   #  of  c-Evalue  i-Evalue  score  bias  from    to  from    to  from    to  acc description of target
+#------------------- ---------- ----- -------------------- ---------- ----- --------- ------ ----- --- --- --------- --------- ------ ----- ----- ----- ----- ----- ----- ----- ---- ---------------------
+Furin-like           PF00757.23   149 sp|P00533|EGFR_HUMAN -           1210   3.4e-12   45.1  11.2   1   2   1.1e-09   5.6e-07   27.9   2.1     2    80   185   265   184   270 0.83 Furin-like cysteine rich region
+Furin-like           PF00757.23   149 sp|P00533|EGFR_HUMAN -           1210   3.4e-12   45.1  11.2   2   2   2.3e-06   0.0011    17.0   1.2    40   149   505   610   500   612 0.79 Furin-like cysteine rich region
+`
+
+func (s *S) TestTable(c *check.C) {
+	r := NewTableReader(strings.NewReader(tblout))
+	r.Scan = true
+	var hits []*Hit
+	for {
+		h, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		hits = append(hits, h)
+	}
+	c.Assert(hits, check.HasLen, 2)
+	h := hits[0]
+	c.Check(h.Seq, check.DeepEquals, &Seq{SeqName: "sp|P00533|EGFR_HUMAN"})
+	c.Check(h.Model, check.DeepEquals, &Seq{SeqName: "Pkinase", Accession: "PF00069.28", SeqDesc: "Protein kinase domain"})
+	c.Check(h.EValue, check.Equals, 1.2e-45)
+	c.Check(h.DomScore, check.Equals, 154.5)
+	c.Check([7]int{h.Reg, h.Clu, h.Ov, h.Env, h.Dom, h.Rep, h.Inc}, check.Equals, [7]int{1, 0, 0, 1, 1, 1, 1})
+	c.Check(hits[1].Model.SeqDesc, check.Equals, "")
+	c.Check(hits[1].Exp, check.Equals, 2.5)
+}
+
+func (s *S) TestDomain(c *check.C) {
+	r := NewDomainReader(strings.NewReader(domtblout))
+	var doms []*Domain
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		doms = append(doms, f.(*Domain))
+	}
+	c.Assert(doms, check.HasLen, 2)
+
+	// Without Scan set the target is the sequence.
+	d := doms[0]
+	c.Check(d.Location().Name(), check.Equals, "Furin-like")
+
+	r = NewDomainReader(strings.NewReader(domtblout))
+	r.Scan = true
+	f, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	d = f.(*Domain)
+	c.Check(d.Seq, check.DeepEquals, &Seq{SeqName: "sp|P00533|EGFR_HUMAN", SeqSize: 1210})
+	c.Check(d.Model, check.DeepEquals, &Seq{SeqName: "Furin-like", Accession: "PF00757.23", SeqDesc: "Furin-like cysteine rich region", SeqSize: 149})
+	c.Check(d.Name(), check.Equals, "Furin-like")
+	c.Check([2]int{d.Num, d.Of}, check.Equals, [2]int{1, 2})
+	c.Check(d.IEValue, check.Equals, 5.6e-07)
+	c.Check(d.Acc, check.Equals, 0.83)
+	c.Check([3]int{d.Start(), d.End(), d.Len()}, check.Equals, [3]int{184, 265, 81})
+	c.Check(d.Envelope().Name(), check.Equals, "sp|P00533|EGFR_HUMAN:[183,270)")
+	fs := d.Features()
+	c.Check(fs[1].Name(), check.Equals, "Furin-like:[1,80)")
+
+	for i, t := range []struct {
+		in  string
+		err string
+	}{
+		{in: "a - 10 b - 20 1 1 1", err: "hmmer: too few fields at line 1"},
+		{in: "a - 10 b - 20 1 1 1 1 1 1 1 1 1 5 4 1 2 1 2 0.9", err: "hmmer: bad coordinates at line 1"},
+		{in: "#\na - 10 b - 20 1 x 1 1 1 1 1 1 1 1 2 1 2 1 2 0.9", err: `strconv.ParseFloat: parsing "x": invalid syntax at line 2`},
+	} {
+		_, err := NewDomainReader(strings.NewReader(t.in)).Read()
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
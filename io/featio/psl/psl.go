@@ -0,0 +1,354 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package psl provides types to read and write BLAT PSL format files.
+//
+// The specification can be found at http://genome.ucsc.edu/FAQ/FAQformat.html#format2.
+package psl
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	ErrBadFields      = errors.New("psl: wrong number of fields")
+	ErrBadStrand      = errors.New("psl: invalid strand")
+	ErrBadBlocks      = errors.New("psl: block count does not match block fields")
+	ErrNotHandled     = errors.New("psl: type not handled")
+	ErrCannotHeader   = errors.New("psl: cannot write header: data written")
+	ErrBadCoordinates = errors.New("psl: bad coordinates")
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+	_ featio.Writer = (*Writer)(nil)
+
+	_ feat.Feature  = (*Record)(nil)
+	_ feat.Orienter = (*Record)(nil)
+	_ feat.Pair     = (*Record)(nil)
+	_ feat.Pair     = (*BlockPair)(nil)
+)
+
+const numFields = 21
+
+// Seq is a sequence named in a PSL record.
+type Seq struct {
+	SeqName string
+	SeqSize int
+}
+
+func (s *Seq) Start() int             { return 0 }
+func (s *Seq) End() int               { return s.SeqSize }
+func (s *Seq) Len() int               { return s.SeqSize }
+func (s *Seq) Name() string           { return s.SeqName }
+func (s *Seq) Description() string    { return "psl sequence" }
+func (s *Seq) Location() feat.Feature { return nil }
+
+// Record is a PSL alignment record. All coordinates are zero-based half-open and
+// on the forward strand of their sequences, except for the block starts which
+// follow the PSL convention of being given relative to the aligned strand.
+type Record struct {
+	Matches    int
+	MisMatches int
+	RepMatches int
+	NCount     int
+
+	QNumInsert  int
+	QBaseInsert int
+	TNumInsert  int
+	TBaseInsert int
+
+	QStrand seq.Strand
+	// TStrand is only set for translated alignments
+	// and is seq.None otherwise.
+	TStrand seq.Strand
+
+	Query  *Seq
+	QStart int
+	QEnd   int
+
+	Target *Seq
+	TStart int
+	TEnd   int
+
+	BlockSizes []int
+	QStarts    []int
+	TStarts    []int
+}
+
+func (r *Record) Start() int             { return r.TStart }
+func (r *Record) End() int               { return r.TEnd }
+func (r *Record) Len() int               { return r.TEnd - r.TStart }
+func (r *Record) Name() string           { return r.Query.Name() }
+func (r *Record) Description() string    { return "psl alignment" }
+func (r *Record) Location() feat.Feature { return r.Target }
+
+// Orientation returns the relative orientation of the query and target.
+func (r *Record) Orientation() feat.Orientation {
+	o := feat.Orientation(r.QStrand)
+	if r.TStrand != seq.None {
+		o *= feat.Orientation(r.TStrand)
+	}
+	return o
+}
+
+// Score returns the BLAT score of the alignment: matches + repMatches/2 - misMatches
+// - qNumInsert - tNumInsert.
+func (r *Record) Score() int {
+	return r.Matches + r.RepMatches/2 - r.MisMatches - r.QNumInsert - r.TNumInsert
+}
+
+// Features returns the target and query extents of the alignment. The order of
+// features matches the reference, query order used by the align package.
+func (r *Record) Features() [2]feat.Feature {
+	return [2]feat.Feature{
+		&Segment{Loc: r.Target, SegStart: r.TStart, SegEnd: r.TEnd, Orient: feat.Forward},
+		&Segment{Loc: r.Query, SegStart: r.QStart, SegEnd: r.QEnd, Orient: feat.Orientation(r.QStrand)},
+	}
+}
+
+// Blocks returns the aligned blocks of the record as a slice of feat.Pair in the
+// same form as the alignments returned by the align package. Coordinates of the
+// returned features are on the forward strand of each sequence.
+func (r *Record) Blocks() []feat.Pair {
+	p := make([]feat.Pair, len(r.BlockSizes))
+	for i, size := range r.BlockSizes {
+		ts, qs := r.TStarts[i], r.QStarts[i]
+		if r.TStrand == seq.Minus {
+			ts = r.Target.SeqSize - (ts + size)
+		}
+		if r.QStrand == seq.Minus {
+			qs = r.Query.SeqSize - (qs + size)
+		}
+		p[i] = &BlockPair{
+			T: Segment{Loc: r.Target, SegStart: ts, SegEnd: ts + size, Orient: feat.Forward},
+			Q: Segment{Loc: r.Query, SegStart: qs, SegEnd: qs + size, Orient: r.Orientation()},
+		}
+	}
+	return p
+}
+
+// A Segment is an interval on a PSL sequence.
+type Segment struct {
+	Loc      feat.Feature
+	SegStart int
+	SegEnd   int
+	Orient   feat.Orientation
+}
+
+func (s *Segment) Start() int                    { return s.SegStart }
+func (s *Segment) End() int                      { return s.SegEnd }
+func (s *Segment) Len() int                      { return s.SegEnd - s.SegStart }
+func (s *Segment) Name() string                  { return fmt.Sprintf("%s:[%d,%d)", s.Loc.Name(), s.SegStart, s.SegEnd) }
+func (s *Segment) Description() string           { return "psl segment" }
+func (s *Segment) Location() feat.Feature        { return s.Loc }
+func (s *Segment) Orientation() feat.Orientation { return s.Orient }
+
+// A BlockPair is an ungapped aligned block.
+type BlockPair struct {
+	T, Q Segment
+}
+
+// Features returns the target and query segments of the block.
+func (b *BlockPair) Features() [2]feat.Feature { return [2]feat.Feature{&b.T, &b.Q} }
+
+func (b *BlockPair) String() string {
+	return fmt.Sprintf("%s/%s", b.T.Name(), b.Q.Name())
+}
+
+func parseStrand(b []byte) (q, t seq.Strand, err error) {
+	ss := [2]seq.Strand{seq.None, seq.None}
+	if len(b) < 1 || len(b) > 2 {
+		return seq.None, seq.None, ErrBadStrand
+	}
+	for i, c := range b {
+		switch c {
+		case '+':
+			ss[i] = seq.Plus
+		case '-':
+			ss[i] = seq.Minus
+		default:
+			return seq.None, seq.None, ErrBadStrand
+		}
+	}
+	return ss[0], ss[1], nil
+}
+
+func parseList(b []byte, n int) ([]int, error) {
+	f := bytes.Split(bytes.TrimRight(b, ","), []byte{','})
+	if len(f) != n {
+		return nil, ErrBadBlocks
+	}
+	l := make([]int, n)
+	for i, v := range f {
+		var err error
+		l[i], err = strconv.Atoi(string(v))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+func parseRecord(line []byte) (*Record, error) {
+	f := bytes.Split(line, []byte{'\t'})
+	if len(f) != numFields {
+		return nil, ErrBadFields
+	}
+	var (
+		n   [21]int
+		err error
+	)
+	for _, i := range []int{0, 1, 2, 3, 4, 5, 6, 7, 10, 11, 12, 14, 15, 16, 17} {
+		n[i], err = strconv.Atoi(string(f[i]))
+		if err != nil {
+			return nil, err
+		}
+	}
+	r := &Record{
+		Matches:     n[0],
+		MisMatches:  n[1],
+		RepMatches:  n[2],
+		NCount:      n[3],
+		QNumInsert:  n[4],
+		QBaseInsert: n[5],
+		TNumInsert:  n[6],
+		TBaseInsert: n[7],
+		Query:       &Seq{SeqName: string(f[9]), SeqSize: n[10]},
+		QStart:      n[11],
+		QEnd:        n[12],
+		Target:      &Seq{SeqName: string(f[13]), SeqSize: n[14]},
+		TStart:      n[15],
+		TEnd:        n[16],
+	}
+	if r.QStart > r.QEnd || r.TStart > r.TEnd {
+		return nil, ErrBadCoordinates
+	}
+	r.QStrand, r.TStrand, err = parseStrand(f[8])
+	if err != nil {
+		return nil, err
+	}
+	count := n[17]
+	if r.BlockSizes, err = parseList(f[18], count); err != nil {
+		return nil, err
+	}
+	if r.QStarts, err = parseList(f[19], count); err != nil {
+		return nil, err
+	}
+	if r.TStarts, err = parseList(f[20], count); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reader is a PSL format reader.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// NewReader returns a new PSL format reader using r. The psLayout header, if present,
+// is skipped.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read reads a single PSL record and returns it or an error. The returned
+// feat.Feature is a *Record.
+func (r *Reader) Read() (feat.Feature, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 || !isDigit(line[0]) {
+			// Skip blank lines and header lines.
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rec, err := parseRecord(line)
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		return rec, nil
+	}
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+func isDigit(b byte) bool { return '0' <= b && b <= '9' }
+
+// Header is the psLayout version 3 header written by BLAT.
+const Header = `psLayout version 3
+
+match	mis- 	rep. 	N's	Q gap	Q gap	T gap	T gap	strand	Q        	Q   	Q    	Q  	T        	T   	T    	T  	block	blockSizes 	qStarts	 tStarts
+     	match	match	   	count	bases	count	bases	      	name     	size	start	end	name     	size	start	end	count
+---------------------------------------------------------------------------------------------------------------------------------------------------------------
+`
+
+// Writer is a PSL format writer.
+type Writer struct {
+	w       io.Writer
+	written bool
+}
+
+// NewWriter returns a new PSL format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// WriteHeader writes the psLayout header. It must be called before any records are
+// written.
+func (w *Writer) WriteHeader() (int, error) {
+	if w.written {
+		return 0, ErrCannotHeader
+	}
+	w.written = true
+	return io.WriteString(w.w, Header)
+}
+
+func joinInts(l []int) string {
+	var b bytes.Buffer
+	for _, v := range l {
+		b.WriteString(strconv.Itoa(v))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// Write writes a single PSL record and returns the number of bytes written and any
+// error. Only *Record features are handled.
+func (w *Writer) Write(f feat.Feature) (int, error) {
+	r, ok := f.(*Record)
+	if !ok {
+		return 0, ErrNotHandled
+	}
+	w.written = true
+	strand := r.QStrand.String()
+	if r.TStrand != seq.None {
+		strand += r.TStrand.String()
+	}
+	return fmt.Fprintf(w.w, "%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\t%d\t%d\t%d\t%s\t%d\t%d\t%d\t%d\t%s\t%s\t%s\n",
+		r.Matches, r.MisMatches, r.RepMatches, r.NCount,
+		r.QNumInsert, r.QBaseInsert, r.TNumInsert, r.TBaseInsert,
+		strand,
+		r.Query.SeqName, r.Query.SeqSize, r.QStart, r.QEnd,
+		r.Target.SeqName, r.Target.SeqSize, r.TStart, r.TEnd,
+		len(r.BlockSizes), joinInts(r.BlockSizes), joinInts(r.QStarts), joinInts(r.TStarts),
+	)
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package psl
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"bytes"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const (
+	rec1 = "59\t9\t0\t0\t1\t823\t1\t96\t+\tquery1\t1000\t0\t891\tchr1\t5000\t100\t264\t2\t20,48,\t0,843,\t100,216,\n"
+	rec2 = "30\t0\t0\t0\t0\t0\t0\t0\t-\tquery2\t100\t10\t40\tchr2\t2000\t500\t530\t1\t30,\t60,\t500,\n"
+)
+
+func (s *S) TestReadWrite(c *check.C) {
+	r := NewReader(bytes.NewBufferString(Header + rec1 + rec2))
+	var got []*Record
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, f.(*Record))
+	}
+	c.Assert(len(got), check.Equals, 2)
+
+	r1 := got[0]
+	c.Check(r1.Location().Name(), check.Equals, "chr1")
+	c.Check(r1.Name(), check.Equals, "query1")
+	c.Check(r1.Start(), check.Equals, 100)
+	c.Check(r1.End(), check.Equals, 264)
+	c.Check(r1.Score(), check.Equals, 48)
+	c.Check(r1.Orientation(), check.Equals, feat.Forward)
+	b := r1.Blocks()
+	c.Assert(len(b), check.Equals, 2)
+	for i, e := range [][4]int{{100, 120, 0, 20}, {216, 264, 843, 891}} {
+		fs := b[i].Features()
+		c.Check([4]int{fs[0].Start(), fs[0].End(), fs[1].Start(), fs[1].End()}, check.Equals, e)
+	}
+
+	r2 := got[1]
+	c.Check(r2.QStrand, check.Equals, seq.Minus)
+	c.Check(r2.Orientation(), check.Equals, feat.Reverse)
+	fs := r2.Blocks()[0].Features()
+	c.Check([4]int{fs[0].Start(), fs[0].End(), fs[1].Start(), fs[1].End()}, check.Equals, [4]int{500, 530, 10, 40})
+	c.Check(fs[1].(feat.Orienter).Orientation(), check.Equals, feat.Reverse)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	_, err := w.WriteHeader()
+	c.Assert(err, check.Equals, nil)
+	for _, rec := range got {
+		_, err := w.Write(rec)
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, Header+rec1+rec2)
+	_, err = w.WriteHeader()
+	c.Check(err, check.Equals, ErrCannotHeader)
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	for _, t := range []struct {
+		data string
+		err  string
+	}{
+		{"59\t9\t0\n", "psl: wrong number of fields at line 1"},
+		{"30\t0\t0\t0\t0\t0\t0\t0\t*\tq\t100\t10\t40\tchr2\t2000\t500\t530\t1\t30,\t60,\t500,\n", "psl: invalid strand at line 1"},
+		{"30\t0\t0\t0\t0\t0\t0\t0\t+\tq\t100\t10\t40\tchr2\t2000\t500\t530\t2\t30,\t60,\t500,\n", "psl: block count does not match block fields at line 1"},
+	} {
+		_, err := NewReader(bytes.NewBufferString(t.data)).Read()
+		c.Check(err, check.ErrorMatches, t.err)
+	}
+}
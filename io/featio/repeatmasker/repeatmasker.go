@@ -0,0 +1,305 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package repeatmasker provides types to read RepeatMasker .out annotation
+// files and to mask the annotated repeats in sequences.
+package repeatmasker
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrBadFields      = errors.New("repeatmasker: wrong number of fields")
+	ErrBadCoordinates = errors.New("repeatmasker: bad coordinates")
+	ErrBadStrand      = errors.New("repeatmasker: bad strand")
+	ErrBadLeft        = errors.New("repeatmasker: bad remaining length")
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+
+	_ feat.Feature  = (*Seq)(nil)
+	_ feat.Feature  = (*Repeat)(nil)
+	_ feat.Orienter = (*Repeat)(nil)
+	_ feat.Pair     = (*Repeat)(nil)
+)
+
+const (
+	numFields     = 15
+	overlapMarker = "*"
+)
+
+// Seq is a query sequence or repeat consensus named in a RepeatMasker record.
+type Seq struct {
+	SeqName string
+	SeqSize int
+}
+
+func (s *Seq) Start() int             { return 0 }
+func (s *Seq) End() int               { return s.SeqSize }
+func (s *Seq) Len() int               { return s.SeqSize }
+func (s *Seq) Name() string           { return s.SeqName }
+func (s *Seq) Description() string    { return "repeatmasker sequence" }
+func (s *Seq) Location() feat.Feature { return nil }
+
+// Repeat is a RepeatMasker annotation record. All coordinates are zero-based
+// half-open. Repeat satisfies feat.Feature with the annotated region of the
+// query sequence.
+type Repeat struct {
+	// Score is the Smith-Waterman score of the
+	// alignment of the query with the repeat.
+	Score int
+
+	// Div, Del and Ins are the percentage
+	// substitutions, deletions and insertions
+	// of the query relative to the repeat.
+	Div, Del, Ins float64
+
+	Query         *Seq
+	QStart, QEnd  int
+	Strand        seq.Strand
+	Repeat        *Seq
+	RStart, REnd  int
+	Class, Family string
+	ID            int
+
+	// Overlap indicates that the query region
+	// overlaps a higher scoring annotation.
+	Overlap bool
+}
+
+func (r *Repeat) Start() int                    { return r.QStart }
+func (r *Repeat) End() int                      { return r.QEnd }
+func (r *Repeat) Len() int                      { return r.QEnd - r.QStart }
+func (r *Repeat) Name() string                  { return r.Repeat.Name() }
+func (r *Repeat) Description() string           { return r.ClassFamily() }
+func (r *Repeat) Location() feat.Feature        { return r.Query }
+func (r *Repeat) Orientation() feat.Orientation { return feat.Orientation(r.Strand) }
+
+// ClassFamily returns the class and family of the repeat in RepeatMasker
+// class/family notation.
+func (r *Repeat) ClassFamily() string {
+	if r.Family == "" {
+		return r.Class
+	}
+	return r.Class + "/" + r.Family
+}
+
+// Features returns the annotated regions of the query and repeat consensus.
+func (r *Repeat) Features() [2]feat.Feature {
+	return [2]feat.Feature{
+		&Segment{Loc: r.Query, SegStart: r.QStart, SegEnd: r.QEnd},
+		&Segment{Loc: r.Repeat, SegStart: r.RStart, SegEnd: r.REnd},
+	}
+}
+
+// A Segment is an interval on a RepeatMasker query or repeat consensus.
+type Segment struct {
+	Loc      feat.Feature
+	SegStart int
+	SegEnd   int
+}
+
+func (s *Segment) Start() int             { return s.SegStart }
+func (s *Segment) End() int               { return s.SegEnd }
+func (s *Segment) Len() int               { return s.SegEnd - s.SegStart }
+func (s *Segment) Name() string           { return fmt.Sprintf("%s:[%d,%d)", s.Loc.Name(), s.SegStart, s.SegEnd) }
+func (s *Segment) Description() string    { return "repeatmasker segment" }
+func (s *Segment) Location() feat.Feature { return s.Loc }
+
+// interval converts one-based inclusive coordinates to a zero-based half-open
+// interval.
+func interval(from, to []byte) (start, end int, err error) {
+	f, err := strconv.Atoi(string(from))
+	if err != nil {
+		return 0, 0, err
+	}
+	t, err := strconv.Atoi(string(to))
+	if err != nil {
+		return 0, 0, err
+	}
+	if f < 1 || f > t {
+		return 0, 0, ErrBadCoordinates
+	}
+	return f - 1, t, nil
+}
+
+// left parses a parenthesised remaining length.
+func left(b []byte) (int, error) {
+	if len(b) < 3 || b[0] != '(' || b[len(b)-1] != ')' {
+		return 0, ErrBadLeft
+	}
+	return strconv.Atoi(string(b[1 : len(b)-1]))
+}
+
+func parseRepeat(line []byte) (*Repeat, error) {
+	f := bytes.Fields(line)
+	switch {
+	case len(f) == numFields:
+	case len(f) == numFields+1 && string(f[numFields]) == overlapMarker:
+	default:
+		return nil, ErrBadFields
+	}
+
+	r := &Repeat{Overlap: len(f) > numFields}
+	var err error
+	r.Score, err = strconv.Atoi(string(f[0]))
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range []*float64{&r.Div, &r.Del, &r.Ins} {
+		*p, err = strconv.ParseFloat(string(f[i+1]), 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r.QStart, r.QEnd, err = interval(f[5], f[6])
+	if err != nil {
+		return nil, err
+	}
+	qLeft, err := left(f[7])
+	if err != nil {
+		return nil, err
+	}
+	r.Query = &Seq{SeqName: string(f[4]), SeqSize: r.QEnd + qLeft}
+
+	var rLeft int
+	switch string(f[8]) {
+	case "+":
+		r.Strand = seq.Plus
+		r.RStart, r.REnd, err = interval(f[11], f[12])
+		if err == nil {
+			rLeft, err = left(f[13])
+		}
+	case "C":
+		r.Strand = seq.Minus
+		r.RStart, r.REnd, err = interval(f[13], f[12])
+		if err == nil {
+			rLeft, err = left(f[11])
+		}
+	default:
+		return nil, ErrBadStrand
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.Repeat = &Seq{SeqName: string(f[9]), SeqSize: r.REnd + rLeft}
+
+	cf := string(f[10])
+	if i := strings.Index(cf, "/"); i >= 0 {
+		r.Class, r.Family = cf[:i], cf[i+1:]
+	} else {
+		r.Class = cf
+	}
+
+	r.ID, err = strconv.Atoi(string(f[14]))
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reader is a RepeatMasker .out format reader.
+type Reader struct {
+	r       *bufio.Reader
+	line    int
+	started bool
+}
+
+// NewReader returns a new RepeatMasker .out format reader using r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read reads a single repeat annotation and returns it or an error. The
+// returned feat.Feature is a *Repeat. Column header lines and any other text
+// preceding the first annotation are skipped.
+func (r *Reader) Read() (feat.Feature, error) {
+	var line []byte
+	for {
+		var err error
+		line, err = r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !r.started && !unicode.IsDigit(rune(line[0])) {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		r.started = true
+		break
+	}
+	rep, err := parseRepeat(line)
+	if err != nil {
+		return nil, fmt.Errorf("%v at line %d", err, r.line)
+	}
+	return rep, nil
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+// Mask masks the regions of s annotated by the repeats in rs. If hard is true,
+// masked positions are replaced by the ambiguous letter of the alphabet of s,
+// otherwise they are converted to lower case. Repeats on sequences not named
+// by the name of s are ignored.
+func Mask(s seq.Sequence, rs []*Repeat, hard bool) error {
+	amb := s.Alphabet().Ambiguous()
+	for _, r := range rs {
+		if r.Query.Name() != s.Name() {
+			continue
+		}
+		start, end := r.QStart, r.QEnd
+		if start < s.Start() {
+			start = s.Start()
+		}
+		if end > s.End() {
+			end = s.End()
+		}
+		for i := start; i < end; i++ {
+			ql := s.At(i)
+			if hard {
+				ql.L = amb
+			} else {
+				ql.L = lower(ql.L)
+			}
+			err := s.Set(i, ql)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func lower(l alphabet.Letter) alphabet.Letter {
+	if 'A' <= l && l <= 'Z' {
+		return l + 'a' - 'A'
+	}
+	return l
+}
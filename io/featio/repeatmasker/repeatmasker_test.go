@@ -0,0 +1,111 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package repeatmasker
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const rmsk = `   SW  perc perc perc  query      position in query           matching       repeat              position in  repeat
+score  div. del. ins.  sequence    begin     end    (left)    repeat         class/family         begin  end (left)   ID
+
+  463   1.3  0.6  1.7  chr1        10001   10468 (248945954) +  (TAACCC)n      Simple_repeat            1    463    (0)      1
+ 1892  10.1  0.9  0.0  chr1        15798   16150 (248940272) C  L2a            LINE/L2              (311)   3115   2763      2
+  239  29.4  1.9  1.0  chr1        16166   16232 (248940190) +  AT_rich        Low_complexity           1     67    (0)      3 *
+`
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(bytes.NewBufferString(rmsk))
+	var got []*Repeat
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, f.(*Repeat))
+	}
+	c.Assert(len(got), check.Equals, 3)
+
+	c.Check(*got[0], check.DeepEquals, Repeat{
+		Score: 463, Div: 1.3, Del: 0.6, Ins: 1.7,
+		Query:  &Seq{SeqName: "chr1", SeqSize: 248956422},
+		QStart: 10000, QEnd: 10468,
+		Strand: seq.Plus,
+		Repeat: &Seq{SeqName: "(TAACCC)n", SeqSize: 463},
+		RStart: 0, REnd: 463,
+		Class: "Simple_repeat",
+		ID:    1,
+	})
+	c.Check(got[0].ClassFamily(), check.Equals, "Simple_repeat")
+
+	l2 := got[1]
+	c.Check(l2.Strand, check.Equals, seq.Minus)
+	c.Check(l2.RStart, check.Equals, 2762)
+	c.Check(l2.REnd, check.Equals, 3115)
+	c.Check(l2.Repeat.Len(), check.Equals, 3426)
+	c.Check(l2.Class, check.Equals, "LINE")
+	c.Check(l2.Family, check.Equals, "L2")
+	c.Check(l2.Description(), check.Equals, "LINE/L2")
+	c.Check(l2.Name(), check.Equals, "L2a")
+	c.Check(l2.Location().Name(), check.Equals, "chr1")
+	c.Check(l2.Len(), check.Equals, 353)
+	fs := l2.Features()
+	c.Check(fs[1].Start(), check.Equals, 2762)
+	c.Check(fs[1].Location(), check.Equals, l2.Repeat)
+
+	c.Check(got[1].Overlap, check.Equals, false)
+	c.Check(got[2].Overlap, check.Equals, true)
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	for i, t := range []struct {
+		line string
+		err  string
+	}{
+		{"  463   1.3  0.6  1.7  chr1  10001  10468 (5) +  X  Simple_repeat  1  463  (0)", "repeatmasker: wrong number of fields at line 1"},
+		{"  463   1.3  0.6  1.7  chr1  10001  10468 (5) -  X  Simple_repeat  1  463  (0)  1", "repeatmasker: bad strand at line 1"},
+		{"  463   1.3  0.6  1.7  chr1  10468  10001 (5) +  X  Simple_repeat  1  463  (0)  1", "repeatmasker: bad coordinates at line 1"},
+		{"  463   1.3  0.6  1.7  chr1  10001  10468 5 +  X  Simple_repeat  1  463  (0)  1", "repeatmasker: bad remaining length at line 1"},
+		{"  463   1.3  0.6  1.7  chr1  10001  10468 (5) +  X  Simple_repeat  1  463  (0)  1 +", "repeatmasker: wrong number of fields at line 1"},
+	} {
+		_, err := NewReader(bytes.NewBufferString(t.line)).Read()
+		c.Check(err, check.ErrorMatches, `\Q`+t.err+`\E`, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestMask(c *check.C) {
+	rs := []*Repeat{
+		{Query: &Seq{SeqName: "a"}, QStart: 2, QEnd: 5},
+		{Query: &Seq{SeqName: "b"}, QStart: 0, QEnd: 10},
+		{Query: &Seq{SeqName: "a"}, QStart: 8, QEnd: 20},
+	}
+	for i, t := range []struct {
+		hard   bool
+		expect string
+	}{
+		{false, "ACgtaCGTac"},
+		{true, "ACnnnCGTnn"},
+	} {
+		sq := linear.NewSeq("a", alphabet.BytesToLetters([]byte("ACGTACGTAC")), alphabet.DNA)
+		c.Check(Mask(sq, rs, t.hard), check.Equals, nil)
+		c.Check(sq.String(), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+}
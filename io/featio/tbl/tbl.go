@@ -0,0 +1,351 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tbl provides types to read and write the NCBI five-column feature
+// table format used by table2asn for GenBank submission.
+//
+// A feature table holds the features of one or more sequences, each introduced
+// by a ">Feature SeqID" line. Each feature is given by lines of start and stop
+// coordinates, the first also holding the feature key, followed by qualifier
+// lines. Features on the reverse strand have starts greater than their stops.
+//
+// The specification can be found at https://www.ncbi.nlm.nih.gov/genbank/feature_table/.
+package tbl
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/location"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/io/seqio/genbank"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNoHeader    = errors.New("tbl: feature before >Feature line")
+	ErrBadLine     = errors.New("tbl: malformed line")
+	ErrBadPosition = errors.New("tbl: bad position")
+	ErrNoFeature   = errors.New("tbl: qualifier or interval before feature")
+	ErrNotFeature  = errors.New("tbl: feature is not a *tbl.Feature")
+	ErrUnsupported = errors.New("tbl: location cannot be represented in a feature table")
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+	_ featio.Writer = (*Writer)(nil)
+
+	_ feat.Feature = (*Feature)(nil)
+)
+
+func init() {
+	featio.RegisterFormat(featio.Format{
+		Name: "tbl",
+		Detect: func(data []byte) bool {
+			return bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte(">Feature"))
+		},
+		NewReader: func(r io.Reader) (featio.Reader, error) {
+			return NewReader(r), nil
+		},
+	})
+}
+
+// Seq is the sequence a feature table feature is on.
+type Seq string
+
+func (s Seq) Start() int             { return 0 }
+func (s Seq) End() int               { return 0 }
+func (s Seq) Len() int               { return 0 }
+func (s Seq) Name() string           { return string(s) }
+func (s Seq) Description() string    { return "tbl seq" }
+func (s Seq) Location() feat.Feature { return nil }
+
+// A Feature is a feature table entry.
+type Feature struct {
+	// SeqID is the identifier of the sequence
+	// and Table the optional table name given
+	// in the >Feature line.
+	SeqID string
+	Table string
+
+	// Key is the feature key, for example "CDS".
+	Key string
+
+	// Loc is the location of the feature on the
+	// sequence. The spans of Loc are local to
+	// the sequence.
+	Loc *location.Location
+
+	Qualifiers []genbank.Qualifier
+}
+
+func (f *Feature) Start() int             { return f.Loc.Start() }
+func (f *Feature) End() int               { return f.Loc.End() }
+func (f *Feature) Len() int               { return f.Loc.Len() }
+func (f *Feature) Name() string           { return f.Key }
+func (f *Feature) Description() string    { return "tbl feature" }
+func (f *Feature) Location() feat.Feature { return Seq(f.SeqID) }
+
+// FromGenBank returns a Feature on the sequence seqID holding the INSDC feature f.
+func FromGenBank(seqID string, f genbank.Feature) (*Feature, error) {
+	l, err := location.Parse(f.Location, Seq(seqID))
+	if err != nil {
+		return nil, err
+	}
+	return &Feature{
+		SeqID:      seqID,
+		Key:        f.Key,
+		Loc:        l,
+		Qualifiers: append([]genbank.Qualifier(nil), f.Qualifiers...),
+	}, nil
+}
+
+// GenBank returns the INSDC feature described by f.
+func (f *Feature) GenBank() genbank.Feature {
+	return genbank.Feature{
+		Key:        f.Key,
+		Location:   f.Loc.String(),
+		Qualifiers: append([]genbank.Qualifier(nil), f.Qualifiers...),
+	}
+}
+
+// Reader is a feature table format reader.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+
+	seqID, table string
+	offset       int
+
+	// next is the feature being read.
+	next *Feature
+}
+
+// NewReader returns a new feature table format reader using r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read reads a single feature and returns it or an error. The returned
+// feat.Feature is a *Feature. Offset lines, "[offset=n]", are applied to the
+// coordinates of the features that follow them for the current sequence.
+func (r *Reader) Read() (feat.Feature, error) {
+	for {
+		line, err := r.r.ReadString('\n')
+		if len(line) == 0 && err != nil {
+			if err == io.EOF && r.next != nil {
+				return r.finish(), nil
+			}
+			return nil, err
+		}
+		r.line++
+		line = strings.TrimRight(line, "\r\n")
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, ">Feature"):
+			f := strings.Fields(line[len(">Feature"):])
+			if len(f) == 0 {
+				return nil, fmt.Errorf("%v at line %d", ErrBadLine, r.line)
+			}
+			done := r.finish()
+			r.seqID, r.table, r.offset = f[0], "", 0
+			if len(f) > 1 {
+				r.table = f[1]
+			}
+			if done != nil {
+				return done, nil
+			}
+			continue
+		case strings.HasPrefix(line, "[offset="):
+			n, err := strconv.Atoi(strings.TrimSuffix(line[len("[offset="):], "]"))
+			if err != nil {
+				return nil, fmt.Errorf("%v at line %d", ErrBadLine, r.line)
+			}
+			r.offset = n
+			continue
+		case strings.HasPrefix(line, "\t\t\t"):
+			if r.next == nil {
+				return nil, fmt.Errorf("%v at line %d", ErrNoFeature, r.line)
+			}
+			f := strings.SplitN(line[3:], "\t", 2)
+			q := genbank.Qualifier{Name: f[0]}
+			if len(f) > 1 {
+				q.Value = f[1]
+			}
+			r.next.Qualifiers = append(r.next.Qualifiers, q)
+			continue
+		}
+
+		f := strings.Split(line, "\t")
+		if len(f) < 2 {
+			return nil, fmt.Errorf("%v at line %d", ErrBadLine, r.line)
+		}
+		s, err := r.span(f[0], f[1])
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		if len(f) < 3 || f[2] == "" {
+			if r.next == nil {
+				return nil, fmt.Errorf("%v at line %d", ErrNoFeature, r.line)
+			}
+			r.next.Loc.Spans = append(r.next.Loc.Spans, s)
+			continue
+		}
+		if r.seqID == "" {
+			return nil, fmt.Errorf("%v at line %d", ErrNoHeader, r.line)
+		}
+		done := r.finish()
+		r.next = &Feature{
+			SeqID: r.seqID,
+			Table: r.table,
+			Key:   f[2],
+			Loc:   &location.Location{Loc: Seq(r.seqID), Spans: []*location.Span{s}},
+		}
+		if done != nil {
+			return done, nil
+		}
+	}
+}
+
+// span returns the span described by the start and stop columns of a line.
+func (r *Reader) span(start, stop string) (*location.Span, error) {
+	p5 := strings.HasPrefix(start, "<")
+	p3 := strings.HasPrefix(stop, ">")
+	a, err := strconv.Atoi(strings.TrimLeft(start, "<>"))
+	if err != nil {
+		return nil, ErrBadPosition
+	}
+	b, err := strconv.Atoi(strings.TrimLeft(stop, "<>"))
+	if err != nil {
+		return nil, ErrBadPosition
+	}
+	a += r.offset
+	b += r.offset
+	if a < 1 || b < 1 {
+		return nil, ErrBadPosition
+	}
+	s := &location.Span{Loc: Seq(r.seqID), Orient: feat.Forward}
+	if a <= b {
+		s.SpanStart, s.SpanEnd = a-1, b
+		s.Partial5, s.Partial3 = p5, p3
+	} else {
+		// Reverse strand partial ends are at
+		// the opposite ends of the span.
+		s.SpanStart, s.SpanEnd = b-1, a
+		s.Partial5, s.Partial3 = p3, p5
+		s.Orient = feat.Reverse
+	}
+	return s, nil
+}
+
+// finish returns the feature being read, if any, and clears it. Single base
+// spans of features with reverse strand spans are placed on the reverse strand.
+func (r *Reader) finish() *Feature {
+	f := r.next
+	r.next = nil
+	if f == nil {
+		return nil
+	}
+	var reverse bool
+	for _, s := range f.Loc.Spans {
+		if s.Orient == feat.Reverse {
+			reverse = true
+			break
+		}
+	}
+	if reverse {
+		for _, s := range f.Loc.Spans {
+			if s.Len() == 1 {
+				s.Orient = feat.Reverse
+			}
+		}
+	}
+	return f
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+// Writer is a feature table format writer.
+type Writer struct {
+	w *bufio.Writer
+
+	seqID, table string
+	started      bool
+}
+
+// NewWriter returns a new feature table format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w)}
+}
+
+// Write writes the *Feature f, preceded by a >Feature line if its sequence or
+// table differs from that of the previously written feature. Write returns an
+// error if f is not a *Feature or its location includes sites, uncertain
+// positions, remote spans or the order operator, which cannot be represented
+// in a feature table.
+func (w *Writer) Write(f feat.Feature) (n int, err error) {
+	tf, ok := f.(*Feature)
+	if !ok {
+		return 0, ErrNotFeature
+	}
+	if tf.Loc == nil || len(tf.Loc.Spans) == 0 || tf.Loc.Order {
+		return 0, ErrUnsupported
+	}
+	for _, s := range tf.Loc.Spans {
+		if s.Site || s.Uncertain || s.Accession != "" {
+			return 0, ErrUnsupported
+		}
+	}
+
+	var buf bytes.Buffer
+	if !w.started || tf.SeqID != w.seqID || tf.Table != w.table {
+		buf.WriteString(">Feature " + tf.SeqID)
+		if tf.Table != "" {
+			buf.WriteString(" " + tf.Table)
+		}
+		buf.WriteByte('\n')
+		w.seqID, w.table, w.started = tf.SeqID, tf.Table, true
+	}
+	for i, s := range tf.Loc.Spans {
+		start, stop := s.SpanStart+1, s.SpanEnd
+		p5, p3 := s.Partial5, s.Partial3
+		if s.Orient == feat.Reverse {
+			start, stop = stop, start
+			p5, p3 = p3, p5
+		}
+		if p5 {
+			buf.WriteByte('<')
+		}
+		fmt.Fprintf(&buf, "%d\t", start)
+		if p3 {
+			buf.WriteByte('>')
+		}
+		fmt.Fprint(&buf, stop)
+		if i == 0 {
+			buf.WriteString("\t" + tf.Key)
+		}
+		buf.WriteByte('\n')
+	}
+	for _, q := range tf.Qualifiers {
+		buf.WriteString("\t\t\t" + q.Name)
+		if q.Value != "" {
+			buf.WriteString("\t" + q.Value)
+		}
+		buf.WriteByte('\n')
+	}
+	n, err = w.w.Write(buf.Bytes())
+	if err != nil {
+		return n, err
+	}
+	return n, w.w.Flush()
+}
@@ -0,0 +1,145 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tbl
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+	"github.com/biogo/biogo/io/seqio/genbank"
+
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const table = `>Feature contig1
+<1	>300	gene
+			locus_tag	ECO_0001
+<1	>300	CDS
+			product	hypothetical protein
+			codon_start	2
+			locus_tag	ECO_0001
+900	400	gene
+			gene	abcD
+900	800	mRNA
+600	400
+900	800	CDS
+600	400
+			pseudo
+>Feature contig2 Table1
+[offset=100]
+1	30	misc_feature
+			note	offset feature
+`
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(strings.NewReader(table))
+	var (
+		got []*Feature
+		err error
+	)
+	for {
+		var f feat.Feature
+		f, err = r.Read()
+		if err != nil {
+			break
+		}
+		got = append(got, f.(*Feature))
+	}
+	c.Assert(err, check.Equals, io.EOF)
+	c.Assert(got, check.HasLen, 6)
+
+	for i, want := range []struct {
+		seqID, table, key, loc string
+		start, end             int
+		quals                  int
+	}{
+		{seqID: "contig1", key: "gene", loc: "<1..>300", start: 0, end: 300, quals: 1},
+		{seqID: "contig1", key: "CDS", loc: "<1..>300", start: 0, end: 300, quals: 3},
+		{seqID: "contig1", key: "gene", loc: "complement(400..900)", start: 399, end: 900, quals: 1},
+		{seqID: "contig1", key: "mRNA", loc: "join(complement(800..900),complement(400..600))", start: 399, end: 900},
+		{seqID: "contig1", key: "CDS", loc: "join(complement(800..900),complement(400..600))", start: 399, end: 900, quals: 1},
+		{seqID: "contig2", table: "Table1", key: "misc_feature", loc: "101..130", start: 100, end: 130, quals: 1},
+	} {
+		f := got[i]
+		c.Check(f.SeqID, check.Equals, want.seqID, check.Commentf("Test %d", i))
+		c.Check(f.Table, check.Equals, want.table, check.Commentf("Test %d", i))
+		c.Check(f.Key, check.Equals, want.key, check.Commentf("Test %d", i))
+		c.Check(f.Loc.String(), check.Equals, want.loc, check.Commentf("Test %d", i))
+		c.Check(f.Start(), check.Equals, want.start, check.Commentf("Test %d", i))
+		c.Check(f.End(), check.Equals, want.end, check.Commentf("Test %d", i))
+		c.Check(f.Qualifiers, check.HasLen, want.quals, check.Commentf("Test %d", i))
+		c.Check(f.Location().Name(), check.Equals, want.seqID, check.Commentf("Test %d", i))
+	}
+	c.Check(got[1].Qualifiers[0], check.Equals, genbank.Qualifier{Name: "product", Value: "hypothetical protein"})
+	c.Check(got[4].Qualifiers[0], check.Equals, genbank.Qualifier{Name: "pseudo"})
+
+	// Reverse strand partial ends.
+	r = NewReader(strings.NewReader(">Feature c\n<300\t>1\tCDS\n"))
+	f, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(f.(*Feature).Loc.String(), check.Equals, "complement(<1..>300)")
+
+	for _, bad := range []string{
+		"1\t10\tgene\n",
+		">Feature c\n\t\t\tnote\tx\n",
+		">Feature c\nx\t10\tgene\n",
+		">Feature c\n1\n",
+	} {
+		_, err = NewReader(strings.NewReader(bad)).Read()
+		c.Check(err, check.NotNil, check.Commentf("%q", bad))
+	}
+}
+
+func (s *S) TestRoundTrip(c *check.C) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	sc := featio.NewScanner(NewReader(strings.NewReader(table)))
+	for sc.Next() {
+		_, err := w.Write(sc.Feat())
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Assert(sc.Error(), check.Equals, nil)
+
+	// Offsets are applied on reading.
+	want := strings.Replace(table, "[offset=100]\n1\t30", "101\t130", 1)
+	c.Check(buf.String(), check.Equals, want)
+}
+
+func (s *S) TestGenBank(c *check.C) {
+	gf := genbank.Feature{
+		Key:      "CDS",
+		Location: "complement(join(1..100,200..>300))",
+		Qualifiers: []genbank.Qualifier{
+			{Name: "locus_tag", Value: "ECO_0001"},
+		},
+	}
+	f, err := FromGenBank("contig1", gf)
+	c.Assert(err, check.Equals, nil)
+	c.Check(f.GenBank(), check.DeepEquals, genbank.Feature{
+		Key:        "CDS",
+		Location:   "join(complement(200..>300),complement(1..100))",
+		Qualifiers: gf.Qualifiers,
+	})
+
+	var buf bytes.Buffer
+	_, err = NewWriter(&buf).Write(f)
+	c.Assert(err, check.Equals, nil)
+	c.Check(buf.String(), check.Equals, ">Feature contig1\n<300\t200\tCDS\n100\t1\n\t\t\tlocus_tag\tECO_0001\n")
+
+	f, err = FromGenBank("contig1", genbank.Feature{Key: "misc_feature", Location: "order(1..10,20..30)"})
+	c.Assert(err, check.Equals, nil)
+	_, err = NewWriter(&buf).Write(f)
+	c.Check(err, check.Equals, ErrUnsupported)
+}
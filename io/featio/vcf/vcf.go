@@ -0,0 +1,257 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vcf provides types to read Variant Call Format files.
+//
+// The specification can be found at https://samtools.github.io/hts-specs/VCFv4.3.pdf.
+package vcf
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrBadFields = errors.New("vcf: too few fields")
+	ErrBadPos    = errors.New("vcf: bad position")
+	ErrBadQual   = errors.New("vcf: bad quality")
+)
+
+var (
+	_ featio.Reader = (*Reader)(nil)
+
+	_ feat.Feature = (*Variant)(nil)
+)
+
+func init() {
+	featio.RegisterFormat(featio.Format{
+		Name: "vcf",
+		Detect: func(data []byte) bool {
+			return bytes.HasPrefix(data, []byte("##fileformat=VCF"))
+		},
+		NewReader: func(r io.Reader) (featio.Reader, error) {
+			return NewReader(r), nil
+		},
+	})
+}
+
+// numFields is the number of fixed fields of a VCF record.
+const numFields = 8
+
+type Chrom string
+
+func (c Chrom) Start() int             { return 0 }
+func (c Chrom) End() int               { return 0 }
+func (c Chrom) Len() int               { return 0 }
+func (c Chrom) Name() string           { return string(c) }
+func (c Chrom) Description() string    { return "vcf chrom" }
+func (c Chrom) Location() feat.Feature { return nil }
+
+// Variant is a VCF record. Pos is zero-based, and the variant spans the
+// reference allele.
+type Variant struct {
+	Chrom string
+	Pos   int
+	ID    string
+	Ref   string
+	Alt   []string
+
+	// Qual is the phred-scaled quality of the
+	// variant, or nil if it is missing.
+	Qual *float64
+
+	// Filter holds the filters the variant
+	// failed, or "PASS". It is nil if filters
+	// have not been applied.
+	Filter []string
+
+	// Info is the unparsed INFO field.
+	Info string
+
+	// Format holds the keys of the sample
+	// fields, and Samples the unparsed field
+	// of each sample.
+	Format  []string
+	Samples []string
+}
+
+func (v *Variant) Start() int             { return v.Pos }
+func (v *Variant) End() int               { return v.Pos + len(v.Ref) }
+func (v *Variant) Len() int               { return len(v.Ref) }
+func (v *Variant) Name() string           { return v.ID }
+func (v *Variant) Description() string    { return "vcf variant" }
+func (v *Variant) Location() feat.Feature { return Chrom(v.Chrom) }
+
+// InfoValue returns the value of the INFO key and whether the key is present.
+// The value of a flag key is empty.
+func (v *Variant) InfoValue(key string) (string, bool) {
+	for _, f := range strings.Split(v.Info, ";") {
+		k, val := f, ""
+		if i := strings.IndexByte(f, '='); i >= 0 {
+			k, val = f[:i], f[i+1:]
+		}
+		if k == key {
+			return val, true
+		}
+	}
+	return "", false
+}
+
+// missing returns nil if s is the VCF missing value, and s split at sep
+// otherwise.
+func missing(s, sep string) []string {
+	if s == "." {
+		return nil
+	}
+	return strings.Split(s, sep)
+}
+
+func parseVariant(line []byte) (*Variant, error) {
+	f := strings.Split(string(line), "\t")
+	if len(f) < numFields {
+		return nil, ErrBadFields
+	}
+	pos, err := strconv.Atoi(f[1])
+	if err != nil || pos < 0 {
+		return nil, ErrBadPos
+	}
+	v := &Variant{
+		Chrom:  f[0],
+		Pos:    pos - 1,
+		ID:     f[2],
+		Ref:    f[3],
+		Alt:    missing(f[4], ","),
+		Filter: missing(f[6], ";"),
+		Info:   f[7],
+	}
+	if f[5] != "." {
+		q, err := strconv.ParseFloat(f[5], 64)
+		if err != nil {
+			return nil, ErrBadQual
+		}
+		v.Qual = &q
+	}
+	if len(f) > numFields {
+		v.Format = strings.Split(f[numFields], ":")
+		v.Samples = f[numFields+1:]
+	}
+	return v, nil
+}
+
+// Reader is a VCF format reader.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+
+	// Meta holds the meta-information lines
+	// read, without their leading "##".
+	Meta []string
+
+	// SampleNames holds the sample names given
+	// in the header line.
+	SampleNames []string
+}
+
+// NewReader returns a new VCF format reader using r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read reads a single VCF record and returns it or an error. The returned
+// feat.Feature is a *Variant. Header lines are retained by the Reader.
+func (r *Reader) Read() (feat.Feature, error) {
+	v, err := r.next(nil)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// A Handler receives the parts of a VCF file as they are parsed by Parse.
+// Returning a non-nil error from any method stops parsing.
+type Handler interface {
+	// Meta is called for each meta-information
+	// line with the line without its leading "##".
+	Meta(line string) error
+
+	// Header is called for the header line with
+	// the sample names it gives.
+	Header(samples []string) error
+
+	// Variant is called for each record.
+	Variant(v *Variant) error
+}
+
+// Parse parses the remaining lines read by r, passing the header lines and records
+// to h. Records are not retained by the Reader, so files of any size can be processed
+// in constant memory. Parse returns nil at the end of the input.
+func (r *Reader) Parse(h Handler) error {
+	for {
+		v, err := r.next(h)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = h.Variant(v)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// next returns the next record, retaining the header lines read before it and
+// passing them to h if h is not nil.
+func (r *Reader) next(h Handler) (*Variant, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimRight(line, "\r\n")
+		switch {
+		case len(line) == 0:
+			continue
+		case bytes.HasPrefix(line, []byte("##")):
+			r.Meta = append(r.Meta, string(line[2:]))
+			if h != nil {
+				err = h.Meta(r.Meta[len(r.Meta)-1])
+				if err != nil {
+					return nil, err
+				}
+			}
+			continue
+		case line[0] == '#':
+			f := strings.Split(string(line), "\t")
+			if len(f) > numFields+1 {
+				r.SampleNames = f[numFields+1:]
+			}
+			if h != nil {
+				err = h.Header(r.SampleNames)
+				if err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		v, err := parseVariant(line)
+		if err != nil {
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		return v, nil
+	}
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
@@ -0,0 +1,117 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vcf
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const vcf = `##fileformat=VCFv4.3
+##INFO=<ID=DP,Number=1,Type=Integer,Description="Total Depth">
+#CHROM	POS	ID	REF	ALT	QUAL	FILTER	INFO	FORMAT	NA00001	NA00002
+20	14370	rs6054257	G	A	29	PASS	NS=3;DP=14;DB	GT:GQ	0|0:48	1|0:48
+20	1110696	.	A	G,T	.	.	DP=10	GT	1|2	2/2
+`
+
+func floatPtr(f float64) *float64 { return &f }
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(strings.NewReader(vcf))
+	var got []*Variant
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, f.(*Variant))
+	}
+	c.Check(r.Meta, check.DeepEquals, []string{
+		"fileformat=VCFv4.3",
+		`INFO=<ID=DP,Number=1,Type=Integer,Description="Total Depth">`,
+	})
+	c.Check(r.SampleNames, check.DeepEquals, []string{"NA00001", "NA00002"})
+	c.Check(got, check.DeepEquals, []*Variant{
+		{
+			Chrom: "20", Pos: 14369, ID: "rs6054257", Ref: "G", Alt: []string{"A"},
+			Qual: floatPtr(29), Filter: []string{"PASS"}, Info: "NS=3;DP=14;DB",
+			Format: []string{"GT", "GQ"}, Samples: []string{"0|0:48", "1|0:48"},
+		},
+		{
+			Chrom: "20", Pos: 1110695, ID: ".", Ref: "A", Alt: []string{"G", "T"},
+			Info:   "DP=10",
+			Format: []string{"GT"}, Samples: []string{"1|2", "2/2"},
+		},
+	})
+	c.Check(got[0].Start(), check.Equals, 14369)
+	c.Check(got[0].End(), check.Equals, 14370)
+	c.Check(got[0].Location().Name(), check.Equals, "20")
+	v, ok := got[0].InfoValue("DP")
+	c.Check([]interface{}{v, ok}, check.DeepEquals, []interface{}{"14", true})
+	v, ok = got[0].InfoValue("DB")
+	c.Check([]interface{}{v, ok}, check.DeepEquals, []interface{}{"", true})
+	_, ok = got[1].InfoValue("NS")
+	c.Check(ok, check.Equals, false)
+
+	_, err := NewReader(strings.NewReader("20\tx\t.\tA\tG\t.\t.\t.\n")).Read()
+	c.Check(err, check.ErrorMatches, "vcf: bad position at line 1")
+	_, err = NewReader(strings.NewReader("20\t1\t.\tA\n")).Read()
+	c.Check(err, check.ErrorMatches, "vcf: too few fields at line 1")
+}
+
+// events is a Handler that records the events it receives.
+type events []string
+
+func (e *events) Meta(line string) error {
+	*e = append(*e, "meta "+line)
+	return nil
+}
+
+func (e *events) Header(samples []string) error {
+	*e = append(*e, "header "+strings.Join(samples, ","))
+	return nil
+}
+
+func (e *events) Variant(v *Variant) error {
+	*e = append(*e, "variant "+v.Chrom+" "+v.Ref+">"+strings.Join(v.Alt, ","))
+	if v.Pos > 1000000 {
+		return errStop
+	}
+	return nil
+}
+
+var errStop = errors.New("stop")
+
+func (s *S) TestParse(c *check.C) {
+	var e events
+	r := NewReader(strings.NewReader(vcf))
+	err := r.Parse(&e)
+	c.Check(err, check.Equals, errStop)
+	c.Check([]string(e), check.DeepEquals, []string{
+		"meta fileformat=VCFv4.3",
+		`meta INFO=<ID=DP,Number=1,Type=Integer,Description="Total Depth">`,
+		"header NA00001,NA00002",
+		"variant 20 G>A",
+		"variant 20 A>G,T",
+	})
+	c.Check(r.SampleNames, check.DeepEquals, []string{"NA00001", "NA00002"})
+
+	e = e[:0]
+	err = NewReader(strings.NewReader(vcf[:strings.LastIndex(vcf[:len(vcf)-1], "\n")+1])).Parse(&e)
+	c.Check(err, check.Equals, nil)
+	c.Check(e, check.HasLen, 4)
+}
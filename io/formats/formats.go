@@ -0,0 +1,94 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package formats registers the sequence and feature file formats provided by
+// bíogo with seqio and featio, and opens files in any registered format.
+//
+// Importing the package for its side effect makes all the formats available
+// to seqio.Open and featio.Open:
+//
+//	import _ "github.com/biogo/biogo/io/formats"
+//
+// The detected formats are FASTA, FASTQ, GenBank, EMBL, SFF and ABI sequence
+// files, and GFF, BED, VCF and NCBI feature table files, each of which may be
+// gzip, BGZF or bzip2 compressed.
+package formats
+
+import (
+	"github.com/biogo/biogo/io/featio"
+	_ "github.com/biogo/biogo/io/featio/bed"
+	_ "github.com/biogo/biogo/io/featio/gff"
+	_ "github.com/biogo/biogo/io/featio/tbl"
+	_ "github.com/biogo/biogo/io/featio/vcf"
+	"github.com/biogo/biogo/io/seqio"
+	_ "github.com/biogo/biogo/io/seqio/abi"
+	_ "github.com/biogo/biogo/io/seqio/embl"
+	_ "github.com/biogo/biogo/io/seqio/fasta"
+	_ "github.com/biogo/biogo/io/seqio/fastq"
+	_ "github.com/biogo/biogo/io/seqio/genbank"
+	_ "github.com/biogo/biogo/io/seqio/sff"
+
+	"errors"
+	"os"
+)
+
+var ErrUnknownFormat = errors.New("formats: unknown format")
+
+// A File is an open sequence or feature file.
+type File struct {
+	// Format is the name of the detected
+	// format of the file.
+	Format string
+
+	// Seqs is a Scanner over the sequences
+	// of a sequence file, and is nil for a
+	// feature file.
+	Seqs *seqio.Scanner
+
+	// Feats is a Scanner over the features
+	// of a feature file, and is nil for a
+	// sequence file.
+	Feats *featio.Scanner
+
+	f *os.File
+}
+
+// Open opens the named file and returns a File for its data. The compression
+// and format of the file are detected from its contents, with registered
+// sequence formats tried before registered feature formats. Sequences read
+// from a sequence file are copied from template, so template must be suitable
+// for the formats that may be read; a sequence type that holds qualities can
+// be used for formats with and without quality data.
+func Open(name string, template seqio.SequenceAppender) (*File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	sr, format, err := seqio.NewReader(f, template)
+	if err == nil {
+		return &File{Format: format, Seqs: seqio.NewScanner(sr), f: f}, nil
+	}
+	if err != seqio.ErrUnknownFormat {
+		f.Close()
+		return nil, err
+	}
+
+	_, err = f.Seek(0, 0)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	fr, format, err := featio.NewReader(f)
+	if err != nil {
+		f.Close()
+		if err == featio.ErrUnknownFormat {
+			err = ErrUnknownFormat
+		}
+		return nil, err
+	}
+	return &File{Format: format, Feats: featio.NewScanner(fr), f: f}, nil
+}
+
+// Close closes the file.
+func (f *File) Close() error { return f.f.Close() }
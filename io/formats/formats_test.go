@@ -0,0 +1,80 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package formats
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestOpen(c *check.C) {
+	dir := c.MkDir()
+	for i, t := range []struct {
+		data   string
+		gzip   bool
+		format string
+		names  []string
+	}{
+		{data: ">a\nACGT\n>b\nGG\n", format: "fasta", names: []string{"a", "b"}},
+		{data: "@a\nACGT\n+\nIIII\n", gzip: true, format: "fastq", names: []string{"a"}},
+		{data: "LOCUS       A 4 bp DNA\nORIGIN\n        1 acgt\n//\n", format: "genbank", names: []string{"A"}},
+		{data: "ID   E; SV 1;\nSQ   Sequence 4 BP;\n     acgt 4\n//\n", gzip: true, format: "embl", names: []string{"E"}},
+		{data: "##gff-version 2\nchr1\tsrc\texon\t1\t10\t.\t+\t.\n", format: "gff", names: []string{"exon/chr1:[0,10)"}},
+		{data: "chr1\t0\t10\tfeat\n", format: "bed", names: []string{"feat"}},
+		{data: ">Feature c1\n1\t10\tgene\n\t\t\tlocus_tag\tA_1\n", gzip: true, format: "tbl", names: []string{"gene"}},
+		{data: "##fileformat=VCFv4.3\n#CHROM\tPOS\tID\tREF\tALT\tQUAL\tFILTER\tINFO\nchr1\t5\trs1\tA\tG\t.\t.\t.\n", gzip: true, format: "vcf", names: []string{"rs1"}},
+	} {
+		path := filepath.Join(dir, t.format)
+		f, err := os.Create(path)
+		c.Assert(err, check.Equals, nil)
+		if t.gzip {
+			gz := gzip.NewWriter(f)
+			gz.Write([]byte(t.data))
+			c.Assert(gz.Close(), check.Equals, nil)
+		} else {
+			f.WriteString(t.data)
+		}
+		c.Assert(f.Close(), check.Equals, nil)
+
+		file, err := Open(path, linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(file.Format, check.Equals, t.format, check.Commentf("Test %d", i))
+		var names []string
+		switch {
+		case file.Seqs != nil:
+			for file.Seqs.Next() {
+				names = append(names, file.Seqs.Seq().Name())
+			}
+			c.Check(file.Seqs.Error(), check.Equals, nil, check.Commentf("Test %d", i))
+		case file.Feats != nil:
+			for file.Feats.Next() {
+				names = append(names, file.Feats.Feat().Name())
+			}
+			c.Check(file.Feats.Error(), check.Equals, nil, check.Commentf("Test %d", i))
+		}
+		c.Check(names, check.DeepEquals, t.names, check.Commentf("Test %d", i))
+		c.Check(file.Close(), check.Equals, nil)
+	}
+
+	path := filepath.Join(dir, "unknown")
+	c.Assert(ioutil.WriteFile(path, []byte("hello\n"), 0644), check.Equals, nil)
+	_, err := Open(path, linear.NewSeq("", nil, alphabet.DNA))
+	c.Check(err, check.Equals, ErrUnknownFormat)
+}
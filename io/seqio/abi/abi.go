@@ -0,0 +1,291 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package abi provides a reader for ABI chromatogram (ABIF, .ab1) trace files.
+package abi
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+var (
+	_ seqio.Reader = (*Reader)(nil)
+)
+
+func init() {
+	seqio.RegisterFormat(seqio.Format{
+		Name: "abi",
+		Detect: func(data []byte) bool {
+			return bytes.HasPrefix(data, []byte("ABIF"))
+		},
+		NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+			return NewReader(r, template)
+		},
+	})
+}
+
+var (
+	ErrNotABIF       = errors.New("abi: not an ABIF file")
+	ErrTruncated     = errors.New("abi: truncated file")
+	ErrNoBases       = errors.New("abi: no base calls")
+	ErrQualityLength = errors.New("abi: quality length does not match base calls")
+	ErrBadEntry      = errors.New("abi: invalid directory entry")
+)
+
+// Element types used in ABIF directory entries.
+const (
+	Byte    = 1
+	Char    = 2
+	Short   = 4
+	Long    = 5
+	Float   = 7
+	PString = 18
+	CString = 19
+)
+
+// An Entry is an ABIF directory entry.
+type Entry struct {
+	Name     string // Four character tag name.
+	Number   int    // Tag number.
+	Type     int    // Element type.
+	ElemSize int    // Size in bytes of each element.
+	Count    int    // Number of elements.
+
+	// Data is the raw big-endian
+	// element data of the entry.
+	Data []byte
+}
+
+// Shorts returns the data of the entry interpreted as big-endian 16 bit integers.
+func (e Entry) Shorts() []int {
+	s := make([]int, len(e.Data)/2)
+	for i := range s {
+		s[i] = int(int16(binary.BigEndian.Uint16(e.Data[2*i:])))
+	}
+	return s
+}
+
+// String returns the data of the entry as a string. Pascal strings have their
+// length prefix removed and C strings their terminating NUL.
+func (e Entry) String() string {
+	d := e.Data
+	switch e.Type {
+	case PString:
+		if len(d) > 0 {
+			n := int(d[0])
+			if n > len(d)-1 {
+				n = len(d) - 1
+			}
+			d = d[1 : n+1]
+		}
+	case CString:
+		if i := bytes.IndexByte(d, 0); i >= 0 {
+			d = d[:i]
+		}
+	}
+	return string(d)
+}
+
+// A Trace holds the chromatogram data of an ABI trace file.
+type Trace struct {
+	// Sample is the sample name.
+	Sample string
+
+	// Order is the base order of the
+	// trace channels, for example "GATC".
+	Order string
+
+	// Channels holds the analyzed trace
+	// data in the order given by Order.
+	Channels [4][]int
+
+	// Peaks holds the trace position of
+	// the peak for each called base.
+	Peaks []int
+
+	// Entries holds all the directory
+	// entries of the file.
+	Entries []Entry
+}
+
+// Channel returns the trace channel for the base b, or nil if b is not in the
+// trace's base order.
+func (t *Trace) Channel(b byte) []int {
+	i := bytes.IndexByte([]byte(t.Order), b)
+	if i < 0 || i >= len(t.Channels) {
+		return nil
+	}
+	return t.Channels[i]
+}
+
+// Entry returns the directory entry with the given name and number and whether
+// it exists.
+func (t *Trace) Entry(name string, number int) (Entry, bool) {
+	for _, e := range t.Entries {
+		if e.Name == name && e.Number == number {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// first returns the first existing entry with the given name and one of the
+// given numbers.
+func (t *Trace) first(name string, numbers ...int) (Entry, bool) {
+	for _, n := range numbers {
+		if e, ok := t.Entry(name, n); ok {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Reader is an ABI trace file reader. An ABI file holds a single sequence.
+type Reader struct {
+	r    io.Reader
+	t    seqio.SequenceAppender
+	done bool
+
+	// Trace holds the trace data of
+	// the most recently read sequence.
+	Trace *Trace
+}
+
+// NewReader returns a new ABI trace file reader using r. The sequence returned by
+// the Reader is copied from the provided template, and has the edited base calls
+// and qualities of the file, or the original base calls and qualities if no edited
+// calls are present. The sequence name is the sample name.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{r: r, t: template}
+}
+
+// Read reads the sequence from the trace file. Subsequent calls return io.EOF.
+func (r *Reader) Read() (seq.Sequence, error) {
+	if r.done {
+		return nil, io.EOF
+	}
+	r.done = true
+
+	b, err := ioutil.ReadAll(r.r)
+	if err != nil {
+		return nil, err
+	}
+	tr, err := parse(b)
+	if err != nil {
+		return nil, err
+	}
+	r.Trace = tr
+
+	bases, ok := tr.first("PBAS", 1, 2)
+	if !ok || len(bases.Data) == 0 {
+		return nil, ErrNoBases
+	}
+	s := r.t.Clone().(seqio.SequenceAppender)
+	err = s.SetName(tr.Sample)
+	if err != nil {
+		return nil, err
+	}
+	qual, ok := tr.first("PCON", 1, 2)
+	if !ok {
+		err = s.AppendLetters(alphabet.BytesToLetters(bases.Data)...)
+		return s, err
+	}
+	if len(qual.Data) != len(bases.Data) {
+		return nil, ErrQualityLength
+	}
+	ql := make([]alphabet.QLetter, len(bases.Data))
+	for i, l := range bases.Data {
+		ql[i] = alphabet.QLetter{L: alphabet.Letter(l), Q: alphabet.Qphred(qual.Data[i])}
+	}
+	err = s.AppendQLetters(ql...)
+	return s, err
+}
+
+const entrySize = 28
+
+// parse parses the ABIF file data in b.
+func parse(b []byte) (*Trace, error) {
+	if len(b) < 6+entrySize || string(b[:4]) != "ABIF" {
+		return nil, ErrNotABIF
+	}
+	root, err := entry(b, 6)
+	if err != nil {
+		return nil, err
+	}
+	if root.Count < 0 {
+		return nil, ErrBadEntry
+	}
+	// Check the directory size against the file size
+	// before allocating, since the count is untrusted.
+	if root.Count > len(b)/entrySize {
+		return nil, ErrTruncated
+	}
+	off := int(binary.BigEndian.Uint32(b[6+20:]))
+	tr := &Trace{Entries: make([]Entry, root.Count)}
+	for i := range tr.Entries {
+		tr.Entries[i], err = entry(b, off+i*entrySize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if e, ok := tr.Entry("SMPL", 1); ok {
+		tr.Sample = e.String()
+	}
+	if e, ok := tr.Entry("FWO_", 1); ok {
+		tr.Order = string(e.Data)
+	}
+	if e, ok := tr.first("PLOC", 1, 2); ok {
+		tr.Peaks = e.Shorts()
+	}
+	for i := range tr.Channels {
+		if e, ok := tr.first("DATA", 9+i, 1+i); ok {
+			tr.Channels[i] = e.Shorts()
+		}
+	}
+
+	return tr, nil
+}
+
+// entry returns the directory entry at offset off in b. Entry data of four bytes
+// or fewer is held in the data offset field.
+func entry(b []byte, off int) (Entry, error) {
+	if off < 0 || off+entrySize > len(b) {
+		return Entry{}, ErrTruncated
+	}
+	d := b[off : off+entrySize]
+	e := Entry{
+		Name:     string(d[:4]),
+		Number:   int(int32(binary.BigEndian.Uint32(d[4:]))),
+		Type:     int(int16(binary.BigEndian.Uint16(d[8:]))),
+		ElemSize: int(int16(binary.BigEndian.Uint16(d[10:]))),
+		Count:    int(int32(binary.BigEndian.Uint32(d[12:]))),
+	}
+	size := int(int32(binary.BigEndian.Uint32(d[16:])))
+	if size < 0 {
+		return Entry{}, fmt.Errorf("%v %s%d", ErrBadEntry, e.Name, e.Number)
+	}
+	if size <= 4 {
+		e.Data = append([]byte(nil), d[20:20+size]...)
+		return e, nil
+	}
+	if size > len(b) {
+		return Entry{}, ErrTruncated
+	}
+	start := int(binary.BigEndian.Uint32(d[20:]))
+	if start < 0 || start > len(b)-size {
+		return Entry{}, ErrTruncated
+	}
+	e.Data = append([]byte(nil), b[start:start+size]...)
+	return e, nil
+}
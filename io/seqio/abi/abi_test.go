@@ -0,0 +1,161 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package abi
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+// abif returns an ABIF file holding the provided entries.
+func abif(entries []Entry) []byte {
+	var data bytes.Buffer
+	offsets := make([]int, len(entries))
+	start := 128
+	for i, e := range entries {
+		if len(e.Data) > 4 {
+			offsets[i] = start + data.Len()
+			data.Write(e.Data)
+		}
+	}
+	dir := start + data.Len()
+
+	var b bytes.Buffer
+	b.WriteString("ABIF")
+	binary.Write(&b, binary.BigEndian, uint16(101))
+	writeEntry(&b, Entry{Name: "tdir", Number: 1, Type: 1023, ElemSize: entrySize, Count: len(entries)}, len(entries)*entrySize, dir)
+	b.Write(make([]byte, start-b.Len()))
+	b.Write(data.Bytes())
+	for i, e := range entries {
+		writeEntry(&b, e, len(e.Data), offsets[i])
+	}
+	return b.Bytes()
+}
+
+func writeEntry(b *bytes.Buffer, e Entry, size, off int) {
+	b.WriteString(e.Name)
+	binary.Write(b, binary.BigEndian, int32(e.Number))
+	binary.Write(b, binary.BigEndian, int16(e.Type))
+	binary.Write(b, binary.BigEndian, int16(e.ElemSize))
+	binary.Write(b, binary.BigEndian, int32(e.Count))
+	binary.Write(b, binary.BigEndian, int32(size))
+	if size <= 4 {
+		var d [4]byte
+		copy(d[:], e.Data)
+		b.Write(d[:])
+	} else {
+		binary.Write(b, binary.BigEndian, int32(off))
+	}
+	binary.Write(b, binary.BigEndian, int32(0))
+}
+
+func shorts(s ...int16) []byte {
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, s)
+	return b.Bytes()
+}
+
+func entries() []Entry {
+	return []Entry{
+		{Name: "SMPL", Number: 1, Type: PString, ElemSize: 1, Count: 7, Data: []byte("\x06sample")},
+		{Name: "FWO_", Number: 1, Type: Char, ElemSize: 1, Count: 4, Data: []byte("GATC")},
+		{Name: "PBAS", Number: 2, Type: Char, ElemSize: 1, Count: 6, Data: []byte("ACGTNN")},
+		{Name: "PCON", Number: 2, Type: Char, ElemSize: 1, Count: 6, Data: []byte{40, 35, 30, 20, 2, 2}},
+		{Name: "PLOC", Number: 2, Type: Short, ElemSize: 2, Count: 6, Data: shorts(2, 5, 8, 11, 13, 16)},
+		{Name: "PBAS", Number: 1, Type: Char, ElemSize: 1, Count: 6, Data: []byte("ACGTAC")},
+		{Name: "PCON", Number: 1, Type: Char, ElemSize: 1, Count: 6, Data: []byte{40, 35, 30, 20, 10, 5}},
+		{Name: "PLOC", Number: 1, Type: Short, ElemSize: 2, Count: 6, Data: shorts(2, 5, 8, 11, 14, 17)},
+		{Name: "DATA", Number: 9, Type: Short, ElemSize: 2, Count: 3, Data: shorts(1, 2, 3)},
+		{Name: "DATA", Number: 10, Type: Short, ElemSize: 2, Count: 3, Data: shorts(4, 5, 6)},
+		{Name: "DATA", Number: 11, Type: Short, ElemSize: 2, Count: 3, Data: shorts(7, 8, 9)},
+		{Name: "DATA", Number: 12, Type: Short, ElemSize: 2, Count: 3, Data: shorts(-1, 0, 1000)},
+	}
+}
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(bytes.NewReader(abif(entries())), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+	sq, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	q := sq.(*linear.QSeq)
+	c.Check(q.Name(), check.Equals, "sample")
+	c.Check(q.Seq, check.DeepEquals, alphabet.QLetters{
+		{L: 'A', Q: 40}, {L: 'C', Q: 35}, {L: 'G', Q: 30}, {L: 'T', Q: 20}, {L: 'A', Q: 10}, {L: 'C', Q: 5},
+	})
+
+	tr := r.Trace
+	c.Assert(tr, check.NotNil)
+	c.Check(tr.Sample, check.Equals, "sample")
+	c.Check(tr.Order, check.Equals, "GATC")
+	c.Check(tr.Peaks, check.DeepEquals, []int{2, 5, 8, 11, 14, 17})
+	c.Check(tr.Channel('G'), check.DeepEquals, []int{1, 2, 3})
+	c.Check(tr.Channel('C'), check.DeepEquals, []int{-1, 0, 1000})
+	c.Check(tr.Channel('N'), check.IsNil)
+	e, ok := tr.Entry("PBAS", 2)
+	c.Check(ok, check.Equals, true)
+	c.Check(string(e.Data), check.Equals, "ACGTNN")
+
+	_, err = r.Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	// Without edited calls, the basecaller calls are used.
+	r = NewReader(bytes.NewReader(abif(entries()[:5])), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+	sq, err = r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(sq.(*linear.QSeq).Seq, check.DeepEquals, alphabet.QLetters{
+		{L: 'A', Q: 40}, {L: 'C', Q: 35}, {L: 'G', Q: 30}, {L: 'T', Q: 20}, {L: 'N', Q: 2}, {L: 'N', Q: 2},
+	})
+	c.Check(r.Trace.Peaks, check.DeepEquals, []int{2, 5, 8, 11, 13, 16})
+}
+
+func (s *S) TestReadErrors(c *check.C) {
+	tmpl := linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger)
+
+	_, err := NewReader(bytes.NewReader([]byte("GIF89a not a trace file at all....")), tmpl).Read()
+	c.Check(err, check.Equals, ErrNotABIF)
+
+	b := abif(entries())
+	_, err = NewReader(bytes.NewReader(b[:len(b)-10]), tmpl).Read()
+	c.Check(err, check.Equals, ErrTruncated)
+
+	ents := entries()
+	ents[6].Data = ents[6].Data[:5]
+	_, err = NewReader(bytes.NewReader(abif(ents)), tmpl).Read()
+	c.Check(err, check.Equals, ErrQualityLength)
+
+	_, err = NewReader(bytes.NewReader(abif(entries()[:2])), tmpl).Read()
+	c.Check(err, check.Equals, ErrNoBases)
+
+	// Directory and data sizes larger than the
+	// file are rejected before allocation.
+	var hdr bytes.Buffer
+	hdr.WriteString("ABIF")
+	binary.Write(&hdr, binary.BigEndian, uint16(101))
+	writeEntry(&hdr, Entry{Name: "tdir", Number: 1, Type: 1023, ElemSize: entrySize, Count: 1<<31 - 1}, 1<<31-1, 6)
+	_, err = NewReader(bytes.NewReader(hdr.Bytes()), tmpl).Read()
+	c.Check(err, check.Equals, ErrTruncated)
+
+	ents = entries()
+	ents[0].Count = 1<<31 - 1
+	b = abif(ents)
+	// Set the data size of the first entry to the maximum.
+	dir := int(binary.BigEndian.Uint32(b[6+20:]))
+	binary.BigEndian.PutUint32(b[dir+16:], 1<<31-1)
+	_, err = NewReader(bytes.NewReader(b), tmpl).Read()
+	c.Check(err, check.Equals, ErrTruncated)
+}
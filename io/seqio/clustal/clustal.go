@@ -0,0 +1,356 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package clustal provides types to read and write CLUSTAL W and Clustal Omega
+// .aln format multiple sequence alignment files.
+package clustal
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrBadHeader  = errors.New("clustal: missing CLUSTAL header")
+	ErrBadLine    = errors.New("clustal: badly formed line")
+	ErrBadBlock   = errors.New("clustal: sequence lengths differ within block")
+	ErrBadNames   = errors.New("clustal: sequence names differ between blocks")
+	ErrRagged     = errors.New("clustal: alignment rows differ in length")
+	ErrEmptyBlock = errors.New("clustal: empty alignment")
+)
+
+// DefaultHeader is the header line written by a Writer if no header is specified.
+const DefaultHeader = "CLUSTAL W multiple sequence alignment"
+
+// Reader is a Clustal format reader.
+type Reader struct {
+	r    *bufio.Reader
+	t    seqio.SequenceAppender
+	line int
+
+	// Header holds the header line of the
+	// most recently read alignment.
+	Header string
+
+	// Conservation holds the conservation line
+	// of the most recently read alignment,
+	// concatenated over all blocks. Columns
+	// without a conservation mark are spaces.
+	Conservation []byte
+}
+
+// NewReader returns a new Clustal format reader using r. Sequences in the returned
+// alignments are copied from the provided template.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{
+		r: bufio.NewReader(r),
+		t: template,
+	}
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+func (r *Reader) readLine() ([]byte, error) {
+	line, err := r.r.ReadBytes('\n')
+	if len(line) == 0 && err != nil {
+		return nil, err
+	}
+	r.line++
+	return bytes.TrimRight(line, "\r\n"), nil
+}
+
+// Read reads a complete Clustal alignment and returns it or an error.
+func (r *Reader) Read() (*multi.Multi, error) {
+	var (
+		line []byte
+		err  error
+	)
+	for {
+		line, err = r.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(line)) != 0 {
+			break
+		}
+	}
+	if !bytes.HasPrefix(line, []byte("CLUSTAL")) {
+		return nil, fmt.Errorf("%v at line %d", ErrBadHeader, r.line)
+	}
+	r.Header = string(line)
+	r.Conservation = r.Conservation[:0]
+
+	var (
+		names []string
+		rows  []seqio.SequenceAppender
+
+		block  int  // index of the current row within a block
+		width  int  // length of sequence data in the current block
+		offset = -1 // column of sequence data in the current block
+		cons   []byte
+	)
+	endBlock := func() error {
+		if block == 0 {
+			return nil
+		}
+		if block != len(names) {
+			return ErrBadNames
+		}
+		c := make([]byte, width)
+		for i := range c {
+			c[i] = ' '
+		}
+		if offset < len(cons) {
+			copy(c, cons[offset:])
+		}
+		r.Conservation = append(r.Conservation, c...)
+		block, width, offset, cons = 0, 0, -1, nil
+		return nil
+	}
+	for {
+		line, err = r.readLine()
+		if err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			if err = endBlock(); err != nil {
+				return nil, fmt.Errorf("%v at line %d", err, r.line)
+			}
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			// Conservation line.
+			cons = line
+			continue
+		}
+
+		f := bytes.Fields(line)
+		if len(f) < 2 || len(f) > 3 {
+			return nil, fmt.Errorf("%v at line %d", ErrBadLine, r.line)
+		}
+		name, data := string(f[0]), f[1]
+		if block == 0 {
+			width = len(data)
+			offset = bytes.Index(line[len(f[0]):], data) + len(f[0])
+		} else if len(data) != width {
+			return nil, fmt.Errorf("%v at line %d", ErrBadBlock, r.line)
+		}
+		if len(r.Conservation) == 0 {
+			// First block defines the rows.
+			if block != len(names) {
+				return nil, fmt.Errorf("%v at line %d", ErrBadNames, r.line)
+			}
+			s := r.t.Clone().(seqio.SequenceAppender)
+			if err = s.SetName(name); err != nil {
+				return nil, fmt.Errorf("%v at line %d", err, r.line)
+			}
+			names = append(names, name)
+			rows = append(rows, s)
+		} else if block >= len(names) || names[block] != name {
+			return nil, fmt.Errorf("%v at line %d", ErrBadNames, r.line)
+		}
+		rows[block].AppendLetters(alphabet.BytesToLetters(data)...)
+		block++
+	}
+	if err = endBlock(); err != nil {
+		return nil, fmt.Errorf("%v at line %d", err, r.line)
+	}
+	if len(rows) == 0 {
+		return nil, ErrEmptyBlock
+	}
+
+	ss := make([]seq.Sequence, len(rows))
+	for i, s := range rows {
+		ss[i] = s
+	}
+	return multi.NewMulti("", ss, seq.DefaultConsensus)
+}
+
+// Writer is a Clustal format writer.
+type Writer struct {
+	w io.Writer
+
+	// Width is the number of columns written
+	// in each alignment block. If Width is less
+	// than one, the alignment is written as a
+	// single block.
+	Width int
+
+	// Header is the header line to write. If
+	// empty, DefaultHeader is used.
+	Header string
+
+	// Conservation is the conservation line to
+	// write. If nil, the conservation line is
+	// calculated using Conservation.
+	Conservation []byte
+}
+
+// NewWriter returns a new Clustal format writer using w. Alignment blocks are
+// written width columns wide.
+func NewWriter(w io.Writer, width int) *Writer {
+	return &Writer{w: w, Width: width}
+}
+
+// Write writes a complete alignment and returns the number of bytes written and any
+// error. The rows of m must all span the same columns.
+func (w *Writer) Write(m *multi.Multi) (n int, err error) {
+	if m.Rows() == 0 {
+		return 0, ErrEmptyBlock
+	}
+	start, end := m.Start(), m.End()
+	for _, s := range m.Seq {
+		if s.Start() != start || s.End() != end {
+			return 0, ErrRagged
+		}
+	}
+	cons := w.Conservation
+	if cons == nil {
+		cons = Conservation(m)
+	}
+	if len(cons) != end-start {
+		return 0, ErrRagged
+	}
+
+	header := w.Header
+	if header == "" {
+		header = DefaultHeader
+	}
+	n, err = fmt.Fprintf(w.w, "%s\n\n", header)
+	if err != nil {
+		return n, err
+	}
+
+	var nameWidth int
+	for _, s := range m.Seq {
+		if len(s.Name()) > nameWidth {
+			nameWidth = len(s.Name())
+		}
+	}
+	nameWidth += 6
+
+	width := w.Width
+	if width < 1 {
+		width = end - start
+	}
+	var (
+		_n  int
+		buf = make([]byte, 0, width)
+	)
+	for i := start; i < end; i += width {
+		if i != start {
+			_n, err = fmt.Fprintln(w.w)
+			if n += _n; err != nil {
+				return n, err
+			}
+		}
+		e := min(i+width, end)
+		for _, s := range m.Seq {
+			buf = buf[:0]
+			for j := i; j < e; j++ {
+				buf = append(buf, byte(s.At(j).L))
+			}
+			_n, err = fmt.Fprintf(w.w, "%-*s%s\n", nameWidth, s.Name(), buf)
+			if n += _n; err != nil {
+				return n, err
+			}
+		}
+		_n, err = fmt.Fprintf(w.w, "%s%s\n", strings.Repeat(" ", nameWidth), cons[i-start:e-start])
+		if n += _n; err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+var (
+	strong = []string{"STA", "NEQK", "NHQK", "NDEQ", "QHRK", "MILV", "MILF", "HY", "FYW"}
+	weak   = []string{"CSA", "ATV", "SAG", "STNK", "STPA", "SGND", "SNDEQK", "NDEQHK", "NEQHRK", "FVLIM", "HFY"}
+)
+
+// Conservation returns a Clustal conservation line for m. Fully conserved columns
+// are marked with '*'. For protein alignments, columns conserved within one of
+// the Clustal strong groups are marked with ':' and those conserved within one of
+// the weak groups are marked with '.'. Columns containing gaps are not marked.
+func Conservation(m *multi.Multi) []byte {
+	var (
+		alpha   = m.Alphabet()
+		protein = alpha != nil && alpha.Moltype() == feat.Protein
+		cons    = make([]byte, 0, m.Len())
+	)
+	for i := m.Start(); i < m.End(); i++ {
+		col := m.Column(i, true)
+		cons = append(cons, mark(col, alpha, protein))
+	}
+	return cons
+}
+
+func mark(col []alphabet.Letter, alpha alphabet.Alphabet, protein bool) byte {
+	if len(col) == 0 {
+		return ' '
+	}
+	var gap alphabet.Letter = '-'
+	if alpha != nil {
+		gap = alpha.Gap()
+	}
+	u := make([]byte, len(col))
+	same := true
+	for i, l := range col {
+		if l == gap || l == '-' || l == '.' {
+			return ' '
+		}
+		u[i] = byte(l &^ 0x20) // Upper case.
+		same = same && u[i] == u[0]
+	}
+	switch {
+	case same:
+		return '*'
+	case !protein:
+		return ' '
+	case within(u, strong):
+		return ':'
+	case within(u, weak):
+		return '.'
+	}
+	return ' '
+}
+
+// within returns whether all the letters in u are found in one of the groups.
+func within(u []byte, groups []string) bool {
+	for _, g := range groups {
+		all := true
+		for _, b := range u {
+			if strings.IndexByte(g, b) < 0 {
+				all = false
+				break
+			}
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package clustal
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const aln = `CLUSTAL W (1.83) multiple sequence alignment
+
+seq1      MKVLA-TSEQ
+seq2      MKILAGTSDQ
+seq3      MRVLA-SSEQ
+          *:.**  .:*
+
+seq1      WLKAG
+seq2      WLRSG
+seq3      YLKAG
+           *  *
+`
+
+func (s *S) TestReadWrite(c *check.C) {
+	r := NewReader(bytes.NewBufferString(aln), linear.NewSeq("", nil, alphabet.Protein))
+	m, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(m.Rows(), check.Equals, 3)
+	c.Check(m.Row(1).Name(), check.Equals, "seq2")
+	c.Check(string(m.Row(1).(*linear.Seq).Seq), check.Equals, "MKILAGTSDQWLRSG")
+	c.Check(r.Header, check.Equals, "CLUSTAL W (1.83) multiple sequence alignment")
+	c.Check(string(r.Conservation), check.Equals, "*:.**  .:* *  *")
+
+	var b bytes.Buffer
+	w := NewWriter(&b, 10)
+	w.Header = r.Header
+	w.Conservation = r.Conservation
+	_, err = w.Write(m)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b.String(), check.Equals, aln)
+}
+
+func (s *S) TestConservation(c *check.C) {
+	r := NewReader(bytes.NewBufferString(aln), linear.NewSeq("", nil, alphabet.Protein))
+	m, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(string(Conservation(m)), check.Equals, "*::** :*:*:*::*")
+}
+
+func (s *S) TestErrors(c *check.C) {
+	for i, t := range []struct {
+		in  string
+		err string
+	}{
+		{"seq1 ACGT\n", "clustal: missing CLUSTAL header at line 1"},
+		{"CLUSTAL\n\nseq1 ACGT\nseq2 ACG\n", "clustal: sequence lengths differ within block at line 4"},
+		{"CLUSTAL\n\nseq1 ACGT\nseq2 ACGT\n\nseq1 AC\nseq3 AC\n", "clustal: sequence names differ between blocks at line 7"},
+	} {
+		_, err := NewReader(bytes.NewBufferString(t.in), linear.NewSeq("", nil, alphabet.DNA)).Read()
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestWriteWidth(c *check.C) {
+	m, err := NewReader(bytes.NewBufferString(aln), linear.NewSeq("", nil, alphabet.Protein)).Read()
+	c.Assert(err, check.Equals, nil)
+	for _, width := range []int{0, -1, 15, 20} {
+		var b bytes.Buffer
+		w := NewWriter(&b, width)
+		w.Header = "CLUSTAL"
+		_, err = w.Write(m)
+		c.Assert(err, check.Equals, nil, check.Commentf("Width %d", width))
+		c.Check(b.String(), check.Equals, `CLUSTAL
+
+seq1      MKVLA-TSEQWLKAG
+seq2      MKILAGTSDQWLRSG
+seq3      MRVLA-SSEQYLKAG
+          *::** :*:*:*::*
+`, check.Commentf("Width %d", width))
+	}
+}
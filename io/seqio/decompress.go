@@ -0,0 +1,53 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package seqio
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+)
+
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	bzip2Magic = []byte("BZh")
+)
+
+// Decompress returns a reader that reads the decompressed data of r if r holds
+// gzip, including BGZF, or bzip2 compressed data, and the data of r unaltered
+// otherwise. The compression format is determined from the leading magic bytes
+// on the first read, and any error in reading them or in starting decompression
+// is returned by that read.
+func Decompress(r io.Reader) io.Reader {
+	return &decompressor{r: bufio.NewReader(r)}
+}
+
+type decompressor struct {
+	r   *bufio.Reader
+	d   io.Reader
+	err error
+}
+
+func (d *decompressor) Read(p []byte) (int, error) {
+	if d.d == nil && d.err == nil {
+		// A short peek is uncompressed data or an
+		// error that is returned by reading d.r.
+		magic, _ := d.r.Peek(len(bzip2Magic))
+		switch {
+		case bytes.HasPrefix(magic, gzipMagic):
+			d.d, d.err = gzip.NewReader(d.r)
+		case bytes.HasPrefix(magic, bzip2Magic):
+			d.d = bzip2.NewReader(d.r)
+		default:
+			d.d = d.r
+		}
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	return d.d.Read(p)
+}
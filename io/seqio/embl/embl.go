@@ -0,0 +1,173 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package embl provides types to read EMBL flat file format sequence records.
+//
+// Records are returned as genbank.Records since the formats describe the same
+// INSDC record content and share the layout of the feature table.
+//
+// The specification can be found at https://ftp.ebi.ac.uk/pub/databases/embl/doc/usrman.txt.
+package embl
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/io/seqio/genbank"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrNoID = errors.New("embl: record does not start with ID line")
+
+var _ seqio.Reader = (*Reader)(nil)
+
+func init() {
+	seqio.RegisterFormat(seqio.Format{
+		Name: "embl",
+		Detect: func(data []byte) bool {
+			return bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("ID   "))
+		},
+		NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+			return NewReader(r, template)
+		},
+	})
+}
+
+// Reader is an EMBL format reader.
+type Reader struct {
+	r    *bufio.Reader
+	t    seqio.SequenceAppender
+	line int
+}
+
+// NewReader returns a new EMBL format reader using r. Sequences returned by
+// the Reader are copied from template.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{r: bufio.NewReader(r), t: template}
+}
+
+// Read reads a single record and returns its sequence or an error. The name
+// of the sequence is the entry name and its description is the description
+// of the record.
+func (r *Reader) Read() (seq.Sequence, error) {
+	rec, err := r.ReadRecord()
+	if rec == nil {
+		return nil, err
+	}
+	s := r.t.Clone().(seqio.SequenceAppender)
+	if serr := s.SetName(rec.Name); serr != nil && err == nil {
+		err = serr
+	}
+	if serr := s.SetDescription(rec.Definition); serr != nil && err == nil {
+		err = serr
+	}
+	if serr := s.AppendLetters(rec.Seq...); serr != nil && err == nil {
+		err = serr
+	}
+	return s, err
+}
+
+// ReadRecord reads a single annotated record and returns it or an error. A
+// record that is not terminated by a "//" line is returned with the error
+// io.ErrUnexpectedEOF.
+func (r *Reader) ReadRecord() (*genbank.Record, error) {
+	var (
+		rec   *genbank.Record
+		table genbank.FeatureTable
+
+		// version is the sequence version
+		// given in the ID line.
+		version string
+	)
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			if err == io.EOF && rec != nil {
+				err = io.ErrUnexpectedEOF
+				rec.Features = table.Features()
+			}
+			return rec, err
+		}
+		r.line++
+		line = bytes.TrimRight(line, "\r\n")
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		code := string(line)
+		if len(line) > 2 {
+			code = code[:2]
+		}
+		var text string
+		if len(line) > 5 {
+			text = strings.TrimSpace(string(line[5:]))
+		}
+		if rec == nil {
+			if code != "ID" {
+				return nil, fmt.Errorf("%v at line %d", ErrNoID, r.line)
+			}
+			rec = &genbank.Record{Name: firstField(text)}
+			for _, f := range strings.Split(text, ";") {
+				if f = strings.TrimSpace(f); strings.HasPrefix(f, "SV ") {
+					version = strings.TrimSpace(f[len("SV "):])
+				}
+			}
+			continue
+		}
+		switch code {
+		case "//":
+			rec.Features = table.Features()
+			return rec, nil
+		case "AC":
+			if rec.Accession == "" {
+				rec.Accession = firstField(text)
+				if version != "" {
+					rec.Version = rec.Accession + "." + version
+				}
+			}
+		case "SV":
+			rec.Version = firstField(text)
+		case "DE":
+			if rec.Definition == "" {
+				rec.Definition = text
+			} else {
+				rec.Definition += " " + text
+			}
+		case "OS":
+			if rec.Organism == "" {
+				rec.Organism = text
+			}
+		case "FT":
+			err = table.AddLine(line)
+			if err != nil {
+				return nil, fmt.Errorf("embl: %v at line %d", err, r.line)
+			}
+		case "  ":
+			// Sequence lines follow the SQ line and
+			// end with a position number.
+			for _, b := range line {
+				if b == ' ' || ('0' <= b && b <= '9') {
+					continue
+				}
+				rec.Seq = append(rec.Seq, alphabet.Letter(b))
+			}
+		}
+	}
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+func firstField(s string) string {
+	f := strings.Fields(strings.Replace(s, ";", " ", -1))
+	if len(f) == 0 {
+		return ""
+	}
+	return f[0]
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package embl
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/genbank"
+	"github.com/biogo/biogo/seq/linear"
+
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const record = `ID   X56734; SV 1; linear; mRNA; STD; PLN; 40 BP.
+XX
+AC   X56734; S46826;
+XX
+DE   Trifolium repens mRNA for non-cyanogenic beta-glucosidase
+XX
+OS   Trifolium repens (white clover)
+OC   Eukaryota; Viridiplantae; Streptophyta; Embryophyta; Tracheophyta;
+XX
+FH   Key             Location/Qualifiers
+FH
+FT   source          1..40
+FT                   /organism="Trifolium repens"
+FT   CDS             14..>40
+FT                   /product="beta-glucosidase"
+FT                   /translation="MDFLKL"
+XX
+SQ   Sequence 40 BP; 10 A; 10 C; 10 G; 10 T; 0 other;
+     aaacaaacca aatatggatt ttattgtagc catatttgct        40
+//
+`
+
+func (s *S) TestReadRecord(c *check.C) {
+	r := NewReader(strings.NewReader(record), nil)
+	rec, err := r.ReadRecord()
+	c.Assert(err, check.Equals, nil)
+	c.Check(rec.Name, check.Equals, "X56734")
+	c.Check(rec.Accession, check.Equals, "X56734")
+	c.Check(rec.Version, check.Equals, "X56734.1")
+	c.Check(rec.Definition, check.Equals, "Trifolium repens mRNA for non-cyanogenic beta-glucosidase")
+	c.Check(rec.Organism, check.Equals, "Trifolium repens (white clover)")
+	c.Check(string(rec.Seq), check.Equals, "aaacaaaccaaatatggattttattgtagccatatttgct")
+	c.Check(rec.Features, check.DeepEquals, []genbank.Feature{
+		{Key: "source", Location: "1..40", Qualifiers: []genbank.Qualifier{{Name: "organism", Value: "Trifolium repens"}}},
+		{Key: "CDS", Location: "14..>40", Qualifiers: []genbank.Qualifier{
+			{Name: "product", Value: "beta-glucosidase"},
+			{Name: "translation", Value: "MDFLKL"},
+		}},
+	})
+	_, err = r.ReadRecord()
+	c.Check(err, check.Equals, io.EOF)
+
+	sq, err := NewReader(strings.NewReader(record), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(sq.Name(), check.Equals, "X56734")
+	c.Check(sq.Len(), check.Equals, 40)
+
+	_, err = NewReader(strings.NewReader("LOCUS x\n"), nil).ReadRecord()
+	c.Check(err, check.ErrorMatches, "embl: record does not start with ID line at line 1")
+}
@@ -6,6 +6,8 @@
 package fai
 
 import (
+	"github.com/biogo/biogo/alphabet"
+
 	"encoding/csv"
 	"errors"
 	"io"
@@ -20,7 +22,11 @@ const (
 	bytesField
 )
 
-var ErrNonUnique = errors.New("non-unique record name")
+var (
+	ErrNonUnique  = errors.New("non-unique record name")
+	ErrShortSeq   = errors.New("fai: sequence shorter than index length")
+	ErrOutOfRange = errors.New("fai: range out of bounds")
+)
 
 // Index is a FAI index.
 type Index map[string]Record
@@ -51,6 +57,55 @@ func (r Record) Position(p int) int64 {
 	return r.Start + int64(p/r.BasesPerLine*r.BytesPerLine+p%r.BasesPerLine)
 }
 
+// A Source reads the letters of an indexed sequence from a fasta file on demand.
+// It satisfies the Source interface of the seq/lazy package.
+type Source struct {
+	r   io.ReaderAt
+	rec Record
+}
+
+// NewSource returns a Source reading the sequence described by rec from r.
+func NewSource(r io.ReaderAt, rec Record) *Source {
+	return &Source{r: r, rec: rec}
+}
+
+// Len returns the length of the sequence.
+func (s *Source) Len() int { return s.rec.Length }
+
+// ReadLetters fills dst with the letters of the sequence starting at position start.
+func (s *Source) ReadLetters(dst []alphabet.Letter, start int) error {
+	if len(dst) == 0 {
+		return nil
+	}
+	if start < 0 || s.rec.Length < start+len(dst) {
+		return ErrOutOfRange
+	}
+	from, to := s.rec.Position(start), s.rec.Position(start+len(dst)-1)+1
+	buf := make([]byte, to-from)
+	n, err := s.r.ReadAt(buf, from)
+	if n < len(buf) {
+		if err == nil || err == io.EOF {
+			err = ErrShortSeq
+		}
+		return err
+	}
+	i := 0
+	for _, b := range buf {
+		if b == '\n' || b == '\r' {
+			continue
+		}
+		if i == len(dst) {
+			return ErrShortSeq
+		}
+		dst[i] = alphabet.Letter(b)
+		i++
+	}
+	if i != len(dst) {
+		return ErrShortSeq
+	}
+	return nil
+}
+
 func mustAtoi(fields []string, index, line int) int {
 	i, err := strconv.Atoi(fields[index])
 	if err != nil {
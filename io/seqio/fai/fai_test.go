@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/biogo/biogo/alphabet"
 	"github.com/biogo/biogo/io/seqio/fai"
 
 	"gopkg.in/check.v1"
@@ -181,3 +182,34 @@ NODE_53327_length_192_cov_1.854167	212	5590	60	61
 		c.Check(idx, check.DeepEquals, t.idx, check.Commentf("Test: %d", i))
 	}
 }
+
+func (s *S) TestSource(c *check.C) {
+	const fasta = ">a\nACGTA\nCGTAC\nGT\n>b\r\nTTTT\r\nGG\r\n"
+	idx := fai.Index{
+		"a": {Name: "a", Length: 12, Start: 3, BasesPerLine: 5, BytesPerLine: 6},
+		"b": {Name: "b", Length: 6, Start: 22, BasesPerLine: 4, BytesPerLine: 6},
+	}
+	r := strings.NewReader(fasta)
+	for i, t := range []struct {
+		name       string
+		start, end int
+		want       string
+		err        error
+	}{
+		{name: "a", start: 0, end: 12, want: "ACGTACGTACGT"},
+		{name: "a", start: 3, end: 7, want: "TACG"},
+		{name: "a", start: 11, end: 12, want: "T"},
+		{name: "b", start: 2, end: 6, want: "TTGG"},
+		{name: "a", start: 10, end: 13, err: fai.ErrOutOfRange},
+	} {
+		src := fai.NewSource(r, idx[t.name])
+		dst := make([]alphabet.Letter, t.end-t.start)
+		err := src.ReadLetters(dst, t.start)
+		c.Check(err, check.Equals, t.err, check.Commentf("Test %d", i))
+		if err == nil {
+			c.Check(alphabet.Letters(dst).String(), check.Equals, t.want, check.Commentf("Test %d", i))
+		}
+	}
+	short := fai.NewSource(strings.NewReader(fasta[:10]), idx["a"])
+	c.Check(short.ReadLetters(make([]alphabet.Letter, 12), 0), check.Equals, fai.ErrShortSeq)
+}
@@ -14,6 +14,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"text/template"
 )
 
 var (
@@ -21,6 +22,27 @@ var (
 	_ seqio.Writer = (*Writer)(nil)
 )
 
+func init() {
+	seqio.RegisterFormat(seqio.Format{
+		Name: "fasta",
+		Detect: func(data []byte) bool {
+			data = bytes.TrimLeft(data, " \t\r\n")
+			if bytes.HasPrefix(data, []byte(">Feature ")) {
+				// NCBI feature tables start with a
+				// >Feature line followed by tab
+				// separated lines.
+				if i := bytes.IndexByte(data, '\n'); i >= 0 && bytes.IndexByte(data[i:], '\t') >= 0 {
+					return false
+				}
+			}
+			return len(data) != 0 && data[0] == '>'
+		},
+		NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+			return NewReader(r, template)
+		},
+	})
+}
+
 // Default delimiters.
 const (
 	DefaultIDPrefix  = ">"
@@ -38,10 +60,11 @@ type Reader struct {
 }
 
 // Returns a new fasta format reader using f. Sequences returned by the Reader are copied
-// from the provided template.
+// from the provided template. Gzip and bzip2 compressed input is decompressed
+// transparently.
 func NewReader(f io.Reader, template seqio.SequenceAppender) *Reader {
 	return &Reader{
-		r:         bufio.NewReader(f),
+		r:         bufio.NewReader(seqio.Decompress(f)),
 		t:         template,
 		IDPrefix:  []byte(DefaultIDPrefix),
 		SeqPrefix: []byte(DefaultSeqPrefix),
@@ -136,12 +159,41 @@ func (r *Reader) header(line []byte) (seqio.SequenceAppender, error) {
 	return s, nil
 }
 
+// Case specifies the letter case of sequence written by a Writer.
+type Case int
+
+const (
+	// PreserveCase writes letters in their stored case,
+	// retaining lower-case masking.
+	PreserveCase Case = iota
+	// UpperCase writes all letters in upper case.
+	UpperCase
+	// LowerCase writes all letters in lower case.
+	LowerCase
+)
+
 // Fasta sequence format writer type.
 type Writer struct {
 	w         io.Writer
 	IDPrefix  []byte
 	SeqPrefix []byte
-	Width     int
+
+	// Width is the number of letters written per line.
+	// If Width is less than one, each sequence is
+	// written on a single line.
+	Width int
+
+	// Case specifies the case of written letters.
+	Case Case
+
+	// Header is used, if not nil, to render the text of
+	// header lines following the IDPrefix. The template is
+	// executed with the sequence being written as its data,
+	// so {{.Name}} and {{.Description}} give the ID and the
+	// description. If Header is nil, the header is the ID
+	// followed by the description, if present, separated
+	// by a space.
+	Header *template.Template
 }
 
 // Returns a new fasta format writer using f.
@@ -156,39 +208,44 @@ func NewWriter(w io.Writer, width int) *Writer {
 
 // Write a single sequence and return the number of bytes written and any error.
 func (w *Writer) Write(s seq.Sequence) (n int, err error) {
-	var (
-		_n     int
-		prefix = append([]byte{'\n'}, w.SeqPrefix...)
-	)
-	id, desc := s.Name(), s.Description()
-	header := make([]byte, 0, len(w.IDPrefix)+len(id)+len(desc)+1)
-	header = append(header, w.IDPrefix...)
-	header = append(header, id...)
-	if len(desc) > 0 {
-		header = append(header, ' ')
-		header = append(header, desc...)
+	var buf bytes.Buffer
+	buf.Write(w.IDPrefix)
+	if w.Header != nil {
+		err = w.Header.Execute(&buf, s)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		buf.WriteString(s.Name())
+		if desc := s.Description(); len(desc) > 0 {
+			buf.WriteByte(' ')
+			buf.WriteString(desc)
+		}
 	}
 
-	n, err = w.w.Write(header)
-	if err != nil {
-		return n, err
+	width := w.Width
+	if width < 1 {
+		width = s.Len()
 	}
 	for i := 0; i < s.Len(); i++ {
-		if i%w.Width == 0 {
-			_n, err = w.w.Write(prefix)
-			if n += _n; err != nil {
-				return n, err
-			}
+		if i%width == 0 {
+			buf.WriteByte('\n')
+			buf.Write(w.SeqPrefix)
 		}
-		_n, err = w.w.Write([]byte{byte(s.At(i).L)})
-		if n += _n; err != nil {
-			return n, err
+		l := s.At(i).L
+		switch w.Case {
+		case UpperCase:
+			if 'a' <= l && l <= 'z' {
+				l -= 'a' - 'A'
+			}
+		case LowerCase:
+			if 'A' <= l && l <= 'Z' {
+				l += 'a' - 'A'
+			}
 		}
+		buf.WriteByte(byte(l))
 	}
-	_n, err = w.w.Write([]byte{'\n'})
-	if n += _n; err != nil {
-		return n, err
-	}
+	buf.WriteByte('\n')
 
-	return n, nil
+	return w.w.Write(buf.Bytes())
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/biogo/biogo/seq/linear"
 
 	"io"
+	"regexp"
 	"testing"
+	"text/template"
 
 	"gopkg.in/check.v1"
 )
@@ -113,3 +115,110 @@ func (s *S) TestWriteFasta(c *check.C) {
 	c.Check(n, check.Equals, b.Len())
 	c.Check(string(b.Bytes()), check.Equals, fa)
 }
+
+func (s *S) TestWriteOptions(c *check.C) {
+	sq := linear.NewSeq("seq1", alphabet.BytesToLetters([]byte("ACGTacgtNNACGT")), alphabet.DNA)
+	sq.Desc = "masked"
+	for i, t := range []struct {
+		width  int
+		cas    Case
+		header string
+		expect string
+	}{
+		{
+			width:  60,
+			expect: ">seq1 masked\nACGTacgtNNACGT\n",
+		},
+		{
+			width:  4,
+			expect: ">seq1 masked\nACGT\nacgt\nNNAC\nGT\n",
+		},
+		{
+			width:  0,
+			cas:    UpperCase,
+			expect: ">seq1 masked\nACGTACGTNNACGT\n",
+		},
+		{
+			width:  7,
+			cas:    LowerCase,
+			expect: ">seq1 masked\nacgtacg\ntnnacgt\n",
+		},
+		{
+			width:  60,
+			header: "{{.Name}}|len={{.Len}} [{{.Description}}]",
+			expect: ">seq1|len=14 [masked]\nACGTacgtNNACGT\n",
+		},
+	} {
+		b := &bytes.Buffer{}
+		w := NewWriter(b, t.width)
+		w.Case = t.cas
+		if t.header != "" {
+			w.Header = template.Must(template.New("header").Parse(t.header))
+		}
+		n, err := w.Write(sq)
+		c.Check(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(n, check.Equals, b.Len(), check.Commentf("Test %d", i))
+		c.Check(b.String(), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+
+	b := &bytes.Buffer{}
+	w := NewWriter(b, 60)
+	w.Header = template.Must(template.New("header").Parse("{{.Missing}}"))
+	n, err := w.Write(sq)
+	c.Check(err, check.Not(check.Equals), nil)
+	c.Check(n, check.Equals, 0)
+	c.Check(b.Len(), check.Equals, 0)
+}
+
+func (s *S) TestQual(c *check.C) {
+	const (
+		fa = ">a first\nACGTA\nC\n>b\nGG\n"
+		qa = ">b\n30 31\n>a first\n10 20 30\n40 50 60\n"
+	)
+	r := NewQualReader(bytes.NewBufferString(fa), bytes.NewBufferString(qa), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+	var got []*linear.QSeq
+	for {
+		sq, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, sq.(*linear.QSeq))
+	}
+	c.Assert(got, check.HasLen, 2)
+	c.Check(got[0].Name(), check.Equals, "a")
+	c.Check(got[0].Description(), check.Equals, "first")
+	c.Check(got[0].Seq, check.DeepEquals, alphabet.QLetters{
+		{L: 'A', Q: 10}, {L: 'C', Q: 20}, {L: 'G', Q: 30}, {L: 'T', Q: 40}, {L: 'A', Q: 50}, {L: 'C', Q: 60},
+	})
+	c.Check(got[1].Seq, check.DeepEquals, alphabet.QLetters{{L: 'G', Q: 30}, {L: 'G', Q: 31}})
+
+	var fb, qb bytes.Buffer
+	w := NewQualWriter(&fb, &qb, 4)
+	var n int
+	for _, sq := range got {
+		_n, err := w.Write(sq)
+		c.Assert(err, check.Equals, nil)
+		n += _n
+	}
+	c.Check(n, check.Equals, fb.Len()+qb.Len())
+	c.Check(fb.String(), check.Equals, ">a first\nACGT\nAC\n>b\nGG\n")
+	c.Check(qb.String(), check.Equals, ">a first\n10 20 30 40\n50 60\n>b\n30 31\n")
+
+	for i, t := range []struct {
+		qual string
+		err  string
+	}{
+		{qual: ">a\n10 20\n", err: `fasta: no quality record: "b"`},
+		{qual: ">a\n10\n", err: `fasta: quality length does not match sequence: "a"`},
+		{qual: ">a\n10 x\n", err: `fasta: bad quality value "x"`},
+		{qual: "10\n", err: `fasta: badly formed quality line "10"`},
+	} {
+		r := NewQualReader(bytes.NewBufferString(">a\nAC\n>b\nG\n"), bytes.NewBufferString(t.qual), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+		var err error
+		for err == nil {
+			_, err = r.Read()
+		}
+		c.Check(err, check.ErrorMatches, regexp.QuoteMeta(t.err), check.Commentf("Test %d", i))
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fasta
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	_ seqio.Reader = (*QualReader)(nil)
+	_ seqio.Writer = (*QualWriter)(nil)
+)
+
+var (
+	ErrNoQuality     = errors.New("fasta: no quality record")
+	ErrQualityLength = errors.New("fasta: quality length does not match sequence")
+)
+
+// QualReader reads sequences from a pair of FASTA and .qual files, as
+// produced by phred and the 454 and Sanger pipelines. Quality records are
+// matched to sequence records by ID, so the records of the two files need not
+// be in the same order, although records are read most efficiently when they
+// are.
+type QualReader struct {
+	r *Reader
+	q *bufio.Reader
+
+	// next is the ID of the next quality record
+	// and held holds quality records read ahead
+	// of their sequence records.
+	next string
+	held map[string][]alphabet.Qphred
+}
+
+// NewQualReader returns a new reader for the FASTA sequence data in f and the
+// quality data in q. Sequences returned by the QualReader are copied from the
+// provided template, which must be able to hold quality scores. Gzip and
+// bzip2 compressed input is decompressed transparently.
+func NewQualReader(f, q io.Reader, template seqio.SequenceAppender) *QualReader {
+	return &QualReader{
+		r:    NewReader(f, template),
+		q:    bufio.NewReader(seqio.Decompress(q)),
+		held: make(map[string][]alphabet.Qphred),
+	}
+}
+
+// Read reads a single sequence with its quality scores and returns it and
+// any error. A sequence with no matching quality record is returned with an
+// error wrapping ErrNoQuality.
+func (r *QualReader) Read() (seq.Sequence, error) {
+	s, err := r.r.Read()
+	if err != nil {
+		return s, err
+	}
+	id := s.Name()
+	qual, ok := r.held[id]
+	if ok {
+		delete(r.held, id)
+	} else {
+		for {
+			var name string
+			name, qual, err = r.readQual()
+			if err == io.EOF {
+				return s, fmt.Errorf("%v: %q", ErrNoQuality, id)
+			}
+			if err != nil {
+				return s, err
+			}
+			if name == id {
+				break
+			}
+			r.held[name] = qual
+		}
+	}
+	if len(qual) != s.Len() {
+		return s, fmt.Errorf("%v: %q", ErrQualityLength, id)
+	}
+	for i, q := range qual {
+		l := s.At(i)
+		l.Q = q
+		err = s.Set(i, l)
+		if err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+// readQual reads the next quality record, returning its ID and scores.
+func (r *QualReader) readQual() (string, []alphabet.Qphred, error) {
+	var (
+		qual []alphabet.Qphred
+		name = r.next
+		seen = name != ""
+	)
+	for {
+		line, err := r.q.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			if err == io.EOF && seen {
+				r.next = ""
+				return name, qual, nil
+			}
+			return "", nil, err
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if bytes.HasPrefix(line, r.r.IDPrefix) {
+			id := line[len(r.r.IDPrefix):]
+			if i := bytes.IndexAny(id, " \t"); i >= 0 {
+				id = id[:i]
+			}
+			if seen {
+				r.next = string(id)
+				return name, qual, nil
+			}
+			name, seen = string(id), true
+			continue
+		}
+		if !seen {
+			return "", nil, fmt.Errorf("fasta: badly formed quality line %q", line)
+		}
+		for _, f := range bytes.Fields(line) {
+			v, err := strconv.ParseUint(string(f), 10, 8)
+			if err != nil {
+				return "", nil, fmt.Errorf("fasta: bad quality value %q", f)
+			}
+			qual = append(qual, alphabet.Qphred(v))
+		}
+	}
+}
+
+// QualWriter writes sequences to a pair of FASTA and .qual files.
+type QualWriter struct {
+	*Writer
+	q io.Writer
+
+	// QualWidth is the number of quality values written
+	// per line. If QualWidth is less than one, the values
+	// of each sequence are written on a single line.
+	QualWidth int
+}
+
+// NewQualWriter returns a new writer of sequence data to f and quality data to
+// q, with width letters and quality values per line.
+func NewQualWriter(f, q io.Writer, width int) *QualWriter {
+	return &QualWriter{Writer: NewWriter(f, width), q: q, QualWidth: width}
+}
+
+// Write writes a single sequence to the sequence file and its quality scores
+// to the quality file, returning the number of bytes written to both and any
+// error.
+func (w *QualWriter) Write(s seq.Sequence) (n int, err error) {
+	n, err = w.Writer.Write(s)
+	if err != nil {
+		return n, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(w.IDPrefix)
+	buf.WriteString(s.Name())
+	if desc := s.Description(); len(desc) > 0 {
+		buf.WriteByte(' ')
+		buf.WriteString(desc)
+	}
+	width := w.QualWidth
+	if width < 1 {
+		width = s.Len()
+	}
+	for i := 0; i < s.Len(); i++ {
+		if i%width == 0 {
+			buf.WriteByte('\n')
+		} else {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(strconv.Itoa(int(s.At(i).Q)))
+	}
+	buf.WriteByte('\n')
+
+	_n, err := w.q.Write(buf.Bytes())
+	return n + _n, err
+}
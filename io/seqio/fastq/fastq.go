@@ -22,6 +22,19 @@ var (
 	_ seqio.Writer = (*Writer)(nil)
 )
 
+func init() {
+	seqio.RegisterFormat(seqio.Format{
+		Name: "fastq",
+		Detect: func(data []byte) bool {
+			data = bytes.TrimLeft(data, " \t\r\n")
+			return len(data) != 0 && data[0] == '@'
+		},
+		NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+			return NewReader(r, template)
+		},
+	})
+}
+
 type Encoder interface {
 	Encoding() alphabet.Encoding
 }
@@ -31,10 +44,22 @@ type Reader struct {
 	r   *bufio.Reader
 	t   seqio.SequenceAppender
 	enc alphabet.Encoding
+
+	// Detect is the number of records examined to
+	// detect the quality encoding of the input before
+	// the first sequence is returned. If Detect is zero,
+	// the encoding of the template is used.
+	Detect   int
+	detected bool
 }
 
+// DefaultDetect is a reasonable number of records to examine when detecting the
+// quality encoding of FASTQ input.
+const DefaultDetect = 1000
+
 // Returns a new fastq format reader using r. Sequences returned by the Reader are copied
-// from the provided template.
+// from the provided template. Gzip and bzip2 compressed input is decompressed
+// transparently.
 func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
 	var enc alphabet.Encoding
 	if e, ok := template.(Encoder); ok {
@@ -44,12 +69,16 @@ func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
 	}
 
 	return &Reader{
-		r:   bufio.NewReader(r),
+		r:   bufio.NewReader(seqio.Decompress(r)),
 		t:   template,
 		enc: enc,
 	}
 }
 
+// Encoding returns the quality encoding used to decode qualities. If the Reader
+// detects the encoding, the value is only valid after the first call to Read.
+func (r *Reader) Encoding() alphabet.Encoding { return r.enc }
+
 // Read a single sequence and return it  and potentially an error. Note that
 // a non-nil returned error may be associated with a valid sequence, so it is
 // the responsibility of the caller to examine the error to determine whether
@@ -78,6 +107,13 @@ func (r *Reader) Read() (seq.Sequence, error) {
 		err   error
 	)
 
+	if r.Detect > 0 && !r.detected {
+		err = r.detect()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 loop:
 	for {
 		buff, isPrefix, err = r.r.ReadLine()
@@ -148,6 +184,90 @@ loop:
 	return t, err
 }
 
+// detect sets the Reader's encoding from the qualities of up to r.Detect
+// records and then restores the examined input.
+func (r *Reader) detect() error {
+	r.detected = true
+	const (
+		header = iota
+		letters
+		quality
+	)
+	var (
+		buf   []byte
+		quals [][]byte
+		state int
+		n     int
+	)
+	for len(quals) < r.Detect {
+		line, err := r.r.ReadBytes('\n')
+		buf = append(buf, line...)
+		line = bytes.TrimSpace(line)
+		switch {
+		case len(line) == 0:
+		case state == header && maybeID1(line):
+			state, n = letters, 0
+		case state == letters && maybeID2(line):
+			if n == 0 {
+				state = header
+			} else {
+				state = quality
+			}
+		case state == letters:
+			n += len(line)
+		case state == quality:
+			quals = append(quals, line)
+			state = header
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	r.r = bufio.NewReader(io.MultiReader(bytes.NewReader(buf), r.r))
+	r.enc = DetectEncoding(quals...)
+	return nil
+}
+
+// DetectEncoding returns the most likely quality encoding of the provided
+// quality lines based on the range of quality bytes they contain. Phred+33
+// encodings are reported as alphabet.Sanger, and Phred+64 encodings as
+// alphabet.Illumina1_5 if no quality below 'B' is present and alphabet.Illumina1_3
+// otherwise. Encodings with qualities between ';' and '?' are reported as
+// alphabet.Solexa unless no quality above 'J' is present, in which case they are
+// reported as alphabet.Sanger. If no quality bytes are provided, alphabet.Sanger
+// is returned.
+func DetectEncoding(quals ...[]byte) alphabet.Encoding {
+	min, max := byte(0xff), byte(0)
+	for _, q := range quals {
+		for _, b := range q {
+			if b < min {
+				min = b
+			}
+			if b > max {
+				max = b
+			}
+		}
+	}
+	switch {
+	case min < ';' || min == 0xff:
+		return alphabet.Sanger
+	case min < '@':
+		// Phred+64 qualities of at most 10 are
+		// implausible, so a low maximum implies
+		// high quality Phred+33 data.
+		if max <= 'J' {
+			return alphabet.Sanger
+		}
+		return alphabet.Solexa
+	case min < 'B':
+		return alphabet.Illumina1_3
+	}
+	return alphabet.Illumina1_5
+}
+
 func maybeID1(l []byte) bool { return len(l) > 0 && l[0] == '@' }
 func maybeID2(l []byte) bool { return len(l) > 0 && l[0] == '+' }
 func isSpace(b byte) bool {
@@ -451,3 +451,63 @@ func (s *S) TestWriteFastq(c *check.C) {
 		}
 	}
 }
+
+func (s *S) TestDetectEncoding(c *check.C) {
+	for i, t := range []struct {
+		quals  []string
+		expect alphabet.Encoding
+	}{
+		{quals: nil, expect: alphabet.Sanger},
+		{quals: []string{"IIII", "#+5?"}, expect: alphabet.Sanger},
+		{quals: []string{"hhhh", ";>@h"}, expect: alphabet.Solexa},
+		{quals: []string{"JJJJ", "IIG<", ";?EJ"}, expect: alphabet.Sanger},
+		{quals: []string{"hhhh", "@Ah"}, expect: alphabet.Illumina1_3},
+		{quals: []string{"hhhh", "BBCh"}, expect: alphabet.Illumina1_5},
+	} {
+		var q [][]byte
+		for _, l := range t.quals {
+			q = append(q, []byte(l))
+		}
+		c.Check(DetectEncoding(q...), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestReadDetect(c *check.C) {
+	const fq = `@r1
+ACGT
++
+hhhh
+
+@r2
+
++
+
+@r3
+ACGTA
++
+@ABIh
+`
+	for _, detect := range []int{1, 2, DefaultDetect} {
+		r := NewReader(bytes.NewBufferString(fq), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+		r.Detect = detect
+		var quals [][]alphabet.Qphred
+		for {
+			s, err := r.Read()
+			if err != nil {
+				c.Check(err, check.Equals, io.EOF)
+				break
+			}
+			var q []alphabet.Qphred
+			for _, l := range s.(*linear.QSeq).Seq {
+				q = append(q, l.Q)
+			}
+			quals = append(quals, q)
+		}
+		c.Check(quals, check.DeepEquals, [][]alphabet.Qphred{{40, 40, 40, 40}, nil, {0, 1, 2, 9, 40}}, check.Commentf("Detect %d", detect))
+		if detect == 1 {
+			c.Check(r.Encoding(), check.Equals, alphabet.Illumina1_5)
+		} else {
+			c.Check(r.Encoding(), check.Equals, alphabet.Illumina1_3)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastq
+
+import (
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrUnpaired = errors.New("fastq: unpaired read at end of input")
+
+// ErrDesync is returned by a PairReader when the names of the reads of a pair
+// do not match.
+type ErrDesync struct {
+	Pair         int    // index of the pair from zero
+	Name1, Name2 string // names of the reads
+}
+
+func (e ErrDesync) Error() string {
+	return fmt.Sprintf("fastq: paired reads out of sync at pair %d: %q and %q", e.Pair, e.Name1, e.Name2)
+}
+
+// PairReader reads paired-end reads from a pair of synchronized FASTQ
+// sources or from a single interleaved source.
+type PairReader struct {
+	r1, r2 *Reader
+	n      int
+}
+
+// NewPairReader returns a PairReader that reads the first read of each pair
+// from r1 and the second from r2.
+func NewPairReader(r1, r2 *Reader) *PairReader {
+	return &PairReader{r1: r1, r2: r2}
+}
+
+// NewInterleavedReader returns a PairReader that reads pairs from consecutive
+// records of r.
+func NewInterleavedReader(r *Reader) *PairReader {
+	return &PairReader{r1: r, r2: r}
+}
+
+// Read reads a read pair. The names of the reads are compared after removing
+// any trailing "/1" or "/2" mate suffix, and if they do not match an ErrDesync
+// is returned. At the end of the input Read returns io.EOF
+// if both sources are exhausted and ErrUnpaired if only one is.
+func (p *PairReader) Read() (s1, s2 seq.Sequence, err error) {
+	s1, err = p.r1.Read()
+	if err != nil {
+		if err == io.EOF && p.r1 != p.r2 {
+			_, err2 := p.r2.Read()
+			if err2 != io.EOF {
+				return nil, nil, ErrUnpaired
+			}
+		}
+		return nil, nil, err
+	}
+	s2, err = p.r2.Read()
+	if err != nil {
+		if err == io.EOF {
+			err = ErrUnpaired
+		}
+		return nil, nil, err
+	}
+	n1, n2 := mateName(s1.Name()), mateName(s2.Name())
+	if n1 != n2 {
+		return nil, nil, ErrDesync{Pair: p.n, Name1: s1.Name(), Name2: s2.Name()}
+	}
+	p.n++
+	return s1, s2, nil
+}
+
+// mateName returns name without a trailing mate number suffix.
+func mateName(name string) string {
+	if strings.HasSuffix(name, "/1") || strings.HasSuffix(name, "/2") {
+		return name[:len(name)-2]
+	}
+	return name
+}
@@ -0,0 +1,80 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fastq
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"io"
+	"strings"
+
+	"gopkg.in/check.v1"
+)
+
+func newReader(s string) *Reader {
+	return NewReader(strings.NewReader(s), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+}
+
+func (s *S) TestPairReader(c *check.C) {
+	const (
+		mate1 = "@p1/1\nACGT\n+\nIIII\n@p2 1:N:0:1\nGGCC\n+\nIIII\n"
+		mate2 = "@p1/2\nTTGA\n+\nIIII\n@p2 2:N:0:1\nAATT\n+\nIIII\n"
+	)
+	for i, t := range []struct {
+		r      *PairReader
+		expect [][2]string
+		err    error
+	}{
+		{
+			r:      NewPairReader(newReader(mate1), newReader(mate2)),
+			expect: [][2]string{{"p1/1", "p1/2"}, {"p2", "p2"}},
+			err:    io.EOF,
+		},
+		{
+			r:      NewInterleavedReader(newReader("@p1/1\nACGT\n+\nIIII\n@p1/2\nTTGA\n+\nIIII\n@p2\nGGCC\n+\nIIII\n@p2\nAATT\n+\nIIII\n")),
+			expect: [][2]string{{"p1/1", "p1/2"}, {"p2", "p2"}},
+			err:    io.EOF,
+		},
+		{
+			r:      NewInterleavedReader(newReader("@p1/1\nACGT\n+\nIIII\n@p1/2\nTTGA\n+\nIIII\n@p2\nGGCC\n+\nIIII\n")),
+			expect: [][2]string{{"p1/1", "p1/2"}},
+			err:    ErrUnpaired,
+		},
+		{
+			r:      NewPairReader(newReader(mate1), newReader("@p1/2\nTTGA\n+\nIIII\n")),
+			expect: [][2]string{{"p1/1", "p1/2"}},
+			err:    ErrUnpaired,
+		},
+		{
+			r:      NewPairReader(newReader("@p1/1\nACGT\n+\nIIII\n"), newReader(mate2)),
+			expect: [][2]string{{"p1/1", "p1/2"}},
+			err:    ErrUnpaired,
+		},
+		{
+			r:      NewPairReader(newReader(mate1), newReader("@p1/2\nTTGA\n+\nIIII\n@p3\nAATT\n+\nIIII\n")),
+			expect: [][2]string{{"p1/1", "p1/2"}},
+			err:    ErrDesync{Pair: 1, Name1: "p2", Name2: "p3"},
+		},
+	} {
+		var got [][2]string
+		var err error
+		for {
+			var s1, s2 seq.Sequence
+			s1, s2, err = t.r.Read()
+			if err != nil {
+				break
+			}
+			got = append(got, [2]string{s1.Name(), s2.Name()})
+		}
+		c.Check(got, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+		c.Check(err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+
+	_, _, err := NewPairReader(newReader(mate1), newReader("@p9/2\nTTGA\n+\nIIII\n")).Read()
+	c.Check(err, check.Equals, ErrDesync{Pair: 0, Name1: "p1/1", Name2: "p9/2"})
+	c.Check(err, check.ErrorMatches, `fastq: paired reads out of sync at pair 0: "p1/1" and "p9/2"`)
+}
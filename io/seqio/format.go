@@ -0,0 +1,164 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package seqio
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+var ErrUnknownFormat = errors.New("seqio: unknown format")
+
+// peekLen is the number of bytes of input provided for format detection.
+const peekLen = 512
+
+// A Format describes a sequence file format that can be detected by content.
+type Format struct {
+	// Name is the name of the format.
+	Name string
+
+	// Detect returns whether the data at the start of
+	// a stream, after decompression, is in the format.
+	// The data is at most 512 bytes long and is shorter
+	// if the stream is shorter. If Detect is nil, the
+	// format is only used when requested by name.
+	Detect func(data []byte) bool
+
+	// NewReader returns a Reader for the format
+	// reading from r and copying sequences from
+	// template.
+	NewReader func(r io.Reader, template SequenceAppender) Reader
+}
+
+var (
+	formatsMu sync.Mutex
+	formats   []Format
+)
+
+// RegisterFormat registers a sequence format for use by NewReader, Open and
+// their named format variants. Formats are tried in the order they are
+// registered. Format packages usually register their format in an init
+// function, so a program needs to import the packages of the formats it is to
+// read, for example:
+//
+//	import _ "github.com/biogo/biogo/io/seqio/fasta"
+//
+// Third-party packages may register their own formats in the same way.
+// RegisterFormat panics if f has no name or NewReader function, or if a format
+// with the same name is already registered.
+func RegisterFormat(f Format) {
+	if f.Name == "" || f.NewReader == nil {
+		panic("seqio: invalid format registration")
+	}
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	for _, e := range formats {
+		if e.Name == f.Name {
+			panic("seqio: format " + f.Name + " registered twice")
+		}
+	}
+	formats = append(formats, f)
+}
+
+// lookupFormat returns the registered format with the given name.
+func lookupFormat(name string) (Format, bool) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	for _, f := range formats {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+// Formats returns the names of the registered formats in the order they are
+// tried.
+func Formats() []string {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	names := make([]string, len(formats))
+	for i, f := range formats {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// NewReader returns a Reader for the data in r, which may be gzip or bzip2
+// compressed, and the name of its format. The format is the first registered
+// format whose Detect function accepts the start of the data. Sequences
+// returned by the Reader are copied from template, so template must be
+// suitable for the formats that may be read; a sequence type that holds
+// qualities can be used for formats with and without quality data.
+func NewReader(r io.Reader, template SequenceAppender) (Reader, string, error) {
+	br := bufio.NewReaderSize(Decompress(r), peekLen)
+	data, err := br.Peek(peekLen)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, "", err
+	}
+	formatsMu.Lock()
+	fs := formats
+	formatsMu.Unlock()
+	for _, f := range fs {
+		if f.Detect != nil && f.Detect(data) {
+			return f.NewReader(br, template), f.Name, nil
+		}
+	}
+	return nil, "", ErrUnknownFormat
+}
+
+// NewReaderFormat returns a Reader for the data in r, which may be gzip or
+// bzip2 compressed, in the named registered format. Sequences returned by
+// the Reader are copied from template.
+func NewReaderFormat(r io.Reader, format string, template SequenceAppender) (Reader, error) {
+	f, ok := lookupFormat(format)
+	if !ok {
+		return nil, ErrUnknownFormat
+	}
+	return f.NewReader(Decompress(r), template), nil
+}
+
+// A ReadCloser is a Reader that must be closed when no longer used.
+type ReadCloser interface {
+	Reader
+	io.Closer
+}
+
+type fileReader struct {
+	Reader
+	io.Closer
+}
+
+// Open opens the named file and returns a ReadCloser for its data and the
+// name of its format as described for NewReader.
+func Open(name string, template SequenceAppender) (ReadCloser, string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, "", err
+	}
+	r, format, err := NewReader(f, template)
+	if err != nil {
+		f.Close()
+		return nil, "", err
+	}
+	return fileReader{Reader: r, Closer: f}, format, nil
+}
+
+// OpenFormat opens the named file and returns a ReadCloser for its data in the
+// named registered format as described for NewReaderFormat.
+func OpenFormat(name, format string, template SequenceAppender) (ReadCloser, error) {
+	fm, ok := lookupFormat(format)
+	if !ok {
+		return nil, ErrUnknownFormat
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return fileReader{Reader: fm.NewReader(Decompress(f), template), Closer: f}, nil
+}
@@ -0,0 +1,161 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A Handler receives the parts of GenBank records as they are parsed by Parse.
+// Returning a non-nil error from any method stops parsing.
+type Handler interface {
+	// Header is called for each header line
+	// of a record with the keyword of the field
+	// the line belongs to and the text of the
+	// line. Each record starts with a call with
+	// the key "LOCUS".
+	Header(key, text string) error
+
+	// Feature is called for each feature of the
+	// feature table once it is complete.
+	Feature(f Feature) error
+
+	// Sequence is called for each line of the
+	// sequence with the zero-based position of
+	// its first letter. The letters are only
+	// valid until Sequence returns.
+	Sequence(pos int, letters alphabet.Letters) error
+
+	// End is called at the end of each record.
+	End() error
+}
+
+// Parse parses the records read by r, passing their parts to h without retaining
+// them, so files of any size can be processed in constant memory. Parse returns
+// nil at the end of the input, and io.ErrUnexpectedEOF if the last record is not
+// terminated by a "//" line.
+func (r *Reader) Parse(h Handler) error {
+	for {
+		err := r.parseRecord(h)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parseRecord parses a single record, passing its parts to h.
+func (r *Reader) parseRecord(h Handler) error {
+	var (
+		started bool
+		key     string
+		table   FeatureTable
+		inSeq   bool
+		pos     int
+		letters alphabet.Letters
+	)
+
+	// features passes the completed features
+	// of the table to h, retaining the last
+	// unless all is true.
+	features := func(all bool) error {
+		f := table.features
+		if all {
+			f = table.Features()
+		} else if len(f) != 0 {
+			f = f[:len(f)-1]
+		}
+		for _, feat := range f {
+			err := h.Feature(feat)
+			if err != nil {
+				return err
+			}
+		}
+		table.features = table.features[len(f):]
+		return nil
+	}
+
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			if err == io.EOF && started {
+				err = features(true)
+				if err == nil {
+					err = io.ErrUnexpectedEOF
+				}
+			}
+			return err
+		}
+		r.line++
+		line = bytes.TrimRight(line, "\r\n")
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		if !started {
+			if !bytes.HasPrefix(line, []byte("LOCUS")) {
+				return fmt.Errorf("%v at line %d", ErrNoLocus, r.line)
+			}
+			started = true
+			err = h.Header("LOCUS", strings.TrimSpace(string(line[len("LOCUS"):])))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if bytes.HasPrefix(line, []byte("//")) {
+			err = features(true)
+			if err != nil {
+				return err
+			}
+			return h.End()
+		}
+
+		if line[0] != ' ' {
+			if key == "FEATURES" {
+				err = features(true)
+				if err != nil {
+					return err
+				}
+			}
+			inSeq = false
+			key = string(bytes.Fields(line)[0])
+			line = line[len(key):]
+			switch key {
+			case "ORIGIN":
+				inSeq = true
+				continue
+			case "FEATURES":
+				continue
+			}
+		}
+		switch {
+		case inSeq:
+			letters = appendLetters(letters[:0], line)
+			if len(letters) == 0 {
+				continue
+			}
+			err = h.Sequence(pos, letters)
+			pos += len(letters)
+		case key == "FEATURES":
+			err = table.AddLine(line)
+			if err != nil {
+				return fmt.Errorf("%v at line %d", err, r.line)
+			}
+			err = features(false)
+		default:
+			err = h.Header(key, string(bytes.TrimSpace(line)))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
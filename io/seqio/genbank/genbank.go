@@ -0,0 +1,186 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package genbank provides types to read GenBank flat file format sequence
+// records and the INSDC feature tables they hold.
+//
+// The specification can be found at https://www.ncbi.nlm.nih.gov/genbank/release/current/.
+package genbank
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+)
+
+var (
+	ErrBadFeature = errors.New("genbank: feature qualifier before feature key")
+	ErrNoLocus    = errors.New("genbank: record does not start with LOCUS line")
+)
+
+var _ seqio.Reader = (*Reader)(nil)
+
+func init() {
+	seqio.RegisterFormat(seqio.Format{
+		Name: "genbank",
+		Detect: func(data []byte) bool {
+			return bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("LOCUS "))
+		},
+		NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+			return NewReader(r, template)
+		},
+	})
+}
+
+// A Record is an annotated sequence record of an INSDC flat file.
+type Record struct {
+	// Name is the locus name or entry name
+	// of the record.
+	Name string
+
+	// Accession and Version are the primary
+	// accession and the accession version.
+	Accession string
+	Version   string
+
+	// Definition is the description of the
+	// sequence.
+	Definition string
+
+	// Organism is the source organism of the
+	// sequence.
+	Organism string
+
+	Features []Feature
+
+	Seq alphabet.Letters
+}
+
+// Reader is a GenBank format reader.
+type Reader struct {
+	r    *bufio.Reader
+	t    seqio.SequenceAppender
+	line int
+}
+
+// NewReader returns a new GenBank format reader using r. Sequences returned by
+// the Reader are copied from template.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{r: bufio.NewReader(r), t: template}
+}
+
+// Read reads a single record and returns its sequence or an error. The name
+// of the sequence is the locus name and its description is the definition.
+func (r *Reader) Read() (seq.Sequence, error) {
+	rec, err := r.ReadRecord()
+	if rec == nil {
+		return nil, err
+	}
+	s := r.t.Clone().(seqio.SequenceAppender)
+	if serr := s.SetName(rec.Name); serr != nil && err == nil {
+		err = serr
+	}
+	if serr := s.SetDescription(rec.Definition); serr != nil && err == nil {
+		err = serr
+	}
+	if serr := s.AppendLetters(rec.Seq...); serr != nil && err == nil {
+		err = serr
+	}
+	return s, err
+}
+
+// ReadRecord reads a single annotated record and returns it or an error. A
+// record that is not terminated by a "//" line is returned with the error
+// io.ErrUnexpectedEOF.
+func (r *Reader) ReadRecord() (*Record, error) {
+	var b builder
+	err := r.parseRecord(&b)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return b.rec, err
+}
+
+// builder is a Handler that builds a Record.
+type builder struct {
+	rec *Record
+}
+
+func (b *builder) Header(key, text string) error {
+	if key == "LOCUS" {
+		b.rec = &Record{Name: firstField(text)}
+		return nil
+	}
+	b.rec.addField(key, text)
+	return nil
+}
+
+func (b *builder) Feature(f Feature) error {
+	b.rec.Features = append(b.rec.Features, f)
+	return nil
+}
+
+func (b *builder) Sequence(_ int, l alphabet.Letters) error {
+	b.rec.Seq = append(b.rec.Seq, l...)
+	return nil
+}
+
+func (b *builder) End() error { return nil }
+
+// addField adds the text of a header line with the given keyword to rec.
+func (rec *Record) addField(key, text string) {
+	// The organism is given by an ORGANISM line
+	// indented within the SOURCE field.
+	if key == "SOURCE" && strings.HasPrefix(text, "ORGANISM") {
+		key, text = "ORGANISM", strings.TrimSpace(text[len("ORGANISM"):])
+	}
+	switch key {
+	case "DEFINITION":
+		rec.Definition = join(rec.Definition, text)
+	case "ACCESSION":
+		if rec.Accession == "" {
+			rec.Accession = firstField(text)
+		}
+	case "VERSION":
+		rec.Version = firstField(text)
+	case "ORGANISM":
+		rec.Organism = text
+	}
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+// appendLetters appends the letters of a sequence line to s, ignoring
+// spaces and position numbers.
+func appendLetters(s alphabet.Letters, line []byte) alphabet.Letters {
+	for _, b := range line {
+		if b == ' ' || b == '\t' || ('0' <= b && b <= '9') {
+			continue
+		}
+		s = append(s, alphabet.Letter(b))
+	}
+	return s
+}
+
+func join(a, b string) string {
+	if a == "" {
+		return b
+	}
+	return a + " " + b
+}
+
+func firstField(s string) string {
+	f := strings.Fields(strings.Replace(s, ";", " ", -1))
+	if len(f) == 0 {
+		return ""
+	}
+	return f[0]
+}
@@ -0,0 +1,189 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const record = `LOCUS       SCU49845                  40 bp    DNA     linear   PLN 21-JUN-1999
+DEFINITION  Saccharomyces cerevisiae TCP1-beta gene, partial cds; and Axl2p
+            (AXL2) gene, complete cds.
+ACCESSION   U49845
+VERSION     U49845.1  GI:1293613
+KEYWORDS    .
+SOURCE      Saccharomyces cerevisiae (baker's yeast)
+  ORGANISM  Saccharomyces cerevisiae
+            Eukaryota; Fungi; Ascomycota; Saccharomycotina; Saccharomycetes;
+            Saccharomycetales; Saccharomycetaceae; Saccharomyces.
+REFERENCE   1  (bases 1 to 40)
+  AUTHORS   Roemer,T., Madden,K., Chang,J. and Snyder,M.
+  TITLE     Selection of axial growth sites in yeast requires Axl2p
+FEATURES             Location/Qualifiers
+     source          1..40
+                     /organism="Saccharomyces cerevisiae"
+                     /db_xref="taxon:4932"
+                     /chromosome="IX"
+     CDS             join(<1..10,
+                     20..>40)
+                     /codon_start=3
+                     /note="a ""quoted"" note that is long enough to wrap
+                     onto a second line"
+                     /translation="SSIYNGISTSGLDLNNGTIADMRQLGIVESYKLKRAVVSSASEA
+                     AEVLLR"
+                     /pseudo
+BASE COUNT       10 a     10 c     10 g     10 t
+ORIGIN
+        1 gatcctccat atacaacggt atctccacct caggtttaga
+//
+LOCUS       SECOND                     4 bp    DNA     linear   PLN 21-JUN-1999
+DEFINITION  Second.
+ORIGIN
+        1 acgt
+//
+`
+
+func (s *S) TestReadRecord(c *check.C) {
+	r := NewReader(strings.NewReader(record), nil)
+	rec, err := r.ReadRecord()
+	c.Assert(err, check.Equals, nil)
+	c.Check(rec.Name, check.Equals, "SCU49845")
+	c.Check(rec.Accession, check.Equals, "U49845")
+	c.Check(rec.Version, check.Equals, "U49845.1")
+	c.Check(rec.Organism, check.Equals, "Saccharomyces cerevisiae")
+	c.Check(rec.Definition, check.Equals, "Saccharomyces cerevisiae TCP1-beta gene, partial cds; and Axl2p (AXL2) gene, complete cds.")
+	c.Check(string(rec.Seq), check.Equals, "gatcctccatatacaacggtatctccacctcaggtttaga")
+	c.Check(rec.Features, check.DeepEquals, []Feature{
+		{
+			Key:      "source",
+			Location: "1..40",
+			Qualifiers: []Qualifier{
+				{Name: "organism", Value: "Saccharomyces cerevisiae"},
+				{Name: "db_xref", Value: "taxon:4932"},
+				{Name: "chromosome", Value: "IX"},
+			},
+		},
+		{
+			Key:      "CDS",
+			Location: "join(<1..10,20..>40)",
+			Qualifiers: []Qualifier{
+				{Name: "codon_start", Value: "3"},
+				{Name: "note", Value: `a "quoted" note that is long enough to wrap onto a second line`},
+				{Name: "translation", Value: "SSIYNGISTSGLDLNNGTIADMRQLGIVESYKLKRAVVSSASEAAEVLLR"},
+				{Name: "pseudo"},
+			},
+		},
+	})
+	v, ok := rec.Features[1].Qualifier("codon_start")
+	c.Check(v, check.Equals, "3")
+	c.Check(ok, check.Equals, true)
+	loc, err := rec.Features[1].Parse(nil)
+	c.Assert(err, check.Equals, nil)
+	c.Check(loc.Len(), check.Equals, 40)
+
+	rec, err = r.ReadRecord()
+	c.Assert(err, check.Equals, nil)
+	c.Check(rec.Name, check.Equals, "SECOND")
+	c.Check(rec.Features, check.HasLen, 0)
+	_, err = r.ReadRecord()
+	c.Check(err, check.Equals, io.EOF)
+
+	_, err = NewReader(strings.NewReader(record[:200]), nil).ReadRecord()
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+	_, err = NewReader(strings.NewReader(">fasta\nACGT\n"), nil).ReadRecord()
+	c.Check(err, check.ErrorMatches, "genbank: record does not start with LOCUS line at line 1")
+}
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(strings.NewReader(record), linear.NewSeq("", nil, alphabet.DNA))
+	var got []string
+	for {
+		sq, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, sq.Name()+":"+sq.Description())
+		c.Check(sq.(feat.Feature).Len() > 0, check.Equals, true)
+	}
+	c.Check(got, check.DeepEquals, []string{
+		"SCU49845:Saccharomyces cerevisiae TCP1-beta gene, partial cds; and Axl2p (AXL2) gene, complete cds.",
+		"SECOND:Second.",
+	})
+}
+
+// events is a Handler that records the events it receives.
+type events []string
+
+func (e *events) Header(key, text string) error {
+	*e = append(*e, "header "+key+": "+text)
+	return nil
+}
+
+func (e *events) Feature(f Feature) error {
+	*e = append(*e, "feature "+f.Key+" "+f.Location)
+	return nil
+}
+
+func (e *events) Sequence(pos int, l alphabet.Letters) error {
+	*e = append(*e, fmt.Sprintf("sequence %d %s", pos, l))
+	return nil
+}
+
+func (e *events) End() error {
+	*e = append(*e, "end")
+	return nil
+}
+
+func (s *S) TestParse(c *check.C) {
+	var e events
+	err := NewReader(strings.NewReader(record), nil).Parse(&e)
+	c.Assert(err, check.Equals, nil)
+	c.Check([]string(e), check.DeepEquals, []string{
+		"header LOCUS: SCU49845                  40 bp    DNA     linear   PLN 21-JUN-1999",
+		"header DEFINITION: Saccharomyces cerevisiae TCP1-beta gene, partial cds; and Axl2p",
+		"header DEFINITION: (AXL2) gene, complete cds.",
+		"header ACCESSION: U49845",
+		"header VERSION: U49845.1  GI:1293613",
+		"header KEYWORDS: .",
+		"header SOURCE: Saccharomyces cerevisiae (baker's yeast)",
+		"header SOURCE: ORGANISM  Saccharomyces cerevisiae",
+		"header SOURCE: Eukaryota; Fungi; Ascomycota; Saccharomycotina; Saccharomycetes;",
+		"header SOURCE: Saccharomycetales; Saccharomycetaceae; Saccharomyces.",
+		"header REFERENCE: 1  (bases 1 to 40)",
+		"header REFERENCE: AUTHORS   Roemer,T., Madden,K., Chang,J. and Snyder,M.",
+		"header REFERENCE: TITLE     Selection of axial growth sites in yeast requires Axl2p",
+		"feature source 1..40",
+		"feature CDS join(<1..10,20..>40)",
+		"header BASE: COUNT       10 a     10 c     10 g     10 t",
+		"sequence 0 gatcctccatatacaacggtatctccacctcaggtttaga",
+		"end",
+		"header LOCUS: SECOND                     4 bp    DNA     linear   PLN 21-JUN-1999",
+		"header DEFINITION: Second.",
+		"sequence 0 acgt",
+		"end",
+	})
+
+	e = e[:0]
+	err = NewReader(strings.NewReader(record[:200]), nil).Parse(&e)
+	c.Check(err, check.Equals, io.ErrUnexpectedEOF)
+	c.Check(e[0], check.Equals, "header LOCUS: SCU49845                  40 bp    DNA     linear   PLN 21-JUN-1999")
+}
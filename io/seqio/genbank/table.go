@@ -0,0 +1,153 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/location"
+
+	"bytes"
+	"strings"
+)
+
+// A Qualifier is a feature qualifier. Value is the unquoted value of the
+// qualifier, and is empty for qualifiers without a value.
+type Qualifier struct {
+	Name  string
+	Value string
+}
+
+// A Feature is an entry in an INSDC feature table.
+type Feature struct {
+	// Key is the feature key, for example "CDS".
+	Key string
+
+	// Location is the location expression of
+	// the feature.
+	Location string
+
+	Qualifiers []Qualifier
+}
+
+// Qualifier returns the value of the first qualifier of f with the given name
+// and whether such a qualifier was found.
+func (f *Feature) Qualifier(name string) (string, bool) {
+	for _, q := range f.Qualifiers {
+		if q.Name == name {
+			return q.Value, true
+		}
+	}
+	return "", false
+}
+
+// Parse returns the parsed location of f on the sequence ref.
+func (f *Feature) Parse(ref feat.Feature) (*location.Location, error) {
+	return location.Parse(f.Location, ref)
+}
+
+const (
+	// keyColumn and qualifierColumn are the columns of
+	// feature keys and of qualifiers and locations in
+	// feature table lines.
+	keyColumn       = 5
+	qualifierColumn = 21
+)
+
+// A FeatureTable accumulates the features of an INSDC feature table from its
+// lines. The layout of the table is shared by the GenBank, EMBL and DDBJ flat
+// file formats; the first five columns of each line, the line code of EMBL
+// format, are ignored.
+type FeatureTable struct {
+	features []Feature
+
+	// quoted indicates the value of the last
+	// qualifier is a quoted string that has
+	// not been closed.
+	quoted bool
+}
+
+// AddLine adds a line of a feature table to t.
+func (t *FeatureTable) AddLine(line []byte) error {
+	line = bytes.TrimRight(line, " \t\r\n")
+	if len(line) <= keyColumn {
+		return nil
+	}
+	if line[keyColumn] != ' ' {
+		t.finishQualifier()
+		fields := strings.Fields(string(line[keyColumn:]))
+		f := Feature{Key: fields[0]}
+		if len(fields) > 1 {
+			f.Location = strings.Join(fields[1:], "")
+		}
+		t.features = append(t.features, f)
+		return nil
+	}
+	if len(t.features) == 0 {
+		return ErrBadFeature
+	}
+	f := &t.features[len(t.features)-1]
+	text := string(bytes.TrimSpace(line[keyColumn:]))
+	switch {
+	case strings.HasPrefix(text, "/") && !t.quoted:
+		t.finishQualifier()
+		q := Qualifier{Name: text[1:]}
+		if i := strings.IndexByte(text, '='); i >= 0 {
+			q.Name, q.Value = text[1:i], text[i+1:]
+			t.quoted = strings.HasPrefix(q.Value, `"`) && !closed(q.Value)
+		}
+		f.Qualifiers = append(f.Qualifiers, q)
+	case len(f.Qualifiers) == 0:
+		f.Location += text
+	default:
+		q := &f.Qualifiers[len(f.Qualifiers)-1]
+		if q.Name == "translation" {
+			q.Value += text
+		} else {
+			q.Value += " " + text
+		}
+		t.quoted = t.quoted && !closed(q.Value)
+	}
+	return nil
+}
+
+// closed returns whether the quoted string v ends with an unescaped closing
+// quote.
+func closed(v string) bool {
+	// Quotes within values are escaped by doubling,
+	// so a closed value has an even number of
+	// trailing quotes, counting the opening quote
+	// when the value is only quotes.
+	n := len(v) - len(strings.TrimRight(v, `"`))
+	if n == len(v) {
+		return n >= 2 && n%2 == 0
+	}
+	return n%2 == 1
+}
+
+// finishQualifier unquotes the value of the last qualifier of the table.
+func (t *FeatureTable) finishQualifier() {
+	t.quoted = false
+	if len(t.features) == 0 {
+		return
+	}
+	f := &t.features[len(t.features)-1]
+	if len(f.Qualifiers) == 0 {
+		return
+	}
+	q := &f.Qualifiers[len(f.Qualifiers)-1]
+	v := q.Value
+	if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+		q.Value = strings.Replace(v[1:len(v)-1], `""`, `"`, -1)
+	}
+}
+
+// Features returns the features added to t.
+func (t *FeatureTable) Features() []Feature {
+	t.finishQualifier()
+	return t.features
+}
+
+// Reset discards the features added to t.
+func (t *FeatureTable) Reset() { *t = FeatureTable{} }
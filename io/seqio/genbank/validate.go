@@ -0,0 +1,363 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/feat/location"
+
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Severity is the severity of a Discrepancy.
+type Severity int
+
+const (
+	// Warning discrepancies are likely to
+	// be questioned by database staff.
+	Warning Severity = iota
+
+	// Error discrepancies prevent the
+	// submission of a record.
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s Severity) MarshalText() ([]byte, error) { return []byte(s.String()), nil }
+
+// A Code identifies the submission rule broken by a Discrepancy.
+type Code string
+
+const (
+	// BadLocation: the feature location cannot be parsed.
+	BadLocation Code = "BAD_LOCATION"
+
+	// LocationOutOfRange: the feature location extends
+	// beyond the end of the sequence.
+	LocationOutOfRange Code = "LOCATION_OUT_OF_RANGE"
+
+	// MissingLocusTag: a gene has no locus_tag qualifier.
+	MissingLocusTag Code = "MISSING_LOCUS_TAG"
+
+	// BadLocusTag: a locus_tag is not a prefix of three to
+	// twelve alphanumeric characters starting with a letter,
+	// an underscore and an alphanumeric identifier, or does
+	// not have the registered prefix.
+	BadLocusTag Code = "BAD_LOCUS_TAG"
+
+	// DuplicateLocusTag: more than one gene has the same
+	// locus_tag.
+	DuplicateLocusTag Code = "DUPLICATE_LOCUS_TAG"
+
+	// CDSWithoutGene: a CDS is not contained in a gene on
+	// the same strand in a record that has genes.
+	CDSWithoutGene Code = "CDS_WITHOUT_GENE"
+
+	// GeneCDSMismatch: the locus_tag or gene qualifier of a
+	// CDS does not match that of any gene containing it.
+	GeneCDSMismatch Code = "GENE_CDS_MISMATCH"
+
+	// BadCodonStart: the codon_start qualifier of a CDS is
+	// not 1, 2 or 3, or is not 1 for a CDS with a complete
+	// 5' end.
+	BadCodonStart Code = "BAD_CODON_START"
+
+	// PartialCodon: the length of a CDS with a complete 3'
+	// end, from its codon_start, is not a multiple of three.
+	PartialCodon Code = "PARTIAL_CODON"
+
+	// ShortIntron: the gap between adjacent spans of a
+	// joined feature is shorter than the minimum intron.
+	ShortIntron Code = "SHORT_INTRON"
+
+	// IllegalCharacter: a qualifier value holds a character
+	// that is not printable ASCII.
+	IllegalCharacter Code = "ILLEGAL_CHARACTER"
+)
+
+// A Discrepancy is a violation of a submission rule by a record.
+type Discrepancy struct {
+	Code     Code     `json:"code"`
+	Severity Severity `json:"severity"`
+
+	// Feature is the index of the feature in the
+	// record's Features, and Key and Location its
+	// key and location.
+	Feature  int    `json:"feature"`
+	Key      string `json:"key"`
+	Location string `json:"location"`
+
+	Message string `json:"message"`
+}
+
+func (d Discrepancy) String() string {
+	return fmt.Sprintf("%s\t%s\t%d\t%s\t%s\t%s", d.Severity, d.Code, d.Feature, d.Key, d.Location, d.Message)
+}
+
+// DefaultMinIntron is the minimum intron length used by a Validator with a zero MinIntron.
+const DefaultMinIntron = 10
+
+// A Validator checks records against common GenBank and ENA submission rules.
+type Validator struct {
+	// RequireLocusTags specifies that every
+	// gene must have a locus_tag.
+	RequireLocusTags bool
+
+	// LocusTagPrefix is the registered locus
+	// tag prefix. If it is not empty, locus
+	// tags must start with the prefix and an
+	// underscore.
+	LocusTagPrefix string
+
+	// MinIntron is the minimum length of the
+	// gaps between the spans of joined CDS,
+	// mRNA and gene features. If MinIntron is
+	// zero, DefaultMinIntron is used.
+	MinIntron int
+}
+
+// Validate returns the discrepancies found in rec in order of feature.
+func (v Validator) Validate(rec *Record) []Discrepancy {
+	minIntron := v.MinIntron
+	if minIntron == 0 {
+		minIntron = DefaultMinIntron
+	}
+
+	var (
+		d    []Discrepancy
+		locs = make([]*location.Location, len(rec.Features))
+	)
+	report := func(i int, code Code, sev Severity, format string, args ...interface{}) {
+		f := rec.Features[i]
+		d = append(d, Discrepancy{
+			Code:     code,
+			Severity: sev,
+			Feature:  i,
+			Key:      f.Key,
+			Location: f.Location,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	// genes holds the indexes of gene features and
+	// tags the index of the first gene with each
+	// locus tag.
+	var genes []int
+	tags := make(map[string]int)
+	for i := range rec.Features {
+		f := &rec.Features[i]
+		l, err := f.Parse(nil)
+		if err != nil {
+			report(i, BadLocation, Error, "%v", err)
+		} else {
+			locs[i] = l
+			if len(rec.Seq) != 0 {
+				for _, s := range l.Spans {
+					if s.Accession == "" && s.End() > len(rec.Seq) {
+						report(i, LocationOutOfRange, Error, "location ends at %d beyond sequence length %d", s.End(), len(rec.Seq))
+						break
+					}
+				}
+			}
+		}
+
+		for _, q := range f.Qualifiers {
+			if p := illegal(q.Value); p >= 0 {
+				report(i, IllegalCharacter, Error, "qualifier /%s has illegal character %#02x at position %d", q.Name, q.Value[p], p+1)
+			}
+		}
+
+		if f.Key != "gene" {
+			continue
+		}
+		genes = append(genes, i)
+		tag, ok := f.Qualifier("locus_tag")
+		switch {
+		case !ok:
+			if v.RequireLocusTags {
+				report(i, MissingLocusTag, Error, "gene has no locus_tag")
+			}
+			continue
+		case !v.validLocusTag(tag):
+			report(i, BadLocusTag, Error, "locus_tag %q is not valid", tag)
+		}
+		if j, dup := tags[tag]; dup {
+			report(i, DuplicateLocusTag, Error, "locus_tag %q is also used by feature %d", tag, j)
+		} else {
+			tags[tag] = i
+		}
+	}
+
+	for i := range rec.Features {
+		f := &rec.Features[i]
+		l := locs[i]
+		if l == nil {
+			continue
+		}
+		switch f.Key {
+		case "CDS", "mRNA", "gene":
+			if !l.Order {
+				checkIntrons(l, minIntron, func(gap int) {
+					report(i, ShortIntron, Warning, "intron of length %d is shorter than %d", gap, minIntron)
+				})
+			}
+		}
+		if f.Key != "CDS" {
+			continue
+		}
+
+		if tag, ok := f.Qualifier("locus_tag"); ok && !v.validLocusTag(tag) {
+			report(i, BadLocusTag, Error, "locus_tag %q is not valid", tag)
+		}
+		if len(genes) != 0 {
+			v.checkGene(rec, locs, genes, i, report)
+		}
+
+		partial5, partial3 := partialEnds(l)
+		start := 1
+		if cs, ok := f.Qualifier("codon_start"); ok {
+			var err error
+			start, err = strconv.Atoi(cs)
+			if err != nil || start < 1 || 3 < start {
+				report(i, BadCodonStart, Error, "codon_start %q is not 1, 2 or 3", cs)
+				continue
+			}
+			if start != 1 && !partial5 {
+				report(i, BadCodonStart, Error, "codon_start %d on CDS with complete 5' end", start)
+			}
+		}
+		var n int
+		for _, s := range l.Spans {
+			n += s.Len()
+		}
+		if !partial3 && (n-(start-1))%3 != 0 {
+			report(i, PartialCodon, Error, "coding length %d from codon_start %d is not a multiple of three", n, start)
+		}
+	}
+
+	return d
+}
+
+// checkGene checks the CDS at index i against the genes of rec at the indexes in genes.
+func (v Validator) checkGene(rec *Record, locs []*location.Location, genes []int, i int, report func(int, Code, Severity, string, ...interface{})) {
+	f := &rec.Features[i]
+	l := locs[i]
+	var containing []int
+	for _, g := range genes {
+		gl := locs[g]
+		if gl == nil || gl.Start() > l.Start() || gl.End() < l.End() || orientation(gl) != orientation(l) {
+			continue
+		}
+		containing = append(containing, g)
+	}
+	if len(containing) == 0 {
+		report(i, CDSWithoutGene, Warning, "CDS is not contained in a gene")
+		return
+	}
+	for _, name := range []string{"locus_tag", "gene"} {
+		val, ok := f.Qualifier(name)
+		if !ok {
+			continue
+		}
+		var found bool
+		for _, g := range containing {
+			if gv, _ := rec.Features[g].Qualifier(name); gv == val {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report(i, GeneCDSMismatch, Error, "%s %q does not match a containing gene", name, val)
+		}
+	}
+}
+
+// validLocusTag returns whether tag is a valid locus tag.
+func (v Validator) validLocusTag(tag string) bool {
+	i := strings.IndexByte(tag, '_')
+	if i < 0 {
+		return false
+	}
+	prefix, id := tag[:i], tag[i+1:]
+	if v.LocusTagPrefix != "" && prefix != v.LocusTagPrefix {
+		return false
+	}
+	if len(prefix) < 3 || len(prefix) > 12 || !isLetter(prefix[0]) || len(id) == 0 {
+		return false
+	}
+	for _, s := range []string{prefix, id} {
+		for j := 0; j < len(s); j++ {
+			if !isLetter(s[j]) && !('0' <= s[j] && s[j] <= '9') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isLetter(b byte) bool { return ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') }
+
+// illegal returns the index of the first character of s that is not printable
+// ASCII, or -1 if there is none.
+func illegal(s string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] < ' ' || s[i] > '~' {
+			return i
+		}
+	}
+	return -1
+}
+
+// orientation returns the orientation of the first span of l.
+func orientation(l *location.Location) feat.Orientation {
+	return l.Spans[0].Orient
+}
+
+// partialEnds returns whether the biological 5' and 3' ends of l are partial.
+// The spans of l are in product order with complemented spans reversed.
+func partialEnds(l *location.Location) (partial5, partial3 bool) {
+	first, last := l.Spans[0], l.Spans[len(l.Spans)-1]
+	if first.Orient == feat.Reverse {
+		partial5 = first.Partial3
+	} else {
+		partial5 = first.Partial5
+	}
+	if last.Orient == feat.Reverse {
+		partial3 = last.Partial5
+	} else {
+		partial3 = last.Partial3
+	}
+	return partial5, partial3
+}
+
+// checkIntrons calls short with the length of each gap between adjacent local
+// spans of l that is shorter than min. Overlapping spans are not reported.
+func checkIntrons(l *location.Location, min int, short func(gap int)) {
+	for k := 1; k < len(l.Spans); k++ {
+		a, b := l.Spans[k-1], l.Spans[k]
+		if a.Accession != "" || b.Accession != "" || a.Orient != b.Orient {
+			continue
+		}
+		gap := b.Start() - a.End()
+		if a.Orient == feat.Reverse {
+			gap = a.Start() - b.End()
+		}
+		if 0 <= gap && gap < min {
+			short(gap)
+		}
+	}
+}
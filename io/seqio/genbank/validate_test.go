@@ -0,0 +1,99 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package genbank
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"encoding/json"
+	"strings"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestValidate(c *check.C) {
+	q := func(name, value string) Qualifier { return Qualifier{Name: name, Value: value} }
+	rec := &Record{
+		Name: "TEST",
+		Features: []Feature{
+			{Key: "source", Location: "1..300", Qualifiers: []Qualifier{q("organism", "Escherichia coli")}},
+
+			// A valid gene and CDS on the reverse strand.
+			{Key: "gene", Location: "complement(1..90)", Qualifiers: []Qualifier{q("locus_tag", "ECO_0001")}},
+			{Key: "CDS", Location: "complement(1..90)", Qualifiers: []Qualifier{q("locus_tag", "ECO_0001")}},
+
+			// A gene without a locus tag and a CDS with a
+			// short intron and a partial codon.
+			{Key: "gene", Location: "100..200", Qualifiers: []Qualifier{q("gene", "abcD")}},
+			{Key: "CDS", Location: "join(100..150,155..200)", Qualifiers: []Qualifier{q("gene", "abcD")}},
+
+			// A 5' partial CDS with a valid codon_start, and a
+			// complete CDS with an invalid codon_start and a
+			// locus tag that does not match its gene.
+			{Key: "gene", Location: "<210..262", Qualifiers: []Qualifier{q("locus_tag", "ECO_0002")}},
+			{Key: "CDS", Location: "<210..262", Qualifiers: []Qualifier{q("locus_tag", "ECO_0002"), q("codon_start", "3")}},
+			{Key: "CDS", Location: "211..262", Qualifiers: []Qualifier{q("locus_tag", "ECO_0003"), q("codon_start", "2")}},
+
+			// Duplicate and malformed locus tags, an illegal
+			// character and a bad location.
+			{Key: "gene", Location: "270..280", Qualifiers: []Qualifier{q("locus_tag", "ECO_0002")}},
+			{Key: "gene", Location: "270..280", Qualifiers: []Qualifier{q("locus_tag", "E_1"), q("note", "caf\xc3\xa9")}},
+			{Key: "misc_feature", Location: "290..310"},
+			{Key: "misc_feature", Location: "join(1..10"},
+		},
+		Seq: alphabet.Letters(strings.Repeat("a", 300)),
+	}
+
+	got := Validator{RequireLocusTags: true}.Validate(rec)
+	type result struct {
+		code    Code
+		feature int
+	}
+	var codes []result
+	for _, d := range got {
+		codes = append(codes, result{d.Code, d.Feature})
+	}
+	c.Check(codes, check.DeepEquals, []result{
+		{MissingLocusTag, 3},
+		{DuplicateLocusTag, 8},
+		{IllegalCharacter, 9},
+		{BadLocusTag, 9},
+		{LocationOutOfRange, 10},
+		{BadLocation, 11},
+		{ShortIntron, 4},
+		{PartialCodon, 4},
+		{GeneCDSMismatch, 7},
+		{BadCodonStart, 7},
+	})
+	c.Check(got[2].Message, check.Equals, `qualifier /note has illegal character 0xc3 at position 4`)
+	c.Check(got[6].Severity, check.Equals, Warning)
+	c.Check(got[6].Message, check.Equals, "intron of length 4 is shorter than 10")
+
+	b, err := json.Marshal(got[0])
+	c.Assert(err, check.Equals, nil)
+	c.Check(string(b), check.Equals, `{"code":"MISSING_LOCUS_TAG","severity":"error","feature":3,"key":"gene","location":"100..200","message":"gene has no locus_tag"}`)
+
+	// A registered prefix is enforced, gene and CDS
+	// qualifiers must match and CDSs must be within
+	// genes.
+	rec = &Record{
+		Features: []Feature{
+			{Key: "gene", Location: "1..90", Qualifiers: []Qualifier{q("locus_tag", "ECO_0001"), q("gene", "abcD")}},
+			{Key: "CDS", Location: "1..90", Qualifiers: []Qualifier{q("locus_tag", "ECO_0001"), q("gene", "abcE")}},
+			{Key: "CDS", Location: "complement(1..90)"},
+		},
+	}
+	got = Validator{LocusTagPrefix: "XYZ"}.Validate(rec)
+	codes = codes[:0]
+	for _, d := range got {
+		codes = append(codes, result{d.Code, d.Feature})
+	}
+	c.Check(codes, check.DeepEquals, []result{
+		{BadLocusTag, 0},
+		{BadLocusTag, 1},
+		{GeneCDSMismatch, 1},
+		{CDSWithoutGene, 2},
+	})
+}
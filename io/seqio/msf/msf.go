@@ -0,0 +1,244 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package msf provides a reader for GCG MSF format multiple sequence alignment
+// files.
+package msf
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	ErrNoSeparator  = errors.New("msf: missing // separator")
+	ErrBadNameLine  = errors.New("msf: bad Name line")
+	ErrUnknownName  = errors.New("msf: sequence name not in header")
+	ErrBadLength    = errors.New("msf: sequence length does not match header")
+	ErrBadChecksum  = errors.New("msf: checksum mismatch")
+	ErrNoSequences  = errors.New("msf: no sequences in header")
+	ErrDuplicateSeq = errors.New("msf: duplicate sequence name in header")
+)
+
+// Checksum returns the GCG checksum of b.
+func Checksum(b []byte) int {
+	var sum int
+	for i, c := range b {
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		sum += (i%57 + 1) * int(c)
+	}
+	return sum % 10000
+}
+
+// Reader is an MSF format reader.
+type Reader struct {
+	r    *bufio.Reader
+	t    seqio.SequenceAppender
+	line int
+
+	// IgnoreChecksums specifies that checksums
+	// are not verified.
+	IgnoreChecksums bool
+
+	// Type is the sequence type given in the header
+	// of the most recently read alignment, "N" for
+	// nucleotide or "P" for protein.
+	Type string
+
+	// Weights holds the sequence weights given in the
+	// header of the most recently read alignment.
+	Weights []float64
+}
+
+// NewReader returns a new MSF format reader using r. Sequences in the returned
+// alignments are copied from the provided template. Gap characters '.' and '~' are
+// converted to the gap letter of the template's alphabet.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{
+		r: bufio.NewReader(r),
+		t: template,
+	}
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+func (r *Reader) readLine() ([]byte, error) {
+	line, err := r.r.ReadBytes('\n')
+	if len(line) == 0 && err != nil {
+		return nil, err
+	}
+	r.line++
+	return bytes.TrimRight(line, "\r\n"), nil
+}
+
+type entry struct {
+	name   string
+	length int
+	check  int
+	weight float64
+	data   []byte
+}
+
+// Read reads a complete MSF alignment and returns it or an error.
+func (r *Reader) Read() (*multi.Multi, error) {
+	var (
+		entries []*entry
+		index   = make(map[string]*entry)
+		check   = -1
+	)
+	r.Type, r.Weights = "", nil
+
+	// Header.
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			if err == io.EOF {
+				if r.line == 0 {
+					return nil, io.EOF
+				}
+				err = ErrNoSeparator
+			}
+			return nil, fmt.Errorf("%v at line %d", err, r.line)
+		}
+		f := bytes.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		if string(f[0]) == "//" {
+			break
+		}
+		if string(f[0]) == "Name:" {
+			e, err := parseName(f)
+			if err != nil {
+				return nil, fmt.Errorf("%v at line %d", err, r.line)
+			}
+			if _, ok := index[e.name]; ok {
+				return nil, fmt.Errorf("%v at line %d", ErrDuplicateSeq, r.line)
+			}
+			index[e.name] = e
+			entries = append(entries, e)
+			continue
+		}
+		if bytes.Contains(line, []byte("MSF:")) {
+			for j := 0; j+1 < len(f); j++ {
+				switch string(f[j]) {
+				case "Type:":
+					r.Type = string(f[j+1])
+				case "Check:":
+					check, _ = strconv.Atoi(string(f[j+1]))
+				}
+			}
+		}
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%v at line %d", ErrNoSequences, r.line)
+	}
+
+	// Alignment blocks.
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		f := bytes.Fields(line)
+		if len(f) == 0 || isRuler(f) {
+			continue
+		}
+		e, ok := index[string(f[0])]
+		if !ok {
+			return nil, fmt.Errorf("%v at line %d", ErrUnknownName, r.line)
+		}
+		for _, d := range f[1:] {
+			e.data = append(e.data, d...)
+		}
+	}
+
+	var (
+		ss  = make([]seq.Sequence, len(entries))
+		sum int
+		gap = alphabet.Letter('-')
+	)
+	if a := r.t.Alphabet(); a != nil {
+		gap = a.Gap()
+	}
+	r.Weights = make([]float64, len(entries))
+	for i, e := range entries {
+		if len(e.data) != e.length {
+			return nil, fmt.Errorf("%v for %q", ErrBadLength, e.name)
+		}
+		if !r.IgnoreChecksums && Checksum(e.data) != e.check {
+			return nil, fmt.Errorf("%v for %q", ErrBadChecksum, e.name)
+		}
+		sum += e.check
+		r.Weights[i] = e.weight
+
+		s := r.t.Clone().(seqio.SequenceAppender)
+		if err := s.SetName(e.name); err != nil {
+			return nil, err
+		}
+		l := alphabet.BytesToLetters(e.data)
+		for j, c := range l {
+			if c == '.' || c == '~' {
+				l[j] = gap
+			}
+		}
+		s.AppendLetters(l...)
+		ss[i] = s
+	}
+	if !r.IgnoreChecksums && check >= 0 && sum%10000 != check {
+		return nil, ErrBadChecksum
+	}
+
+	return multi.NewMulti("", ss, seq.DefaultConsensus)
+}
+
+func parseName(f [][]byte) (*entry, error) {
+	if len(f) < 2 {
+		return nil, ErrBadNameLine
+	}
+	e := &entry{name: string(f[1]), length: -1, weight: 1}
+	for j := 2; j+1 < len(f); j += 2 {
+		var err error
+		switch string(f[j]) {
+		case "Len:":
+			e.length, err = strconv.Atoi(string(f[j+1]))
+		case "Check:":
+			e.check, err = strconv.Atoi(string(f[j+1]))
+		case "Weight:":
+			e.weight, err = strconv.ParseFloat(string(f[j+1]), 64)
+		}
+		if err != nil {
+			return nil, ErrBadNameLine
+		}
+	}
+	if e.length < 0 {
+		return nil, ErrBadNameLine
+	}
+	return e, nil
+}
+
+// isRuler returns whether the fields f form a column number ruler line.
+func isRuler(f [][]byte) bool {
+	for _, w := range f {
+		if _, err := strconv.Atoi(string(w)); err != nil {
+			return false
+		}
+	}
+	return true
+}
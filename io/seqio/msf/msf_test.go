@@ -0,0 +1,84 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package msf
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const msf = `!!NA_MULTIPLE_ALIGNMENT 1.0
+
+ test.msf  MSF: 42  Type: N  October 16, 2026 12:00  Check: 7045  ..
+
+ Name: Turkey      Len:    42  Check:  4450  Weight:  1.00
+ Name: Salmo_gair  Len:    42  Check:   595  Weight:  0.50
+ Name: H.Sapiens   Len:    42  Check:  2000  Weight:  0.25
+
+//
+
+            1                                     40
+Turkey      AAGCTNGGGC ATTTCAGGGT GAGCCCGGGC AATACAGGGT
+Salmo_gair  AAGCCTTGGC AGTGCAGGGT GAGCCGTGGC CGGGCACGG.
+H.Sapiens   ACCGGTTGGC CGTTCAGGGT ACAGGTTGGC CGTTCAGGG~
+
+            41 42
+Turkey      AT
+Salmo_gair  ..
+H.Sapiens   ~~
+`
+
+func (s *S) TestRead(c *check.C) {
+	r := NewReader(strings.NewReader(msf), linear.NewSeq("", nil, alphabet.DNAgapped))
+	m, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Type, check.Equals, "N")
+	c.Check(r.Weights, check.DeepEquals, []float64{1, 0.5, 0.25})
+	c.Assert(m.Rows(), check.Equals, 3)
+	for i, e := range []struct{ name, seq string }{
+		{"Turkey", "AAGCTNGGGCATTTCAGGGTGAGCCCGGGCAATACAGGGTAT"},
+		{"Salmo_gair", "AAGCCTTGGCAGTGCAGGGTGAGCCGTGGCCGGGCACGG---"},
+		{"H.Sapiens", "ACCGGTTGGCCGTTCAGGGTACAGGTTGGCCGTTCAGGG---"},
+	} {
+		row := m.Row(i).(*linear.Seq)
+		c.Check(row.Name(), check.Equals, e.name)
+		c.Check(string(row.Seq), check.Equals, e.seq)
+	}
+}
+
+func (s *S) TestErrors(c *check.C) {
+	bad := strings.Replace(msf, "Check:   595", "Check:   596", 1)
+	_, err := NewReader(strings.NewReader(bad), linear.NewSeq("", nil, alphabet.DNAgapped)).Read()
+	c.Check(err, check.ErrorMatches, `msf: checksum mismatch for "Salmo_gair"`)
+
+	r := NewReader(strings.NewReader(bad), linear.NewSeq("", nil, alphabet.DNAgapped))
+	r.IgnoreChecksums = true
+	_, err = r.Read()
+	c.Check(err, check.Equals, nil)
+
+	_, err = NewReader(bytes.NewBufferString(strings.Replace(msf, "Turkey      AT", "Turkey      A", 1)), linear.NewSeq("", nil, alphabet.DNAgapped)).Read()
+	c.Check(err, check.ErrorMatches, `msf: sequence length does not match header for "Turkey"`)
+
+	_, err = NewReader(strings.NewReader(strings.Replace(msf, "//", "", 1)), linear.NewSeq("", nil, alphabet.DNAgapped)).Read()
+	c.Check(err, check.ErrorMatches, `msf: missing // separator at line .*`)
+}
+
+func (s *S) TestChecksum(c *check.C) {
+	c.Check(Checksum([]byte("AAGCTNGGGCATTTCAGGGTGAGCCCGGGCAATACAGGGTAT")), check.Equals, 4450)
+	c.Check(Checksum([]byte("aagctngggcatttcagggtgagcccgggcaatacagggtat")), check.Equals, 4450)
+}
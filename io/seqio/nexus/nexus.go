@@ -0,0 +1,570 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package nexus provides types to read and write NEXUS format files.
+//
+// The TAXA, DATA, CHARACTERS and TREES blocks are handled. Character matrices
+// are read into a multi.Multi and trees are held as Newick strings. Other
+// blocks are skipped when reading.
+package nexus
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrNotNexus       = errors.New("nexus: missing #NEXUS header")
+	ErrUnterminated   = errors.New("nexus: unterminated comment or quoted string")
+	ErrMissingEnd     = errors.New("nexus: missing END command")
+	ErrBadDimensions  = errors.New("nexus: bad DIMENSIONS command")
+	ErrBadMatrix      = errors.New("nexus: matrix does not match dimensions")
+	ErrBadTree        = errors.New("nexus: bad TREE command")
+	ErrBadTranslate   = errors.New("nexus: bad TRANSLATE command")
+	ErrNoDimensions   = errors.New("nexus: MATRIX before DIMENSIONS")
+	ErrRagged         = errors.New("nexus: alignment rows differ in length")
+	ErrNotHandled     = errors.New("nexus: ambiguity sets not handled")
+	ErrEmptyAlignment = errors.New("nexus: empty alignment")
+)
+
+// Nexus holds the data read from or to be written to a NEXUS file.
+type Nexus struct {
+	// Taxa holds the taxon labels from a TAXA block.
+	Taxa []string
+
+	// Alignment holds the character matrix from a
+	// DATA or CHARACTERS block.
+	Alignment *multi.Multi
+
+	// Translate holds the TRANSLATE table of a TREES
+	// block mapping tokens used in trees to taxon labels.
+	Translate map[string]string
+
+	// Trees holds the trees of a TREES block.
+	Trees []Tree
+}
+
+// A Tree is a named tree from a TREES block.
+type Tree struct {
+	Name string
+
+	// Rooted indicates whether the tree was marked
+	// as rooted with the [&R] comment.
+	Rooted bool
+
+	// Newick is the Newick representation of the tree
+	// including the terminating semicolon. Labels are
+	// as given in the file and are not translated.
+	Newick string
+}
+
+// Reader is a NEXUS format reader.
+type Reader struct {
+	r io.Reader
+	t seqio.SequenceAppender
+}
+
+// NewReader returns a new NEXUS format reader using r. Sequences in the returned
+// alignment are copied from the provided template.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{r: r, t: template}
+}
+
+// command is a single semicolon terminated NEXUS command.
+type command struct {
+	text string
+	line int
+}
+
+// commands splits data into commands, removing comments other than those
+// beginning with '&'.
+func commands(data []byte) ([]command, error) {
+	var (
+		cmds  []command
+		buf   bytes.Buffer
+		line  = 1
+		start = 0
+	)
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch c {
+		case '\n':
+			line++
+		case '[':
+			depth, keep := 1, i+1 < len(data) && data[i+1] == '&'
+			j := i + 1
+			for ; j < len(data) && depth > 0; j++ {
+				switch data[j] {
+				case '[':
+					depth++
+				case ']':
+					depth--
+				case '\n':
+					line++
+				}
+			}
+			if depth != 0 {
+				return nil, fmt.Errorf("%v at line %d", ErrUnterminated, line)
+			}
+			if keep {
+				buf.Write(data[i:j])
+			}
+			i = j - 1
+			continue
+		case '\'':
+			j := i + 1
+			for ; j < len(data); j++ {
+				if data[j] == '\'' {
+					if j+1 < len(data) && data[j+1] == '\'' {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j == len(data) {
+				return nil, fmt.Errorf("%v at line %d", ErrUnterminated, line)
+			}
+			line += bytes.Count(data[i:j], []byte{'\n'})
+			buf.Write(data[i : j+1])
+			i = j
+			continue
+		case ';':
+			cmds = append(cmds, command{text: buf.String(), line: start})
+			buf.Reset()
+			start = 0
+			continue
+		}
+		if start == 0 && !unicode.IsSpace(rune(c)) {
+			start = line
+		}
+		buf.WriteByte(c)
+	}
+	if strings.TrimSpace(buf.String()) != "" {
+		cmds = append(cmds, command{text: buf.String(), line: start})
+	}
+	return cmds, nil
+}
+
+// words splits s into white space separated words, treating '=' and ',' as
+// separate words and handling quoted words.
+func words(s string) []string {
+	var (
+		w   []string
+		buf bytes.Buffer
+		in  bool
+	)
+	flush := func() {
+		if in {
+			w = append(w, buf.String())
+			buf.Reset()
+			in = false
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\'':
+			in = true
+			for i++; i < len(s); i++ {
+				if s[i] == '\'' {
+					if i+1 < len(s) && s[i+1] == '\'' {
+						i++
+					} else {
+						break
+					}
+				}
+				buf.WriteByte(s[i])
+			}
+		case c == '=' || c == ',':
+			flush()
+			w = append(w, string(c))
+		case unicode.IsSpace(rune(c)):
+			flush()
+		default:
+			in = true
+			buf.WriteByte(c)
+		}
+	}
+	flush()
+	return w
+}
+
+// Read reads a complete NEXUS file and returns its contents or an error.
+func (r *Reader) Read() (*Nexus, error) {
+	data, err := ioutil.ReadAll(r.r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimLeftFunc(data, unicode.IsSpace)
+	if len(data) < 6 || !strings.EqualFold(string(data[:6]), "#NEXUS") {
+		return nil, ErrNotNexus
+	}
+	cmds, err := commands(data[6:])
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Nexus{}
+	for i := 0; i < len(cmds); i++ {
+		w := words(cmds[i].text)
+		if len(w) < 2 || !strings.EqualFold(w[0], "begin") {
+			continue
+		}
+		block := strings.ToUpper(w[1])
+		j := i + 1
+		for ; j < len(cmds); j++ {
+			e := strings.ToUpper(strings.TrimSpace(cmds[j].text))
+			if e == "END" || e == "ENDBLOCK" {
+				break
+			}
+		}
+		if j == len(cmds) {
+			return nil, fmt.Errorf("%v at line %d", ErrMissingEnd, cmds[i].line)
+		}
+		body := cmds[i+1 : j]
+		switch block {
+		case "TAXA":
+			err = n.readTaxa(body)
+		case "DATA", "CHARACTERS":
+			err = n.readCharacters(body, r.t)
+		case "TREES":
+			err = n.readTrees(body)
+		}
+		if err != nil {
+			return nil, err
+		}
+		i = j
+	}
+	return n, nil
+}
+
+func (n *Nexus) readTaxa(body []command) error {
+	for _, c := range body {
+		w := words(c.text)
+		if len(w) != 0 && strings.EqualFold(w[0], "taxlabels") {
+			n.Taxa = append(n.Taxa, w[1:]...)
+		}
+	}
+	return nil
+}
+
+// options returns the KEY=value options of a command as an upper case keyed map.
+// Options without a value are given the value "".
+func options(w []string) map[string]string {
+	o := make(map[string]string)
+	for i := 0; i < len(w); i++ {
+		k := strings.ToUpper(w[i])
+		if i+2 < len(w) && w[i+1] == "=" {
+			o[k] = w[i+2]
+			i += 2
+		} else {
+			o[k] = ""
+		}
+	}
+	return o
+}
+
+func (n *Nexus) readCharacters(body []command, t seqio.SequenceAppender) error {
+	var (
+		ntax, nchar = len(n.Taxa), -1
+		interleave  bool
+		match       byte
+	)
+	for _, c := range body {
+		text := strings.TrimLeftFunc(c.text, unicode.IsSpace)
+		w := words(text)
+		if len(w) == 0 {
+			continue
+		}
+		switch strings.ToUpper(w[0]) {
+		case "DIMENSIONS":
+			o := options(w[1:])
+			var err error
+			if v, ok := o["NTAX"]; ok {
+				if ntax, err = strconv.Atoi(v); err != nil {
+					return fmt.Errorf("%v at line %d", ErrBadDimensions, c.line)
+				}
+			}
+			if nchar, err = strconv.Atoi(o["NCHAR"]); err != nil {
+				return fmt.Errorf("%v at line %d", ErrBadDimensions, c.line)
+			}
+		case "FORMAT":
+			o := options(w[1:])
+			if v, ok := o["INTERLEAVE"]; ok {
+				interleave = v == "" || strings.EqualFold(v, "yes")
+			}
+			if v := o["MATCHCHAR"]; len(v) == 1 {
+				match = v[0]
+			}
+		case "MATRIX":
+			if ntax < 1 || nchar < 0 {
+				return fmt.Errorf("%v at line %d", ErrNoDimensions, c.line)
+			}
+			m, err := matrix(text[len(w[0]):], ntax, nchar, interleave, match, t)
+			if err != nil {
+				return fmt.Errorf("%v at line %d", err, c.line)
+			}
+			n.Alignment = m
+		}
+	}
+	return nil
+}
+
+func matrix(text string, ntax, nchar int, interleave bool, match byte, t seqio.SequenceAppender) (*multi.Multi, error) {
+	var (
+		names []string
+		data  = make(map[string][]byte)
+	)
+	add := func(name string, w []string) error {
+		if _, ok := data[name]; !ok {
+			if len(names) == ntax {
+				return ErrBadMatrix
+			}
+			names = append(names, name)
+			data[name] = nil
+		}
+		for _, s := range w {
+			if strings.ContainsAny(s, "{}()") {
+				return ErrNotHandled
+			}
+			data[name] = append(data[name], s...)
+		}
+		return nil
+	}
+	if interleave {
+		for _, l := range strings.Split(text, "\n") {
+			w := words(l)
+			if len(w) == 0 {
+				continue
+			}
+			if err := add(w[0], w[1:]); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		w := words(text)
+		for i := 0; i < len(w); {
+			name := w[i]
+			if err := add(name, nil); err != nil {
+				return nil, err
+			}
+			for i++; i < len(w) && len(data[name]) < nchar; i++ {
+				if err := add(name, w[i:i+1]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if len(names) != ntax {
+		return nil, ErrBadMatrix
+	}
+
+	ss := make([]seq.Sequence, ntax)
+	for i, name := range names {
+		d := data[name]
+		if len(d) != nchar {
+			return nil, ErrBadMatrix
+		}
+		if match != 0 && i != 0 {
+			for j, c := range d {
+				if c == match {
+					d[j] = data[names[0]][j]
+				}
+			}
+		}
+		s := t.Clone().(seqio.SequenceAppender)
+		if err := s.SetName(name); err != nil {
+			return nil, err
+		}
+		s.AppendLetters(alphabet.BytesToLetters(d)...)
+		ss[i] = s
+	}
+	return multi.NewMulti("", ss, seq.DefaultConsensus)
+}
+
+func (n *Nexus) readTrees(body []command) error {
+	for _, c := range body {
+		text := strings.TrimSpace(c.text)
+		w := words(text)
+		if len(w) == 0 {
+			continue
+		}
+		switch strings.ToUpper(w[0]) {
+		case "TRANSLATE":
+			if n.Translate == nil {
+				n.Translate = make(map[string]string)
+			}
+			w = w[1:]
+			for i := 0; i < len(w); i += 3 {
+				if i+1 >= len(w) || (i+2 < len(w) && w[i+2] != ",") {
+					return fmt.Errorf("%v at line %d", ErrBadTranslate, c.line)
+				}
+				n.Translate[w[i]] = w[i+1]
+			}
+		case "TREE", "UTREE":
+			eq := strings.Index(text, "=")
+			if eq < 0 || len(w) < 3 || w[2] != "=" {
+				return fmt.Errorf("%v at line %d", ErrBadTree, c.line)
+			}
+			tr := Tree{Name: w[1]}
+			nwk := strings.TrimSpace(text[eq+1:])
+			for strings.HasPrefix(nwk, "[&") {
+				end := strings.Index(nwk, "]")
+				if end < 0 {
+					return fmt.Errorf("%v at line %d", ErrBadTree, c.line)
+				}
+				switch strings.ToUpper(nwk[:end+1]) {
+				case "[&R]":
+					tr.Rooted = true
+				case "[&U]":
+					tr.Rooted = false
+				}
+				nwk = strings.TrimSpace(nwk[end+1:])
+			}
+			tr.Newick = nwk + ";"
+			n.Trees = append(n.Trees, tr)
+		}
+	}
+	return nil
+}
+
+// Writer is a NEXUS format writer.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a new NEXUS format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// quote returns s quoted if it contains characters not allowed in an unquoted
+// NEXUS word.
+func quote(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\n()[]{}/\\,;:=*'\"`+<>-") {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+func datatype(a alphabet.Alphabet) string {
+	if a == nil {
+		return "STANDARD"
+	}
+	switch a.Moltype() {
+	case feat.DNA:
+		return "DNA"
+	case feat.RNA:
+		return "RNA"
+	case feat.Protein:
+		return "PROTEIN"
+	}
+	return "STANDARD"
+}
+
+// Write writes n as a NEXUS file, returning the number of bytes written and any
+// error. A DATA block is written if n holds an alignment and a TREES block is
+// written if n holds trees. The rows of the alignment must all span the same
+// columns.
+func (w *Writer) Write(n *Nexus) (int, error) {
+	var b bytes.Buffer
+	b.WriteString("#NEXUS\n")
+
+	if m := n.Alignment; m != nil {
+		if m.Rows() == 0 {
+			return 0, ErrEmptyAlignment
+		}
+		start, end := m.Start(), m.End()
+		var width int
+		for _, s := range m.Seq {
+			if s.Start() != start || s.End() != end {
+				return 0, ErrRagged
+			}
+			if l := len(quote(s.Name())); l > width {
+				width = l
+			}
+		}
+		gap := byte('-')
+		if a := m.Alphabet(); a != nil {
+			gap = byte(a.Gap())
+		}
+		fmt.Fprintf(&b, "\nBEGIN DATA;\n\tDIMENSIONS NTAX=%d NCHAR=%d;\n", m.Rows(), end-start)
+		fmt.Fprintf(&b, "\tFORMAT DATATYPE=%s MISSING=? GAP=%c;\n\tMATRIX\n", datatype(m.Alphabet()), gap)
+		for _, s := range m.Seq {
+			fmt.Fprintf(&b, "\t%-*s ", width, quote(s.Name()))
+			for i := start; i < end; i++ {
+				b.WriteByte(byte(s.At(i).L))
+			}
+			b.WriteByte('\n')
+		}
+		b.WriteString("\t;\nEND;\n")
+	}
+
+	if len(n.Trees) != 0 {
+		b.WriteString("\nBEGIN TREES;\n")
+		if len(n.Translate) != 0 {
+			keys := make([]string, 0, len(n.Translate))
+			for k := range n.Translate {
+				keys = append(keys, k)
+			}
+			sort.Sort(tokens(keys))
+			b.WriteString("\tTRANSLATE\n")
+			for i, k := range keys {
+				sep := ","
+				if i == len(keys)-1 {
+					sep = ";"
+				}
+				fmt.Fprintf(&b, "\t\t%s %s%s\n", quote(k), quote(n.Translate[k]), sep)
+			}
+		}
+		for _, t := range n.Trees {
+			root := "[&U]"
+			if t.Rooted {
+				root = "[&R]"
+			}
+			nwk := t.Newick
+			if !strings.HasSuffix(nwk, ";") {
+				nwk += ";"
+			}
+			fmt.Fprintf(&b, "\tTREE %s = %s %s\n", quote(t.Name), root, nwk)
+		}
+		b.WriteString("END;\n")
+	}
+
+	return w.w.Write(b.Bytes())
+}
+
+// tokens sorts translation tokens numerically where possible and lexically
+// otherwise.
+type tokens []string
+
+func (t tokens) Len() int { return len(t) }
+func (t tokens) Less(i, j int) bool {
+	x, errX := strconv.Atoi(t[i])
+	y, errY := strconv.Atoi(t[j])
+	switch {
+	case errX == nil && errY == nil:
+		return x < y
+	case errX == nil:
+		return true
+	case errY == nil:
+		return false
+	}
+	return t[i] < t[j]
+}
+func (t tokens) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
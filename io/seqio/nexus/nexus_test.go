@@ -0,0 +1,136 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nexus
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const primates = `#NEXUS
+[ A comment; with a semicolon. ]
+BEGIN TAXA;
+	DIMENSIONS NTAX=3;
+	TAXLABELS Homo Pan 'Gorilla gorilla';
+END;
+
+BEGIN CHARACTERS;
+	DIMENSIONS NCHAR=12;
+	FORMAT DATATYPE=DNA MISSING=? GAP=- MATCHCHAR=. INTERLEAVE;
+	MATRIX
+	Homo              ACGTAC
+	Pan               ...T..
+	'Gorilla gorilla' AC-TAC
+
+	Homo              GGTTAA
+	Pan               ......
+	'Gorilla gorilla' GGTTCA
+	;
+END;
+
+BEGIN ASSUMPTIONS;
+	OPTIONS DEFTYPE=unord;
+END;
+
+BEGIN TREES;
+	TRANSLATE
+		1 Homo,
+		2 Pan,
+		3 'Gorilla gorilla';
+	TREE one = [&R] ((1:0.1,2:0.2):0.05,3:0.3);
+	TREE two = (1,(2,3));
+END;
+`
+
+func (s *S) TestRead(c *check.C) {
+	n, err := NewReader(bytes.NewBufferString(primates), linear.NewSeq("", nil, alphabet.DNAgapped)).Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(n.Taxa, check.DeepEquals, []string{"Homo", "Pan", "Gorilla gorilla"})
+	c.Assert(n.Alignment, check.NotNil)
+	c.Assert(n.Alignment.Rows(), check.Equals, 3)
+	for i, e := range []struct{ name, seq string }{
+		{"Homo", "ACGTACGGTTAA"},
+		{"Pan", "ACGTACGGTTAA"},
+		{"Gorilla gorilla", "AC-TACGGTTCA"},
+	} {
+		r := n.Alignment.Row(i).(*linear.Seq)
+		c.Check(r.Name(), check.Equals, e.name)
+		c.Check(string(r.Seq), check.Equals, e.seq)
+	}
+	c.Check(n.Translate, check.DeepEquals, map[string]string{"1": "Homo", "2": "Pan", "3": "Gorilla gorilla"})
+	c.Check(n.Trees, check.DeepEquals, []Tree{
+		{Name: "one", Rooted: true, Newick: "((1:0.1,2:0.2):0.05,3:0.3);"},
+		{Name: "two", Rooted: false, Newick: "(1,(2,3));"},
+	})
+}
+
+func (s *S) TestSequential(c *check.C) {
+	const in = `#nexus
+begin data;
+	dimensions ntax=2 nchar=8;
+	format datatype=dna;
+	matrix
+	a ACGT
+	  ACGT
+	b TTTT TTTT
+	;
+end;
+`
+	n, err := NewReader(bytes.NewBufferString(in), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(string(n.Alignment.Row(0).(*linear.Seq).Seq), check.Equals, "ACGTACGT")
+	c.Check(string(n.Alignment.Row(1).(*linear.Seq).Seq), check.Equals, "TTTTTTTT")
+
+	_, err = NewReader(bytes.NewBufferString("#NEXUS\nbegin data;\ndimensions ntax=2 nchar=8;\nmatrix\na ACGT\n;\nend;\n"), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.ErrorMatches, "nexus: matrix does not match dimensions at line 4")
+}
+
+func (s *S) TestRoundTrip(c *check.C) {
+	n, err := NewReader(bytes.NewBufferString(primates), linear.NewSeq("", nil, alphabet.DNAgapped)).Read()
+	c.Assert(err, check.Equals, nil)
+	n.Taxa = nil
+
+	var b bytes.Buffer
+	_, err = NewWriter(&b).Write(n)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b.String(), check.Equals, `#NEXUS
+
+BEGIN DATA;
+	DIMENSIONS NTAX=3 NCHAR=12;
+	FORMAT DATATYPE=DNA MISSING=? GAP=-;
+	MATRIX
+	Homo              ACGTACGGTTAA
+	Pan               ACGTACGGTTAA
+	'Gorilla gorilla' AC-TACGGTTCA
+	;
+END;
+
+BEGIN TREES;
+	TRANSLATE
+		1 Homo,
+		2 Pan,
+		3 'Gorilla gorilla';
+	TREE one = [&R] ((1:0.1,2:0.2):0.05,3:0.3);
+	TREE two = [&U] (1,(2,3));
+END;
+`)
+
+	got, err := NewReader(&b, linear.NewSeq("", nil, alphabet.DNAgapped)).Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(got.Trees, check.DeepEquals, n.Trees)
+	c.Check(got.Translate, check.DeepEquals, n.Translate)
+}
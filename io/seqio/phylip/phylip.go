@@ -0,0 +1,378 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package phylip provides types to read and write PHYLIP format multiple
+// sequence alignment files.
+//
+// Both the strict format, where sequence names occupy a fixed field of ten
+// characters, and the relaxed format, where names are separated from sequence
+// data by white space, are supported. Alignments may be laid out sequentially
+// or interleaved; the layout is detected automatically when reading.
+package phylip
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrBadHeader   = errors.New("phylip: bad header line")
+	ErrBadLine     = errors.New("phylip: badly formed line")
+	ErrBadLayout   = errors.New("phylip: sequence data does not match header")
+	ErrNameTooLong = errors.New("phylip: name too long for strict format")
+	ErrRagged      = errors.New("phylip: alignment rows differ in length")
+	ErrEmpty       = errors.New("phylip: empty alignment")
+)
+
+// NameWidth is the width of the name field in strict PHYLIP format.
+const NameWidth = 10
+
+// Reader is a PHYLIP format reader.
+type Reader struct {
+	r       *bufio.Reader
+	t       seqio.SequenceAppender
+	line    int
+	pending []byte
+
+	// Strict specifies that names are read from
+	// the fixed width field of the strict format.
+	Strict bool
+
+	// Interleaved reports whether the most recently
+	// read alignment was in interleaved layout.
+	Interleaved bool
+}
+
+// NewReader returns a new relaxed PHYLIP format reader using r. Sequences in the
+// returned alignments are copied from the provided template.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{
+		r: bufio.NewReader(r),
+		t: template,
+	}
+}
+
+// Line returns the current line number.
+func (r *Reader) Line() int { return r.line }
+
+// Read reads a single alignment and returns it or an error. Consecutive alignments
+// in a single stream, as produced by bootstrap tools, may be read by successive
+// calls to Read.
+func (r *Reader) Read() (*multi.Multi, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	ntax, nchar, ok := header(line)
+	if !ok || ntax < 1 {
+		return nil, fmt.Errorf("%v at line %d", ErrBadHeader, r.line)
+	}
+
+	// Collect the lines of the alignment, stopping at
+	// EOF or the header of a following alignment.
+	var (
+		lines [][]byte
+		first = r.line + 1
+	)
+	for {
+		line, err = r.readLine()
+		if err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		if _, _, ok := header(line); ok {
+			r.pending = line
+			break
+		}
+		lines = append(lines, line)
+	}
+	last := r.line
+	if r.pending != nil {
+		last--
+	}
+
+	// Every taxon needs at least one line, so check the
+	// header's taxon count before it is used to allocate.
+	if ntax > len(lines) {
+		return nil, fmt.Errorf("%v at lines %d-%d", ErrBadLayout, first, last)
+	}
+
+	names, data, err := r.interleaved(lines, ntax, nchar)
+	// A single block is reported as sequential.
+	r.Interleaved = err == nil && len(lines) > ntax
+	if err != nil {
+		names, data, err = r.sequential(lines, ntax, nchar)
+		if err != nil {
+			return nil, fmt.Errorf("%v at lines %d-%d", err, first, last)
+		}
+	}
+
+	ss := make([]seq.Sequence, ntax)
+	for i := range ss {
+		s := r.t.Clone().(seqio.SequenceAppender)
+		if err = s.SetName(names[i]); err != nil {
+			return nil, err
+		}
+		s.AppendLetters(alphabet.BytesToLetters(data[i])...)
+		ss[i] = s
+	}
+	return multi.NewMulti("", ss, seq.DefaultConsensus)
+}
+
+// readLine returns the next non-blank line.
+func (r *Reader) readLine() ([]byte, error) {
+	if r.pending != nil {
+		line := r.pending
+		r.pending = nil
+		return line, nil
+	}
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		r.line++
+		line = bytes.TrimRight(line, "\r\n")
+		if len(bytes.TrimSpace(line)) != 0 {
+			return line, nil
+		}
+	}
+}
+
+// header returns the number of taxa and characters held in a header line
+// and whether the line is a valid header.
+func header(line []byte) (ntax, nchar int, ok bool) {
+	f := bytes.Fields(line)
+	if len(f) != 2 {
+		return 0, 0, false
+	}
+	ntax, err := strconv.Atoi(string(f[0]))
+	if err != nil || ntax < 0 {
+		return 0, 0, false
+	}
+	nchar, err = strconv.Atoi(string(f[1]))
+	if err != nil || nchar < 0 {
+		return 0, 0, false
+	}
+	return ntax, nchar, true
+}
+
+// split returns the name and sequence data held in a named line.
+func (r *Reader) split(line []byte) (name string, data []byte, err error) {
+	if r.Strict {
+		if len(line) < NameWidth {
+			return "", nil, ErrBadLine
+		}
+		return string(bytes.TrimSpace(line[:NameWidth])), residues(line[NameWidth:]), nil
+	}
+	line = bytes.TrimLeftFunc(line, unicode.IsSpace)
+	i := bytes.IndexFunc(line, unicode.IsSpace)
+	if i < 0 {
+		return "", nil, ErrBadLine
+	}
+	return string(line[:i]), residues(line[i:]), nil
+}
+
+// residues returns the sequence letters in b, removing white space and digits.
+func residues(b []byte) []byte {
+	r := make([]byte, 0, len(b))
+	for _, c := range b {
+		if c <= ' ' || ('0' <= c && c <= '9') {
+			continue
+		}
+		r = append(r, c)
+	}
+	return r
+}
+
+// interleaved parses lines as an interleaved alignment.
+func (r *Reader) interleaved(lines [][]byte, ntax, nchar int) ([]string, [][]byte, error) {
+	if len(lines)%ntax != 0 {
+		return nil, nil, ErrBadLayout
+	}
+	names := make([]string, ntax)
+	data := make([][]byte, ntax)
+	for i, l := range lines {
+		var (
+			t = i % ntax
+			d []byte
+		)
+		if i < ntax {
+			var err error
+			names[t], d, err = r.split(l)
+			if err != nil {
+				return nil, nil, err
+			}
+		} else {
+			d = residues(l)
+		}
+		data[t] = append(data[t], d...)
+		// All lines of a block hold the same number of residues.
+		if t != 0 && len(data[t]) != len(data[t-1]) {
+			return nil, nil, ErrBadLayout
+		}
+	}
+	for _, d := range data {
+		if len(d) != nchar {
+			return nil, nil, ErrBadLayout
+		}
+	}
+	return names, data, nil
+}
+
+// sequential parses lines as a sequential alignment.
+func (r *Reader) sequential(lines [][]byte, ntax, nchar int) ([]string, [][]byte, error) {
+	names := make([]string, 0, ntax)
+	data := make([][]byte, 0, ntax)
+	for i := 0; i < len(lines); {
+		if len(names) == ntax {
+			return nil, nil, ErrBadLayout
+		}
+		name, d, err := r.split(lines[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		for i++; len(d) < nchar && i < len(lines); i++ {
+			d = append(d, residues(lines[i])...)
+		}
+		if len(d) != nchar {
+			return nil, nil, ErrBadLayout
+		}
+		names = append(names, name)
+		data = append(data, d)
+	}
+	if len(names) != ntax {
+		return nil, nil, ErrBadLayout
+	}
+	return names, data, nil
+}
+
+// Writer is a PHYLIP format writer.
+type Writer struct {
+	w io.Writer
+
+	// Strict specifies that names are written in
+	// the fixed width field of the strict format.
+	Strict bool
+
+	// Interleaved specifies that the alignment is
+	// written in interleaved layout.
+	Interleaved bool
+
+	// Width is the number of residues written per line.
+	// If Width is zero, each sequence is written on a
+	// single line.
+	Width int
+}
+
+// NewWriter returns a new relaxed sequential PHYLIP format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes a single alignment and returns the number of bytes written and any
+// error. The rows of m must all span the same columns.
+func (w *Writer) Write(m *multi.Multi) (n int, err error) {
+	if m.Rows() == 0 {
+		return 0, ErrEmpty
+	}
+	start, end := m.Start(), m.End()
+	nameWidth := NameWidth
+	if !w.Strict {
+		nameWidth = 0
+	}
+	for _, s := range m.Seq {
+		if s.Start() != start || s.End() != end {
+			return 0, ErrRagged
+		}
+		switch {
+		case w.Strict && len(s.Name()) > NameWidth:
+			return 0, ErrNameTooLong
+		case !w.Strict && strings.IndexFunc(s.Name(), unicode.IsSpace) >= 0:
+			return 0, ErrBadLine
+		case !w.Strict && len(s.Name())+1 > nameWidth:
+			nameWidth = len(s.Name()) + 1
+		}
+	}
+
+	n, err = fmt.Fprintf(w.w, "%d %d\n", m.Rows(), end-start)
+	if err != nil {
+		return n, err
+	}
+
+	width := w.Width
+	if width <= 0 {
+		width = end - start
+	}
+	if width < 1 {
+		// An alignment with no columns is written
+		// as a header and a line of names.
+		width = 1
+	}
+	var (
+		_n  int
+		buf = make([]byte, 0, nameWidth+width+1)
+	)
+	letters := func(s seq.Sequence, i int) {
+		for j := i; j < i+width && j < end; j++ {
+			buf = append(buf, byte(s.At(j).L))
+		}
+		buf = append(buf, '\n')
+	}
+	name := func(s seq.Sequence) {
+		buf = append(buf, s.Name()...)
+		for k := len(s.Name()); k < nameWidth; k++ {
+			buf = append(buf, ' ')
+		}
+	}
+	if w.Interleaved {
+		for i := start; i < end || i == start; i += width {
+			if i != start {
+				_n, err = fmt.Fprintln(w.w)
+				if n += _n; err != nil {
+					return n, err
+				}
+			}
+			for _, s := range m.Seq {
+				buf = buf[:0]
+				if i == start {
+					name(s)
+				}
+				letters(s, i)
+				_n, err = w.w.Write(buf)
+				if n += _n; err != nil {
+					return n, err
+				}
+			}
+		}
+		return n, nil
+	}
+	for _, s := range m.Seq {
+		for i := start; i < end || i == start; i += width {
+			buf = buf[:0]
+			if i == start {
+				name(s)
+			}
+			letters(s, i)
+			_n, err = w.w.Write(buf)
+			if n += _n; err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
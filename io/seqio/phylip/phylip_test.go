@@ -0,0 +1,168 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylip
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bytes"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+var (
+	expectNames = []string{"Turkey", "Salmo_gair", "H.Sapiens"}
+	expectSeqs  = []string{
+		"AAGCTNGGGCATTTCAGGGTGAGCCCGGGCAATACAGGGTAT",
+		"AAGCCTTGGCAGTGCAGGGTGAGCCGTGGCCGGGCACGGTAT",
+		"ACCGGTTGGCCGTTCAGGGTACAGGTTGGCCGTTCAGGGTAA",
+	}
+)
+
+var readTests = []struct {
+	in          string
+	strict      bool
+	interleaved bool
+}{
+	{
+		in: `3 42
+Turkey    AAGCTNGGGC ATTTCAGGGT
+GAGCCCGGGC AATACAGGGT AT
+Salmo_gair AAGCCTTGGC AGTGCAGGGT
+GAGCCGTGGC CGGGCACGGT AT
+H.Sapiens ACCGGTTGGC CGTTCAGGGT
+ACAGGTTGGC CGTTCAGGGT AA
+`,
+		interleaved: false,
+	},
+	{
+		in: ` 3 42
+Turkey     AAGCTNGGGC ATTTCAGGGT
+Salmo_gair AAGCCTTGGC AGTGCAGGGT
+H.Sapiens  ACCGGTTGGC CGTTCAGGGT
+
+GAGCCCGGGC AATACAGGGT AT
+GAGCCGTGGC CGGGCACGGT AT
+ACAGGTTGGC CGTTCAGGGT AA
+`,
+		interleaved: true,
+	},
+	{
+		in: `3 42
+Turkey    AAGCTNGGGCATTTCAGGGTGAGCCCGGGCAATACAGGGTAT
+Salmo_gairAAGCCTTGGCAGTGCAGGGTGAGCCGTGGCCGGGCACGGTAT
+H.Sapiens ACCGGTTGGCCGTTCAGGGTACAGGTTGGCCGTTCAGGGTAA
+`,
+		strict:      true,
+		interleaved: false,
+	},
+}
+
+func (s *S) TestRead(c *check.C) {
+	for i, t := range readTests {
+		r := NewReader(bytes.NewBufferString(t.in), linear.NewSeq("", nil, alphabet.DNAgapped))
+		r.Strict = t.strict
+		m, err := r.Read()
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(r.Interleaved, check.Equals, t.interleaved, check.Commentf("Test %d", i))
+		c.Assert(m.Rows(), check.Equals, 3, check.Commentf("Test %d", i))
+		for j := 0; j < m.Rows(); j++ {
+			c.Check(m.Row(j).Name(), check.Equals, expectNames[j], check.Commentf("Test %d row %d", i, j))
+			c.Check(string(m.Row(j).(*linear.Seq).Seq), check.Equals, expectSeqs[j], check.Commentf("Test %d row %d", i, j))
+		}
+		_, err = r.Read()
+		c.Check(err, check.Equals, io.EOF, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestReadMultiple(c *check.C) {
+	r := NewReader(bytes.NewBufferString("2 3\na ACG\nb ACT\n2 2\na AC\nb AG\n"), linear.NewSeq("", nil, alphabet.DNA))
+	for _, n := range []int{3, 2} {
+		m, err := r.Read()
+		c.Assert(err, check.Equals, nil)
+		c.Check(m.Len(), check.Equals, n)
+	}
+	_, err := r.Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	_, err = NewReader(bytes.NewBufferString("2 3\na ACG\nb AC\n"), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.ErrorMatches, "phylip: sequence data does not match header at lines 2-3")
+}
+
+func (s *S) TestWrite(c *check.C) {
+	for i, t := range []struct {
+		strict, interleaved bool
+		width               int
+		expect              string
+	}{
+		{
+			strict: false, interleaved: false, width: 0,
+			expect: "3 42\nTurkey     AAGCTNGGGCATTTCAGGGTGAGCCCGGGCAATACAGGGTAT\nSalmo_gair AAGCCTTGGCAGTGCAGGGTGAGCCGTGGCCGGGCACGGTAT\nH.Sapiens  ACCGGTTGGCCGTTCAGGGTACAGGTTGGCCGTTCAGGGTAA\n",
+		},
+		{
+			strict: true, interleaved: true, width: 30,
+			expect: "3 42\nTurkey    AAGCTNGGGCATTTCAGGGTGAGCCCGGGC\nSalmo_gairAAGCCTTGGCAGTGCAGGGTGAGCCGTGGC\nH.Sapiens ACCGGTTGGCCGTTCAGGGTACAGGTTGGC\n\nAATACAGGGTAT\nCGGGCACGGTAT\nCGTTCAGGGTAA\n",
+		},
+		{
+			strict: false, interleaved: false, width: 30,
+			expect: "3 42\nTurkey     AAGCTNGGGCATTTCAGGGTGAGCCCGGGC\nAATACAGGGTAT\nSalmo_gair AAGCCTTGGCAGTGCAGGGTGAGCCGTGGC\nCGGGCACGGTAT\nH.Sapiens  ACCGGTTGGCCGTTCAGGGTACAGGTTGGC\nCGTTCAGGGTAA\n",
+		},
+	} {
+		var rows []*linear.Seq
+		for j, n := range expectNames {
+			rows = append(rows, linear.NewSeq(n, alphabet.BytesToLetters([]byte(expectSeqs[j])), alphabet.DNAgapped))
+		}
+		m, err := multi.NewMulti("", nil, nil)
+		c.Assert(err, check.Equals, nil)
+		for _, r := range rows {
+			m.Add(r)
+		}
+
+		var b bytes.Buffer
+		w := NewWriter(&b)
+		w.Strict, w.Interleaved, w.Width = t.strict, t.interleaved, t.width
+		_, err = w.Write(m)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(b.String(), check.Equals, t.expect, check.Commentf("Test %d", i))
+
+		r := NewReader(&b, linear.NewSeq("", nil, alphabet.DNAgapped))
+		r.Strict = t.strict
+		got, err := r.Read()
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(got.Rows(), check.Equals, 3, check.Commentf("Test %d", i))
+		c.Check(r.Interleaved, check.Equals, t.interleaved, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestReadBadTaxonCount(c *check.C) {
+	_, err := NewReader(bytes.NewBufferString("1000000000000 3\na ACG\nb ACT\n"), linear.NewSeq("", nil, alphabet.DNA)).Read()
+	c.Check(err, check.ErrorMatches, "phylip: sequence data does not match header at lines 2-3")
+}
+
+func (s *S) TestWriteEmpty(c *check.C) {
+	m, err := multi.NewMulti("", nil, nil)
+	c.Assert(err, check.Equals, nil)
+	m.Add(linear.NewSeq("a", nil, alphabet.DNAgapped))
+	m.Add(linear.NewSeq("b", nil, alphabet.DNAgapped))
+	for _, interleaved := range []bool{false, true} {
+		var b bytes.Buffer
+		w := NewWriter(&b)
+		w.Interleaved = interleaved
+		_, err = w.Write(m)
+		c.Assert(err, check.Equals, nil, check.Commentf("Interleaved %v", interleaved))
+		c.Check(b.String(), check.Equals, "2 0\na \nb \n", check.Commentf("Interleaved %v", interleaved))
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package record
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/io/seqio/abi"
+	"github.com/biogo/biogo/io/seqio/clustal"
+	"github.com/biogo/biogo/io/seqio/embl"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/io/seqio/fastq"
+	"github.com/biogo/biogo/io/seqio/genbank"
+	"github.com/biogo/biogo/io/seqio/msf"
+	"github.com/biogo/biogo/io/seqio/nexus"
+	"github.com/biogo/biogo/io/seqio/phylip"
+	"github.com/biogo/biogo/io/seqio/sff"
+	"github.com/biogo/biogo/io/seqio/tabular"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bytes"
+	"errors"
+	"io"
+)
+
+var (
+	ErrUnknownFormat = errors.New("record: unknown format")
+	ErrNotWritable   = errors.New("record: format cannot be written")
+)
+
+// A Converter converts records to and from a sequence format.
+type Converter struct {
+	// Name is the name of the format.
+	Name string
+
+	// Keeps is the set of record fields
+	// held by the format.
+	Keeps Field
+
+	// Aligned indicates the format holds
+	// an alignment, so the records written
+	// must have the same length and a
+	// gapped alphabet.
+	Aligned bool
+
+	// Read reads all the records from r,
+	// using alpha as the alphabet of the
+	// sequence letters.
+	Read func(r io.Reader, alpha alphabet.Alphabet) ([]*Record, error)
+
+	// Write writes the records to w. Write
+	// is nil for formats that can only be
+	// read.
+	Write func(w io.Writer, recs []*Record) error
+}
+
+// Lost returns the fields of r holding content that are not kept by the format of c.
+func (c Converter) Lost(r *Record) Field {
+	return r.Fields() &^ c.Keeps
+}
+
+// RoundTrip writes recs with c and reads them back, returning the records read and the
+// differences between the fields of the records that c keeps. The records read have the
+// alphabet of the first record of recs.
+func (c Converter) RoundTrip(recs []*Record) ([]*Record, []Difference, error) {
+	if c.Write == nil {
+		return nil, nil, ErrNotWritable
+	}
+	var alpha alphabet.Alphabet
+	if len(recs) != 0 {
+		alpha = recs[0].Alpha
+	}
+	var buf bytes.Buffer
+	err := c.Write(&buf, recs)
+	if err != nil {
+		return nil, nil, err
+	}
+	got, err := c.Read(&buf, alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	return got, Compare(recs, got, c.Keeps), nil
+}
+
+// Converters returns the converters for the supported formats.
+func Converters() []Converter {
+	return append([]Converter(nil), converters...)
+}
+
+// Lookup returns the converter for the named format.
+func Lookup(name string) (Converter, error) {
+	for _, c := range converters {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return Converter{}, ErrUnknownFormat
+}
+
+var converters = []Converter{
+	{
+		Name:  "fasta",
+		Keeps: FieldID | FieldDescription | FieldLetters,
+		Read: seqReader("fasta", func(r io.Reader, t seqio.SequenceAppender) seqio.Reader {
+			return fasta.NewReader(r, t)
+		}, false),
+		Write: seqWriter(func(w io.Writer) seqio.Writer { return fasta.NewWriter(w, 60) }),
+	},
+	{
+		Name:  "fastq",
+		Keeps: FieldID | FieldDescription | FieldLetters | FieldQualities,
+		Read: seqReader("fastq", func(r io.Reader, t seqio.SequenceAppender) seqio.Reader {
+			return fastq.NewReader(r, t)
+		}, true),
+		Write: seqWriter(func(w io.Writer) seqio.Writer { return fastq.NewWriter(w) }),
+	},
+	{
+		Name:  "tabular",
+		Keeps: FieldID | FieldDescription | FieldLetters | FieldQualities,
+		Read: seqReader("tabular", func(r io.Reader, t seqio.SequenceAppender) seqio.Reader {
+			tr := tabular.NewReader(r, t, '\t')
+			tr.Columns = tabularColumns
+			return tr
+		}, true),
+		Write: seqWriter(func(w io.Writer) seqio.Writer {
+			tw := tabular.NewWriter(w, '\t')
+			tw.Columns = tabularColumns
+			return tw
+		}),
+	},
+	{
+		Name:    "clustal",
+		Keeps:   FieldID | FieldLetters,
+		Aligned: true,
+		Read: multiReader("clustal", func(r io.Reader, t seqio.SequenceAppender) (*multi.Multi, error) {
+			return clustal.NewReader(r, t).Read()
+		}),
+		Write: multiWriter(func(w io.Writer, m *multi.Multi) error {
+			_, err := clustal.NewWriter(w, 60).Write(m)
+			return err
+		}),
+	},
+	{
+		Name:    "phylip",
+		Keeps:   FieldID | FieldLetters,
+		Aligned: true,
+		Read: multiReader("phylip", func(r io.Reader, t seqio.SequenceAppender) (*multi.Multi, error) {
+			return phylip.NewReader(r, t).Read()
+		}),
+		Write: multiWriter(func(w io.Writer, m *multi.Multi) error {
+			_, err := phylip.NewWriter(w).Write(m)
+			return err
+		}),
+	},
+	{
+		Name:    "nexus",
+		Keeps:   FieldID | FieldLetters,
+		Aligned: true,
+		Read: multiReader("nexus", func(r io.Reader, t seqio.SequenceAppender) (*multi.Multi, error) {
+			n, err := nexus.NewReader(r, t).Read()
+			if err != nil {
+				return nil, err
+			}
+			return n.Alignment, nil
+		}),
+		Write: multiWriter(func(w io.Writer, m *multi.Multi) error {
+			_, err := nexus.NewWriter(w).Write(&nexus.Nexus{Alignment: m})
+			return err
+		}),
+	},
+	{
+		Name:    "msf",
+		Keeps:   FieldID | FieldLetters,
+		Aligned: true,
+		Read: multiReader("msf", func(r io.Reader, t seqio.SequenceAppender) (*multi.Multi, error) {
+			return msf.NewReader(r, t).Read()
+		}),
+	},
+	{
+		Name:  "genbank",
+		Keeps: FieldID | FieldDescription | FieldLetters | FieldFeatures,
+		Read: flatReader("genbank", func(r io.Reader) recordReader {
+			return genbank.NewReader(r, nil)
+		}),
+	},
+	{
+		Name:  "embl",
+		Keeps: FieldID | FieldDescription | FieldLetters | FieldFeatures,
+		Read: flatReader("embl", func(r io.Reader) recordReader {
+			return embl.NewReader(r, nil)
+		}),
+	},
+	{
+		Name:  "sff",
+		Keeps: FieldID | FieldLetters | FieldQualities,
+		Read: seqReader("sff", func(r io.Reader, t seqio.SequenceAppender) seqio.Reader {
+			return sff.NewReader(r, t)
+		}, true),
+	},
+	{
+		Name:  "abi",
+		Keeps: FieldID | FieldLetters | FieldQualities,
+		Read: seqReader("abi", func(r io.Reader, t seqio.SequenceAppender) seqio.Reader {
+			return abi.NewReader(r, t)
+		}, true),
+	},
+}
+
+// tabularColumns is the column layout of tabular records.
+var tabularColumns = tabular.Columns{ID: 0, Description: 1, Sequence: 2, Quality: 3}
+
+// template returns a sequence template with the alphabet alpha, with
+// Sanger encoded qualities if qual is true.
+func template(alpha alphabet.Alphabet, qual bool) seqio.SequenceAppender {
+	if qual {
+		return linear.NewQSeq("", nil, alpha, alphabet.Sanger)
+	}
+	return linear.NewSeq("", nil, alpha)
+}
+
+// seqReader returns a Read function for the named format reading sequences
+// with readers returned by fn.
+func seqReader(name string, fn func(io.Reader, seqio.SequenceAppender) seqio.Reader, qual bool) func(io.Reader, alphabet.Alphabet) ([]*Record, error) {
+	return func(r io.Reader, alpha alphabet.Alphabet) ([]*Record, error) {
+		sr := fn(r, template(alpha, qual))
+		var recs []*Record
+		for {
+			s, err := sr.Read()
+			if err == io.EOF {
+				return recs, nil
+			}
+			if err != nil {
+				return recs, err
+			}
+			rec := FromSequence(s)
+			rec.Provenance = Provenance{Format: name, Index: len(recs)}
+			recs = append(recs, rec)
+		}
+	}
+}
+
+// seqWriter returns a Write function writing sequences with writers returned
+// by fn.
+func seqWriter(fn func(io.Writer) seqio.Writer) func(io.Writer, []*Record) error {
+	return func(w io.Writer, recs []*Record) error {
+		sw := fn(w)
+		for _, r := range recs {
+			_, err := sw.Write(r.Sequence())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// multiReader returns a Read function for the named format reading an
+// alignment with fn.
+func multiReader(name string, fn func(io.Reader, seqio.SequenceAppender) (*multi.Multi, error)) func(io.Reader, alphabet.Alphabet) ([]*Record, error) {
+	return func(r io.Reader, alpha alphabet.Alphabet) ([]*Record, error) {
+		m, err := fn(r, template(alpha, false))
+		if err != nil {
+			return nil, err
+		}
+		if m == nil {
+			return nil, nil
+		}
+		var recs []*Record
+		for i := 0; i < m.Rows(); i++ {
+			rec := FromSequence(m.Row(i))
+			rec.Provenance = Provenance{Format: name, Index: i}
+			recs = append(recs, rec)
+		}
+		return recs, nil
+	}
+}
+
+// multiWriter returns a Write function writing the records as an alignment
+// with fn.
+func multiWriter(fn func(io.Writer, *multi.Multi) error) func(io.Writer, []*Record) error {
+	return func(w io.Writer, recs []*Record) error {
+		rows := make([]seq.Sequence, len(recs))
+		for i, r := range recs {
+			rows[i] = r.Sequence()
+		}
+		m, err := multi.NewMulti("", rows, nil)
+		if err != nil {
+			return err
+		}
+		return fn(w, m)
+	}
+}
+
+// recordReader is a reader of INSDC flat file records.
+type recordReader interface {
+	ReadRecord() (*genbank.Record, error)
+}
+
+// flatReader returns a Read function for the named format reading INSDC flat
+// file records with readers returned by fn.
+func flatReader(name string, fn func(io.Reader) recordReader) func(io.Reader, alphabet.Alphabet) ([]*Record, error) {
+	return func(r io.Reader, alpha alphabet.Alphabet) ([]*Record, error) {
+		rr := fn(r)
+		var recs []*Record
+		for {
+			rec, err := rr.ReadRecord()
+			if err == io.EOF {
+				return recs, nil
+			}
+			if err != nil {
+				return recs, err
+			}
+			c := FromGenBank(rec, alpha)
+			c.Provenance = Provenance{Format: name, Index: len(recs)}
+			recs = append(recs, c)
+		}
+	}
+}
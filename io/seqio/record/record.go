@@ -0,0 +1,219 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package record provides a canonical in-memory sequence record model and
+// converters between records and the sequence formats of the seqio packages.
+//
+// Each Converter states the record fields its format keeps, so the content lost
+// by converting a record to a format can be determined before conversion with
+// Lost, and RoundTrip checks that the fields a format claims to keep survive
+// writing and reading back.
+package record
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/genbank"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// A Field is a set of fields of a Record.
+type Field uint
+
+const (
+	FieldID Field = 1 << iota
+	FieldDescription
+	FieldLetters
+	FieldQualities
+	FieldFeatures
+
+	// AllFields is the set of all fields.
+	AllFields = FieldID | FieldDescription | FieldLetters | FieldQualities | FieldFeatures
+)
+
+var fieldNames = []string{"id", "description", "letters", "qualities", "features"}
+
+// String returns a "|" separated list of the names of the fields in f.
+func (f Field) String() string {
+	var names []string
+	for i, n := range fieldNames {
+		if f&(1<<uint(i)) != 0 {
+			names = append(names, n)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "|")
+}
+
+// A Record is a sequence with its annotations, qualities and provenance.
+type Record struct {
+	ID          string
+	Description string
+
+	// Alpha is the alphabet of the
+	// sequence letters in Seq.
+	Alpha alphabet.Alphabet
+	Seq   alphabet.Letters
+
+	// Qual holds the quality of each
+	// letter of Seq. Qual is nil if the
+	// record has no qualities.
+	Qual []alphabet.Qphred
+
+	// Features holds the feature table
+	// annotations of the record.
+	Features []genbank.Feature
+
+	Provenance Provenance
+}
+
+// Provenance describes the source of a record.
+type Provenance struct {
+	// Format is the name of the format
+	// the record was read from.
+	Format string
+
+	// Source is a description of the
+	// source, such as a file name.
+	Source string
+
+	// Index is the zero-based index of
+	// the record in its source.
+	Index int
+}
+
+// encoder is a sequence with letter qualities.
+type encoder interface {
+	Encoding() alphabet.Encoding
+}
+
+// FromSequence returns a Record holding the name, description and letters of s. The
+// qualities of the letters are included if s has a quality encoding.
+func FromSequence(s seq.Sequence) *Record {
+	r := &Record{
+		ID:          s.Name(),
+		Description: s.Description(),
+		Alpha:       s.Alphabet(),
+		Seq:         make(alphabet.Letters, s.Len()),
+	}
+	_, hasQual := s.(encoder)
+	if hasQual {
+		r.Qual = make([]alphabet.Qphred, s.Len())
+	}
+	for i := range r.Seq {
+		ql := s.At(s.Start() + i)
+		r.Seq[i] = ql.L
+		if hasQual {
+			r.Qual[i] = ql.Q
+		}
+	}
+	return r
+}
+
+// FromGenBank returns a Record holding the content of the INSDC record rec, with
+// sequence letters in the alphabet alpha. The record ID is the locus name and its
+// description is the definition of the record.
+func FromGenBank(rec *genbank.Record, alpha alphabet.Alphabet) *Record {
+	return &Record{
+		ID:          rec.Name,
+		Description: rec.Definition,
+		Alpha:       alpha,
+		Seq:         append(alphabet.Letters(nil), rec.Seq...),
+		Features:    append([]genbank.Feature(nil), rec.Features...),
+	}
+}
+
+// Sequence returns the letters of r as a sequence with the name and description of r.
+// The returned sequence is a *linear.QSeq with Sanger encoding if r has qualities, and a
+// *linear.Seq otherwise.
+func (r *Record) Sequence() seq.Sequence {
+	if r.Qual == nil {
+		s := linear.NewSeq(r.ID, append(alphabet.Letters(nil), r.Seq...), r.Alpha)
+		s.Desc = r.Description
+		return s
+	}
+	ql := make([]alphabet.QLetter, len(r.Seq))
+	for i, l := range r.Seq {
+		ql[i] = alphabet.QLetter{L: l, Q: r.Qual[i]}
+	}
+	s := linear.NewQSeq(r.ID, ql, r.Alpha, alphabet.Sanger)
+	s.Desc = r.Description
+	return s
+}
+
+// Fields returns the set of fields of r that hold content.
+func (r *Record) Fields() Field {
+	var f Field
+	if r.ID != "" {
+		f |= FieldID
+	}
+	if r.Description != "" {
+		f |= FieldDescription
+	}
+	if len(r.Seq) != 0 {
+		f |= FieldLetters
+	}
+	if r.Qual != nil {
+		f |= FieldQualities
+	}
+	if len(r.Features) != 0 {
+		f |= FieldFeatures
+	}
+	return f
+}
+
+// A Difference is a difference between the content of two records.
+type Difference struct {
+	// Index is the index of the record.
+	Index int
+
+	// Field is the differing field.
+	Field Field
+
+	Want, Got string
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("record %d: %v: want %q got %q", d.Index, d.Field, d.Want, d.Got)
+}
+
+// Compare returns the differences between the fields in f of the records of want and
+// got. Qualities are only compared for records in want that have qualities, since a
+// format may give qualities to letters that had none. A record missing from got is
+// reported as a difference in its ID.
+func Compare(want, got []*Record, f Field) []Difference {
+	var diffs []Difference
+	for i, w := range want {
+		if i >= len(got) {
+			diffs = append(diffs, Difference{Index: i, Field: FieldID, Want: w.ID})
+			continue
+		}
+		g := got[i]
+		if f&FieldID != 0 && w.ID != g.ID {
+			diffs = append(diffs, Difference{i, FieldID, w.ID, g.ID})
+		}
+		if f&FieldDescription != 0 && w.Description != g.Description {
+			diffs = append(diffs, Difference{i, FieldDescription, w.Description, g.Description})
+		}
+		if f&FieldLetters != 0 && w.Seq.String() != g.Seq.String() {
+			diffs = append(diffs, Difference{i, FieldLetters, w.Seq.String(), g.Seq.String()})
+		}
+		if f&FieldQualities != 0 && w.Qual != nil && !reflect.DeepEqual(w.Qual, g.Qual) {
+			diffs = append(diffs, Difference{i, FieldQualities, fmt.Sprint(w.Qual), fmt.Sprint(g.Qual)})
+		}
+		if f&FieldFeatures != 0 && len(w.Features)+len(g.Features) != 0 && !reflect.DeepEqual(w.Features, g.Features) {
+			diffs = append(diffs, Difference{i, FieldFeatures, fmt.Sprint(w.Features), fmt.Sprint(g.Features)})
+		}
+	}
+	for i := len(want); i < len(got); i++ {
+		diffs = append(diffs, Difference{Index: i, Field: FieldID, Got: got[i].ID})
+	}
+	return diffs
+}
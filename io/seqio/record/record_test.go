@@ -0,0 +1,134 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package record
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/genbank"
+	"github.com/biogo/biogo/seq/linear"
+
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func records() []*Record {
+	return []*Record{
+		{
+			ID:          "r1",
+			Description: "first read",
+			Alpha:       alphabet.DNAgapped,
+			Seq:         alphabet.Letters("ACGTacgt-N"),
+			Qual:        []alphabet.Qphred{40, 30, 20, 10, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			ID:          "r2",
+			Description: "second read",
+			Alpha:       alphabet.DNAgapped,
+			Seq:         alphabet.Letters("TTGCA-ACGN"),
+			Qual:        []alphabet.Qphred{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+	}
+}
+
+func (s *S) TestRoundTrip(c *check.C) {
+	for _, conv := range Converters() {
+		recs := records()
+		got, diffs, err := conv.RoundTrip(recs)
+		if conv.Write == nil {
+			c.Check(err, check.Equals, ErrNotWritable, check.Commentf("Format %s", conv.Name))
+			continue
+		}
+		c.Assert(err, check.Equals, nil, check.Commentf("Format %s", conv.Name))
+		c.Check(diffs, check.HasLen, 0, check.Commentf("Format %s: %v", conv.Name, diffs))
+		c.Assert(got, check.HasLen, len(recs), check.Commentf("Format %s", conv.Name))
+		for i, r := range got {
+			c.Check(r.Provenance, check.Equals, Provenance{Format: conv.Name, Index: i})
+		}
+
+		// The lost fields are the fields that
+		// differ when all fields are compared.
+		var lost Field
+		for _, r := range recs {
+			lost |= conv.Lost(r)
+		}
+		var differ Field
+		for _, d := range Compare(recs, got, AllFields) {
+			differ |= d.Field
+		}
+		c.Check(differ, check.Equals, lost, check.Commentf("Format %s", conv.Name))
+	}
+}
+
+func (s *S) TestLost(c *check.C) {
+	fasta, err := Lookup("fasta")
+	c.Assert(err, check.Equals, nil)
+	c.Check(fasta.Lost(records()[0]), check.Equals, FieldQualities)
+	c.Check(fasta.Lost(records()[0]).String(), check.Equals, "qualities")
+	phylip, err := Lookup("phylip")
+	c.Assert(err, check.Equals, nil)
+	c.Check(phylip.Lost(records()[0]).String(), check.Equals, "description|qualities")
+	c.Check(phylip.Aligned, check.Equals, true)
+	_, err = Lookup("sam")
+	c.Check(err, check.Equals, ErrUnknownFormat)
+}
+
+func (s *S) TestSequence(c *check.C) {
+	r := records()[0]
+	q := r.Sequence()
+	c.Check(q, check.FitsTypeOf, &linear.QSeq{})
+	c.Check(FromSequence(q), check.DeepEquals, r)
+	r.Qual = nil
+	l := r.Sequence()
+	c.Check(l, check.FitsTypeOf, &linear.Seq{})
+	c.Check(FromSequence(l), check.DeepEquals, r)
+}
+
+const gb = `LOCUS       TEST                      12 bp    DNA     linear   PLN 21-JUN-1999
+DEFINITION  A test record.
+ACCESSION   T00001
+FEATURES             Location/Qualifiers
+     gene            1..12
+                     /locus_tag="T_0001"
+ORIGIN
+        1 acgtacgtac gt
+//
+`
+
+func (s *S) TestGenBank(c *check.C) {
+	conv, err := Lookup("genbank")
+	c.Assert(err, check.Equals, nil)
+	recs, err := conv.Read(strings.NewReader(gb), alphabet.DNA)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(recs, check.HasLen, 1)
+	c.Check(recs[0].ID, check.Equals, "TEST")
+	c.Check(recs[0].Description, check.Equals, "A test record.")
+	c.Check(recs[0].Seq.String(), check.Equals, "acgtacgtacgt")
+	c.Check(recs[0].Features, check.DeepEquals, []genbank.Feature{{
+		Key:        "gene",
+		Location:   "1..12",
+		Qualifiers: []genbank.Qualifier{{Name: "locus_tag", Value: "T_0001"}},
+	}})
+	c.Check(recs[0].Fields(), check.Equals, FieldID|FieldDescription|FieldLetters|FieldFeatures)
+
+	// Writing to a format without features
+	// loses them.
+	fasta, err := Lookup("fasta")
+	c.Assert(err, check.Equals, nil)
+	c.Check(fasta.Lost(recs[0]), check.Equals, FieldFeatures)
+	_, diffs, err := fasta.RoundTrip(recs)
+	c.Assert(err, check.Equals, nil)
+	c.Check(diffs, check.HasLen, 0)
+	got, _, err := fasta.RoundTrip(recs)
+	c.Assert(err, check.Equals, nil)
+	c.Check(Compare(recs, got, AllFields), check.HasLen, 1)
+}
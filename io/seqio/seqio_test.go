@@ -5,14 +5,22 @@
 package seqio_test
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 
 	"github.com/biogo/biogo/alphabet"
 	"github.com/biogo/biogo/io/seqio"
 	"github.com/biogo/biogo/io/seqio/fasta"
 	"github.com/biogo/biogo/io/seqio/fastq"
+	"github.com/biogo/biogo/seq"
 	"github.com/biogo/biogo/seq/linear"
 
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"testing"
 
 	"gopkg.in/check.v1"
@@ -446,3 +454,177 @@ func (s *S) TestReadFastq(c *check.C) {
 	c.Check(obtainNfq, check.DeepEquals, expectNfq)
 	c.Check(obtainQL, check.DeepEquals, expectQL)
 }
+
+func gzipped(s string) *bytes.Buffer {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte(s))
+	gz.Close()
+	return &buf
+}
+
+// bzip2 compression of ">a\nACGT\n>b\nGGCC\n".
+var bzfa = []byte{
+	0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0xdf, 0xb5, 0x08, 0x10, 0x00, 0x00,
+	0x02, 0x4f, 0x00, 0x00, 0x10, 0x00, 0x01, 0x28, 0x80, 0x04, 0x00, 0x30, 0x00, 0x20, 0x00, 0x31,
+	0x0c, 0x08, 0x20, 0x62, 0x7a, 0x8a, 0x70, 0xed, 0x86, 0x25, 0xa5, 0x78, 0xbb, 0x92, 0x29, 0xc2,
+	0x84, 0x86, 0xfd, 0xa8, 0x40, 0x80,
+}
+
+func (s *S) TestReadCompressed(c *check.C) {
+	for i, t := range []struct {
+		in     *bytes.Buffer
+		expect []string
+	}{
+		{bytes.NewBufferString(">a\nACGT\n>b\nGGCC\n"), []string{"ACGT", "GGCC"}},
+		{gzipped(">a\nACGT\n>b\nGGCC\n"), []string{"ACGT", "GGCC"}},
+		{bytes.NewBuffer(bzfa), []string{"ACGT", "GGCC"}},
+		{bytes.NewBufferString(""), nil},
+	} {
+		var obtain []string
+		sc := seqio.NewScanner(fasta.NewReader(t.in, linear.NewSeq("", nil, alphabet.DNA)))
+		for sc.Next() {
+			obtain = append(obtain, sc.Seq().(*linear.Seq).String())
+		}
+		c.Check(sc.Error(), check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(obtain, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+
+	var obtainNfq []string
+	sc := seqio.NewScanner(
+		fastq.NewReader(
+			gzipped(fq0),
+			linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger),
+		),
+	)
+	for sc.Next() {
+		t := sc.Seq().(*linear.QSeq)
+		header := t.Name()
+		if desc := t.Description(); len(desc) > 0 {
+			header += " " + desc
+		}
+		obtainNfq = append(obtainNfq, header)
+	}
+	c.Check(sc.Error(), check.Equals, nil)
+	c.Check(obtainNfq, check.DeepEquals, expectNfq)
+
+	sc = seqio.NewScanner(fasta.NewReader(bytes.NewBuffer([]byte{0x1f, 0x8b, 0}), linear.NewSeq("", nil, alphabet.DNA)))
+	c.Check(sc.Next(), check.Equals, false)
+	c.Check(sc.Error(), check.Not(check.Equals), nil)
+}
+
+func (s *S) TestNewReaderDetect(c *check.C) {
+	formats := seqio.Formats()
+	sort.Strings(formats)
+	c.Check(formats, check.DeepEquals, []string{"fasta", "fastq"})
+	for i, t := range []struct {
+		in     *bytes.Buffer
+		format string
+		expect []string
+	}{
+		{bytes.NewBufferString("\n>a\nACGT\n>b\nGGCC\n"), "fasta", []string{"a", "b"}},
+		{gzipped(">a\nACGT\n"), "fasta", []string{"a"}},
+		{bytes.NewBufferString("@a\nACGT\n+\nIIII\n"), "fastq", []string{"a"}},
+		{gzipped(fq0), "fastq", expectNfq},
+	} {
+		r, format, err := seqio.NewReader(t.in, linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(format, check.Equals, t.format, check.Commentf("Test %d", i))
+		var obtain []string
+		sc := seqio.NewScanner(r)
+		for sc.Next() {
+			header := sc.Seq().Name()
+			if desc := sc.Seq().Description(); len(desc) > 0 {
+				header += " " + desc
+			}
+			obtain = append(obtain, header)
+		}
+		c.Check(sc.Error(), check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(obtain, check.DeepEquals, t.expect, check.Commentf("Test %d", i))
+	}
+
+	for i, in := range []string{"", "ACGT\n", "#NEXUS\n"} {
+		_, _, err := seqio.NewReader(bytes.NewBufferString(in), linear.NewSeq("", nil, alphabet.DNA))
+		c.Check(err, check.Equals, seqio.ErrUnknownFormat, check.Commentf("Test %d", i))
+	}
+}
+
+// linesReader is a third-party format reader for testing format
+// registration. The format has a "#lines" header line followed by
+// one sequence per line.
+type linesReader struct {
+	r *bufio.Reader
+	t seqio.SequenceAppender
+	n int
+}
+
+func (r *linesReader) Read() (seq.Sequence, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		r.n++
+		s := r.t.Clone().(seqio.SequenceAppender)
+		s.SetName(strconv.Itoa(r.n))
+		s.AppendLetters(alphabet.BytesToLetters(line)...)
+		return s, nil
+	}
+}
+
+func (s *S) TestRegisterFormat(c *check.C) {
+	seqio.RegisterFormat(seqio.Format{
+		Name:   "lines",
+		Detect: func(data []byte) bool { return bytes.HasPrefix(data, []byte("#lines\n")) },
+		NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+			return &linesReader{r: bufio.NewReader(r), t: template}
+		},
+	})
+	c.Check(func() {
+		seqio.RegisterFormat(seqio.Format{
+			Name: "lines",
+			NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+				return fasta.NewReader(r, template)
+			},
+		})
+	}, check.PanicMatches, `seqio: format lines registered twice`)
+
+	template := linear.NewSeq("", nil, alphabet.DNA)
+	r, format, err := seqio.NewReader(gzipped("#lines\nACGT\nGG\n"), template)
+	c.Assert(err, check.Equals, nil)
+	c.Check(format, check.Equals, "lines")
+	var obtain []string
+	for sc := seqio.NewScanner(r); sc.Next(); {
+		obtain = append(obtain, sc.Seq().Name())
+	}
+	c.Check(obtain, check.DeepEquals, []string{"1", "2"})
+
+	// Formats may be requested by name without detection.
+	r, err = seqio.NewReaderFormat(bytes.NewBufferString("ACGT\n"), "lines", template)
+	c.Assert(err, check.Equals, nil)
+	sq, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(sq.Len(), check.Equals, 4)
+	r, err = seqio.NewReaderFormat(gzipped(">a\nACGT\n"), "fasta", template)
+	c.Assert(err, check.Equals, nil)
+	sq, err = r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(sq.Name(), check.Equals, "a")
+	_, err = seqio.NewReaderFormat(bytes.NewBufferString(">a\nACGT\n"), "genbank", template)
+	c.Check(err, check.Equals, seqio.ErrUnknownFormat)
+
+	path := filepath.Join(c.MkDir(), "seqs.txt")
+	c.Assert(ioutil.WriteFile(path, []byte("ACGT\nGGCC\nT\n"), 0644), check.Equals, nil)
+	rc, err := seqio.OpenFormat(path, "lines", template)
+	c.Assert(err, check.Equals, nil)
+	defer rc.Close()
+	var n int
+	for sc := seqio.NewScanner(rc); sc.Next(); {
+		n++
+	}
+	c.Check(n, check.Equals, 3)
+}
@@ -0,0 +1,302 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sff provides a reader for 454 and Ion Torrent Standard Flowgram
+// Format (SFF) files.
+package sff
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+var (
+	_ seqio.Reader = (*Reader)(nil)
+)
+
+func init() {
+	seqio.RegisterFormat(seqio.Format{
+		Name: "sff",
+		Detect: func(data []byte) bool {
+			return len(data) >= 4 && binary.BigEndian.Uint32(data) == magic
+		},
+		NewReader: func(r io.Reader, template seqio.SequenceAppender) seqio.Reader {
+			return NewReader(r, template)
+		},
+	})
+}
+
+var (
+	ErrNotSFF        = errors.New("sff: not an SFF file")
+	ErrBadVersion    = errors.New("sff: unsupported version")
+	ErrBadFormat     = errors.New("sff: unsupported flowgram format")
+	ErrBadHeader     = errors.New("sff: invalid header length")
+	ErrUnexpectedEOF = errors.New("sff: unexpected end of file")
+)
+
+const magic = 0x2e736666 // ".sff"
+
+// Header holds the common header of an SFF file.
+type Header struct {
+	IndexOffset uint64
+	IndexLength uint32
+	Reads       int
+	Flows       string // Flow order.
+	Key         string // Key sequence.
+}
+
+// A Record holds the flowgram data of a read.
+type Record struct {
+	Name string
+
+	// Flowgram holds the flow signal
+	// values multiplied by 100.
+	Flowgram []uint16
+
+	// FlowIndex holds, for each base, the
+	// 1-based increment in flow position
+	// from the previous base.
+	FlowIndex []uint8
+
+	// ClipQualLeft, ClipQualRight,
+	// ClipAdapterLeft and ClipAdapterRight
+	// are the 1-based clip points given in
+	// the file, zero if not set.
+	ClipQualLeft, ClipQualRight       int
+	ClipAdapterLeft, ClipAdapterRight int
+}
+
+// Clip returns the 0-based half-open interval of bases in a read of length n
+// retained after applying both the quality and adapter clip points.
+func (r *Record) Clip(n int) (start, end int) {
+	start = max(1, r.ClipQualLeft, r.ClipAdapterLeft) - 1
+	end = n
+	for _, c := range []int{r.ClipQualRight, r.ClipAdapterRight} {
+		if c > 0 && c < end {
+			end = c
+		}
+	}
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+func max(a ...int) int {
+	m := a[0]
+	for _, v := range a[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Reader is an SFF format reader.
+type Reader struct {
+	r   *bufio.Reader
+	t   seqio.SequenceAppender
+	off uint64
+	n   int
+
+	// Clip specifies that returned sequences
+	// have their clip points applied.
+	Clip bool
+
+	// Header holds the file header. It is
+	// valid after the first call to Read.
+	Header *Header
+
+	// Record holds the flowgram data of
+	// the most recently read sequence.
+	Record *Record
+}
+
+// NewReader returns a new SFF format reader using r. Sequences returned by the
+// Reader are copied from the provided template and hold the base calls and
+// qualities of each read. Unless the Reader's Clip field is set the complete
+// read is returned and the clip points are available in the Reader's Record.
+func NewReader(r io.Reader, template seqio.SequenceAppender) *Reader {
+	return &Reader{
+		r: bufio.NewReader(r),
+		t: template,
+	}
+}
+
+func (r *Reader) read(b []byte) error {
+	n, err := io.ReadFull(r.r, b)
+	r.off += uint64(n)
+	if err == io.ErrUnexpectedEOF || (err == io.EOF && len(b) != 0) {
+		return ErrUnexpectedEOF
+	}
+	return err
+}
+
+// readN reads n bytes. The returned slice grows as data is read so that a
+// corrupt length cannot cause an allocation larger than the input.
+func (r *Reader) readN(n int64) ([]byte, error) {
+	var b bytes.Buffer
+	m, err := io.CopyN(&b, r.r, n)
+	r.off += uint64(m)
+	if err == io.EOF {
+		return nil, ErrUnexpectedEOF
+	}
+	return b.Bytes(), err
+}
+
+func (r *Reader) skip(n uint64) error {
+	m, err := io.CopyN(ioutil.Discard, r.r, int64(n))
+	r.off += uint64(m)
+	if err == io.EOF {
+		return ErrUnexpectedEOF
+	}
+	return err
+}
+
+// pad skips to the next 8 byte boundary.
+func (r *Reader) pad() error {
+	if p := r.off % 8; p != 0 {
+		return r.skip(8 - p)
+	}
+	return nil
+}
+
+func (r *Reader) readHeader() error {
+	var b [31]byte
+	err := r.read(b[:])
+	if err != nil {
+		if r.off == 0 {
+			return io.EOF
+		}
+		return ErrNotSFF
+	}
+	be := binary.BigEndian
+	if be.Uint32(b[0:]) != magic {
+		return ErrNotSFF
+	}
+	if be.Uint32(b[4:]) != 1 {
+		return ErrBadVersion
+	}
+	h := &Header{
+		IndexOffset: be.Uint64(b[8:]),
+		IndexLength: be.Uint32(b[16:]),
+		Reads:       int(be.Uint32(b[20:])),
+	}
+	hlen := int(be.Uint16(b[24:]))
+	klen := int(be.Uint16(b[26:]))
+	flows := int(be.Uint16(b[28:]))
+	if b[30] != 1 {
+		return ErrBadFormat
+	}
+	if hlen < len(b)+flows+klen {
+		return ErrBadHeader
+	}
+	fk, err := r.readN(int64(flows + klen))
+	if err != nil {
+		return err
+	}
+	h.Flows, h.Key = string(fk[:flows]), string(fk[flows:])
+	err = r.skip(uint64(hlen) - r.off)
+	if err != nil {
+		return err
+	}
+	r.Header = h
+	return nil
+}
+
+// Read reads a single sequence and returns it or an error.
+func (r *Reader) Read() (seq.Sequence, error) {
+	if r.Header == nil {
+		err := r.readHeader()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.n == r.Header.Reads {
+		return nil, io.EOF
+	}
+	if r.Header.IndexLength != 0 && r.off == r.Header.IndexOffset {
+		err := r.skip(uint64(r.Header.IndexLength))
+		if err != nil {
+			return nil, err
+		}
+		err = r.pad()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	be := binary.BigEndian
+	start := r.off
+	var b [16]byte
+	err := r.read(b[:])
+	if err != nil {
+		return nil, err
+	}
+	hlen := uint64(be.Uint16(b[0:]))
+	nlen := int(be.Uint16(b[2:]))
+	nbases := int64(be.Uint32(b[4:]))
+	rec := &Record{
+		ClipQualLeft:     int(be.Uint16(b[8:])),
+		ClipQualRight:    int(be.Uint16(b[10:])),
+		ClipAdapterLeft:  int(be.Uint16(b[12:])),
+		ClipAdapterRight: int(be.Uint16(b[14:])),
+	}
+	if hlen < uint64(len(b)+nlen) {
+		return nil, ErrBadHeader
+	}
+	name := make([]byte, nlen)
+	err = r.read(name)
+	if err != nil {
+		return nil, err
+	}
+	rec.Name = string(name)
+	err = r.skip(start + hlen - r.off)
+	if err != nil {
+		return nil, err
+	}
+
+	flows := len(r.Header.Flows)
+	data, err := r.readN(2*int64(flows) + 3*nbases)
+	if err != nil {
+		return nil, err
+	}
+	rec.Flowgram = make([]uint16, flows)
+	for i := range rec.Flowgram {
+		rec.Flowgram[i] = be.Uint16(data[2*i:])
+	}
+	data = data[2*flows:]
+	rec.FlowIndex = data[:nbases]
+	bases, quals := data[nbases:2*nbases], data[2*nbases:]
+	err = r.pad()
+	if err != nil && !(err == ErrUnexpectedEOF && r.n+1 == r.Header.Reads) {
+		return nil, err
+	}
+	r.n++
+	r.Record = rec
+
+	from, to := 0, len(bases)
+	if r.Clip {
+		from, to = rec.Clip(len(bases))
+	}
+	s := r.t.Clone().(seqio.SequenceAppender)
+	err = s.SetName(rec.Name)
+	if err != nil {
+		return nil, err
+	}
+	ql := make([]alphabet.QLetter, to-from)
+	for i := range ql {
+		ql[i] = alphabet.QLetter{L: alphabet.Letter(bases[from+i]), Q: alphabet.Qphred(quals[from+i])}
+	}
+	err = s.AppendQLetters(ql...)
+	return s, err
+}
@@ -0,0 +1,196 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sff
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type read struct {
+	name  string
+	bases string
+	quals []byte
+	clip  [4]uint16
+}
+
+const (
+	flows = "TACGTACG"
+	key   = "TCAG"
+)
+
+func pad(b *bytes.Buffer) {
+	for b.Len()%8 != 0 {
+		b.WriteByte(0)
+	}
+}
+
+// sff returns an SFF file holding the reads with an index of length
+// indexLen after the read at indexAfter.
+func sff(reads []read, indexAfter, indexLen int) []byte {
+	var b bytes.Buffer
+	be := binary.BigEndian
+	hlen := 31 + len(flows) + len(key)
+	hlen += (8 - hlen%8) % 8
+	binary.Write(&b, be, uint32(magic))
+	binary.Write(&b, be, uint32(1))
+	binary.Write(&b, be, uint64(0)) // Index offset, filled below.
+	binary.Write(&b, be, uint32(indexLen))
+	binary.Write(&b, be, uint32(len(reads)))
+	binary.Write(&b, be, uint16(hlen))
+	binary.Write(&b, be, uint16(len(key)))
+	binary.Write(&b, be, uint16(len(flows)))
+	b.WriteByte(1)
+	b.WriteString(flows)
+	b.WriteString(key)
+	pad(&b)
+
+	for i, r := range reads {
+		rhlen := 16 + len(r.name)
+		rhlen += (8 - rhlen%8) % 8
+		binary.Write(&b, be, uint16(rhlen))
+		binary.Write(&b, be, uint16(len(r.name)))
+		binary.Write(&b, be, uint32(len(r.bases)))
+		binary.Write(&b, be, r.clip)
+		b.WriteString(r.name)
+		pad(&b)
+		for f := range flows {
+			binary.Write(&b, be, uint16(100*f))
+		}
+		for range r.bases {
+			b.WriteByte(1)
+		}
+		b.WriteString(r.bases)
+		b.Write(r.quals)
+		if i != len(reads)-1 || i == indexAfter {
+			pad(&b)
+		}
+		if i == indexAfter {
+			be.PutUint64(b.Bytes()[8:], uint64(b.Len()))
+			b.Write(make([]byte, indexLen))
+			if i != len(reads)-1 {
+				pad(&b)
+			}
+		}
+	}
+	return b.Bytes()
+}
+
+var reads = []read{
+	{name: "r1", bases: "TCAGACGTAC", quals: []byte{30, 30, 30, 30, 35, 36, 37, 38, 10, 5}, clip: [4]uint16{5, 8, 0, 0}},
+	{name: "read2", bases: "TCAGGG", quals: []byte{20, 21, 22, 23, 24, 25}, clip: [4]uint16{0, 0, 3, 0}},
+}
+
+func (s *S) TestRead(c *check.C) {
+	for i, t := range []struct {
+		index, indexLen int
+	}{
+		{index: -1},
+		{index: 0, indexLen: 20},
+		{index: 1, indexLen: 12},
+	} {
+		for _, clip := range []bool{false, true} {
+			r := NewReader(bytes.NewReader(sff(reads, t.index, t.indexLen)), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger))
+			r.Clip = clip
+			var (
+				got   []string
+				quals [][]alphabet.Qphred
+			)
+			for {
+				sq, err := r.Read()
+				if err == io.EOF {
+					break
+				}
+				c.Assert(err, check.Equals, nil, check.Commentf("Test %d clip=%t", i, clip))
+				q := sq.(*linear.QSeq)
+				var (
+					ls []byte
+					qs []alphabet.Qphred
+				)
+				for _, l := range q.Seq {
+					ls = append(ls, byte(l.L))
+					qs = append(qs, l.Q)
+				}
+				got = append(got, q.Name()+":"+string(ls))
+				quals = append(quals, qs)
+			}
+			if clip {
+				c.Check(got, check.DeepEquals, []string{"r1:ACGT", "read2:AGGG"}, check.Commentf("Test %d", i))
+				c.Check(quals[0], check.DeepEquals, []alphabet.Qphred{35, 36, 37, 38}, check.Commentf("Test %d", i))
+			} else {
+				c.Check(got, check.DeepEquals, []string{"r1:TCAGACGTAC", "read2:TCAGGG"}, check.Commentf("Test %d", i))
+			}
+			c.Check(r.Header.Flows, check.Equals, flows)
+			c.Check(r.Header.Key, check.Equals, key)
+			c.Check(r.Record.Name, check.Equals, "read2")
+			c.Check(r.Record.ClipAdapterLeft, check.Equals, 3)
+			c.Check(r.Record.Flowgram, check.DeepEquals, []uint16{0, 100, 200, 300, 400, 500, 600, 700})
+		}
+	}
+}
+
+func (s *S) TestErrors(c *check.C) {
+	tmpl := linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger)
+
+	_, err := NewReader(bytes.NewReader(nil), tmpl).Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	_, err = NewReader(bytes.NewReader(make([]byte, 40)), tmpl).Read()
+	c.Check(err, check.Equals, ErrNotSFF)
+
+	b := sff(reads, -1, 0)
+	r := NewReader(bytes.NewReader(b[:len(b)-4]), tmpl)
+	_, err = r.Read()
+	c.Check(err, check.Equals, nil)
+	_, err = r.Read()
+	c.Check(err, check.Equals, ErrUnexpectedEOF)
+}
+
+func (s *S) TestCorruptLengths(c *check.C) {
+	tmpl := linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger)
+	be := binary.BigEndian
+	for i, t := range []struct {
+		corrupt func(b []byte)
+		err     error
+	}{
+		{
+			// Flow count exceeds the header length.
+			corrupt: func(b []byte) { be.PutUint16(b[28:], 0xffff) },
+			err:     ErrBadHeader,
+		},
+		{
+			// Flow count and header length exceed the file.
+			corrupt: func(b []byte) {
+				be.PutUint16(b[24:], 0xffff)
+				be.PutUint16(b[28:], 0xff00)
+			},
+			err: ErrUnexpectedEOF,
+		},
+		{
+			// Base count exceeds the file.
+			corrupt: func(b []byte) { be.PutUint32(b[48+4:], 0xffffffff) },
+			err:     ErrUnexpectedEOF,
+		},
+	} {
+		b := sff(reads, -1, 0)
+		t.corrupt(b)
+		_, err := NewReader(bytes.NewReader(b), tmpl).Read()
+		c.Check(err, check.Equals, t.err, check.Commentf("Test %d", i))
+	}
+}
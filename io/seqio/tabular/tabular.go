@@ -0,0 +1,285 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tabular provides types to read and write sequence records as rows of
+// comma or tab separated values, for interchange with spreadsheets and simple
+// databases.
+//
+// Each row holds the fields of a single record, the ID, description, letters
+// and, optionally, quality scores encoded as in FASTQ. The position of each
+// field in a row is specified by a Columns value, either directly or by
+// naming the columns of a header row.
+package tabular
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio"
+	"github.com/biogo/biogo/seq"
+
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	_ seqio.Reader = (*Reader)(nil)
+	_ seqio.Writer = (*Writer)(nil)
+)
+
+var (
+	ErrNoColumn       = errors.New("tabular: column not found")
+	ErrMissingField   = errors.New("tabular: missing field")
+	ErrBadColumns     = errors.New("tabular: ID and sequence columns required")
+	ErrQualityLength  = errors.New("tabular: sequence/quality length mismatch")
+	ErrDuplicateField = errors.New("tabular: columns share a field")
+)
+
+// Columns specifies the zero-based column of each field of a record. A
+// negative column indicates the field is absent. The ID and Sequence columns
+// are required.
+type Columns struct {
+	ID          int
+	Description int
+	Sequence    int
+	Quality     int
+}
+
+// DefaultColumns is the default column layout: ID, description and sequence.
+var DefaultColumns = Columns{ID: 0, Description: 1, Sequence: 2, Quality: -1}
+
+// DefaultNames are the default names of the columns of a header row.
+var DefaultNames = [4]string{"id", "description", "sequence", "quality"}
+
+func (c Columns) fields() [4]int {
+	return [4]int{c.ID, c.Description, c.Sequence, c.Quality}
+}
+
+func (c Columns) validate() error {
+	if c.ID < 0 || c.Sequence < 0 {
+		return ErrBadColumns
+	}
+	seen := make(map[int]bool)
+	for _, f := range c.fields() {
+		if f < 0 {
+			continue
+		}
+		if seen[f] {
+			return ErrDuplicateField
+		}
+		seen[f] = true
+	}
+	return nil
+}
+
+// width returns the number of fields needed to hold the columns.
+func (c Columns) width() int {
+	var w int
+	for _, f := range c.fields() {
+		if f+1 > w {
+			w = f + 1
+		}
+	}
+	return w
+}
+
+// Encoder is a type that specifies the quality encoding of a sequence.
+type Encoder interface {
+	Encoding() alphabet.Encoding
+}
+
+// Reader reads sequence records from rows of separated values.
+type Reader struct {
+	r *csv.Reader
+	t seqio.SequenceAppender
+	n int
+
+	// Columns specifies the layout of the rows.
+	Columns Columns
+
+	// Encoding is the quality encoding of the quality
+	// column. It is initially the encoding of the template,
+	// or Sanger if the template is not an Encoder.
+	Encoding alphabet.Encoding
+}
+
+// NewReader returns a new Reader of records separated by comma in r, using the
+// DefaultColumns layout. A comma of '\t' reads tab separated values. Sequences
+// returned by the Reader are copied from the provided template. Gzip and bzip2
+// compressed input is decompressed transparently.
+func NewReader(r io.Reader, template seqio.SequenceAppender, comma rune) *Reader {
+	cr := csv.NewReader(seqio.Decompress(r))
+	cr.Comma = comma
+	cr.FieldsPerRecord = -1
+	if comma == '\t' {
+		cr.LazyQuotes = true
+	}
+	enc := alphabet.Sanger
+	if e, ok := template.(Encoder); ok {
+		enc = e.Encoding()
+	}
+	return &Reader{r: cr, t: template, Columns: DefaultColumns, Encoding: enc}
+}
+
+// ReadHeader reads a header row and sets the Reader's Columns to the positions
+// of the named columns. An empty name indicates the field is absent. It
+// returns an error wrapping ErrNoColumn if a non-empty name is not in the
+// header.
+func (r *Reader) ReadHeader(id, desc, sequence, quality string) error {
+	row, err := r.r.Read()
+	if err != nil {
+		return err
+	}
+	var c [4]int
+	for i, name := range [4]string{id, desc, sequence, quality} {
+		c[i] = -1
+		if name == "" {
+			continue
+		}
+		for j, h := range row {
+			if h == name {
+				c[i] = j
+				break
+			}
+		}
+		if c[i] < 0 {
+			return fmt.Errorf("%v: %q", ErrNoColumn, name)
+		}
+	}
+	r.Columns = Columns{ID: c[0], Description: c[1], Sequence: c[2], Quality: c[3]}
+	return r.Columns.validate()
+}
+
+// Read reads a single sequence record and returns it and any error.
+func (r *Reader) Read() (seq.Sequence, error) {
+	if err := r.Columns.validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	r.n++
+	if len(row) < r.Columns.width() {
+		return nil, fmt.Errorf("%v at record %d", ErrMissingField, r.n)
+	}
+
+	s := r.t.Clone().(seqio.SequenceAppender)
+	err = s.SetName(row[r.Columns.ID])
+	if err != nil {
+		return nil, err
+	}
+	if r.Columns.Description >= 0 {
+		err = s.SetDescription(row[r.Columns.Description])
+		if err != nil {
+			return nil, err
+		}
+	}
+	letters := row[r.Columns.Sequence]
+	if r.Columns.Quality < 0 {
+		err = s.AppendLetters(alphabet.Letters(letters)...)
+		return s, err
+	}
+	quals := row[r.Columns.Quality]
+	if len(quals) != len(letters) {
+		return nil, fmt.Errorf("%v at record %d", ErrQualityLength, r.n)
+	}
+	ql := make([]alphabet.QLetter, len(letters))
+	for i := range ql {
+		ql[i] = alphabet.QLetter{L: alphabet.Letter(letters[i]), Q: r.Encoding.DecodeToQphred(quals[i])}
+	}
+	err = s.AppendQLetters(ql...)
+	return s, err
+}
+
+// Writer writes sequence records as rows of separated values.
+type Writer struct {
+	w *csv.Writer
+	c *counter
+
+	// Columns specifies the layout of the rows.
+	Columns Columns
+
+	// Header specifies whether a header row of column
+	// names is written before the first record. Names
+	// holds the names used for the ID, description,
+	// sequence and quality columns.
+	Header bool
+	Names  [4]string
+
+	wroteHeader bool
+}
+
+type counter struct {
+	w io.Writer
+	n int
+}
+
+func (c *counter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += n
+	return n, err
+}
+
+// NewWriter returns a new Writer of records separated by comma to w, using the
+// DefaultColumns layout and DefaultNames.
+func NewWriter(w io.Writer, comma rune) *Writer {
+	c := &counter{w: w}
+	cw := csv.NewWriter(c)
+	cw.Comma = comma
+	return &Writer{w: cw, c: c, Columns: DefaultColumns, Names: DefaultNames}
+}
+
+// Write writes a single sequence and returns the number of bytes written and
+// any error. Qualities are encoded using the encoding of s if it is an
+// Encoder, and Sanger encoding otherwise.
+func (w *Writer) Write(s seq.Sequence) (n int, err error) {
+	err = w.Columns.validate()
+	if err != nil {
+		return 0, err
+	}
+	w.c.n = 0
+	fields := w.Columns.fields()
+	row := make([]string, w.Columns.width())
+	if w.Header && !w.wroteHeader {
+		for i, f := range fields {
+			if f >= 0 {
+				row[f] = w.Names[i]
+			}
+		}
+		err = w.w.Write(row)
+		if err != nil {
+			return 0, err
+		}
+		w.wroteHeader = true
+	}
+
+	enc := alphabet.Sanger
+	if e, ok := s.(Encoder); ok {
+		enc = e.Encoding()
+	}
+	letters := make([]byte, s.Len())
+	var quals []byte
+	if w.Columns.Quality >= 0 {
+		quals = make([]byte, s.Len())
+	}
+	for i := range letters {
+		ql := s.At(i)
+		letters[i] = byte(ql.L)
+		if quals != nil {
+			quals[i] = ql.Q.Encode(enc)
+		}
+	}
+	for i, v := range [4]string{s.Name(), s.Description(), string(letters), string(quals)} {
+		if fields[i] >= 0 {
+			row[fields[i]] = v
+		}
+	}
+	err = w.w.Write(row)
+	if err != nil {
+		return w.c.n, err
+	}
+	w.w.Flush()
+	return w.c.n, w.w.Error()
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabular
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"io"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestReadWrite(c *check.C) {
+	const in = "a,first seq,ACGT\nb,\"with, comma\",GG\n"
+	r := NewReader(bytes.NewBufferString(in), linear.NewSeq("", nil, alphabet.DNA), ',')
+	var got []*linear.Seq
+	for {
+		sq, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		got = append(got, sq.(*linear.Seq))
+	}
+	c.Assert(got, check.HasLen, 2)
+	c.Check(got[0].Name(), check.Equals, "a")
+	c.Check(got[0].Description(), check.Equals, "first seq")
+	c.Check(got[0].String(), check.Equals, "ACGT")
+	c.Check(got[1].Description(), check.Equals, "with, comma")
+
+	var b bytes.Buffer
+	w := NewWriter(&b, ',')
+	var n int
+	for _, sq := range got {
+		_n, err := w.Write(sq)
+		c.Assert(err, check.Equals, nil)
+		n += _n
+	}
+	c.Check(n, check.Equals, b.Len())
+	c.Check(b.String(), check.Equals, in)
+}
+
+func (s *S) TestQualityHeader(c *check.C) {
+	const in = "qual\tname\tseq\textra\nII5+\tr1\tACGT\tx\n"
+	r := NewReader(bytes.NewBufferString(in), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger), '\t')
+	c.Check(r.ReadHeader("name", "", "seq", "missing"), check.ErrorMatches, `tabular: column not found: "missing"`)
+
+	r = NewReader(bytes.NewBufferString(in), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger), '\t')
+	c.Assert(r.ReadHeader("name", "", "seq", "qual"), check.Equals, nil)
+	c.Check(r.Columns, check.Equals, Columns{ID: 1, Description: -1, Sequence: 2, Quality: 0})
+	sq, err := r.Read()
+	c.Assert(err, check.Equals, nil)
+	c.Check(sq.Name(), check.Equals, "r1")
+	c.Check(sq.(*linear.QSeq).Seq, check.DeepEquals, alphabet.QLetters{
+		{L: 'A', Q: 40}, {L: 'C', Q: 40}, {L: 'G', Q: 20}, {L: 'T', Q: 10},
+	})
+
+	var b bytes.Buffer
+	w := NewWriter(&b, '\t')
+	w.Header = true
+	w.Columns = Columns{ID: 0, Description: -1, Sequence: 1, Quality: 2}
+	_, err = w.Write(sq)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b.String(), check.Equals, "id\tsequence\tquality\nr1\tACGT\tII5+\n")
+
+	for i, t := range []struct {
+		in  string
+		col Columns
+		err string
+	}{
+		{in: "a\n", col: DefaultColumns, err: `tabular: missing field at record 1`},
+		{in: "a,AC,I\n", col: Columns{ID: 0, Description: -1, Sequence: 1, Quality: 2}, err: `tabular: sequence/quality length mismatch at record 1`},
+		{in: "a,AC\n", col: Columns{ID: -1, Description: 0, Sequence: 1, Quality: -1}, err: `tabular: ID and sequence columns required`},
+		{in: "a,AC\n", col: Columns{ID: 0, Description: 1, Sequence: 1, Quality: -1}, err: `tabular: columns share a field`},
+	} {
+		r := NewReader(bytes.NewBufferString(t.in), linear.NewQSeq("", nil, alphabet.DNA, alphabet.Sanger), ',')
+		r.Columns = t.col
+		_, err := r.Read()
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
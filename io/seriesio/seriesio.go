@@ -0,0 +1,156 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package seriesio provides a uniform representation of plottable data series,
+// such as tracks of window statistics along a sequence or distributions over
+// categories, and types to read and write them as JSON.
+//
+// Series are written one JSON object per line, for example:
+//
+//	{"name":"GC","kind":"track","ref":"chr1","x":{"label":"position","unit":"bp"},"y":{"label":"GC fraction"},"points":[{"x":0,"end":5000,"y":0.51}]}
+package seriesio
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+)
+
+var (
+	ErrNotFinite = errors.New("seriesio: value not finite")
+)
+
+// Kind is the kind of data held by a Series.
+type Kind string
+
+const (
+	// Track is a series of values over intervals of a
+	// sequence or alignment given by X and End.
+	Track Kind = "track"
+
+	// Distribution is a series of values over the
+	// categories or bins of its X axis.
+	Distribution Kind = "distribution"
+
+	// Scatter is a series of paired observations.
+	Scatter Kind = "scatter"
+)
+
+// An Axis describes an axis of a Series.
+type Axis struct {
+	Label string `json:"label"`
+	Unit  string `json:"unit,omitempty"`
+
+	// Categories holds the category names of a
+	// categorical axis. The values on the axis
+	// are indexes into Categories.
+	Categories []string `json:"categories,omitempty"`
+}
+
+// A Point is a single value of a Series. For a Track, the value applies to the
+// half-open interval [X, End).
+type Point struct {
+	X   float64 `json:"x"`
+	End float64 `json:"end,omitempty"`
+	Y   float64 `json:"y"`
+
+	// Label optionally names the point.
+	Label string `json:"label,omitempty"`
+}
+
+// A Series is a plottable data series with its axis metadata.
+type Series struct {
+	Name string `json:"name"`
+	Kind Kind   `json:"kind"`
+
+	// Ref is the name of the sequence or alignment
+	// that a Track lies on.
+	Ref string `json:"ref,omitempty"`
+
+	X      Axis    `json:"x"`
+	Y      Axis    `json:"y"`
+	Points []Point `json:"points"`
+}
+
+// Reader is a JSON series reader.
+type Reader struct {
+	d *json.Decoder
+}
+
+// NewReader returns a new JSON series reader using r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: json.NewDecoder(bufio.NewReader(r))}
+}
+
+// Read reads a single series and returns it or an error. At the end of the
+// input Read returns io.EOF.
+func (r *Reader) Read() (*Series, error) {
+	var s Series
+	err := r.d.Decode(&s)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Writer is a JSON series writer.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a new JSON series writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes a single series as a line of JSON and returns the number of
+// bytes written and any error. Series with a NaN or infinite value cannot be
+// represented in JSON and result in ErrNotFinite.
+func (w *Writer) Write(s *Series) (int, error) {
+	for _, p := range s.Points {
+		for _, v := range [...]float64{p.X, p.End, p.Y} {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return 0, ErrNotFinite
+			}
+		}
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return 0, err
+	}
+	return w.w.Write(append(unescapeHTML(b), '\n'))
+}
+
+// unescapeHTML reverts the escaping of <, > and & performed by json.Marshal
+// so that labels such as mutation contexts remain readable.
+func unescapeHTML(b []byte) []byte {
+	out := b[:0]
+	for i := 0; i < len(b); i++ {
+		if b[i] != '\\' || i+1 == len(b) {
+			out = append(out, b[i])
+			continue
+		}
+		if b[i+1] == 'u' && i+6 <= len(b) {
+			switch string(b[i+2 : i+6]) {
+			case "003c":
+				out = append(out, '<')
+				i += 5
+				continue
+			case "003e":
+				out = append(out, '>')
+				i += 5
+				continue
+			case "0026":
+				out = append(out, '&')
+				i += 5
+				continue
+			}
+		}
+		out = append(out, b[i], b[i+1])
+		i++
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package seriesio
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestReadWrite(c *check.C) {
+	series := []*Series{
+		{
+			Name: "GC",
+			Kind: Track,
+			Ref:  "chr1",
+			X:    Axis{Label: "position", Unit: "bp"},
+			Y:    Axis{Label: "GC fraction"},
+			Points: []Point{
+				{X: 0, End: 5000, Y: 0.51},
+				{X: 5000, End: 10000, Y: 0.38},
+			},
+		},
+		{
+			Name:   "spectrum",
+			Kind:   Distribution,
+			X:      Axis{Label: "context", Categories: []string{"A[C>A]A", "A[C>A]C"}},
+			Y:      Axis{Label: "substitutions"},
+			Points: []Point{{X: 0, Y: 3, Label: "A[C>A]A"}, {X: 1, Y: 0, Label: "A[C>A]C"}},
+		},
+	}
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	var n int
+	for _, s := range series {
+		_n, err := w.Write(s)
+		c.Assert(err, check.Equals, nil)
+		n += _n
+	}
+	c.Check(n, check.Equals, buf.Len())
+	c.Check(buf.String(), check.Equals, `{"name":"GC","kind":"track","ref":"chr1","x":{"label":"position","unit":"bp"},"y":{"label":"GC fraction"},"points":[{"x":0,"end":5000,"y":0.51},{"x":5000,"end":10000,"y":0.38}]}
+{"name":"spectrum","kind":"distribution","x":{"label":"context","categories":["A[C>A]A","A[C>A]C"]},"y":{"label":"substitutions"},"points":[{"x":0,"y":3,"label":"A[C>A]A"},{"x":1,"y":0,"label":"A[C>A]C"}]}
+`)
+
+	r := NewReader(&buf)
+	for i, s := range series {
+		got, err := r.Read()
+		c.Assert(err, check.Equals, nil)
+		c.Check(got, check.DeepEquals, s, check.Commentf("Test %d", i))
+	}
+	_, err := r.Read()
+	c.Check(err, check.Equals, io.EOF)
+
+	_, err = w.Write(&Series{Points: []Point{{Y: math.NaN()}}})
+	c.Check(err, check.Equals, ErrNotFinite)
+}
+
+func (s *S) TestWriteEscapes(c *check.C) {
+	for _, label := range []string{`a<b>&c`, `<`, `\\u003c`, `\`, `"<"`} {
+		var buf bytes.Buffer
+		_, err := NewWriter(&buf).Write(&Series{Points: []Point{{Label: label}}})
+		c.Assert(err, check.Equals, nil)
+		got, err := NewReader(&buf).Read()
+		c.Assert(err, check.Equals, nil)
+		c.Check(got.Points[0].Label, check.Equals, label)
+	}
+}
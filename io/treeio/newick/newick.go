@@ -0,0 +1,378 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package newick provides types to read and write Newick format trees.
+//
+// Labels may be unquoted, in which case underscores are read as spaces, or
+// quoted with single quotes, in which case a doubled single quote represents
+// a single quote. Square bracketed comments are retained and attached to the
+// node they follow or, for comments preceding a node, the node they precede.
+package newick
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var (
+	ErrUnterminated    = errors.New("newick: tree not terminated by semicolon")
+	ErrUnclosedQuote   = errors.New("newick: unclosed quote")
+	ErrUnclosedComment = errors.New("newick: unclosed comment")
+	ErrUnclosedParen   = errors.New("newick: unclosed parenthesis")
+	ErrUnexpected      = errors.New("newick: unexpected character")
+	ErrBadLength       = errors.New("newick: invalid branch length")
+)
+
+// A Node is a node of a tree.
+type Node struct {
+	Name string
+
+	// Length is the length of the branch
+	// leading to the node. HasLength is true
+	// if a length was given.
+	Length    float64
+	HasLength bool
+
+	// Comments holds the text of any square
+	// bracketed comments attached to the node.
+	Comments []string
+
+	Parent   *Node
+	Children []*Node
+}
+
+// AddChild adds c as the last child of n.
+func (n *Node) AddChild(c *Node) {
+	c.Parent = n
+	n.Children = append(n.Children, c)
+}
+
+// IsLeaf returns whether n has no children.
+func (n *Node) IsLeaf() bool { return len(n.Children) == 0 }
+
+// IsRoot returns whether n has no parent.
+func (n *Node) IsRoot() bool { return n.Parent == nil }
+
+// Root returns the root of the tree containing n.
+func (n *Node) Root() *Node {
+	for n.Parent != nil {
+		n = n.Parent
+	}
+	return n
+}
+
+// Walk calls fn for n and each of its descendants in pre-order. If fn returns
+// false the descendants of the node passed to fn are not visited.
+func (n *Node) Walk(fn func(*Node) bool) {
+	if !fn(n) {
+		return
+	}
+	for _, c := range n.Children {
+		c.Walk(fn)
+	}
+}
+
+// Leaves returns the leaves descended from n in left to right order.
+func (n *Node) Leaves() []*Node {
+	var l []*Node
+	n.Walk(func(d *Node) bool {
+		if d.IsLeaf() {
+			l = append(l, d)
+		}
+		return true
+	})
+	return l
+}
+
+// Find returns the first node in pre-order below and including n with the
+// given name, or nil if no node is found.
+func (n *Node) Find(name string) *Node {
+	var f *Node
+	n.Walk(func(d *Node) bool {
+		if f == nil && d.Name == name {
+			f = d
+		}
+		return f == nil
+	})
+	return f
+}
+
+// DistanceToRoot returns the sum of the branch lengths from n to the root of
+// its tree.
+func (n *Node) DistanceToRoot() float64 {
+	var d float64
+	for ; n.Parent != nil; n = n.Parent {
+		d += n.Length
+	}
+	return d
+}
+
+// String returns the Newick representation of the tree rooted at n, including
+// the terminating semicolon.
+func (n *Node) String() string {
+	var buf bytes.Buffer
+	n.format(&buf)
+	buf.WriteByte(';')
+	return buf.String()
+}
+
+func (n *Node) format(buf *bytes.Buffer) {
+	if len(n.Children) != 0 {
+		buf.WriteByte('(')
+		for i, c := range n.Children {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			c.format(buf)
+		}
+		buf.WriteByte(')')
+	}
+	buf.WriteString(Quote(n.Name))
+	if n.HasLength {
+		buf.WriteByte(':')
+		buf.WriteString(strconv.FormatFloat(n.Length, 'g', -1, 64))
+	}
+	for _, c := range n.Comments {
+		buf.WriteByte('[')
+		buf.WriteString(c)
+		buf.WriteByte(']')
+	}
+}
+
+// Quote returns s quoted for use as a Newick label if it contains characters
+// that are not permitted in unquoted labels, and s unaltered otherwise. Spaces
+// are written as underscores when no other quoting is required.
+func Quote(s string) string {
+	var space bool
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case ' ':
+			space = true
+		case '(', ')', '[', ']', '\'', ':', ';', ',', '_', '\t', '\n', '\r':
+			return "'" + string(bytes.Replace([]byte(s), []byte("'"), []byte("''"), -1)) + "'"
+		}
+	}
+	if space {
+		return string(bytes.Replace([]byte(s), []byte(" "), []byte("_"), -1))
+	}
+	return s
+}
+
+// Parse parses a single Newick tree from s. The terminating semicolon is
+// optional.
+func Parse(s string) (*Node, error) {
+	p := &parser{b: []byte(s)}
+	n, err := p.tree()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.b) && p.b[p.pos] == ';' {
+		p.pos++
+		p.skip()
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.pos != len(p.b) {
+		return nil, fmt.Errorf("%v at offset %d", ErrUnexpected, p.pos)
+	}
+	return n, nil
+}
+
+// Reader is a Newick format reader.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader returns a new Newick format reader using r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read reads the next tree from the underlying reader and returns its root or
+// an error. At the end of the input Read returns io.EOF.
+func (r *Reader) Read() (*Node, error) {
+	var (
+		buf     []byte
+		quote   bool
+		comment bool
+	)
+	for {
+		c, err := r.r.ReadByte()
+		if err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			if len(bytes.TrimSpace(buf)) == 0 {
+				return nil, io.EOF
+			}
+			switch {
+			case quote:
+				return nil, ErrUnclosedQuote
+			case comment:
+				return nil, ErrUnclosedComment
+			}
+			return nil, ErrUnterminated
+		}
+		buf = append(buf, c)
+		switch {
+		case quote:
+			quote = c != '\''
+		case comment:
+			comment = c != ']'
+		case c == '\'':
+			quote = true
+		case c == '[':
+			comment = true
+		case c == ';':
+			return Parse(string(buf))
+		}
+	}
+}
+
+// Writer is a Newick format writer.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a new Newick format writer using w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes the tree rooted at n followed by a newline.
+func (w *Writer) Write(n *Node) (int, error) {
+	return fmt.Fprintln(w.w, n)
+}
+
+type parser struct {
+	b        []byte
+	pos      int
+	comments []string
+	err      error
+}
+
+// skip skips white space and collects comments.
+func (p *parser) skip() {
+	for p.pos < len(p.b) && p.err == nil {
+		switch c := p.b[p.pos]; {
+		case c == ' ', c == '\t', c == '\n', c == '\r':
+			p.pos++
+		case c == '[':
+			end := bytes.IndexByte(p.b[p.pos:], ']')
+			if end < 0 {
+				p.err = fmt.Errorf("%v at offset %d", ErrUnclosedComment, p.pos)
+				return
+			}
+			p.comments = append(p.comments, string(p.b[p.pos+1:p.pos+end]))
+			p.pos += end + 1
+		default:
+			return
+		}
+	}
+}
+
+// take returns and clears the collected comments.
+func (p *parser) take() []string {
+	c := p.comments
+	p.comments = nil
+	return c
+}
+
+func (p *parser) peek() byte {
+	if p.pos < len(p.b) {
+		return p.b[p.pos]
+	}
+	return 0
+}
+
+func (p *parser) tree() (*Node, error) {
+	p.skip()
+	n := p.node()
+	if p.err != nil {
+		return nil, p.err
+	}
+	return n, nil
+}
+
+func (p *parser) node() *Node {
+	n := &Node{Comments: p.take()}
+	if p.peek() == '(' {
+		p.pos++
+		for {
+			p.skip()
+			c := p.node()
+			if p.err != nil {
+				return nil
+			}
+			n.AddChild(c)
+			switch p.peek() {
+			case ',':
+				p.pos++
+				continue
+			case ')':
+				p.pos++
+			case 0:
+				p.err = fmt.Errorf("%v at offset %d", ErrUnclosedParen, p.pos)
+				return nil
+			default:
+				p.err = fmt.Errorf("%v at offset %d", ErrUnexpected, p.pos)
+				return nil
+			}
+			break
+		}
+		p.skip()
+	}
+	n.Name = p.label()
+	p.skip()
+	if p.err == nil && p.peek() == ':' {
+		p.pos++
+		p.skip()
+		start := p.pos
+		for p.pos < len(p.b) && bytes.IndexByte([]byte("0123456789+-.eE"), p.b[p.pos]) >= 0 {
+			p.pos++
+		}
+		l, err := strconv.ParseFloat(string(p.b[start:p.pos]), 64)
+		if err != nil {
+			p.err = fmt.Errorf("%v at offset %d", ErrBadLength, start)
+			return nil
+		}
+		n.Length, n.HasLength = l, true
+		p.skip()
+	}
+	n.Comments = append(n.Comments, p.take()...)
+	return n
+}
+
+func (p *parser) label() string {
+	if p.peek() == '\'' {
+		start := p.pos
+		p.pos++
+		var name []byte
+		for {
+			if p.pos >= len(p.b) {
+				p.err = fmt.Errorf("%v at offset %d", ErrUnclosedQuote, start)
+				return ""
+			}
+			c := p.b[p.pos]
+			p.pos++
+			if c == '\'' {
+				if p.peek() != '\'' {
+					return string(name)
+				}
+				p.pos++
+			}
+			name = append(name, c)
+		}
+	}
+	start := p.pos
+	for p.pos < len(p.b) && bytes.IndexByte([]byte("()[]':;, \t\n\r"), p.b[p.pos]) < 0 {
+		p.pos++
+	}
+	return string(bytes.Replace(p.b[start:p.pos], []byte("_"), []byte(" "), -1))
+}
@@ -0,0 +1,107 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package newick
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func names(ns []*Node) []string {
+	var s []string
+	for _, n := range ns {
+		s = append(s, n.Name)
+	}
+	return s
+}
+
+func (s *S) TestParse(c *check.C) {
+	for i, t := range []struct {
+		in     string
+		leaves []string
+		out    string
+		err    error
+	}{
+		{in: "(A,B,(C,D));", leaves: []string{"A", "B", "C", "D"}, out: "(A,B,(C,D));"},
+		{in: "(A:0.1,B:0.2,(C:0.3,D:0.4)E:0.5)F;", leaves: []string{"A", "B", "C", "D"}, out: "(A:0.1,B:0.2,(C:0.3,D:0.4)E:0.5)F;"},
+		{in: " ( Homo_sapiens , 'Pan troglodytes' ) ; ", leaves: []string{"Homo sapiens", "Pan troglodytes"}, out: "(Homo_sapiens,Pan_troglodytes);"},
+		{in: "('O''Brien''s:1','a_b');", leaves: []string{"O'Brien's:1", "a_b"}, out: "('O''Brien''s:1','a_b');"},
+		{in: "[&R] (A[x],B:1[y]);", leaves: []string{"A", "B"}, out: "(A[x],B:1[y])[&R];"},
+		{in: "A;", leaves: []string{"A"}, out: "A;"},
+		{in: "(A,B", err: ErrUnclosedParen},
+		{in: "(A:x,B);", err: ErrBadLength},
+		{in: "(A,'B);", err: ErrUnclosedQuote},
+		{in: "(A,B)[c;", err: ErrUnclosedComment},
+		{in: "(A,B)C D;", err: ErrUnexpected},
+	} {
+		n, err := Parse(t.in)
+		if t.err != nil {
+			c.Check(err, check.ErrorMatches, t.err.Error()+".*", check.Commentf("Test %d", i))
+			continue
+		}
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Check(names(n.Leaves()), check.DeepEquals, t.leaves, check.Commentf("Test %d", i))
+		c.Check(n.String(), check.Equals, t.out, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestNavigate(c *check.C) {
+	n, err := Parse("((A:1,B:2)AB:3,C:4)root;")
+	c.Assert(err, check.Equals, nil)
+	c.Check(n.IsRoot(), check.Equals, true)
+	b := n.Find("B")
+	c.Assert(b, check.NotNil)
+	c.Check(b.IsLeaf(), check.Equals, true)
+	c.Check(b.Parent.Name, check.Equals, "AB")
+	c.Check(b.Root(), check.Equals, n)
+	c.Check(b.DistanceToRoot(), check.Equals, 5.)
+	c.Check(n.Find("X"), check.IsNil)
+
+	var order []string
+	n.Walk(func(d *Node) bool {
+		order = append(order, d.Name)
+		return d.Name != "AB"
+	})
+	c.Check(order, check.DeepEquals, []string{"root", "AB", "C"})
+}
+
+func (s *S) TestReadWrite(c *check.C) {
+	in := "(A,B)[one;two];\n('x;y',C:1);\n\n"
+	r := NewReader(strings.NewReader(in))
+	var trees []*Node
+	for {
+		n, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, check.Equals, nil)
+		trees = append(trees, n)
+	}
+	c.Assert(trees, check.HasLen, 2)
+	c.Check(trees[0].Comments, check.DeepEquals, []string{"one;two"})
+	c.Check(names(trees[1].Leaves()), check.DeepEquals, []string{"x;y", "C"})
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, n := range trees {
+		_, err := w.Write(n)
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Check(buf.String(), check.Equals, "(A,B)[one;two];\n('x;y',C:1);\n")
+
+	_, err := NewReader(strings.NewReader("(A,B)")).Read()
+	c.Check(err, check.Equals, ErrUnterminated)
+}
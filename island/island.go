@@ -0,0 +1,329 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package island provides heuristic detection of candidate genomic islands and
+// prophages in prokaryotic genomes.
+//
+// Candidates are identified as runs of windows with anomalous composition, GC
+// content and tetranucleotide usage, relative to the whole sequence. Candidate
+// regions are then scored by the strength of the compositional signal and by
+// supporting evidence from the positions of tRNA genes, which are common
+// integration sites, and integrase gene hits. tRNA and integrase features are
+// provided by the caller.
+package island
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/seriesio"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrBadAlphabet = errors.New("island: alphabet size != 4")
+	ErrBadWindow   = errors.New("island: invalid window or step size")
+	ErrShortSeq    = errors.New("island: sequence shorter than window")
+)
+
+const tetra = 256 // Number of tetranucleotides.
+
+// Params describes the parameters used to find candidate islands.
+type Params struct {
+	Window int // Window length.
+	Step   int // Distance between window starts.
+
+	MinGCZ    float64 // Minimum absolute GC z-score for an anomalous window.
+	MinTetraZ float64 // Minimum tetranucleotide deviation z-score for an anomalous window.
+
+	MinLen int // Minimum length of a reported region.
+
+	// Flank is the distance from a region boundary within which
+	// tRNA and integrase features are counted as supporting evidence.
+	Flank int
+
+	// Weights for scoring evidence.
+	GCWeight        float64
+	TetraWeight     float64
+	TRNAWeight      float64
+	IntegraseWeight float64
+
+	MinScore float64 // Minimum score of a reported region.
+}
+
+// DefaultParams are parameters suitable for scanning bacterial genomes.
+var DefaultParams = Params{
+	Window:          5000,
+	Step:            1000,
+	MinGCZ:          1.5,
+	MinTetraZ:       1.5,
+	MinLen:          8000,
+	Flank:           2000,
+	GCWeight:        1,
+	TetraWeight:     1,
+	TRNAWeight:      1,
+	IntegraseWeight: 2,
+	MinScore:        2,
+}
+
+// A Window holds the compositional statistics for a single window of a sequence.
+type Window struct {
+	Start, End int
+
+	GC  float64 // GC fraction of the window.
+	GCZ float64 // Z-score of GC relative to all windows.
+
+	// Tetra is the distance between the relative tetranucleotide
+	// frequencies of the window and those of the whole sequence,
+	// expressed as the sum of absolute differences.
+	Tetra  float64
+	TetraZ float64 // Z-score of Tetra relative to all windows.
+}
+
+// Anomalous returns whether the window meets the composition thresholds in p.
+func (w Window) Anomalous(p Params) bool {
+	return math.Abs(w.GCZ) >= p.MinGCZ || w.TetraZ >= p.MinTetraZ
+}
+
+// Scan returns the compositional statistics for windows of s as described by
+// the Window and Step fields of p. The alphabet of s must be a four letter
+// alphabet with G and C at indexes 1 and 2 as is the case for alphabet.DNA.
+func Scan(s *linear.Seq, p Params) ([]Window, error) {
+	switch {
+	case s.Alpha.Len() != 4:
+		return nil, ErrBadAlphabet
+	case p.Window < 4 || p.Step < 1:
+		return nil, ErrBadWindow
+	case s.Len() < p.Window:
+		return nil, ErrShortSeq
+	}
+	index := s.Alpha.LetterIndex()
+
+	global := tetraCounts(s.Seq, index)
+	gFreq := relative(global[:])
+
+	var ws []Window
+	for start := 0; start+p.Window <= s.Len(); start += p.Step {
+		end := start + p.Window
+		counts := tetraCounts(s.Seq[start:end], index)
+		var gc, valid int
+		for _, l := range s.Seq[start:end] {
+			switch index[l] {
+			case 1, 2:
+				gc++
+				fallthrough
+			case 0, 3:
+				valid++
+			}
+		}
+		w := Window{Start: start, End: end}
+		if valid > 0 {
+			w.GC = float64(gc) / float64(valid)
+		}
+		for i, f := range relative(counts[:]) {
+			w.Tetra += math.Abs(f - gFreq[i])
+		}
+		ws = append(ws, w)
+	}
+
+	gcMean, gcSD := meanSD(ws, func(w Window) float64 { return w.GC })
+	tMean, tSD := meanSD(ws, func(w Window) float64 { return w.Tetra })
+	for i := range ws {
+		if gcSD > 0 {
+			ws[i].GCZ = (ws[i].GC - gcMean) / gcSD
+		}
+		if tSD > 0 {
+			ws[i].TetraZ = (ws[i].Tetra - tMean) / tSD
+		}
+	}
+
+	return ws, nil
+}
+
+func tetraCounts(l alphabet.Letters, index alphabet.Index) (c [tetra]int) {
+	var (
+		kmer  int
+		valid int
+	)
+	for _, b := range l {
+		i := index[b]
+		if i < 0 {
+			valid = 0
+			continue
+		}
+		kmer = (kmer<<2 | i) & (tetra - 1)
+		if valid++; valid >= 4 {
+			c[kmer]++
+		}
+	}
+	return c
+}
+
+func relative(c []int) []float64 {
+	var sum int
+	for _, v := range c {
+		sum += v
+	}
+	f := make([]float64, len(c))
+	if sum == 0 {
+		return f
+	}
+	for i, v := range c {
+		f[i] = float64(v) / float64(sum)
+	}
+	return f
+}
+
+func meanSD(ws []Window, v func(Window) float64) (mean, sd float64) {
+	for _, w := range ws {
+		mean += v(w)
+	}
+	mean /= float64(len(ws))
+	for _, w := range ws {
+		d := v(w) - mean
+		sd += d * d
+	}
+	if len(ws) > 1 {
+		sd = math.Sqrt(sd / float64(len(ws)-1))
+	}
+	return mean, sd
+}
+
+// A Region is a candidate genomic island.
+type Region struct {
+	Loc         feat.Feature
+	RegionStart int
+	RegionEnd   int
+
+	Score float64
+
+	GCZ    float64 // Mean absolute GC z-score of windows in the region.
+	TetraZ float64 // Mean tetranucleotide deviation z-score of windows in the region.
+
+	TRNA      []feat.Feature // tRNA features flanking the region.
+	Integrase []feat.Feature // Integrase features within or flanking the region.
+}
+
+func (r *Region) Start() int { return r.RegionStart }
+func (r *Region) End() int   { return r.RegionEnd }
+func (r *Region) Len() int   { return r.RegionEnd - r.RegionStart }
+func (r *Region) Name() string {
+	var name string
+	if r.Loc != nil {
+		name = r.Loc.Name()
+	}
+	return fmt.Sprintf("%s:[%d,%d)", name, r.RegionStart, r.RegionEnd)
+}
+func (r *Region) Description() string    { return "genomic island" }
+func (r *Region) Location() feat.Feature { return r.Loc }
+
+// Find returns candidate genomic islands in s. Anomalous windows, as determined by
+// Scan and Window.Anomalous, are merged into regions which are then scored as
+//
+//	GCWeight*GCZ + TetraWeight*TetraZ + TRNAWeight*[tRNA at boundary] + IntegraseWeight*[integrase hit]
+//
+// where the bracketed terms are 1 if the evidence is present and 0 otherwise. tRNA
+// features are counted as evidence when they lie within p.Flank of either region
+// boundary, and integrase features are counted when they overlap the region extended
+// by p.Flank. Regions shorter than p.MinLen or scoring below p.MinScore are not returned.
+// The coordinates of trna and integrase features are taken to be relative to s.
+func Find(s *linear.Seq, trna, integrase []feat.Feature, p Params) ([]*Region, error) {
+	ws, err := Scan(s, p)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rs   []*Region
+		r    *Region
+		n    int
+		last = -1
+	)
+	flush := func() {
+		if r == nil {
+			return
+		}
+		r.GCZ /= float64(n)
+		r.TetraZ /= float64(n)
+		if r.Len() >= p.MinLen {
+			r.evidence(trna, integrase, p)
+			if r.Score >= p.MinScore {
+				rs = append(rs, r)
+			}
+		}
+		r, n = nil, 0
+	}
+	for i, w := range ws {
+		if !w.Anomalous(p) {
+			continue
+		}
+		if r != nil && i != last+1 {
+			flush()
+		}
+		if r == nil {
+			r = &Region{Loc: s, RegionStart: w.Start}
+		}
+		r.RegionEnd = w.End
+		r.GCZ += math.Abs(w.GCZ)
+		r.TetraZ += w.TetraZ
+		n++
+		last = i
+	}
+	flush()
+
+	return rs, nil
+}
+
+func (r *Region) evidence(trna, integrase []feat.Feature, p Params) {
+	for _, t := range trna {
+		if near(t, r.RegionStart, p.Flank) || near(t, r.RegionEnd, p.Flank) {
+			r.TRNA = append(r.TRNA, t)
+		}
+	}
+	for _, in := range integrase {
+		if in.End() > r.RegionStart-p.Flank && in.Start() < r.RegionEnd+p.Flank {
+			r.Integrase = append(r.Integrase, in)
+		}
+	}
+	r.Score = p.GCWeight*r.GCZ + p.TetraWeight*r.TetraZ
+	if len(r.TRNA) != 0 {
+		r.Score += p.TRNAWeight
+	}
+	if len(r.Integrase) != 0 {
+		r.Score += p.IntegraseWeight
+	}
+}
+
+// near returns whether f lies within d of pos.
+func near(f feat.Feature, pos, d int) bool {
+	return f.End() > pos-d && f.Start() < pos+d
+}
+
+// Series returns the GC fraction, GC Z-score, tetranucleotide distance and
+// tetranucleotide Z-score tracks of the windows ws of the sequence named ref.
+func Series(ref string, ws []Window) []*seriesio.Series {
+	track := func(name, label string, v func(Window) float64) *seriesio.Series {
+		s := &seriesio.Series{
+			Name:   name,
+			Kind:   seriesio.Track,
+			Ref:    ref,
+			X:      seriesio.Axis{Label: "position", Unit: "bp"},
+			Y:      seriesio.Axis{Label: label},
+			Points: make([]seriesio.Point, len(ws)),
+		}
+		for i, w := range ws {
+			s.Points[i] = seriesio.Point{X: float64(w.Start), End: float64(w.End), Y: v(w)}
+		}
+		return s
+	}
+	return []*seriesio.Series{
+		track("GC", "GC fraction", func(w Window) float64 { return w.GC }),
+		track("GCZ", "GC Z-score", func(w Window) float64 { return w.GCZ }),
+		track("Tetra", "tetranucleotide distance", func(w Window) float64 { return w.Tetra }),
+		track("TetraZ", "tetranucleotide distance Z-score", func(w Window) float64 { return w.TetraZ }),
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package island
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type feature struct{ start, end int }
+
+func (f feature) Start() int             { return f.start }
+func (f feature) End() int               { return f.end }
+func (f feature) Len() int               { return f.end - f.start }
+func (f feature) Name() string           { return "" }
+func (f feature) Description() string    { return "" }
+func (f feature) Location() feat.Feature { return nil }
+
+// randomSeq returns a sequence with the specified GC fraction.
+func randomSeq(rnd *rand.Rand, n int, gc float64) alphabet.Letters {
+	l := make(alphabet.Letters, n)
+	for i := range l {
+		if rnd.Float64() < gc {
+			l[i] = alphabet.Letter("gc"[rnd.Intn(2)])
+		} else {
+			l[i] = alphabet.Letter("at"[rnd.Intn(2)])
+		}
+	}
+	return l
+}
+
+func (s *S) TestFind(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	var l alphabet.Letters
+	l = append(l, randomSeq(rnd, 40000, 0.4)...)
+	l = append(l, randomSeq(rnd, 20000, 0.7)...)
+	l = append(l, randomSeq(rnd, 40000, 0.4)...)
+	sq := linear.NewSeq("genome", l, alphabet.DNA)
+
+	ws, err := Scan(sq, DefaultParams)
+	c.Assert(err, check.Equals, nil)
+	c.Check(len(ws), check.Equals, 96)
+
+	ss := Series("genome", ws)
+	c.Assert(ss, check.HasLen, 4)
+	for _, s := range ss {
+		c.Check(s.Ref, check.Equals, "genome")
+		c.Check(s.Points, check.HasLen, len(ws))
+	}
+	c.Check(ss[0].Points[1].X, check.Equals, float64(ws[1].Start))
+	c.Check(ss[0].Points[1].End, check.Equals, float64(ws[1].End))
+	c.Check(ss[0].Points[1].Y, check.Equals, ws[1].GC)
+
+	trna := []feat.Feature{feature{39000, 39076}, feature{80000, 80076}}
+	integrase := []feat.Feature{feature{42000, 43000}}
+	rs, err := Find(sq, trna, integrase, DefaultParams)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(rs), check.Equals, 1)
+	r := rs[0]
+	c.Check(r.Start() > 35000 && r.Start() <= 40000, check.Equals, true, check.Commentf("start=%d", r.Start()))
+	c.Check(r.End() >= 60000 && r.End() < 65000, check.Equals, true, check.Commentf("end=%d", r.End()))
+	c.Check(r.TRNA, check.DeepEquals, trna[:1])
+	c.Check(r.Integrase, check.DeepEquals, integrase)
+	c.Check(r.Score > DefaultParams.TRNAWeight+DefaultParams.IntegraseWeight, check.Equals, true)
+
+	p := DefaultParams
+	p.MinScore = 100
+	rs, err = Find(sq, trna, integrase, p)
+	c.Assert(err, check.Equals, nil)
+	c.Check(len(rs), check.Equals, 0)
+}
+
+func (s *S) TestScanErrors(c *check.C) {
+	sq := linear.NewSeq("short", alphabet.Letters("acgtacgt"), alphabet.DNA)
+	_, err := Scan(sq, DefaultParams)
+	c.Check(err, check.Equals, ErrShortSeq)
+	_, err = Scan(sq, Params{Window: 4, Step: 0})
+	c.Check(err, check.Equals, ErrBadWindow)
+	_, err = Scan(linear.NewSeq("prot", alphabet.Letters("acgtacgt"), alphabet.Protein), Params{Window: 4, Step: 1})
+	c.Check(err, check.Equals, ErrBadAlphabet)
+}
@@ -0,0 +1,216 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package markov provides order-k Markov chain background models of sequence
+// composition for the assessment of motif significance, compositional
+// anomaly detection and sequence simulation.
+package markov
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"errors"
+	"math"
+	"math/rand"
+)
+
+var (
+	ErrBadOrder = errors.New("markov: order out of range")
+	ErrNoData   = errors.New("markov: no letters to estimate from")
+)
+
+// maxTable is the maximum number of conditional probabilities held by the
+// highest order table of a Model.
+const maxTable = 1 << 26
+
+// A Model is an order-k Markov chain model of sequences of letters in an
+// alphabet. The probability of each letter is conditioned on the preceding k
+// letters. Letters closer than k to the start of a sequence, or to a letter
+// not in the alphabet, are conditioned on the shorter available context
+// using the lower order tables of the model.
+type Model struct {
+	alpha alphabet.Alphabet
+	index alphabet.Index
+	order int
+
+	// probs holds the conditional letter probabilities
+	// of each order from zero to the order of the model.
+	// The probability of the letter with index i in the
+	// context c of length o, encoded as a base n number
+	// of letter indexes with the most recent letter
+	// least significant, is probs[o][c*n+i].
+	probs [][]float64
+}
+
+// Estimate returns an order k Markov model of the letters in seqs in the
+// alphabet a. Counts are incremented by pseudo before conversion to
+// probabilities, and contexts that are not observed are given uniform letter
+// probabilities. Letters not in a, such as ambiguous letters in alphabets that
+// do not include them, break the sequences into separate runs.
+func Estimate(a alphabet.Alphabet, k int, pseudo float64, seqs ...alphabet.Letters) (*Model, error) {
+	n := a.Len()
+	if k < 0 || math.Pow(float64(n), float64(k+1)) > maxTable {
+		return nil, ErrBadOrder
+	}
+	m := &Model{alpha: a, index: a.LetterIndex(), order: k, probs: make([][]float64, k+1)}
+	size := n
+	for o := range m.probs {
+		m.probs[o] = make([]float64, size)
+		size *= n
+	}
+
+	var total int
+	for _, s := range seqs {
+		var ctx, run int
+		for _, l := range s {
+			i := m.index[l]
+			if i < 0 {
+				ctx, run = 0, 0
+				continue
+			}
+			mod := 1
+			for o := 0; o <= k && o <= run; o++ {
+				m.probs[o][(ctx%mod)*n+i]++
+				mod *= n
+			}
+			ctx = m.push(ctx, i)
+			run++
+			total++
+		}
+	}
+	if total == 0 {
+		return nil, ErrNoData
+	}
+
+	for _, t := range m.probs {
+		for c := 0; c < len(t); c += n {
+			row := t[c : c+n]
+			var sum float64
+			for j := range row {
+				row[j] += pseudo
+				sum += row[j]
+			}
+			for j := range row {
+				if sum == 0 {
+					row[j] = 1 / float64(n)
+				} else {
+					row[j] /= sum
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+// push returns the context code ctx extended by the letter with index i and
+// truncated to the order of the model.
+func (m *Model) push(ctx, i int) int {
+	if m.order == 0 {
+		return 0
+	}
+	n := m.alpha.Len()
+	return (ctx*n + i) % (len(m.probs[m.order-1]))
+}
+
+// Alphabet returns the alphabet of the model.
+func (m *Model) Alphabet() alphabet.Alphabet { return m.alpha }
+
+// Order returns the order of the model.
+func (m *Model) Order() int { return m.order }
+
+// Background returns the order zero letter frequencies of the model.
+func (m *Model) Background() *alphabet.Freqs {
+	f := alphabet.NewFreqs(m.alpha)
+	copy(f.Vector(), m.probs[0])
+	return f
+}
+
+// Prob returns the probability of l following the letters in context. Only
+// the last Order letters of context following any letter not in the alphabet
+// are used. If l is not in the alphabet, Prob returns zero.
+func (m *Model) Prob(context alphabet.Letters, l alphabet.Letter) float64 {
+	i := m.index[l]
+	if i < 0 {
+		return 0
+	}
+	n := m.alpha.Len()
+	var ctx, o int
+	for j, mul := len(context)-1, 1; j >= 0 && o < m.order; j, mul = j-1, mul*n {
+		c := m.index[context[j]]
+		if c < 0 {
+			break
+		}
+		ctx += c * mul
+		o++
+	}
+	return m.probs[o][ctx*n+i]
+}
+
+// LogLikelihood returns the natural log likelihood of s under the model.
+// Letters not in the alphabet are skipped and break the context.
+func (m *Model) LogLikelihood(s alphabet.Letters) float64 {
+	n := m.alpha.Len()
+	var (
+		ll       float64
+		ctx, run int
+	)
+	for _, l := range s {
+		i := m.index[l]
+		if i < 0 {
+			ctx, run = 0, 0
+			continue
+		}
+		o := run
+		if o > m.order {
+			o = m.order
+		}
+		ll += math.Log(m.probs[o][(ctx%m.mod(o))*n+i])
+		ctx = m.push(ctx, i)
+		run++
+	}
+	return ll
+}
+
+// mod returns the number of contexts of length o.
+func (m *Model) mod(o int) int {
+	return len(m.probs[o]) / m.alpha.Len()
+}
+
+// LogOdds returns the natural log likelihood ratio of s under the models fg
+// and bg, a measure of how much better s is explained by fg than by bg.
+func LogOdds(fg, bg *Model, s alphabet.Letters) float64 {
+	return fg.LogLikelihood(s) - bg.LogLikelihood(s)
+}
+
+// Sample returns a sequence of n letters sampled from the model using
+// random numbers from rnd. If rnd is nil, the default source of the math/rand
+// package is used.
+func (m *Model) Sample(n int, rnd *rand.Rand) alphabet.Letters {
+	float := rand.Float64
+	if rnd != nil {
+		float = rnd.Float64
+	}
+	let := m.alpha.Len()
+	s := make(alphabet.Letters, n)
+	var ctx int
+	for p := range s {
+		o := p
+		if o > m.order {
+			o = m.order
+		}
+		row := m.probs[o][(ctx%m.mod(o))*let:][:let]
+		u := float()
+		i := let - 1
+		for j, v := range row {
+			if u < v {
+				i = j
+				break
+			}
+			u -= v
+		}
+		s[p] = m.alpha.Letter(i)
+		ctx = m.push(ctx, i)
+	}
+	return s
+}
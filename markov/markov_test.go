@@ -0,0 +1,73 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package markov
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func near(a, b float64) bool { return math.Abs(a-b) < 1e-12 }
+
+func (s *S) TestEstimate(c *check.C) {
+	_, err := Estimate(alphabet.DNA, -1, 0, alphabet.Letters("ACGT"))
+	c.Check(err, check.Equals, ErrBadOrder)
+	_, err = Estimate(alphabet.DNA, 20, 0, alphabet.Letters("ACGT"))
+	c.Check(err, check.Equals, ErrBadOrder)
+	_, err = Estimate(alphabet.DNA, 1, 0, alphabet.Letters("NNN"))
+	c.Check(err, check.Equals, ErrNoData)
+
+	m, err := Estimate(alphabet.DNA, 1, 0, alphabet.Letters("AACANAG"), alphabet.Letters("ct"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(m.Order(), check.Equals, 1)
+	bg := m.Background()
+	c.Check(bg.Freq('A'), check.Equals, 4./8)
+	c.Check(bg.Freq('C'), check.Equals, 2./8)
+
+	// Transitions are AA, AC, CA and AG and CT.
+	c.Check(m.Prob(alphabet.Letters("A"), 'A'), check.Equals, 1./3)
+	c.Check(m.Prob(alphabet.Letters("GA"), 'G'), check.Equals, 1./3)
+	c.Check(m.Prob(alphabet.Letters("C"), 'a'), check.Equals, 1./2)
+	c.Check(m.Prob(alphabet.Letters("G"), 'T'), check.Equals, 1./4)
+	c.Check(m.Prob(alphabet.Letters("N"), 'A'), check.Equals, 4./8)
+	c.Check(m.Prob(nil, 'N'), check.Equals, 0.)
+
+	ll := m.LogLikelihood(alphabet.Letters("ACNA"))
+	c.Check(near(ll, math.Log(4./8*1./3*4./8)), check.Equals, true, check.Commentf("got %v", ll))
+
+	p, err := Estimate(alphabet.DNA, 1, 1, alphabet.Letters("AA"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(p.Prob(alphabet.Letters("A"), 'A'), check.Equals, 2./5)
+	c.Check(p.Prob(alphabet.Letters("C"), 'A'), check.Equals, 1./4)
+}
+
+func (s *S) TestSample(c *check.C) {
+	m, err := Estimate(alphabet.DNA, 2, 0, alphabet.Letters("ACGACGACGACGACG"))
+	c.Assert(err, check.Equals, nil)
+	smp := m.Sample(12, rand.New(rand.NewSource(1)))
+	c.Assert(smp, check.HasLen, 12)
+	// The second order model is deterministic after
+	// the first two letters.
+	for i := 2; i < len(smp); i++ {
+		c.Check(m.Prob(smp[i-2:i], smp[i]), check.Equals, 1.)
+	}
+	c.Check(math.IsInf(m.LogLikelihood(alphabet.Letters("ACGT")), -1), check.Equals, true)
+
+	bg, err := Estimate(alphabet.DNA, 0, 1, alphabet.Letters("ACGT"))
+	c.Assert(err, check.Equals, nil)
+	c.Check(LogOdds(m, bg, smp[2:]) > 0, check.Equals, true)
+}
@@ -0,0 +1,579 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package motif provides de novo discovery of ungapped sequence motifs by
+// expectation maximization in the style of MEME (Bailey and Elkan, Proc Int
+// Conf Intell Syst Mol Biol 2:28-36, 1994).
+//
+// Sequences are modeled as background sequence, described by a Markov model,
+// containing sites of a motif of fixed width, described by a position-specific
+// letter frequency matrix. In the one occurrence per sequence (OOPS) model
+// each sequence contains exactly one site, and in the zero or one occurrence
+// per sequence (ZOOPS) model each sequence contains at most one site. Only
+// the given strand of each sequence is searched.
+package motif
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/markov"
+	"github.com/biogo/biogo/pwm"
+
+	"errors"
+	"math"
+)
+
+var (
+	ErrBadWidth    = errors.New("motif: bad motif width")
+	ErrTooFewSeqs  = errors.New("motif: too few sequences")
+	ErrBackground  = errors.New("motif: background alphabet mismatch")
+	ErrShortSeqs   = errors.New("motif: too few sequences longer than motif width")
+	ErrUnknownMode = errors.New("motif: unknown site distribution model")
+)
+
+// Mode specifies the distribution of motif sites among sequences.
+type Mode int
+
+const (
+	// OOPS specifies exactly one site per sequence.
+	OOPS Mode = iota
+	// ZOOPS specifies zero or one site per sequence.
+	ZOOPS
+)
+
+// Params describes the parameters of motif discovery.
+type Params struct {
+	// Width is the width of motifs.
+	Width int
+
+	// Mode is the site distribution model.
+	Mode Mode
+
+	// Motifs is the number of motifs to find. The sites
+	// of each motif found are excluded from the search
+	// for subsequent motifs.
+	Motifs int
+
+	// Starts is the maximum number of sequence words
+	// used as starting points for EM. The starting
+	// point giving the highest likelihood after one
+	// iteration is refined.
+	Starts int
+
+	// Iterations is the maximum number of EM iterations
+	// and Tolerance is the maximum change in any motif
+	// frequency at convergence.
+	Iterations int
+	Tolerance  float64
+
+	// Pseudo is the weight of the background frequencies
+	// added to the site counts of each motif column.
+	Pseudo float64
+
+	// Background is the background model. If nil, an
+	// order zero model is estimated from the sequences.
+	Background *markov.Model
+}
+
+// DefaultParams are the default motif discovery parameters for a motif of
+// width 8.
+var DefaultParams = Params{
+	Width:      8,
+	Mode:       ZOOPS,
+	Motifs:     1,
+	Starts:     200,
+	Iterations: 200,
+	Tolerance:  1e-6,
+	Pseudo:     0.1,
+}
+
+// A Site is a predicted motif site.
+type Site struct {
+	Seq        int     // Index of the sequence holding the site.
+	Start, End int     // Position of the site in the sequence.
+	Prob       float64 // Posterior probability of the site.
+	LogOdds    float64 // Log-odds score of the site in nats.
+}
+
+// A Motif is a discovered motif.
+type Motif struct {
+	// Freqs holds the letter frequencies of each
+	// motif column indexed by alphabet index.
+	Freqs [][]float64
+
+	// Sites holds the predicted site of each
+	// sequence predicted to have a site.
+	Sites []Site
+
+	// LLR is the log likelihood ratio of the sites
+	// under the motif and background models in nats.
+	LLR float64
+
+	// EValue is an approximation of the expected
+	// number of motifs with LLR at least as large
+	// in random sequences of the same lengths. Its
+	// p-value is calculated by treating 2*LLR as
+	// a chi-squared statistic.
+	EValue float64
+}
+
+// Width returns the width of the motif.
+func (m *Motif) Width() int { return len(m.Freqs) }
+
+// PWM returns a position weight matrix for the motif.
+func (m *Motif) PWM() *pwm.PWM {
+	f := make([][]float64, len(m.Freqs))
+	for i, r := range m.Freqs {
+		f[i] = append([]float64(nil), r...)
+	}
+	return pwm.New(f)
+}
+
+// Discover returns motifs found in seqs, which are in the alphabet a.
+func Discover(seqs []alphabet.Letters, a alphabet.Alphabet, p Params) ([]*Motif, error) {
+	if p.Mode != OOPS && p.Mode != ZOOPS {
+		return nil, ErrUnknownMode
+	}
+	if p.Width < 1 {
+		return nil, ErrBadWidth
+	}
+	if len(seqs) < 2 {
+		return nil, ErrTooFewSeqs
+	}
+	bg := p.Background
+	if bg == nil {
+		var err error
+		bg, err = markov.Estimate(a, 0, 1, seqs...)
+		if err != nil {
+			return nil, err
+		}
+	} else if bg.Alphabet() != a {
+		return nil, ErrBackground
+	}
+
+	d := newData(seqs, a, bg, p)
+	if len(d.seqs) < 2 {
+		return nil, ErrShortSeqs
+	}
+	var ms []*Motif
+	for n := 0; n < p.Motifs || (p.Motifs < 1 && n == 0); n++ {
+		m := d.discover()
+		if m == nil {
+			break
+		}
+		ms = append(ms, m)
+		d.erase(m)
+	}
+	return ms, nil
+}
+
+// data holds the encoded sequences of a motif search.
+type data struct {
+	p Params
+	n int // Alphabet length.
+
+	// seqs holds the sequences with at least
+	// one window, as alphabet indexes with
+	// -1 for letters not in the alphabet,
+	// and idx the original index of each.
+	seqs [][]int
+	idx  []int
+
+	// bg holds the background log probability
+	// of each letter given its context and back
+	// holds the background letter frequencies.
+	bg   [][]float64
+	back []float64
+
+	// valid marks windows available for sites.
+	valid [][]bool
+}
+
+func newData(seqs []alphabet.Letters, a alphabet.Alphabet, bg *markov.Model, p Params) *data {
+	index := a.LetterIndex()
+	d := &data{p: p, n: a.Len(), back: bg.Background().Vector()}
+	for i, s := range seqs {
+		if len(s) < p.Width {
+			continue
+		}
+		enc := make([]int, len(s))
+		lp := make([]float64, len(s))
+		for j, l := range s {
+			enc[j] = index[l]
+			if enc[j] >= 0 {
+				lp[j] = math.Log(bg.Prob(s[:j], l))
+			}
+		}
+		valid := make([]bool, len(s)-p.Width+1)
+		var any bool
+	window:
+		for j := range valid {
+			for k := 0; k < p.Width; k++ {
+				if enc[j+k] < 0 {
+					continue window
+				}
+			}
+			valid[j] = true
+			any = true
+		}
+		if !any {
+			continue
+		}
+		d.seqs = append(d.seqs, enc)
+		d.idx = append(d.idx, i)
+		d.bg = append(d.bg, lp)
+		d.valid = append(d.valid, valid)
+	}
+	return d
+}
+
+// model is the state of an EM search.
+type model struct {
+	freqs [][]float64
+	gamma float64
+
+	// z holds the posterior site probabilities.
+	z [][]float64
+}
+
+// discover returns the best motif found in d, or nil if no windows remain.
+func (d *data) discover() *Motif {
+	starts := d.starts()
+	if len(starts) == 0 {
+		return nil
+	}
+	var (
+		best   *model
+		bestLL = math.Inf(-1)
+	)
+	for _, st := range starts {
+		m := d.initial(st)
+		d.iterate(m)
+		if ll := d.expect(m); ll > bestLL {
+			best, bestLL = m, ll
+		}
+	}
+	bestLL = d.converge(best)
+
+	// EM may converge to a phase shifted version
+	// of a motif, so shifted models are refined
+	// and kept if they are better.
+	for shift := -d.p.Width / 2; shift <= d.p.Width/2; shift++ {
+		if shift == 0 {
+			continue
+		}
+		m := d.shifted(best, shift)
+		if ll := d.converge(m); ll > bestLL {
+			best, bestLL = m, ll
+		}
+	}
+	d.expect(best)
+	return d.motif(best)
+}
+
+// converge iterates EM on m until convergence, returning the final log
+// likelihood ratio.
+func (d *data) converge(m *model) float64 {
+	for i := 0; i < d.p.Iterations; i++ {
+		old := make([][]float64, len(m.freqs))
+		for k, r := range m.freqs {
+			old[k] = append([]float64(nil), r...)
+		}
+		d.iterate(m)
+		if maxDiff(old, m.freqs) < d.p.Tolerance {
+			break
+		}
+	}
+	return d.expect(m)
+}
+
+// shifted returns a copy of m with motif columns shifted by shift positions.
+// Columns shifted in are given background frequencies.
+func (d *data) shifted(m *model, shift int) *model {
+	c := &model{freqs: make([][]float64, len(m.freqs)), gamma: m.gamma, z: make([][]float64, len(m.z))}
+	for k := range c.freqs {
+		if o := k + shift; 0 <= o && o < len(m.freqs) {
+			c.freqs[k] = append([]float64(nil), m.freqs[o]...)
+		} else {
+			c.freqs[k] = append([]float64(nil), d.back...)
+		}
+	}
+	for i, z := range m.z {
+		c.z[i] = make([]float64, len(z))
+	}
+	return c
+}
+
+// starts returns the positions of up to p.Starts available windows, evenly
+// spaced through the sequences.
+func (d *data) starts() [][2]int {
+	var all [][2]int
+	for i, v := range d.valid {
+		for j, ok := range v {
+			if ok {
+				all = append(all, [2]int{i, j})
+			}
+		}
+	}
+	n := d.p.Starts
+	if n < 1 || n >= len(all) {
+		return all
+	}
+	st := make([][2]int, n)
+	for i := range st {
+		st[i] = all[i*len(all)/n]
+	}
+	return st
+}
+
+// initial returns a model initialized from the word at st.
+func (d *data) initial(st [2]int) *model {
+	const w = 0.5
+	m := &model{freqs: make([][]float64, d.p.Width), gamma: 1}
+	if d.p.Mode == ZOOPS {
+		m.gamma = 0.5
+	}
+	for k := range m.freqs {
+		m.freqs[k] = make([]float64, d.n)
+		for a := range m.freqs[k] {
+			m.freqs[k][a] = (1 - w) / float64(d.n-1)
+		}
+		m.freqs[k][d.seqs[st[0]][st[1]+k]] = w
+	}
+	m.z = make([][]float64, len(d.seqs))
+	for i, v := range d.valid {
+		m.z[i] = make([]float64, len(v))
+	}
+	return m
+}
+
+// logOdds returns the log-odds of the window at j of sequence i under the
+// motif frequencies f.
+func (d *data) logOdds(f [][]float64, i, j int) float64 {
+	var s float64
+	for k, r := range f {
+		s += math.Log(r[d.seqs[i][j+k]]) - d.bg[i][j+k]
+	}
+	return s
+}
+
+// expect performs the E step, returning the log likelihood ratio of the data
+// under the model and the background.
+func (d *data) expect(m *model) float64 {
+	var ll float64
+	for i, v := range d.valid {
+		z := m.z[i]
+		var (
+			n   int
+			max = math.Inf(-1)
+		)
+		for j, ok := range v {
+			if !ok {
+				z[j] = math.Inf(-1)
+				continue
+			}
+			n++
+			z[j] = d.logOdds(m.freqs, i, j)
+			if z[j] > max {
+				max = z[j]
+			}
+		}
+		var sum float64
+		for j := range z {
+			z[j] = math.Exp(z[j] - max)
+			sum += z[j]
+		}
+		// The likelihood ratio of the sequence is
+		// (1-gamma) + gamma/n * sum*exp(max).
+		site := math.Log(m.gamma/float64(n)) + math.Log(sum) + max
+		var norm float64
+		if m.gamma < 1 {
+			norm = logAdd(math.Log(1-m.gamma), site)
+		} else {
+			norm = site
+		}
+		ll += norm
+		scale := math.Exp(site-norm) / sum
+		for j := range z {
+			z[j] *= scale
+		}
+	}
+	return ll
+}
+
+// maximize performs the M step.
+func (d *data) maximize(m *model) {
+	for k, r := range m.freqs {
+		for a := range r {
+			r[a] = d.p.Pseudo * d.back[a]
+		}
+		for i, z := range m.z {
+			for j, p := range z {
+				if p != 0 {
+					r[d.seqs[i][j+k]] += p
+				}
+			}
+		}
+		var sum float64
+		for _, v := range r {
+			sum += v
+		}
+		for a := range r {
+			r[a] /= sum
+		}
+	}
+	if d.p.Mode == ZOOPS {
+		var g float64
+		for _, z := range m.z {
+			for _, p := range z {
+				g += p
+			}
+		}
+		m.gamma = math.Min(g/float64(len(m.z)), 1-1e-6)
+	}
+}
+
+// iterate performs an EM iteration, returning the log likelihood ratio of the
+// E step.
+func (d *data) iterate(m *model) float64 {
+	ll := d.expect(m)
+	d.maximize(m)
+	return ll
+}
+
+// motif returns the motif described by the model after an E step.
+func (d *data) motif(m *model) *Motif {
+	mot := &Motif{Freqs: m.freqs}
+	logSpace := 0.
+	for i, z := range m.z {
+		var (
+			best     = -1
+			sum, max float64
+		)
+		for j, p := range z {
+			sum += p
+			if p > max {
+				best, max = j, p
+			}
+		}
+		var n int
+		for _, ok := range d.valid[i] {
+			if ok {
+				n++
+			}
+		}
+		logSpace += math.Log(float64(n))
+		if best < 0 || (d.p.Mode == ZOOPS && sum < 0.5) {
+			continue
+		}
+		lo := d.logOdds(m.freqs, i, best)
+		mot.Sites = append(mot.Sites, Site{
+			Seq:     d.idx[i],
+			Start:   best,
+			End:     best + d.p.Width,
+			Prob:    max,
+			LogOdds: lo,
+		})
+		mot.LLR += lo
+	}
+	df := float64(d.p.Width * (d.n - 1))
+	mot.EValue = math.Exp(logGammaQ(df/2, math.Max(mot.LLR, 0)) + logSpace)
+	return mot
+}
+
+// erase excludes the windows overlapping the sites of m from future searches.
+func (d *data) erase(m *Motif) {
+	pos := make(map[int]int, len(d.idx))
+	for i, o := range d.idx {
+		pos[o] = i
+	}
+	for _, s := range m.Sites {
+		v := d.valid[pos[s.Seq]]
+		for j := s.Start - d.p.Width + 1; j < s.End; j++ {
+			if 0 <= j && j < len(v) {
+				v[j] = false
+			}
+		}
+	}
+	// Sequences without windows cannot hold sites
+	// and must be removed.
+	var n int
+	for i, v := range d.valid {
+		for _, ok := range v {
+			if ok {
+				d.seqs[n], d.idx[n], d.bg[n], d.valid[n] = d.seqs[i], d.idx[i], d.bg[i], v
+				n++
+				break
+			}
+		}
+	}
+	d.seqs, d.idx, d.bg, d.valid = d.seqs[:n], d.idx[:n], d.bg[:n], d.valid[:n]
+}
+
+func maxDiff(a, b [][]float64) float64 {
+	var d float64
+	for i := range a {
+		for j := range a[i] {
+			d = math.Max(d, math.Abs(a[i][j]-b[i][j]))
+		}
+	}
+	return d
+}
+
+// logAdd returns log(exp(a)+exp(b)).
+func logAdd(a, b float64) float64 {
+	if a < b {
+		a, b = b, a
+	}
+	return a + math.Log1p(math.Exp(b-a))
+}
+
+// logGammaQ returns the log of the regularized upper incomplete gamma function
+// Q(a, x).
+func logGammaQ(a, x float64) float64 {
+	const (
+		eps   = 1e-15
+		iters = 1000
+	)
+	lg, _ := math.Lgamma(a)
+	if x <= 0 {
+		return 0
+	}
+	if x < a+1 {
+		// Series for P(a, x).
+		sum, del := 1/a, 1/a
+		for n := 1; n < iters; n++ {
+			del *= x / (a + float64(n))
+			sum += del
+			if math.Abs(del) < math.Abs(sum)*eps {
+				break
+			}
+		}
+		return math.Log1p(-math.Exp(math.Log(sum) - x + a*math.Log(x) - lg))
+	}
+	// Continued fraction for Q(a, x).
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	dd := 1 / b
+	h := dd
+	for i := 1; i < iters; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		dd = an*dd + b
+		if math.Abs(dd) < tiny {
+			dd = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		dd = 1 / dd
+		del := dd * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return math.Log(h) - x + a*math.Log(x) - lg
+}
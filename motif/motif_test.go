@@ -0,0 +1,114 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package motif
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+// planted returns n random sequences of length l with the motif inserted in
+// those sequences for which has returns true, and the positions of the sites.
+func planted(rnd *rand.Rand, n, l int, motif string, has func(int) bool) ([]alphabet.Letters, map[int]int) {
+	seqs := make([]alphabet.Letters, n)
+	sites := make(map[int]int)
+	for i := range seqs {
+		s := make(alphabet.Letters, l)
+		for j := range s {
+			s[j] = alphabet.Letter("ACGT"[rnd.Intn(4)])
+		}
+		if has(i) {
+			p := rnd.Intn(l - len(motif) + 1)
+			copy(s[p:], alphabet.Letters(motif))
+			sites[i] = p
+		}
+		seqs[i] = s
+	}
+	return seqs, sites
+}
+
+func (s *S) TestOOPS(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	seqs, sites := planted(rnd, 12, 80, "TGACGTCA", func(int) bool { return true })
+	p := DefaultParams
+	p.Mode = OOPS
+	ms, err := Discover(seqs, alphabet.DNA, p)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(ms, check.HasLen, 1)
+	m := ms[0]
+	c.Check(m.Width(), check.Equals, 8)
+	c.Assert(m.Sites, check.HasLen, len(seqs))
+	for _, st := range m.Sites {
+		c.Check(st.Start, check.Equals, sites[st.Seq], check.Commentf("Seq %d", st.Seq))
+		c.Check(st.Prob > 0.9, check.Equals, true, check.Commentf("Seq %d", st.Seq))
+	}
+	for k, l := range "TGACGTCA" {
+		c.Check(m.Freqs[k][alphabet.DNA.IndexOf(alphabet.Letter(l))] > 0.9, check.Equals, true, check.Commentf("Column %d", k))
+	}
+	c.Check(m.EValue < 1e-3, check.Equals, true, check.Commentf("E-value %v", m.EValue))
+	c.Check(m.PWM(), check.NotNil)
+}
+
+func (s *S) TestZOOPS(c *check.C) {
+	rnd := rand.New(rand.NewSource(2))
+	seqs, sites := planted(rnd, 16, 80, "CCATATGG", func(i int) bool { return i%4 != 0 })
+	p := DefaultParams
+	p.Motifs = 2
+	ms, err := Discover(seqs, alphabet.DNA, p)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(ms, check.HasLen, 2)
+	m := ms[0]
+	// All planted sites are found, and any sites
+	// in other sequences are weaker matches.
+	found := make(map[int]int)
+	weakest := math.Inf(1)
+	for _, st := range m.Sites {
+		found[st.Seq] = st.Start
+		if _, ok := sites[st.Seq]; ok {
+			weakest = math.Min(weakest, st.LogOdds)
+		}
+	}
+	for i, pos := range sites {
+		c.Check(found[i], check.Equals, pos, check.Commentf("Seq %d", i))
+	}
+	for _, st := range m.Sites {
+		if _, ok := sites[st.Seq]; !ok {
+			c.Check(st.LogOdds < weakest, check.Equals, true, check.Commentf("Seq %d", st.Seq))
+		}
+	}
+	c.Check(ms[1].EValue > m.EValue, check.Equals, true)
+
+	_, err = Discover(seqs[:1], alphabet.DNA, p)
+	c.Check(err, check.Equals, ErrTooFewSeqs)
+	p.Width = 0
+	_, err = Discover(seqs, alphabet.DNA, p)
+	c.Check(err, check.Equals, ErrBadWidth)
+}
+
+func (s *S) TestLogGammaQ(c *check.C) {
+	for i, t := range []struct {
+		a, x, q float64
+	}{
+		{a: 1, x: 1, q: math.Exp(-1)},
+		{a: 1, x: 10, q: math.Exp(-10)},
+		{a: 0.5, x: 2, q: math.Erfc(math.Sqrt(2))},
+		{a: 3, x: 0.5, q: math.Exp(-0.5) * (1 + 0.5 + 0.125)},
+	} {
+		got := math.Exp(logGammaQ(t.a, t.x))
+		c.Check(math.Abs(got-t.q) < 1e-12, check.Equals, true, check.Commentf("Test %d: got %v want %v", i, got, t.q))
+	}
+}
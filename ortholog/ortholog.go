@@ -0,0 +1,155 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ortholog provides ortholog calling from similarity search results.
+//
+// Orthologs are called as reciprocal best hits (RBH). Given the hits of the
+// genes of genome A searched against genome B and of B against A, a gene a of
+// A and a gene b of B are reciprocal best hits if b is a best hit of a and a is
+// a best hit of b. Near ties are handled by a score ratio: every hit scoring at
+// least the ratio times the best score of its query is considered a best hit,
+// so a gene may take part in more than one ortholog pair.
+package ortholog
+
+import (
+	"github.com/biogo/biogo/io/featio/blast"
+
+	"sort"
+)
+
+// A Hit is a similarity search hit of a query to a subject.
+type Hit struct {
+	Query, Subject string
+	Score          float64
+}
+
+// FromBLAST returns the hits described by the BLAST HSPs in recs, scored by bit
+// score. Multiple HSPs of a query and subject give a single hit with the score
+// of the best HSP.
+func FromBLAST(recs []*blast.Record) []Hit {
+	var (
+		hits  []Hit
+		index = make(map[[2]string]int)
+	)
+	for _, r := range recs {
+		k := [2]string{r.Query.Name(), r.Subject.Name()}
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(hits)
+			hits = append(hits, Hit{Query: k[0], Subject: k[1], Score: r.BitScore})
+			continue
+		}
+		if r.BitScore > hits[i].Score {
+			hits[i].Score = r.BitScore
+		}
+	}
+	return hits
+}
+
+// A Pair is a pair of orthologs called as reciprocal best hits.
+type Pair struct {
+	// A and B are the genes of the first
+	// and second genome.
+	A, B string
+
+	// ScoreAB and ScoreBA are the scores of
+	// the hits of A to B and B to A.
+	ScoreAB, ScoreBA float64
+
+	// Unique is true if B is the only best
+	// hit of A and A is the only best hit
+	// of B.
+	Unique bool
+}
+
+// best holds the best hits of each query.
+type best map[string]map[string]float64
+
+// bestHits returns the hits of each query scoring at least ratio times
+// the best score of the query, and the order of first appearance of the
+// queries.
+func bestHits(hits []Hit, ratio float64) (best, []string) {
+	var (
+		top   = make(map[string]float64)
+		order []string
+	)
+	for _, h := range hits {
+		s, ok := top[h.Query]
+		if !ok {
+			order = append(order, h.Query)
+		}
+		if !ok || h.Score > s {
+			top[h.Query] = h.Score
+		}
+	}
+	b := make(best)
+	for _, h := range hits {
+		if h.Score < ratio*top[h.Query] {
+			continue
+		}
+		m, ok := b[h.Query]
+		if !ok {
+			m = make(map[string]float64)
+			b[h.Query] = m
+		}
+		if s, ok := m[h.Subject]; !ok || h.Score > s {
+			m[h.Subject] = h.Score
+		}
+	}
+	return b, order
+}
+
+// RBH returns the reciprocal best hit ortholog pairs of the hits ab of the genes of
+// one genome against another and the hits ba of the second genome against the first.
+// Hits with a score of at least ratio times the best score of their query are best
+// hits. If ratio is not in (0, 1], 1 is used, so only hits tying the best score are
+// best hits. Pairs are returned in order of the first hit of each query in ab, and
+// then in order of the first best hit of each pair in ab.
+func RBH(ab, ba []Hit, ratio float64) []Pair {
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+	bestAB, order := bestHits(ab, ratio)
+	bestBA, _ := bestHits(ba, ratio)
+	var (
+		pairs []Pair
+		seen  = make(map[[2]string]bool)
+	)
+	for _, h := range ab {
+		sAB, ok := bestAB[h.Query][h.Subject]
+		if !ok {
+			continue
+		}
+		sBA, ok := bestBA[h.Subject][h.Query]
+		if !ok {
+			continue
+		}
+		k := [2]string{h.Query, h.Subject}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		pairs = append(pairs, Pair{
+			A: h.Query, B: h.Subject,
+			ScoreAB: sAB, ScoreBA: sBA,
+			Unique: len(bestAB[h.Query]) == 1 && len(bestBA[h.Subject]) == 1,
+		})
+	}
+	rank := make(map[string]int, len(order))
+	for i, q := range order {
+		rank[q] = i
+	}
+	sort.Stable(pairsByRank{pairs: pairs, rank: rank})
+	return pairs
+}
+
+// pairsByRank sorts pairs by the rank of their A sequence.
+type pairsByRank struct {
+	pairs []Pair
+	rank  map[string]int
+}
+
+func (p pairsByRank) Len() int           { return len(p.pairs) }
+func (p pairsByRank) Less(i, j int) bool { return p.rank[p.pairs[i].A] < p.rank[p.pairs[j].A] }
+func (p pairsByRank) Swap(i, j int)      { p.pairs[i], p.pairs[j] = p.pairs[j], p.pairs[i] }
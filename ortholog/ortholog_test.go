@@ -0,0 +1,58 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ortholog
+
+import (
+	"github.com/biogo/biogo/io/featio/blast"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestRBH(c *check.C) {
+	ab := []Hit{
+		{"a1", "b1", 100},
+		{"a1", "b2", 40},
+		{"a2", "b2", 80},
+		{"a2", "b3", 76},
+		{"a3", "b1", 90},
+		{"a4", "b4", 10},
+	}
+	ba := []Hit{
+		{"b1", "a1", 98},
+		{"b1", "a3", 89},
+		{"b2", "a2", 82},
+		{"b3", "a2", 75},
+		{"b4", "a5", 30},
+	}
+
+	c.Check(RBH(ab, ba, 1), check.DeepEquals, []Pair{
+		{A: "a1", B: "b1", ScoreAB: 100, ScoreBA: 98, Unique: true},
+		{A: "a2", B: "b2", ScoreAB: 80, ScoreBA: 82, Unique: true},
+	})
+	c.Check(RBH(ab, ba, 0.9), check.DeepEquals, []Pair{
+		{A: "a1", B: "b1", ScoreAB: 100, ScoreBA: 98, Unique: false},
+		{A: "a2", B: "b2", ScoreAB: 80, ScoreBA: 82, Unique: false},
+		{A: "a2", B: "b3", ScoreAB: 76, ScoreBA: 75, Unique: false},
+		{A: "a3", B: "b1", ScoreAB: 90, ScoreBA: 89, Unique: false},
+	})
+}
+
+func (s *S) TestFromBLAST(c *check.C) {
+	q, s1, s2 := &blast.Seq{SeqName: "q"}, &blast.Seq{SeqName: "s1"}, &blast.Seq{SeqName: "s2"}
+	c.Check(FromBLAST([]*blast.Record{
+		{Query: q, Subject: s1, BitScore: 50},
+		{Query: q, Subject: s2, BitScore: 60},
+		{Query: q, Subject: s1, BitScore: 70},
+	}), check.DeepEquals, []Hit{{"q", "s1", 70}, {"q", "s2", 60}})
+}
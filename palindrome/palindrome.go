@@ -0,0 +1,246 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package palindrome provides functions for finding inverted repeats and
+// palindromic sequences.
+//
+// Candidate inverted repeats are found by seeding with exact matches between
+// the k-mers of a sequence and the k-mers of its reverse complement. Seeds are
+// then extended inwards towards the spacer and outwards with a limited number
+// of mismatches.
+package palindrome
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/index/kmerindex"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrNotComplementor = errors.New("palindrome: alphabet is not a complementor")
+	ErrBadArm          = errors.New("palindrome: minimum arm length less than seed length")
+	ErrBadSpacer       = errors.New("palindrome: invalid spacer limits")
+	ErrBadMismatch     = errors.New("palindrome: negative mismatch limit")
+)
+
+// Params describes the constraints on inverted repeats reported by Find.
+type Params struct {
+	K int // Seed length used to find candidate arms.
+
+	MinArm int // Minimum length of each arm.
+
+	MinSpacer int // Minimum number of unpaired letters between arms.
+	MaxSpacer int // Maximum number of unpaired letters between arms.
+
+	// MaxMismatch is the maximum number of mismatched pairs allowed
+	// in the outward extension of the arms.
+	MaxMismatch int
+}
+
+// DefaultParams are reasonable parameters for finding hairpins and short
+// transposon terminal inverted repeats.
+var DefaultParams = Params{
+	K:           6,
+	MinArm:      8,
+	MinSpacer:   0,
+	MaxSpacer:   100,
+	MaxMismatch: 1,
+}
+
+func (p Params) check() error {
+	switch {
+	case p.MinArm < p.K:
+		return ErrBadArm
+	case p.MinSpacer < 0, p.MaxSpacer < p.MinSpacer:
+		return ErrBadSpacer
+	case p.MaxMismatch < 0:
+		return ErrBadMismatch
+	}
+	return nil
+}
+
+// An Arm is one of the two arms of an inverted repeat.
+type Arm struct {
+	Repeat     *Feature
+	ArmStart   int
+	ArmEnd     int
+	ArmOrient  feat.Orientation
+	armOrdinal byte
+}
+
+func (a *Arm) Start() int                    { return a.ArmStart }
+func (a *Arm) End() int                      { return a.ArmEnd }
+func (a *Arm) Len() int                      { return a.ArmEnd - a.ArmStart }
+func (a *Arm) Name() string                  { return fmt.Sprintf("%s:%c", a.Repeat.Name(), a.armOrdinal) }
+func (a *Arm) Description() string           { return "inverted repeat arm" }
+func (a *Arm) Location() feat.Feature        { return a.Repeat.Loc }
+func (a *Arm) Orientation() feat.Orientation { return a.ArmOrient }
+
+// A Feature describes an inverted repeat. The left arm [LeftStart, LeftEnd) is
+// the reverse complement of the right arm [RightStart, RightEnd), allowing for
+// Mismatches mismatched pairs.
+type Feature struct {
+	Loc        feat.Feature
+	LeftStart  int
+	LeftEnd    int
+	RightStart int
+	RightEnd   int
+	Mismatches int
+}
+
+func (f *Feature) Start() int { return f.LeftStart }
+func (f *Feature) End() int   { return f.RightEnd }
+func (f *Feature) Len() int   { return f.RightEnd - f.LeftStart }
+func (f *Feature) Name() string {
+	var name string
+	if f.Loc != nil {
+		name = f.Loc.Name()
+	}
+	return fmt.Sprintf("%s:[%d,%d)", name, f.LeftStart, f.RightEnd)
+}
+func (f *Feature) Description() string    { return "inverted repeat" }
+func (f *Feature) Location() feat.Feature { return f.Loc }
+
+// Spacer returns the number of unpaired letters between the arms of the repeat.
+func (f *Feature) Spacer() int { return f.RightStart - f.LeftEnd }
+
+// ArmLen returns the length of each arm of the repeat.
+func (f *Feature) ArmLen() int { return f.LeftEnd - f.LeftStart }
+
+// IsPalindrome returns whether the repeat is a perfect palindrome, that is
+// it has no spacer and no mismatches.
+func (f *Feature) IsPalindrome() bool { return f.Spacer() == 0 && f.Mismatches == 0 }
+
+// Features returns the left and right arms of the repeat.
+func (f *Feature) Features() [2]feat.Feature {
+	return [2]feat.Feature{
+		&Arm{Repeat: f, ArmStart: f.LeftStart, ArmEnd: f.LeftEnd, ArmOrient: feat.Forward, armOrdinal: 'L'},
+		&Arm{Repeat: f, ArmStart: f.RightStart, ArmEnd: f.RightEnd, ArmOrient: feat.Reverse, armOrdinal: 'R'},
+	}
+}
+
+type features []*Feature
+
+func (f features) Len() int { return len(f) }
+func (f features) Less(i, j int) bool {
+	if f[i].LeftStart == f[j].LeftStart {
+		return f[i].RightEnd < f[j].RightEnd
+	}
+	return f[i].LeftStart < f[j].LeftStart
+}
+func (f features) Swap(i, j int) { f[i], f[j] = f[j], f[i] }
+
+// Find returns the inverted repeats in s that satisfy the constraints in p,
+// sorted by start position. The alphabet of s must be a four letter
+// alphabet.Complementor.
+func Find(s *linear.Seq, p Params) ([]*Feature, error) {
+	err := p.check()
+	if err != nil {
+		return nil, err
+	}
+	comp, ok := s.Alpha.(alphabet.Complementor)
+	if !ok {
+		return nil, ErrNotComplementor
+	}
+	ki, err := kmerindex.New(p.K, s)
+	if err != nil {
+		return nil, err
+	}
+	ki.Build()
+
+	f := finder{
+		seq:    s.Seq,
+		index:  s.Alpha.LetterIndex(),
+		comp:   comp.ComplementTable(),
+		params: p,
+		seen:   make(map[[3]int]struct{}),
+	}
+	var rs []*Feature
+	err = ki.ForEachKmerOf(s, 0, s.Len(), func(ki *kmerindex.Index, i, kmer int) {
+		pos, err := ki.KmerPositions(kmerindex.ComplementOf(p.K, kmerindex.Kmer(kmer)))
+		if err != nil {
+			panic(err)
+		}
+		for _, j := range pos {
+			// Only consider each seed pair once and only those that
+			// may be the innermost seed of a repeat.
+			if j < i || j-(i+p.K) > p.MaxSpacer {
+				continue
+			}
+			if r := f.extend(i, j+p.K); r != nil {
+				r.Loc = s
+				rs = append(rs, r)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(features(rs))
+
+	return rs, nil
+}
+
+type finder struct {
+	seq    alphabet.Letters
+	index  alphabet.Index
+	comp   []alphabet.Letter
+	params Params
+	seen   map[[3]int]struct{}
+}
+
+// pairs returns whether the letters at i and j are complementary.
+func (f *finder) pairs(i, j int) bool {
+	a, b := f.index[f.comp[f.seq[i]]], f.index[f.seq[j]]
+	return a >= 0 && a == b
+}
+
+// extend returns the inverted repeat seeded by the pairing of start and end-1,
+// or nil if the repeat does not satisfy the finder's parameters or has already
+// been found.
+func (f *finder) extend(start, end int) *Feature {
+	// Extend inwards, exact matches only.
+	le, rs := start, end
+	for le < rs-1 && f.pairs(le, rs-1) {
+		le++
+		rs--
+	}
+
+	// Extend outwards allowing mismatches, trimming back
+	// to the last matching pair.
+	ls, re := start, end
+	var mm, bestMM int
+	for i, j := start-1, end; i >= 0 && j < len(f.seq); i, j = i-1, j+1 {
+		if !f.pairs(i, j) {
+			if mm++; mm > f.params.MaxMismatch {
+				break
+			}
+			continue
+		}
+		ls, re, bestMM = i, j+1, mm
+	}
+
+	r := &Feature{
+		LeftStart:  ls,
+		LeftEnd:    le,
+		RightStart: rs,
+		RightEnd:   re,
+		Mismatches: bestMM,
+	}
+	if r.ArmLen() < f.params.MinArm || r.Spacer() < f.params.MinSpacer || r.Spacer() > f.params.MaxSpacer {
+		return nil
+	}
+	key := [3]int{ls, le, re}
+	if _, ok := f.seen[key]; ok {
+		return nil
+	}
+	f.seen[key] = struct{}{}
+
+	return r
+}
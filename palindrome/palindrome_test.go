@@ -0,0 +1,92 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package palindrome
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestFind(c *check.C) {
+	for i, t := range []struct {
+		seq    string
+		params Params
+		expect []Feature
+	}{
+		{
+			seq:    "cccccgatcctagcaatttttattgctaggatcccccc",
+			params: DefaultParams,
+			expect: []Feature{{LeftStart: 5, LeftEnd: 17, RightStart: 21, RightEnd: 33}},
+		},
+		{
+			seq:    "cccccggaattcccccccc",
+			params: Params{K: 4, MinArm: 4, MaxSpacer: 0},
+			expect: []Feature{{LeftStart: 5, LeftEnd: 9, RightStart: 9, RightEnd: 13}},
+		},
+		{
+			seq:    "ccctgcatgcaagggggggcttgcctgcaccc",
+			params: Params{K: 4, MinArm: 8, MaxSpacer: 10, MaxMismatch: 1},
+			expect: []Feature{{LeftStart: 3, LeftEnd: 13, RightStart: 19, RightEnd: 29, Mismatches: 1}},
+		},
+		{
+			seq:    "ccctgcatgcaagggggggcttgcctgcaccc",
+			params: Params{K: 4, MinArm: 8, MaxSpacer: 10, MaxMismatch: 0},
+			expect: nil,
+		},
+		{
+			seq:    "cccccgatcctagcaatttttattgctaggatcccccc",
+			params: Params{K: 6, MinArm: 8, MinSpacer: 5, MaxSpacer: 10},
+			expect: nil,
+		},
+	} {
+		sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte(t.seq)), alphabet.DNA)
+		r, err := Find(sq, t.params)
+		c.Assert(err, check.Equals, nil, check.Commentf("Test %d", i))
+		c.Assert(len(r), check.Equals, len(t.expect), check.Commentf("Test %d", i))
+		for j, f := range r {
+			c.Check(f.Loc, check.Equals, sq)
+			f.Loc = nil
+			c.Check(*f, check.Equals, t.expect[j], check.Commentf("Test %d", i))
+		}
+	}
+}
+
+func (s *S) TestFeature(c *check.C) {
+	f := &Feature{LeftStart: 5, LeftEnd: 9, RightStart: 9, RightEnd: 13}
+	c.Check(f.IsPalindrome(), check.Equals, true)
+	c.Check(f.Spacer(), check.Equals, 0)
+	c.Check(f.ArmLen(), check.Equals, 4)
+	arms := f.Features()
+	c.Check(arms[0].Start(), check.Equals, 5)
+	c.Check(arms[0].End(), check.Equals, 9)
+	c.Check(arms[1].Start(), check.Equals, 9)
+	c.Check(arms[1].End(), check.Equals, 13)
+}
+
+func (s *S) TestParams(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte("acgtacgtacgtacgt")), alphabet.DNA)
+	for _, t := range []struct {
+		params Params
+		err    error
+	}{
+		{Params{K: 6, MinArm: 4}, ErrBadArm},
+		{Params{K: 4, MinArm: 4, MinSpacer: 3, MaxSpacer: 2}, ErrBadSpacer},
+		{Params{K: 4, MinArm: 4, MaxMismatch: -1}, ErrBadMismatch},
+	} {
+		_, err := Find(sq, t.params)
+		c.Check(err, check.Equals, t.err)
+	}
+}
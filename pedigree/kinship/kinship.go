@@ -0,0 +1,200 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package kinship provides pairwise identity-by-state and KING-robust kinship
+// estimation from genotype matrices for sample relatedness QC.
+//
+// The KING-robust kinship coefficient of samples i and j is
+//
+//	φ = (N(Aa,Aa) - 2 N(AA,aa)) / (N(Aa)_i + N(Aa)_j)
+//
+// where N(Aa,Aa) is the number of sites where both samples are heterozygous,
+// N(AA,aa) the number of sites where they are opposite homozygotes, and N(Aa)
+// the number of heterozygous sites of each sample, counted over the sites
+// where both samples are called.
+//
+// Reference:
+//
+//	Manichaikul A et al. Robust relationship inference in genome-wide
+//	association studies. Bioinformatics 26:2867-2873 (2010).
+package kinship
+
+import (
+	"github.com/biogo/biogo/pedigree"
+
+	"errors"
+	"fmt"
+)
+
+var ErrSampleCount = errors.New("kinship: inconsistent sample count")
+
+// Relation is an inferred degree of relationship.
+type Relation int
+
+const (
+	Unrelated Relation = iota
+	Third
+	Second
+	First
+	Duplicate // Duplicate samples or monozygotic twins.
+)
+
+var relationNames = [...]string{
+	Unrelated: "unrelated",
+	Third:     "third degree",
+	Second:    "second degree",
+	First:     "first degree",
+	Duplicate: "duplicate/MZ twin",
+}
+
+func (r Relation) String() string {
+	if r < 0 || int(r) >= len(relationNames) {
+		return fmt.Sprintf("Relation(%d)", int(r))
+	}
+	return relationNames[r]
+}
+
+// Thresholds holds the minimum kinship coefficients for each relation.
+type Thresholds struct {
+	Duplicate, First, Second, Third float64
+}
+
+// DefaultThresholds are the kinship coefficient thresholds recommended for
+// KING, the geometric means of the expected coefficients of adjacent degrees.
+var DefaultThresholds = Thresholds{
+	Duplicate: 0.354,
+	First:     0.177,
+	Second:    0.0884,
+	Third:     0.0442,
+}
+
+// Classify returns the relation corresponding to the kinship coefficient phi.
+func (t Thresholds) Classify(phi float64) Relation {
+	switch {
+	case phi > t.Duplicate:
+		return Duplicate
+	case phi > t.First:
+		return First
+	case phi > t.Second:
+		return Second
+	case phi > t.Third:
+		return Third
+	}
+	return Unrelated
+}
+
+// A Pair holds the relatedness estimates for a pair of samples.
+type Pair struct {
+	I, J int // Sample indices with I < J.
+
+	// Sites is the number of sites
+	// called in both samples.
+	Sites int
+
+	// IBS0 is the fraction of sites where
+	// the samples share no allele, and IBS
+	// is the mean fraction of alleles shared
+	// identical by state.
+	IBS0, IBS float64
+
+	// Kinship is the KING-robust kinship
+	// coefficient, and Relation the relation
+	// it implies.
+	Kinship  float64
+	Relation Relation
+}
+
+// Estimate returns the relatedness estimates for each pair of samples in the
+// genotype matrix gts, which is indexed by site and then by sample, in order of
+// I and then J. Genotypes are treated as biallelic, with any non-reference
+// allele counted as the alternative allele.
+func Estimate(gts [][]pedigree.Genotype, t Thresholds) ([]Pair, error) {
+	if len(gts) == 0 {
+		return nil, nil
+	}
+	n := len(gts[0])
+	dos := make([][]int8, len(gts))
+	for s, site := range gts {
+		if len(site) != n {
+			return nil, fmt.Errorf("%v at site %d", ErrSampleCount, s)
+		}
+		dos[s] = make([]int8, n)
+		for i, g := range site {
+			dos[s][i] = dosage(g)
+		}
+	}
+
+	var ps []Pair
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			var (
+				sites, ibs0, shared int
+				hetHet, oppHom      int
+				hetI, hetJ          int
+			)
+			for _, d := range dos {
+				a, b := d[i], d[j]
+				if a < 0 || b < 0 {
+					continue
+				}
+				sites++
+				diff := a - b
+				if diff < 0 {
+					diff = -diff
+				}
+				shared += 2 - int(diff)
+				if diff == 2 {
+					ibs0++
+					oppHom++
+				}
+				if a == 1 {
+					hetI++
+				}
+				if b == 1 {
+					hetJ++
+				}
+				if a == 1 && b == 1 {
+					hetHet++
+				}
+			}
+			p := Pair{I: i, J: j, Sites: sites}
+			if sites != 0 {
+				p.IBS0 = float64(ibs0) / float64(sites)
+				p.IBS = float64(shared) / float64(2*sites)
+			}
+			if hetI+hetJ != 0 {
+				p.Kinship = float64(hetHet-2*oppHom) / float64(hetI+hetJ)
+			}
+			p.Relation = t.Classify(p.Kinship)
+			ps = append(ps, p)
+		}
+	}
+	return ps, nil
+}
+
+// dosage returns the number of non-reference alleles in g, or -1 if g is
+// missing.
+func dosage(g pedigree.Genotype) int8 {
+	if g.IsMissing() {
+		return -1
+	}
+	var d int8
+	for _, a := range g {
+		if a != 0 {
+			d++
+		}
+	}
+	return d
+}
+
+// Related returns the pairs in ps with a relation of at least r.
+func Related(ps []Pair, r Relation) []Pair {
+	var rel []Pair
+	for _, p := range ps {
+		if p.Relation >= r {
+			rel = append(rel, p)
+		}
+	}
+	return rel
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kinship
+
+import (
+	"github.com/biogo/biogo/pedigree"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+var (
+	hom = pedigree.Genotype{0, 0}
+	het = pedigree.Genotype{0, 1}
+	alt = pedigree.Genotype{1, 1}
+	mis = pedigree.Genotype{pedigree.Missing, pedigree.Missing}
+)
+
+func (s *S) TestEstimate(c *check.C) {
+	// Samples 0 and 1 are duplicates; sample 2 is unrelated.
+	gts := [][]pedigree.Genotype{
+		{het, het, hom},
+		{het, het, alt},
+		{hom, hom, alt},
+		{alt, alt, hom},
+		{het, het, het},
+		{mis, het, het},
+	}
+	ps, err := Estimate(gts, DefaultThresholds)
+	c.Assert(err, check.Equals, nil)
+	c.Check(ps, check.DeepEquals, []Pair{
+		{I: 0, J: 1, Sites: 5, IBS0: 0, IBS: 1, Kinship: 0.5, Relation: Duplicate},
+		{I: 0, J: 2, Sites: 5, IBS0: 0.4, IBS: 0.4, Kinship: -0.75, Relation: Unrelated},
+		{I: 1, J: 2, Sites: 6, IBS0: 2. / 6, IBS: 0.5, Kinship: -2. / 6, Relation: Unrelated},
+	})
+	c.Check(Related(ps, Duplicate), check.DeepEquals, ps[:1])
+
+	_, err = Estimate([][]pedigree.Genotype{{het, het}, {het}}, DefaultThresholds)
+	c.Check(err, check.ErrorMatches, "kinship: inconsistent sample count at site 1")
+}
+
+func (s *S) TestClassify(c *check.C) {
+	for i, t := range []struct {
+		phi    float64
+		expect Relation
+	}{
+		{0.5, Duplicate},
+		{0.25, First},
+		{0.125, Second},
+		{0.0625, Third},
+		{0, Unrelated},
+		{-0.2, Unrelated},
+	} {
+		c.Check(DefaultThresholds.Classify(t.phi), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+	c.Check(First.String(), check.Equals, "first degree")
+}
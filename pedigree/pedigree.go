@@ -0,0 +1,252 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pedigree provides a pedigree model read from PED and FAM files and
+// Mendelian inconsistency checking of genotypes over the trios it contains.
+package pedigree
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrShortLine     = errors.New("pedigree: too few fields")
+	ErrBadSex        = errors.New("pedigree: invalid sex code")
+	ErrDuplicate     = errors.New("pedigree: duplicate individual ID")
+	ErrSampleCount   = errors.New("pedigree: genotype count does not match sample count")
+	ErrUnknownSample = errors.New("pedigree: sample not in pedigree")
+)
+
+// Sex is the sex of an individual.
+type Sex int
+
+const (
+	UnknownSex Sex = iota
+	Male
+	Female
+)
+
+// An Individual is a member of a pedigree. Father and Mother are nil for
+// founders.
+type Individual struct {
+	Family    string
+	ID        string
+	Sex       Sex
+	Phenotype string
+
+	Father, Mother *Individual
+}
+
+// Pedigree holds the individuals of a set of families.
+type Pedigree struct {
+	Individuals []*Individual
+	index       map[string]*Individual
+}
+
+// Individual returns the individual with the given ID, or nil if there is no
+// such individual.
+func (p *Pedigree) Individual(id string) *Individual { return p.index[id] }
+
+// A Trio is a child and both its parents.
+type Trio struct {
+	Child, Father, Mother *Individual
+}
+
+// Trios returns the trios of the pedigree in the order of their children.
+func (p *Pedigree) Trios() []Trio {
+	var t []Trio
+	for _, ind := range p.Individuals {
+		if ind.Father != nil && ind.Mother != nil {
+			t = append(t, Trio{Child: ind, Father: ind.Father, Mother: ind.Mother})
+		}
+	}
+	return t
+}
+
+// Read reads a pedigree in PED or FAM format from r. Only the first six fields
+// of each line, family ID, individual ID, father ID, mother ID, sex and
+// phenotype, are used. Parent IDs of "0" denote unknown parents, as do parent
+// IDs not present in the file. Individual IDs must be unique across families.
+// Blank lines and lines starting with '#' are ignored.
+func Read(r io.Reader) (*Pedigree, error) {
+	type parents struct{ father, mother string }
+	var (
+		p    = &Pedigree{index: make(map[string]*Individual)}
+		pars []parents
+		sc   = bufio.NewScanner(r)
+		line int
+	)
+	for sc.Scan() {
+		line++
+		b := bytes.TrimSpace(sc.Bytes())
+		if len(b) == 0 || b[0] == '#' {
+			continue
+		}
+		f := bytes.Fields(b)
+		if len(f) < 6 {
+			return nil, fmt.Errorf("%v at line %d", ErrShortLine, line)
+		}
+		ind := &Individual{
+			Family:    string(f[0]),
+			ID:        string(f[1]),
+			Phenotype: string(f[5]),
+		}
+		switch string(f[4]) {
+		case "1":
+			ind.Sex = Male
+		case "2":
+			ind.Sex = Female
+		case "0", "-9", "other":
+			ind.Sex = UnknownSex
+		default:
+			return nil, fmt.Errorf("%v at line %d", ErrBadSex, line)
+		}
+		if _, ok := p.index[ind.ID]; ok {
+			return nil, fmt.Errorf("%v at line %d", ErrDuplicate, line)
+		}
+		p.index[ind.ID] = ind
+		p.Individuals = append(p.Individuals, ind)
+		pars = append(pars, parents{father: string(f[2]), mother: string(f[3])})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	for i, ind := range p.Individuals {
+		if pars[i].father != "0" {
+			ind.Father = p.index[pars[i].father]
+		}
+		if pars[i].mother != "0" {
+			ind.Mother = p.index[pars[i].mother]
+		}
+	}
+	return p, nil
+}
+
+// Missing is the allele value of a missing genotype call.
+const Missing = -1
+
+// A Genotype is a diploid genotype call holding allele indices, with 0 for the
+// reference allele. Genotypes with either allele Missing are not called.
+type Genotype [2]int
+
+// IsMissing returns whether the genotype is not called.
+func (g Genotype) IsMissing() bool { return g[0] == Missing || g[1] == Missing }
+
+func (g Genotype) has(a int) bool { return g[0] == a || g[1] == a }
+
+// Consistent returns whether the child genotype can be inherited from the
+// father and mother genotypes. Genotypes with missing calls are consistent.
+func Consistent(child, father, mother Genotype) bool {
+	if child.IsMissing() || father.IsMissing() || mother.IsMissing() {
+		return true
+	}
+	return (father.has(child[0]) && mother.has(child[1])) ||
+		(father.has(child[1]) && mother.has(child[0]))
+}
+
+// Counts holds the number of trio genotype tests and Mendelian errors.
+type Counts struct {
+	Tested int // Number of fully called trio genotypes tested.
+	Errors int // Number of inconsistent trio genotypes.
+}
+
+// Rate returns the fraction of tests that were inconsistent, or zero if no
+// tests were made.
+func (c Counts) Rate() float64 {
+	if c.Tested == 0 {
+		return 0
+	}
+	return float64(c.Errors) / float64(c.Tested)
+}
+
+// A DeNovo is a candidate de novo mutation, a child allele absent from both
+// parents.
+type DeNovo struct {
+	Site   int
+	Trio   Trio
+	Allele int
+}
+
+// A Report holds the results of Mendelian inconsistency checking.
+type Report struct {
+	// Sites holds the counts for each site.
+	Sites []Counts
+
+	// Samples holds the counts for each sample. An
+	// inconsistent trio counts as an error for each
+	// member of the trio.
+	Samples []Counts
+
+	// DeNovo holds the candidate de novo mutations
+	// in site and then trio order.
+	DeNovo []DeNovo
+}
+
+// Check checks each trio of the pedigree for Mendelian inconsistency at each
+// site of the genotype matrix gts, which is indexed by site and then by sample.
+// The samples are identified by the IDs in samples. Trios with members not in
+// samples are not checked.
+func Check(p *Pedigree, samples []string, gts [][]Genotype) (*Report, error) {
+	col := make(map[*Individual]int, len(samples))
+	for i, id := range samples {
+		ind := p.Individual(id)
+		if ind == nil {
+			return nil, fmt.Errorf("%v: %q", ErrUnknownSample, id)
+		}
+		col[ind] = i
+	}
+	type trio struct {
+		Trio
+		c, f, m int
+	}
+	var trios []trio
+	for _, t := range p.Trios() {
+		c, okc := col[t.Child]
+		f, okf := col[t.Father]
+		m, okm := col[t.Mother]
+		if okc && okf && okm {
+			trios = append(trios, trio{t, c, f, m})
+		}
+	}
+
+	r := &Report{
+		Sites:   make([]Counts, len(gts)),
+		Samples: make([]Counts, len(samples)),
+	}
+	for s, site := range gts {
+		if len(site) != len(samples) {
+			return nil, fmt.Errorf("%v at site %d", ErrSampleCount, s)
+		}
+		for _, t := range trios {
+			child, father, mother := site[t.c], site[t.f], site[t.m]
+			if child.IsMissing() || father.IsMissing() || mother.IsMissing() {
+				continue
+			}
+			r.Sites[s].Tested++
+			for _, i := range []int{t.c, t.f, t.m} {
+				r.Samples[i].Tested++
+			}
+			if Consistent(child, father, mother) {
+				continue
+			}
+			r.Sites[s].Errors++
+			for _, i := range []int{t.c, t.f, t.m} {
+				r.Samples[i].Errors++
+			}
+			for j, a := range child {
+				if j == 1 && a == child[0] {
+					break
+				}
+				if !father.has(a) && !mother.has(a) {
+					r.DeNovo = append(r.DeNovo, DeNovo{Site: s, Trio: t.Trio, Allele: a})
+				}
+			}
+		}
+	}
+	return r, nil
+}
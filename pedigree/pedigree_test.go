@@ -0,0 +1,109 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pedigree
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+const fam = `# family 1
+F1 dad 0 0 1 -9
+F1 mum 0 0 2 -9
+F1 kid dad mum 1 2
+F1 kid2 dad mum 2 1 A A C G
+
+F2 solo 0 0 0 -9
+F2 orphan dad2 0 1 1
+`
+
+func (s *S) TestRead(c *check.C) {
+	p, err := Read(strings.NewReader(fam))
+	c.Assert(err, check.Equals, nil)
+	c.Check(p.Individuals, check.HasLen, 6)
+	kid := p.Individual("kid")
+	c.Assert(kid, check.NotNil)
+	c.Check(kid.Father, check.Equals, p.Individual("dad"))
+	c.Check(kid.Mother, check.Equals, p.Individual("mum"))
+	c.Check(kid.Sex, check.Equals, Male)
+	c.Check(kid.Phenotype, check.Equals, "2")
+	c.Check(p.Individual("mum").Sex, check.Equals, Female)
+	c.Check(p.Individual("orphan").Father, check.IsNil)
+
+	var kids []string
+	for _, t := range p.Trios() {
+		kids = append(kids, t.Child.ID)
+	}
+	c.Check(kids, check.DeepEquals, []string{"kid", "kid2"})
+
+	for i, t := range []struct {
+		in  string
+		err string
+	}{
+		{in: "F1 a 0 0 1", err: "pedigree: too few fields at line 1"},
+		{in: "F1 a 0 0 3 1", err: "pedigree: invalid sex code at line 1"},
+		{in: "F1 a 0 0 1 1\nF2 a 0 0 1 1", err: "pedigree: duplicate individual ID at line 2"},
+	} {
+		_, err := Read(strings.NewReader(t.in))
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestConsistent(c *check.C) {
+	for i, t := range []struct {
+		child, father, mother Genotype
+		expect                bool
+	}{
+		{Genotype{0, 1}, Genotype{0, 0}, Genotype{1, 1}, true},
+		{Genotype{1, 0}, Genotype{0, 0}, Genotype{1, 1}, true},
+		{Genotype{1, 1}, Genotype{0, 1}, Genotype{0, 1}, true},
+		{Genotype{1, 1}, Genotype{0, 0}, Genotype{0, 1}, false},
+		{Genotype{0, 1}, Genotype{0, 0}, Genotype{0, 0}, false},
+		{Genotype{1, 2}, Genotype{0, 2}, Genotype{1, 0}, true},
+		{Genotype{Missing, 1}, Genotype{0, 0}, Genotype{0, 0}, true},
+	} {
+		c.Check(Consistent(t.child, t.father, t.mother), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestCheck(c *check.C) {
+	p, err := Read(strings.NewReader(fam))
+	c.Assert(err, check.Equals, nil)
+	samples := []string{"dad", "mum", "kid", "kid2", "solo"}
+	gts := [][]Genotype{
+		{{0, 0}, {0, 1}, {0, 1}, {0, 0}, {1, 1}},             // Consistent.
+		{{0, 0}, {0, 0}, {0, 1}, {0, 0}, {0, 0}},             // De novo in kid.
+		{{0, 0}, {0, 1}, {1, 1}, {0, 1}, {0, 0}},             // Error in kid, not de novo.
+		{{0, 0}, {0, 0}, {Missing, Missing}, {0, 2}, {0, 0}}, // Missing kid, de novo in kid2.
+	}
+	r, err := Check(p, samples, gts)
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Sites, check.DeepEquals, []Counts{{2, 0}, {2, 1}, {2, 1}, {1, 1}})
+	c.Check(r.Samples, check.DeepEquals, []Counts{{7, 3}, {7, 3}, {3, 2}, {4, 1}, {0, 0}})
+	c.Check(r.Sites[1].Rate(), check.Equals, 0.5)
+	c.Check(r.Samples[4].Rate(), check.Equals, 0.)
+
+	var dn []string
+	for _, d := range r.DeNovo {
+		dn = append(dn, d.Trio.Child.ID)
+	}
+	c.Check(dn, check.DeepEquals, []string{"kid", "kid2"})
+	c.Check(r.DeNovo[1].Site, check.Equals, 3)
+	c.Check(r.DeNovo[1].Allele, check.Equals, 2)
+
+	_, err = Check(p, []string{"dad", "nobody"}, nil)
+	c.Check(err, check.ErrorMatches, `pedigree: sample not in pedigree: "nobody"`)
+	_, err = Check(p, samples, [][]Genotype{{{0, 0}}})
+	c.Check(err, check.ErrorMatches, "pedigree: genotype count does not match sample count at site 0")
+}
@@ -0,0 +1,327 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package phase provides read-backed phasing of heterozygous variants.
+//
+// Variants are connected by the reads that span them, and each connected set of
+// variants forms a haplotype block. Within a block an initial phasing is taken
+// from a maximum spanning tree of the read support for the relative phase of
+// variant pairs, and is then refined by flipping single variants while doing so
+// reduces the minimum error correction (MEC) score, the number of read alleles
+// that disagree with the haplotype each read is assigned to.
+//
+// There is no VCF writer in this tree; phased genotypes are reported as VCF GT
+// and PS field values so they can be written by one.
+package phase
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+)
+
+var (
+	ErrBadVariant = errors.New("phase: observation of unknown variant")
+	ErrBadAllele  = errors.New("phase: allele is not 0 or 1")
+)
+
+// A Variant is a heterozygous variant.
+type Variant struct {
+	Pos int // Position of the variant on its reference.
+}
+
+// An Observation is an allele of a variant seen in a read.
+type Observation struct {
+	Variant int // Index of the variant.
+	Allele  int // Observed allele, 0 for reference and 1 for alternative.
+}
+
+// A Fragment is the set of variant alleles observed in a read or read pair.
+type Fragment []Observation
+
+// Params describes the parameters used for phasing.
+type Params struct {
+	// MinSupport is the minimum absolute difference between
+	// the number of fragments supporting each relative phase
+	// of a pair of variants for the pair to join a block.
+	MinSupport int
+
+	// MaxIter is the maximum number of refinement passes.
+	MaxIter int
+}
+
+// DefaultParams are the default phasing parameters.
+var DefaultParams = Params{
+	MinSupport: 1,
+	MaxIter:    100,
+}
+
+// A Genotype is the phased or unphased genotype of a heterozygous variant.
+type Genotype struct {
+	Variant int
+
+	// Phased indicates whether the variant was phased.
+	Phased bool
+
+	// Alleles holds the alleles of
+	// the first and second haplotypes.
+	Alleles [2]int
+
+	// Block is the phase set of the variant, the
+	// position of the first variant in its block,
+	// or -1 if the variant is unphased.
+	Block int
+}
+
+// GT returns the VCF GT field value for the genotype.
+func (g Genotype) GT() string {
+	if !g.Phased {
+		return "0/1"
+	}
+	return strconv.Itoa(g.Alleles[0]) + "|" + strconv.Itoa(g.Alleles[1])
+}
+
+// PS returns the VCF PS field value for the genotype, or "." if it is
+// unphased. VCF positions are 1-based.
+func (g Genotype) PS() string {
+	if !g.Phased {
+		return "."
+	}
+	return strconv.Itoa(g.Block + 1)
+}
+
+// Phase returns the genotypes of the variants phased by the fragments, in
+// variant order. Observations within a fragment of the same variant after the
+// first are ignored.
+func Phase(vs []Variant, frags []Fragment, p Params) ([]Genotype, error) {
+	// Validate and deduplicate fragments.
+	fs := make([]Fragment, 0, len(frags))
+	for _, f := range frags {
+		seen := make(map[int]bool, len(f))
+		var d Fragment
+		for _, o := range f {
+			if o.Variant < 0 || o.Variant >= len(vs) {
+				return nil, ErrBadVariant
+			}
+			if o.Allele != 0 && o.Allele != 1 {
+				return nil, ErrBadAllele
+			}
+			if !seen[o.Variant] {
+				seen[o.Variant] = true
+				d = append(d, o)
+			}
+		}
+		if len(d) > 1 {
+			fs = append(fs, d)
+		}
+	}
+
+	// Relative phase support between variant pairs;
+	// positive weights support cis and negative trans.
+	weight := make(map[[2]int]int)
+	for _, f := range fs {
+		for i, a := range f {
+			for _, b := range f[i+1:] {
+				k := [2]int{a.Variant, b.Variant}
+				if k[0] > k[1] {
+					k[0], k[1] = k[1], k[0]
+				}
+				if a.Allele == b.Allele {
+					weight[k]++
+				} else {
+					weight[k]--
+				}
+			}
+		}
+	}
+	var es edges
+	for k, w := range weight {
+		if abs(w) >= p.MinSupport && w != 0 {
+			es = append(es, edge{u: k[0], v: k[1], w: w})
+		}
+	}
+	sort.Sort(es)
+
+	// Maximum spanning forest by Kruskal's algorithm.
+	uf := newUnionFind(len(vs))
+	adj := make([][]edge, len(vs))
+	for _, e := range es {
+		if uf.union(e.u, e.v) {
+			adj[e.u] = append(adj[e.u], e)
+			adj[e.v] = append(adj[e.v], edge{u: e.v, v: e.u, w: e.w})
+		}
+	}
+
+	// Initial haplotype from the spanning forest.
+	hap := make([]int, len(vs))
+	seen := make([]bool, len(vs))
+	for i := range vs {
+		if seen[i] {
+			continue
+		}
+		seen[i] = true
+		stack := []int{i}
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, e := range adj[u] {
+				if seen[e.v] {
+					continue
+				}
+				seen[e.v] = true
+				if e.w > 0 {
+					hap[e.v] = hap[u]
+				} else {
+					hap[e.v] = 1 - hap[u]
+				}
+				stack = append(stack, e.v)
+			}
+		}
+	}
+
+	// Refine by single variant flips.
+	byVariant := make([][]int, len(vs))
+	for i, f := range fs {
+		for _, o := range f {
+			byVariant[o.Variant] = append(byVariant[o.Variant], i)
+		}
+	}
+	for iter := 0; iter < p.MaxIter; iter++ {
+		var changed bool
+		for v := range vs {
+			if len(adj[v]) == 0 {
+				continue
+			}
+			before := mecOf(fs, byVariant[v], hap)
+			hap[v] = 1 - hap[v]
+			if mecOf(fs, byVariant[v], hap) < before {
+				changed = true
+			} else {
+				hap[v] = 1 - hap[v]
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	// Assign block IDs from the first variant position in each block.
+	first := make(map[int]int)
+	for i, v := range vs {
+		r := uf.find(i)
+		if pos, ok := first[r]; !ok || v.Pos < pos {
+			first[r] = v.Pos
+		}
+	}
+	gts := make([]Genotype, len(vs))
+	for i := range vs {
+		g := Genotype{Variant: i, Alleles: [2]int{0, 1}, Block: -1}
+		if len(adj[i]) != 0 {
+			g.Phased = true
+			g.Alleles = [2]int{hap[i], 1 - hap[i]}
+			g.Block = first[uf.find(i)]
+		}
+		gts[i] = g
+	}
+	return gts, nil
+}
+
+// MEC returns the minimum error correction score of the fragments given the
+// phased genotypes. Observations of unphased variants are ignored.
+func MEC(gts []Genotype, frags []Fragment) int {
+	hap := make([]int, len(gts))
+	phased := make([]bool, len(gts))
+	for i, g := range gts {
+		hap[i], phased[i] = g.Alleles[0], g.Phased
+	}
+	var mec int
+	for _, f := range frags {
+		var p Fragment
+		for _, o := range f {
+			if o.Variant >= 0 && o.Variant < len(gts) && phased[o.Variant] {
+				p = append(p, o)
+			}
+		}
+		mec += fragmentMEC(p, hap)
+	}
+	return mec
+}
+
+// mecOf returns the MEC score of the fragments in fs indexed by idx.
+func mecOf(fs []Fragment, idx []int, hap []int) int {
+	var mec int
+	for _, i := range idx {
+		mec += fragmentMEC(fs[i], hap)
+	}
+	return mec
+}
+
+// fragmentMEC returns the number of observations in f that disagree with the
+// closer of hap and its complement.
+func fragmentMEC(f Fragment, hap []int) int {
+	var diff int
+	for _, o := range f {
+		if o.Allele != hap[o.Variant] {
+			diff++
+		}
+	}
+	if len(f)-diff < diff {
+		return len(f) - diff
+	}
+	return diff
+}
+
+type edge struct {
+	u, v int
+	w    int
+}
+
+type edges []edge
+
+func (e edges) Len() int { return len(e) }
+func (e edges) Less(i, j int) bool {
+	wi, wj := abs(e[i].w), abs(e[j].w)
+	switch {
+	case wi != wj:
+		return wi > wj
+	case e[i].u != e[j].u:
+		return e[i].u < e[j].u
+	}
+	return e[i].v < e[j].v
+}
+func (e edges) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+type unionFind []int
+
+func newUnionFind(n int) unionFind {
+	u := make(unionFind, n)
+	for i := range u {
+		u[i] = i
+	}
+	return u
+}
+
+func (u unionFind) find(i int) int {
+	for u[i] != i {
+		u[i] = u[u[i]]
+		i = u[i]
+	}
+	return i
+}
+
+func (u unionFind) union(a, b int) bool {
+	ra, rb := u.find(a), u.find(b)
+	if ra == rb {
+		return false
+	}
+	u[rb] = ra
+	return true
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phase
+
+import (
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+// reads returns fragments covering the variants vs drawn from the haplotype h
+// and its complement.
+func reads(h []int, vs ...int) []Fragment {
+	var f1, f2 Fragment
+	for _, v := range vs {
+		f1 = append(f1, Observation{Variant: v, Allele: h[v]})
+		f2 = append(f2, Observation{Variant: v, Allele: 1 - h[v]})
+	}
+	return []Fragment{f1, f2}
+}
+
+func (s *S) TestPhase(c *check.C) {
+	vs := []Variant{{100}, {200}, {300}, {400}, {500}, {600}, {700}}
+	h := []int{0, 1, 1, 0, 0, 1, 1}
+
+	var frags []Fragment
+	for _, span := range [][]int{{0, 1}, {1, 2}, {2, 3}, {0, 1, 2}, {1, 2, 3}, {5, 6}} {
+		frags = append(frags, reads(h, span...)...)
+	}
+	// A read with a sequencing error at variant 2,
+	// and a read covering only variant 4.
+	frags = append(frags,
+		Fragment{{Variant: 1, Allele: 1}, {Variant: 2, Allele: 0}, {Variant: 3, Allele: 0}},
+		Fragment{{Variant: 4, Allele: 1}},
+	)
+
+	gts, err := Phase(vs, frags, DefaultParams)
+	c.Assert(err, check.Equals, nil)
+
+	var gt, ps []string
+	for _, g := range gts {
+		gt = append(gt, g.GT())
+		ps = append(ps, g.PS())
+	}
+	c.Check(gt, check.DeepEquals, []string{"0|1", "1|0", "1|0", "0|1", "0/1", "0|1", "0|1"})
+	c.Check(ps, check.DeepEquals, []string{"101", "101", "101", "101", ".", "601", "601"})
+	c.Check(gts[4].Block, check.Equals, -1)
+	c.Check(MEC(gts, frags), check.Equals, 1)
+}
+
+func (s *S) TestDiscordant(c *check.C) {
+	// A discordant read does not alter the
+	// phasing supported by the other reads.
+	vs := []Variant{{10}, {20}, {30}}
+	h := []int{0, 1, 0}
+	var frags []Fragment
+	for i := 0; i < 3; i++ {
+		frags = append(frags, reads(h, 0, 1, 2)...)
+	}
+	frags = append(frags, Fragment{{Variant: 0, Allele: 0}, {Variant: 1, Allele: 0}})
+
+	gts, err := Phase(vs, frags, DefaultParams)
+	c.Assert(err, check.Equals, nil)
+	c.Check([]string{gts[0].GT(), gts[1].GT(), gts[2].GT()}, check.DeepEquals, []string{"0|1", "1|0", "0|1"})
+	c.Check(MEC(gts, frags), check.Equals, 1)
+}
+
+func (s *S) TestErrors(c *check.C) {
+	vs := []Variant{{10}, {20}}
+	_, err := Phase(vs, []Fragment{{{Variant: 0, Allele: 0}, {Variant: 2, Allele: 1}}}, DefaultParams)
+	c.Check(err, check.Equals, ErrBadVariant)
+	_, err = Phase(vs, []Fragment{{{Variant: 0, Allele: 2}}}, DefaultParams)
+	c.Check(err, check.Equals, ErrBadAllele)
+}
@@ -0,0 +1,257 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package clock provides molecular clock analyses of rooted trees with dated
+// tips, root-to-tip regression and strict clock least-squares dating.
+//
+// Branch lengths are in substitutions per site and dates are in arbitrary
+// units, usually decimal years, so rates are substitutions per site per unit
+// time.
+package clock
+
+import (
+	"github.com/biogo/biogo/io/treeio/newick"
+
+	"errors"
+	"math"
+)
+
+var (
+	ErrTooFewDates = errors.New("clock: too few distinct tip dates")
+	ErrSingular    = errors.New("clock: dates not determined by tree")
+	ErrBadRate     = errors.New("clock: estimated rate not positive")
+)
+
+// Regression is the result of a root-to-tip regression.
+type Regression struct {
+	// Rate is the slope of the regression of root-to-tip
+	// distance on tip date, and Intercept its intercept.
+	Rate, Intercept float64
+
+	// RootDate is the date at which the regression
+	// line reaches zero distance, an estimate of the
+	// date of the root.
+	RootDate float64
+
+	// R2 is the coefficient of determination.
+	R2 float64
+
+	// Residuals holds the residual root-to-tip
+	// distance of each dated tip.
+	Residuals map[string]float64
+}
+
+// RootToTip performs a regression of the root-to-tip distances of the dated
+// tips of the rooted tree t on their dates, which are keyed by tip name. Tips
+// without a date are ignored.
+func RootToTip(t *newick.Node, dates map[string]float64) (*Regression, error) {
+	var x, y []float64
+	var names []string
+	for _, l := range t.Leaves() {
+		d, ok := dates[l.Name]
+		if !ok {
+			continue
+		}
+		x = append(x, d)
+		y = append(y, l.DistanceToRoot())
+		names = append(names, l.Name)
+	}
+	n := float64(len(x))
+	var mx, my float64
+	for i := range x {
+		mx += x[i]
+		my += y[i]
+	}
+	mx /= n
+	my /= n
+	var sxx, sxy, syy float64
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if len(x) < 2 || sxx == 0 {
+		return nil, ErrTooFewDates
+	}
+
+	r := &Regression{Rate: sxy / sxx, Residuals: make(map[string]float64, len(x))}
+	r.Intercept = my - r.Rate*mx
+	r.RootDate = -r.Intercept / r.Rate
+	if syy != 0 {
+		r.R2 = sxy * sxy / (sxx * syy)
+	} else {
+		r.R2 = 1
+	}
+	for i, name := range names {
+		r.Residuals[name] = y[i] - (r.Intercept + r.Rate*x[i])
+	}
+	return r, nil
+}
+
+// Dating is the result of strict clock dating.
+type Dating struct {
+	// Rate is the estimated substitution rate.
+	Rate float64
+
+	// Dates holds the date of each node of the
+	// tree, including the root and any tips
+	// without a given date.
+	Dates map[*newick.Node]float64
+}
+
+// StrictClock estimates the substitution rate and the dates of the nodes of
+// the rooted tree t under a strict molecular clock by least-squares, given the
+// dates of some or all of its tips, keyed by tip name. The rate ω and node
+// dates t minimize the sum over branches of (b - ω(t_child - t_parent))², where
+// b is the branch length. Node dates are not constrained to be ordered.
+//
+// The solution uses dense linear algebra with cost cubic in the number of
+// undated nodes, so it is intended for trees of up to a few thousand tips.
+func StrictClock(t *newick.Node, dates map[string]float64) (*Dating, error) {
+	// The problem is linear in the rate and
+	// the rate-scaled dates of undated nodes.
+	// Variable 0 is the rate. Dates are taken
+	// relative to their mean for conditioning.
+	idx := make(map[*newick.Node]int)
+	var (
+		nodes []*newick.Node
+		dated = make(map[float64]bool)
+		mean  float64
+		n     int
+	)
+	t.Walk(func(v *newick.Node) bool {
+		if v.IsLeaf() {
+			if d, ok := dates[v.Name]; ok {
+				dated[d] = true
+				mean += d
+				n++
+				return true
+			}
+		}
+		nodes = append(nodes, v)
+		idx[v] = len(nodes)
+		return true
+	})
+	if len(dated) < 2 {
+		return nil, ErrTooFewDates
+	}
+	mean /= float64(n)
+
+	m := len(nodes) + 1
+	ata := make([][]float64, m)
+	for i := range ata {
+		ata[i] = make([]float64, m)
+	}
+	atb := make([]float64, m)
+	t.Walk(func(n *newick.Node) bool {
+		if n.Parent == nil {
+			return true
+		}
+		// Row coefficients of the child minus the parent.
+		var (
+			cols []int
+			vals []float64
+		)
+		for _, v := range []struct {
+			n    *newick.Node
+			sign float64
+		}{{n, 1}, {n.Parent, -1}} {
+			if i, ok := idx[v.n]; ok {
+				cols = append(cols, i)
+				vals = append(vals, v.sign)
+			} else {
+				cols = append(cols, 0)
+				vals = append(vals, v.sign*(dates[v.n.Name]-mean))
+			}
+		}
+		for a := range cols {
+			for b := range cols {
+				ata[cols[a]][cols[b]] += vals[a] * vals[b]
+			}
+			atb[cols[a]] += vals[a] * n.Length
+		}
+		return true
+	})
+	x, err := solve(ata, atb)
+	if err != nil {
+		return nil, err
+	}
+	rate := x[0]
+	if rate <= 0 {
+		return nil, ErrBadRate
+	}
+	d := &Dating{Rate: rate, Dates: make(map[*newick.Node]float64)}
+	t.Walk(func(n *newick.Node) bool {
+		if i, ok := idx[n]; ok {
+			d.Dates[n] = x[i]/rate + mean
+		} else {
+			d.Dates[n] = dates[n.Name]
+		}
+		return true
+	})
+	return d, nil
+}
+
+// TimeTree returns a copy of t with branch lengths in units of time, given by
+// the node dates of d.
+func (d *Dating) TimeTree(t *newick.Node) *newick.Node {
+	c := &newick.Node{
+		Name:     t.Name,
+		Comments: append([]string(nil), t.Comments...),
+	}
+	if t.Parent != nil {
+		c.Length = d.Dates[t] - d.Dates[t.Parent]
+		c.HasLength = true
+	}
+	for _, ch := range t.Children {
+		c.AddChild(d.TimeTree(ch))
+	}
+	return c
+}
+
+// solve solves the linear system a x = b by Gaussian elimination with partial
+// pivoting. The contents of a and b are destroyed.
+func solve(a [][]float64, b []float64) ([]float64, error) {
+	n := len(b)
+	var scale float64
+	for _, r := range a {
+		for _, v := range r {
+			scale = math.Max(scale, math.Abs(v))
+		}
+	}
+	eps := 1e-12 * scale
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a[i][k]) > math.Abs(a[p][k]) {
+				p = i
+			}
+		}
+		if math.Abs(a[p][k]) <= eps {
+			return nil, ErrSingular
+		}
+		a[k], a[p] = a[p], a[k]
+		b[k], b[p] = b[p], b[k]
+		for i := k + 1; i < n; i++ {
+			f := a[i][k] / a[k][k]
+			if f == 0 {
+				continue
+			}
+			for j := k; j < n; j++ {
+				a[i][j] -= f * a[k][j]
+			}
+			b[i] -= f * b[k]
+		}
+	}
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		s := b[i]
+		for j := i + 1; j < n; j++ {
+			s -= a[i][j] * x[j]
+		}
+		x[i] = s / a[i][i]
+	}
+	return x, nil
+}
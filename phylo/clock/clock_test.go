@@ -0,0 +1,74 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package clock
+
+import (
+	"github.com/biogo/biogo/io/treeio/newick"
+
+	"math"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func near(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+// A clock-like tree with a rate of 0.5 rooted in 2000 with the
+// internal node X in 2001.
+const clockTree = "((A:0.5,B:1.5)X:0.5,C:1.5);"
+
+var dates = map[string]float64{"A": 2002, "B": 2004, "C": 2003}
+
+func (s *S) TestRootToTip(c *check.C) {
+	t, err := newick.Parse(clockTree)
+	c.Assert(err, check.Equals, nil)
+	r, err := RootToTip(t, dates)
+	c.Assert(err, check.Equals, nil)
+	c.Check(near(r.Rate, 0.5), check.Equals, true, check.Commentf("got %v", r.Rate))
+	c.Check(near(r.RootDate, 2000), check.Equals, true, check.Commentf("got %v", r.RootDate))
+	c.Check(near(r.R2, 1), check.Equals, true, check.Commentf("got %v", r.R2))
+	for name, res := range r.Residuals {
+		c.Check(near(res, 0), check.Equals, true, check.Commentf("tip %s", name))
+	}
+
+	_, err = RootToTip(t, map[string]float64{"A": 2002, "B": 2002})
+	c.Check(err, check.Equals, ErrTooFewDates)
+}
+
+func (s *S) TestStrictClock(c *check.C) {
+	t, err := newick.Parse(clockTree)
+	c.Assert(err, check.Equals, nil)
+	d, err := StrictClock(t, dates)
+	c.Assert(err, check.Equals, nil)
+	c.Check(near(d.Rate, 0.5), check.Equals, true, check.Commentf("got %v", d.Rate))
+	c.Check(near(d.Dates[t], 2000), check.Equals, true, check.Commentf("got %v", d.Dates[t]))
+	c.Check(near(d.Dates[t.Find("X")], 2001), check.Equals, true, check.Commentf("got %v", d.Dates[t.Find("X")]))
+
+	tt := d.TimeTree(t)
+	for _, e := range []struct {
+		name   string
+		length float64
+	}{{"X", 1}, {"A", 1}, {"B", 3}, {"C", 3}} {
+		n := tt.Find(e.name)
+		c.Check(near(n.Length, e.length), check.Equals, true, check.Commentf("node %s got %v", e.name, n.Length))
+	}
+
+	// An undated tip is dated by the clock.
+	partial := map[string]float64{"A": 2002, "C": 2003}
+	d, err = StrictClock(t, partial)
+	c.Assert(err, check.Equals, nil)
+	b := t.Find("B")
+	c.Check(near(d.Dates[b]-d.Dates[b.Parent], 1.5/d.Rate), check.Equals, true)
+
+	_, err = StrictClock(t, map[string]float64{"A": 2002})
+	c.Check(err, check.Equals, ErrTooFewDates)
+}
@@ -0,0 +1,112 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/index/kmerindex"
+	"github.com/biogo/biogo/io/treeio/newick"
+	"github.com/biogo/biogo/seq/linear"
+)
+
+// A TreeBuilder constructs a tree from the distance matrix d between the named
+// taxa. NeighborJoining and UPGMA are TreeBuilders.
+type TreeBuilder func(names []string, d [][]float64) (*newick.Node, error)
+
+// KmerDistance returns the matrix of pairwise k-mer distances between the
+// nucleotide sequences seqs, computed without alignment using k-mer indexes.
+// The distance between two sequences is one minus the fraction of the k-mers of
+// the sequence with fewer k-mers that are shared with the other, counting each
+// k-mer up to the smaller number of times it occurs in either sequence, as used
+// by MUSCLE to build its initial guide tree (Edgar, NAR 32:1792-1797, 2004).
+// K-mers including gaps or ambiguous letters are not counted. Each sequence must
+// be longer than k.
+func KmerDistance(k int, seqs []*linear.Seq) ([][]float64, error) {
+	n := len(seqs)
+	if n == 0 {
+		return nil, ErrNoSequences
+	}
+	counts := make([]map[kmerindex.Kmer]int, n)
+	totals := make([]int, n)
+	for i, s := range seqs {
+		ki, err := kmerindex.New(k, ungapped(s))
+		if err != nil {
+			return nil, err
+		}
+		counts[i], _ = ki.KmerFrequencies()
+		for _, f := range counts[i] {
+			totals[i] += f
+		}
+	}
+
+	d := make([][]float64, n)
+	for i := range d {
+		d[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			a, b := counts[i], counts[j]
+			if len(b) < len(a) {
+				a, b = b, a
+			}
+			var shared int
+			for kmer, f := range a {
+				if g := b[kmer]; g < f {
+					shared += g
+				} else {
+					shared += f
+				}
+			}
+			min := totals[i]
+			if totals[j] < min {
+				min = totals[j]
+			}
+			d[i][j] = 1
+			if min != 0 {
+				d[i][j] -= float64(shared) / float64(min)
+			}
+			d[j][i] = d[i][j]
+		}
+	}
+	return d, nil
+}
+
+// ungapped returns s viewed with the four letter nucleotide alphabet used by
+// kmerindex, in which gaps and ambiguous letters are not indexed, if s uses a
+// gapped nucleotide alphabet.
+func ungapped(s *linear.Seq) *linear.Seq {
+	if s.Alpha == nil || s.Alpha.Len() == 4 {
+		return s
+	}
+	var alpha alphabet.Alphabet
+	switch s.Alpha.Moltype() {
+	case feat.DNA:
+		alpha = alphabet.DNA
+	case feat.RNA:
+		alpha = alphabet.RNA
+	default:
+		return s
+	}
+	u := *s
+	u.Alpha = alpha
+	return &u
+}
+
+// KmerTree returns a guide tree for the nucleotide sequences seqs constructed by
+// build from their k-mer distances as calculated by KmerDistance. The leaves of the
+// tree are named by the sequence IDs, so the tree is suitable for use as the guide
+// tree of a progressive multiple sequence alignment.
+func KmerTree(k int, seqs []*linear.Seq, build TreeBuilder) (*newick.Node, error) {
+	d, err := KmerDistance(k, seqs)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(seqs))
+	for i, s := range seqs {
+		names[i] = s.ID
+	}
+	return build(names, d)
+}
@@ -0,0 +1,349 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package phylo provides distance-based phylogenetic tree construction and
+// tree comparison.
+package phylo
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/treeio/newick"
+	"github.com/biogo/biogo/seq/multi"
+
+	"errors"
+	"math"
+	"sort"
+)
+
+var (
+	ErrNoOverlap     = errors.New("phylo: no comparable sites")
+	ErrBadMatrix     = errors.New("phylo: distance matrix does not match names")
+	ErrLeafMismatch  = errors.New("phylo: trees have different leaf sets")
+	ErrSaturated     = errors.New("phylo: distance too large for correction")
+	ErrNoSequences   = errors.New("phylo: no sequences")
+	ErrDuplicateLeaf = errors.New("phylo: duplicate leaf name")
+)
+
+// informative returns whether l is a residue that can be compared, neither a
+// gap nor the ambiguous letter of alpha.
+func informative(l alphabet.Letter, alpha alphabet.Alphabet) bool {
+	return l != alpha.Gap() && upper(l) != upper(alpha.Ambiguous())
+}
+
+func upper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l - 'a' + 'A'
+	}
+	return l
+}
+
+// PDistance returns the matrix of uncorrected pairwise distances, the
+// proportion of differing sites, between the rows of m over the alignment
+// columns [start, end). Sites with a gap or ambiguous letter in either row are
+// excluded from the comparison of that pair of rows. Letters are compared
+// without regard to case.
+func PDistance(m *multi.Multi, start, end int) ([][]float64, error) {
+	n := m.Rows()
+	if n == 0 {
+		return nil, ErrNoSequences
+	}
+	rows := make([]alphabet.Letters, n)
+	for i := range rows {
+		s := m.Row(i)
+		rows[i] = make(alphabet.Letters, end-start)
+		for col := start; col < end; col++ {
+			l := m.Alpha.Gap()
+			if s.Start() <= col && col < s.End() {
+				l = upper(s.At(col).L)
+			}
+			if !informative(l, m.Alpha) {
+				l = m.Alpha.Gap()
+			}
+			rows[i][col-start] = l
+		}
+	}
+
+	gap := m.Alpha.Gap()
+	d := make([][]float64, n)
+	for i := range d {
+		d[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			var sites, diff int
+			for k, a := range rows[i] {
+				b := rows[j][k]
+				if a == gap || b == gap {
+					continue
+				}
+				sites++
+				if a != b {
+					diff++
+				}
+			}
+			if sites == 0 {
+				return nil, ErrNoOverlap
+			}
+			d[i][j] = float64(diff) / float64(sites)
+			d[j][i] = d[i][j]
+		}
+	}
+	return d, nil
+}
+
+// JukesCantor applies the Jukes-Cantor correction for nucleotide sequences to
+// the p-distances in d, in place.
+func JukesCantor(d [][]float64) error {
+	for i, r := range d {
+		for j, p := range r {
+			if p >= 0.75 {
+				return ErrSaturated
+			}
+			d[i][j] = -0.75 * math.Log(1-4*p/3)
+		}
+	}
+	return nil
+}
+
+// NeighborJoining returns the tree constructed by the neighbor-joining
+// method of Saitou and Nei from the distance matrix d between the named taxa.
+// The returned tree is unrooted, represented by a root node with three
+// children when there are more than two taxa. Negative branch lengths are set
+// to zero.
+func NeighborJoining(names []string, d [][]float64) (*newick.Node, error) {
+	n := len(names)
+	if n == 0 {
+		return nil, ErrNoSequences
+	}
+	if len(d) != n {
+		return nil, ErrBadMatrix
+	}
+	dist := make([][]float64, n)
+	for i, r := range d {
+		if len(r) != n {
+			return nil, ErrBadMatrix
+		}
+		dist[i] = append([]float64(nil), r...)
+	}
+	nodes := make([]*newick.Node, n)
+	for i, name := range names {
+		nodes[i] = &newick.Node{Name: name}
+	}
+	if n == 1 {
+		return nodes[0], nil
+	}
+
+	active := make([]int, n)
+	for i := range active {
+		active[i] = i
+	}
+	for len(active) > 3 {
+		k := float64(len(active))
+		r := make(map[int]float64, len(active))
+		for _, i := range active {
+			for _, j := range active {
+				r[i] += dist[i][j]
+			}
+		}
+		bi, bj := -1, -1
+		best := math.Inf(1)
+		for x, i := range active {
+			for _, j := range active[x+1:] {
+				q := (k-2)*dist[i][j] - r[i] - r[j]
+				if q < best {
+					best, bi, bj = q, i, j
+				}
+			}
+		}
+		li := dist[bi][bj]/2 + (r[bi]-r[bj])/(2*(k-2))
+		u := &newick.Node{}
+		join(u, nodes[bi], li)
+		join(u, nodes[bj], dist[bi][bj]-li)
+
+		// Reuse the slot of bi for the new node.
+		for _, x := range active {
+			if x == bi || x == bj {
+				continue
+			}
+			dx := (dist[bi][x] + dist[bj][x] - dist[bi][bj]) / 2
+			dist[bi][x], dist[x][bi] = dx, dx
+		}
+		nodes[bi] = u
+		for x, i := range active {
+			if i == bj {
+				active = append(active[:x], active[x+1:]...)
+				break
+			}
+		}
+	}
+
+	root := &newick.Node{}
+	if len(active) == 2 {
+		a, b := active[0], active[1]
+		join(root, nodes[a], dist[a][b]/2)
+		join(root, nodes[b], dist[a][b]/2)
+		return root, nil
+	}
+	a, b, c := active[0], active[1], active[2]
+	join(root, nodes[a], (dist[a][b]+dist[a][c]-dist[b][c])/2)
+	join(root, nodes[b], (dist[a][b]+dist[b][c]-dist[a][c])/2)
+	join(root, nodes[c], (dist[a][c]+dist[b][c]-dist[a][b])/2)
+	return root, nil
+}
+
+// UPGMA returns the rooted tree constructed by the unweighted pair group method
+// with arithmetic mean from the distance matrix d between the named taxa. The
+// tree is ultrametric, with each internal node at half the average distance
+// between the taxa of its two subtrees.
+func UPGMA(names []string, d [][]float64) (*newick.Node, error) {
+	n := len(names)
+	if n == 0 {
+		return nil, ErrNoSequences
+	}
+	if len(d) != n {
+		return nil, ErrBadMatrix
+	}
+	dist := make([][]float64, n)
+	for i, r := range d {
+		if len(r) != n {
+			return nil, ErrBadMatrix
+		}
+		dist[i] = append([]float64(nil), r...)
+	}
+	nodes := make([]*newick.Node, n)
+	size := make([]float64, n)
+	height := make([]float64, n)
+	active := make([]int, n)
+	for i, name := range names {
+		nodes[i] = &newick.Node{Name: name}
+		size[i] = 1
+		active[i] = i
+	}
+
+	for len(active) > 1 {
+		bi, bj := -1, -1
+		best := math.Inf(1)
+		for x, i := range active {
+			for _, j := range active[x+1:] {
+				if dist[i][j] < best {
+					best, bi, bj = dist[i][j], i, j
+				}
+			}
+		}
+		h := dist[bi][bj] / 2
+		u := &newick.Node{}
+		join(u, nodes[bi], h-height[bi])
+		join(u, nodes[bj], h-height[bj])
+
+		// Reuse the slot of bi for the new node.
+		for _, x := range active {
+			if x == bi || x == bj {
+				continue
+			}
+			dx := (size[bi]*dist[bi][x] + size[bj]*dist[bj][x]) / (size[bi] + size[bj])
+			dist[bi][x], dist[x][bi] = dx, dx
+		}
+		nodes[bi], size[bi], height[bi] = u, size[bi]+size[bj], h
+		for x, i := range active {
+			if i == bj {
+				active = append(active[:x], active[x+1:]...)
+				break
+			}
+		}
+	}
+	return nodes[active[0]], nil
+}
+
+func join(parent, child *newick.Node, length float64) {
+	if length < 0 {
+		length = 0
+	}
+	child.Length, child.HasLength = length, true
+	parent.AddChild(child)
+}
+
+// splits returns the set of non-trivial bipartitions of the leaves of t. Each
+// bipartition is represented by the side not containing the first of the
+// sorted leaf names, encoded as a string of leaf membership flags.
+func splits(t *newick.Node) (map[string]bool, []string, error) {
+	leaves := t.Leaves()
+	names := make([]string, len(leaves))
+	for i, l := range leaves {
+		names[i] = l.Name
+	}
+	sort.Strings(names)
+	idx := make(map[string]int, len(names))
+	for i, name := range names {
+		if _, ok := idx[name]; ok {
+			return nil, nil, ErrDuplicateLeaf
+		}
+		idx[name] = i
+	}
+
+	s := make(map[string]bool)
+	var below func(*newick.Node) []byte
+	below = func(n *newick.Node) []byte {
+		set := make([]byte, len(names))
+		if n.IsLeaf() {
+			set[idx[n.Name]] = 1
+			return set
+		}
+		var size int
+		for _, c := range n.Children {
+			for i, v := range below(c) {
+				set[i] |= v
+			}
+		}
+		for _, v := range set {
+			size += int(v)
+		}
+		if n.Parent != nil && size >= 2 && size <= len(names)-2 {
+			key := append([]byte(nil), set...)
+			if key[0] == 1 {
+				for i := range key {
+					key[i] ^= 1
+				}
+			}
+			s[string(key)] = true
+		}
+		return set
+	}
+	below(t)
+	return s, names, nil
+}
+
+// RobinsonFoulds returns the Robinson-Foulds distance between the unrooted
+// topologies of trees a and b, the number of bipartitions of the leaves present
+// in only one of the trees. Leaves are identified by name, and both trees must
+// have the same leaves.
+func RobinsonFoulds(a, b *newick.Node) (int, error) {
+	sa, na, err := splits(a)
+	if err != nil {
+		return 0, err
+	}
+	sb, nb, err := splits(b)
+	if err != nil {
+		return 0, err
+	}
+	if len(na) != len(nb) {
+		return 0, ErrLeafMismatch
+	}
+	for i := range na {
+		if na[i] != nb[i] {
+			return 0, ErrLeafMismatch
+		}
+	}
+	var d int
+	for s := range sa {
+		if !sb[s] {
+			d++
+		}
+	}
+	for s := range sb {
+		if !sa[s] {
+			d++
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/treeio/newick"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestPDistance(c *check.C) {
+	var rows []seq.Sequence
+	for _, r := range [][2]string{{"a", "ACGTACGT"}, {"b", "ACGTACGA"}, {"c", "acg-nnGA"}} {
+		rows = append(rows, linear.NewSeq(r[0], alphabet.BytesToLetters([]byte(r[1])), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("", rows, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	d, err := PDistance(m, 0, 8)
+	c.Assert(err, check.Equals, nil)
+	c.Check(d, check.DeepEquals, [][]float64{
+		{0, 1. / 8, 1. / 5},
+		{1. / 8, 0, 0},
+		{1. / 5, 0, 0},
+	})
+	_, err = PDistance(m, 3, 6)
+	c.Check(err, check.Equals, ErrNoOverlap)
+
+	c.Check(JukesCantor([][]float64{{0.75}}), check.Equals, ErrSaturated)
+	jc := [][]float64{{0, 0.1}}
+	c.Assert(JukesCantor(jc), check.Equals, nil)
+	c.Check(jc[0][1] > 0.1, check.Equals, true)
+}
+
+func (s *S) TestNeighborJoining(c *check.C) {
+	// Additive distances of ((A:1,B:2):1,(C:1,D:3));
+	names := []string{"A", "B", "C", "D"}
+	d := [][]float64{
+		{0, 3, 3, 5},
+		{3, 0, 4, 6},
+		{3, 4, 0, 4},
+		{5, 6, 4, 0},
+	}
+	t, err := NeighborJoining(names, d)
+	c.Assert(err, check.Equals, nil)
+	for _, e := range []struct {
+		name   string
+		length float64
+	}{{"A", 1}, {"B", 2}, {"C", 1}, {"D", 3}} {
+		c.Check(t.Find(e.name).Length, check.Equals, e.length, check.Commentf("Leaf %s", e.name))
+	}
+
+	for i, e := range []struct {
+		tree string
+		rf   int
+		err  error
+	}{
+		{tree: "((A,B),(C,D));", rf: 0},
+		{tree: "(B,A,(D,C));", rf: 0},
+		{tree: "((A,C),(B,D));", rf: 2},
+		{tree: "(A,B,C,D);", rf: 1},
+		{tree: "((A,B),(C,E));", err: ErrLeafMismatch},
+		{tree: "((A,A),(C,D));", err: ErrDuplicateLeaf},
+	} {
+		other, err := newick.Parse(e.tree)
+		c.Assert(err, check.Equals, nil)
+		rf, err := RobinsonFoulds(t, other)
+		c.Check(err, check.Equals, e.err, check.Commentf("Test %d", i))
+		c.Check(rf, check.Equals, e.rf, check.Commentf("Test %d", i))
+	}
+
+	_, err = NeighborJoining(names, d[:3])
+	c.Check(err, check.Equals, ErrBadMatrix)
+	t, err = NeighborJoining(names[:2], [][]float64{{0, 2}, {2, 0}})
+	c.Assert(err, check.Equals, nil)
+	c.Check(t.String(), check.Equals, "(A:1,B:1);")
+}
+
+func (s *S) TestUPGMA(c *check.C) {
+	// Distances of ((A:1,B:1):2,(C:2,D:2):1);
+	names := []string{"A", "B", "C", "D"}
+	d := [][]float64{
+		{0, 2, 6, 6},
+		{2, 0, 6, 6},
+		{6, 6, 0, 4},
+		{6, 6, 4, 0},
+	}
+	t, err := UPGMA(names, d)
+	c.Assert(err, check.Equals, nil)
+	c.Check(t.String(), check.Equals, "((A:1,B:1):2,(C:2,D:2):1);")
+
+	_, err = UPGMA(names, d[:3])
+	c.Check(err, check.Equals, ErrBadMatrix)
+	t, err = UPGMA(names[:1], [][]float64{{0}})
+	c.Assert(err, check.Equals, nil)
+	c.Check(t.String(), check.Equals, "A;")
+}
+
+func (s *S) TestKmerTree(c *check.C) {
+	seqs := []*linear.Seq{
+		linear.NewSeq("a", alphabet.BytesToLetters([]byte("acgtacgtac")), alphabet.DNAgapped),
+		linear.NewSeq("b", alphabet.BytesToLetters([]byte("acgtacg-tac")), alphabet.DNAgapped),
+		linear.NewSeq("c", alphabet.BytesToLetters([]byte("ttttgggttt")), alphabet.DNAgapped),
+		linear.NewSeq("d", alphabet.BytesToLetters([]byte("tttggggttt")), alphabet.DNAgapped),
+	}
+	d, err := KmerDistance(4, seqs)
+	c.Assert(err, check.Equals, nil)
+	c.Check(d[0][1], check.Equals, 0.)
+	c.Check(d[0][2], check.Equals, 1.)
+	shared, total := 6., 7.
+	c.Check(d[2][3], check.Equals, 1-shared/total)
+	c.Check(d[3][2], check.Equals, d[2][3])
+
+	for _, build := range []TreeBuilder{NeighborJoining, UPGMA} {
+		t, err := KmerTree(4, seqs, build)
+		c.Assert(err, check.Equals, nil)
+		want, err := newick.Parse("((a,b),(c,d));")
+		c.Assert(err, check.Equals, nil)
+		rf, err := RobinsonFoulds(t, want)
+		c.Assert(err, check.Equals, nil)
+		c.Check(rf, check.Equals, 0)
+	}
+
+	_, err = KmerDistance(4, seqs[:1:1])
+	c.Check(err, check.Equals, nil)
+	_, err = KmerDistance(10, seqs)
+	c.Check(err, check.Not(check.Equals), nil)
+}
+
+func (s *S) TestWindows(c *check.C) {
+	// The first half of the alignment supports AB|CD
+	// and the second half AC|BD.
+	var rows []seq.Sequence
+	for _, r := range [][2]string{
+		{"A", "AAAAAAAAGGGGGGGG"},
+		{"B", "AAAAAAAATTTTTTTT"},
+		{"C", "CCCCCCCCGGGGGGGG"},
+		{"D", "CCCCCCCCTTTTTTTT"},
+	} {
+		rows = append(rows, linear.NewSeq(r[0], alphabet.BytesToLetters([]byte(r[1])), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("", rows, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	ref, err := newick.Parse("((A,B),(C,D));")
+	c.Assert(err, check.Equals, nil)
+
+	ws, err := Windows(m, ref, 8, 8)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(ws), check.Equals, 2)
+	for i, e := range []struct {
+		start, end, informative, rf int
+		changed                     bool
+	}{
+		{0, 8, 8, 0, false},
+		{8, 16, 8, 2, true},
+	} {
+		w := ws[i]
+		c.Check(w.Start, check.Equals, e.start, check.Commentf("Test %d", i))
+		c.Check(w.End, check.Equals, e.end, check.Commentf("Test %d", i))
+		c.Check(w.Informative, check.Equals, e.informative, check.Commentf("Test %d", i))
+		c.Check(w.RF, check.Equals, e.rf, check.Commentf("Test %d", i))
+		c.Check(w.Distance, check.Equals, float64(e.rf)/2, check.Commentf("Test %d", i))
+		c.Check(w.Changed, check.Equals, e.changed, check.Commentf("Test %d", i))
+	}
+
+	ss := WindowSeries("aln", ws)
+	c.Assert(ss, check.HasLen, 4)
+	c.Check(ss[3].Name, check.Equals, "Changed")
+	c.Check(ss[3].Points[1].Y, check.Equals, 1.)
+
+	ws, err = Windows(m, nil, 0, 0)
+	c.Assert(err, check.Equals, nil)
+	c.Check(len(ws), check.Equals, 1)
+	c.Check(ws[0].RF, check.Equals, 0)
+
+	bad, err := newick.Parse("((A,B),(C,E));")
+	c.Assert(err, check.Equals, nil)
+	_, err = Windows(m, bad, 8, 8)
+	c.Check(err, check.Equals, ErrLeafMismatch)
+}
@@ -0,0 +1,288 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package recomb provides screening of multiple alignments for recombination.
+//
+// Two screens are provided. The pairwise homoplasy index (PHI) test of Bruen,
+// Philippe and Bryant (Genetics 172:2665-2681, 2006) tests for recombination
+// anywhere in an alignment by comparing the mean incompatibility of nearby
+// parsimony informative sites with that of the same sites in permuted order.
+// The topology incongruence screen locates candidate recombinant regions by
+// comparing neighbor-joining trees of sliding windows of the alignment with the
+// tree of the whole alignment.
+package recomb
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seriesio"
+	"github.com/biogo/biogo/phylo"
+	"github.com/biogo/biogo/seq/multi"
+
+	"errors"
+	"math/rand"
+)
+
+var (
+	ErrTooFewSites = errors.New("recomb: too few informative sites")
+	ErrTooFewSeqs  = errors.New("recomb: too few sequences")
+)
+
+// Params describes the parameters used for recombination screening.
+type Params struct {
+	// PHIWindow is the maximum separation, in informative
+	// sites, of the pairs of sites scored by the PHI test,
+	// and Permutations is the number of permutations used
+	// to assess its significance.
+	PHIWindow    int
+	Permutations int
+
+	// Window and Step are the width and step, in alignment
+	// columns, of the windows of the incongruence screen.
+	Window, Step int
+
+	// Threshold is the normalized Robinson-Foulds distance
+	// from the whole alignment tree above which a window
+	// is reported as a candidate recombinant region.
+	Threshold float64
+}
+
+// DefaultParams are the default recombination screening parameters.
+var DefaultParams = Params{
+	PHIWindow:    100,
+	Permutations: 1000,
+
+	Window: 500,
+	Step:   100,
+
+	Threshold: 0,
+}
+
+// PHIResult is the result of a PHI test.
+type PHIResult struct {
+	// Sites is the number of parsimony
+	// informative sites.
+	Sites int
+
+	// PHI is the mean refined incompatibility
+	// of pairs of informative sites separated
+	// by at most the PHI window.
+	PHI float64
+
+	// P is the permutation p-value, the
+	// proportion of permutations, including the
+	// observed order, with a PHI no greater
+	// than the observed PHI.
+	P float64
+}
+
+// columns returns the parsimony informative columns of m, those with at least
+// two states each present in at least two rows, with gaps and ambiguous letters
+// replaced by the gap letter.
+func columns(m *multi.Multi) [][]alphabet.Letter {
+	gap := m.Alpha.Gap()
+	amb := upper(m.Alpha.Ambiguous())
+	var cols [][]alphabet.Letter
+	for col := m.Start(); col < m.End(); col++ {
+		c := make([]alphabet.Letter, m.Rows())
+		count := make(map[alphabet.Letter]int)
+		for i := range c {
+			s := m.Row(i)
+			l := gap
+			if s.Start() <= col && col < s.End() {
+				l = upper(s.At(col).L)
+			}
+			if l == amb {
+				l = gap
+			}
+			c[i] = l
+			if l != gap {
+				count[l]++
+			}
+		}
+		var shared int
+		for _, n := range count {
+			if n >= 2 {
+				shared++
+			}
+		}
+		if shared >= 2 {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
+func upper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l - 'a' + 'A'
+	}
+	return l
+}
+
+// incompatibility returns the refined incompatibility score of sites a and b,
+// the cyclomatic number of the bipartite graph joining the states of a and b
+// that occur together in a row. Rows with a gap at either site are ignored.
+func incompatibility(a, b []alphabet.Letter, gap alphabet.Letter) int {
+	type edge struct{ x, y alphabet.Letter }
+	var (
+		edges  = make(map[edge]bool)
+		parent = make(map[int]int)
+	)
+	// States of a are numbered by letter and those
+	// of b by letter offset by 256.
+	var find func(int) int
+	find = func(v int) int {
+		p, ok := parent[v]
+		if !ok || p == v {
+			parent[v] = v
+			return v
+		}
+		r := find(p)
+		parent[v] = r
+		return r
+	}
+	for i, x := range a {
+		y := b[i]
+		if x == gap || y == gap {
+			continue
+		}
+		e := edge{x, y}
+		if edges[e] {
+			continue
+		}
+		edges[e] = true
+		u, v := find(int(x)), find(int(y)+256)
+		if u != v {
+			parent[u] = v
+		}
+	}
+	var comps int
+	for v := range parent {
+		if find(v) == v {
+			comps++
+		}
+	}
+	return len(edges) - len(parent) + comps
+}
+
+// PHI performs the PHI test for recombination on m using random permutations
+// drawn from rnd. If rnd is nil, the default source of the math/rand package is
+// used.
+func PHI(m *multi.Multi, p Params, rnd *rand.Rand) (PHIResult, error) {
+	cols := columns(m)
+	k := len(cols)
+	if k < 2 {
+		return PHIResult{Sites: k}, ErrTooFewSites
+	}
+	gap := m.Alpha.Gap()
+	score := make([]int16, k*k)
+	for i := 0; i < k; i++ {
+		for j := i + 1; j < k; j++ {
+			s := int16(incompatibility(cols[i], cols[j], gap))
+			score[i*k+j], score[j*k+i] = s, s
+		}
+	}
+	w := p.PHIWindow
+	if w < 1 || w >= k {
+		w = k - 1
+	}
+	phi := func(order []int) float64 {
+		var (
+			sum float64
+			n   int
+		)
+		for i := range order {
+			for j := i + 1; j <= i+w && j < len(order); j++ {
+				sum += float64(score[order[i]*k+order[j]])
+				n++
+			}
+		}
+		return sum / float64(n)
+	}
+
+	order := make([]int, k)
+	for i := range order {
+		order[i] = i
+	}
+	r := PHIResult{Sites: k, PHI: phi(order)}
+	perm := rand.Perm
+	if rnd != nil {
+		perm = rnd.Perm
+	}
+	le := 1
+	for i := 0; i < p.Permutations; i++ {
+		if phi(perm(k)) <= r.PHI {
+			le++
+		}
+	}
+	r.P = float64(le) / float64(p.Permutations+1)
+	return r, nil
+}
+
+// A Window is a window of the topology incongruence screen.
+type Window struct {
+	Start, End int // Alignment columns of the window.
+
+	// RF is the Robinson-Foulds distance of the
+	// window tree from the whole alignment tree,
+	// and Distance is RF normalized by its maximum.
+	RF       int
+	Distance float64
+}
+
+// A Region is a candidate recombinant region of an alignment.
+type Region struct {
+	Start, End int // Alignment columns of the region.
+}
+
+// Incongruence performs the topology incongruence screen on m. It returns the
+// windows of m, excluding those in which a pair of rows have no comparable
+// sites, and the regions formed by the union of the windows with a normalized
+// distance greater than p.Threshold. If p.Window is less than one, a single
+// window covering the whole alignment is used, and if p.Step is less than one,
+// windows do not overlap.
+func Incongruence(m *multi.Multi, p Params) ([]Window, []Region, error) {
+	if m.Rows() < 4 {
+		return nil, nil, ErrTooFewSeqs
+	}
+	pws, err := phylo.Windows(m, nil, p.Window, p.Step)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		ws []Window
+		rs []Region
+	)
+	for _, pw := range pws {
+		w := Window{Start: pw.Start, End: pw.End, RF: pw.RF, Distance: pw.Distance}
+		ws = append(ws, w)
+		if w.Distance <= p.Threshold {
+			continue
+		}
+		if len(rs) != 0 && w.Start <= rs[len(rs)-1].End {
+			rs[len(rs)-1].End = w.End
+		} else {
+			rs = append(rs, Region{Start: w.Start, End: w.End})
+		}
+	}
+	return ws, rs, nil
+}
+
+// WindowSeries returns the normalized Robinson-Foulds distance track of the
+// windows ws of the alignment named ref.
+func WindowSeries(ref string, ws []Window) *seriesio.Series {
+	s := &seriesio.Series{
+		Name:   "Incongruence",
+		Kind:   seriesio.Track,
+		Ref:    ref,
+		X:      seriesio.Axis{Label: "alignment column"},
+		Y:      seriesio.Axis{Label: "normalized Robinson-Foulds distance"},
+		Points: make([]seriesio.Point, len(ws)),
+	}
+	for i, w := range ws {
+		s.Points[i] = seriesio.Point{X: float64(w.Start), End: float64(w.End), Y: w.Distance}
+	}
+	return s
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package recomb
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+// recombinant returns an alignment of four sequences where the first 140
+// columns support the split AB|CD and the remaining 60 support AC|BD.
+func recombinant(c *check.C) *multi.Multi {
+	rows := make([][]byte, 4)
+	for i := 0; i < 200; i++ {
+		col := "AAGG"
+		if i >= 140 {
+			col = "CTCT"
+		}
+		for r := range rows {
+			rows[r] = append(rows[r], col[r])
+		}
+	}
+	var s []seq.Sequence
+	for i, r := range rows {
+		s = append(s, linear.NewSeq(string(rune('A'+i)), alphabet.BytesToLetters(r), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("", s, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+func (s *S) TestIncompatibility(c *check.C) {
+	l := func(s string) []alphabet.Letter { return alphabet.BytesToLetters([]byte(s)) }
+	for i, t := range []struct {
+		a, b   string
+		expect int
+	}{
+		{"AAGG", "CCTT", 0},
+		{"AAGG", "CTCT", 1},
+		{"AAGG", "C-CT", 0},
+		{"ACGT", "ACGT", 0},
+		{"AACCGG", "ATATAT", 2},
+	} {
+		c.Check(incompatibility(l(t.a), l(t.b), '-'), check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestPHI(c *check.C) {
+	p := DefaultParams
+	p.PHIWindow = 10
+	p.Permutations = 99
+	r, err := PHI(recombinant(c), p, rand.New(rand.NewSource(1)))
+	c.Assert(err, check.Equals, nil)
+	c.Check(r.Sites, check.Equals, 200)
+	c.Check(r.PHI, check.Equals, 55./(200*10-55))
+	c.Check(r.P, check.Equals, 0.01)
+}
+
+func (s *S) TestIncongruence(c *check.C) {
+	p := DefaultParams
+	p.Window, p.Step = 50, 25
+	ws, rs, err := Incongruence(recombinant(c), p)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(ws, check.HasLen, 7)
+	var rf []int
+	for _, w := range ws {
+		rf = append(rf, w.RF)
+	}
+	c.Check(rf, check.DeepEquals, []int{0, 0, 0, 0, 0, 2, 2})
+	c.Check(ws[6].Distance, check.Equals, 1.)
+	c.Check(rs, check.DeepEquals, []Region{{Start: 125, End: 200}})
+
+	ts := WindowSeries("recombinant", ws)
+	c.Assert(ts.Points, check.HasLen, 7)
+	c.Check(ts.Points[6].Y, check.Equals, 1.)
+	c.Check(ts.Points[6].X, check.Equals, 150.)
+}
@@ -0,0 +1,228 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package spectrum provides mapping of nucleotide substitutions onto the
+// branches of a tree from ancestral sequence reconstructions, and their
+// classification into the 96 trinucleotide mutation contexts used for mutation
+// signature analysis.
+//
+// Substitutions are classified by the pyrimidine of the mutated base pair, so
+// G>T in the context AGC is counted as C>A in the context GCT. Contexts are
+// ordered by substitution type, C>A, C>G, C>T, T>A, T>C and T>G, then by 5'
+// base and then by 3' base, each in the order A, C, G, T, as in the COSMIC
+// signature tables.
+package spectrum
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seriesio"
+	"github.com/biogo/biogo/io/treeio/newick"
+
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrMissingSeq     = errors.New("spectrum: missing sequence")
+	ErrLengthMismatch = errors.New("spectrum: sequence length mismatch")
+)
+
+// NumContexts is the number of trinucleotide mutation contexts.
+const NumContexts = 96
+
+const bases = "ACGT"
+
+var types = [...]string{"C>A", "C>G", "C>T", "T>A", "T>C", "T>G"}
+
+func index(l alphabet.Letter) int {
+	switch l {
+	case 'A', 'a':
+		return 0
+	case 'C', 'c':
+		return 1
+	case 'G', 'g':
+		return 2
+	case 'T', 't':
+		return 3
+	}
+	return -1
+}
+
+// A Context is a trinucleotide mutation context.
+type Context int
+
+// ContextOf returns the context of the substitution of ref by alt with the
+// 5' neighbour five and the 3' neighbour three. It returns false if any of
+// the letters is not an unambiguous nucleotide or if ref and alt are the same.
+func ContextOf(five, ref, alt, three alphabet.Letter) (Context, bool) {
+	f, r, a, t := index(five), index(ref), index(alt), index(three)
+	if f < 0 || r < 0 || a < 0 || t < 0 || r == a {
+		return 0, false
+	}
+	if r == 0 || r == 2 {
+		// Purines are taken on the opposite strand.
+		f, r, a, t = 3-t, 3-r, 3-a, 3-f
+	}
+	var typ int
+	if r == 1 {
+		typ = [...]int{0, -1, 1, 2}[a]
+	} else {
+		typ = [...]int{3, 4, 5, -1}[a]
+	}
+	return Context(typ*16 + f*4 + t), true
+}
+
+// String returns the context in the form A[C>T]G.
+func (c Context) String() string {
+	if c < 0 || c >= NumContexts {
+		return fmt.Sprintf("Context(%d)", int(c))
+	}
+	return fmt.Sprintf("%c[%s]%c", bases[c%16/4], types[c/16], bases[c%4])
+}
+
+// A Substitution is a nucleotide substitution on a branch of a tree.
+type Substitution struct {
+	// Branch is the node at the child end of
+	// the branch.
+	Branch *newick.Node
+
+	// Pos is the position of the substitution and
+	// Ref and Alt are the parent and child letters.
+	Pos      int
+	Ref, Alt alphabet.Letter
+
+	// Context is the mutation context of the
+	// substitution, given by the parent sequence.
+	// It is only valid if HasContext is true.
+	Context    Context
+	HasContext bool
+}
+
+// Map returns the substitutions on the branches of the tree t given the
+// sequences of all its nodes, including the reconstructed ancestral sequences
+// of internal nodes, keyed by node name. The sequences must be aligned and of
+// equal length. Positions with a gap or an ambiguous letter at either end of a
+// branch are ignored, and substitutions next to such a position, or at either
+// end of the sequence, have no context. Substitutions are returned in the order
+// of a walk of t and by position within each branch.
+func Map(t *newick.Node, seqs map[string]alphabet.Letters) ([]Substitution, error) {
+	var (
+		subs []Substitution
+		err  error
+		n    = -1
+	)
+	t.Walk(func(v *newick.Node) bool {
+		s, ok := seqs[v.Name]
+		if !ok {
+			err = fmt.Errorf("%v: %q", ErrMissingSeq, v.Name)
+			return false
+		}
+		if n < 0 {
+			n = len(s)
+		}
+		if len(s) != n {
+			err = ErrLengthMismatch
+			return false
+		}
+		if v.Parent == nil {
+			return true
+		}
+		p, ok := seqs[v.Parent.Name]
+		if !ok {
+			err = fmt.Errorf("%v: %q", ErrMissingSeq, v.Parent.Name)
+			return false
+		}
+		for i, a := range s {
+			r := p[i]
+			if index(r) < 0 || index(a) < 0 || index(r) == index(a) {
+				continue
+			}
+			sub := Substitution{Branch: v, Pos: i, Ref: r, Alt: a}
+			if i > 0 && i < n-1 {
+				sub.Context, sub.HasContext = ContextOf(p[i-1], r, a, p[i+1])
+			}
+			subs = append(subs, sub)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// A Spectrum holds counts of substitutions in each mutation context.
+type Spectrum [NumContexts]int
+
+// Add adds the substitutions in subs that have a context to the spectrum.
+func (s *Spectrum) Add(subs ...Substitution) {
+	for _, sub := range subs {
+		if sub.HasContext {
+			s[sub.Context]++
+		}
+	}
+}
+
+// Total returns the total number of substitutions in the spectrum.
+func (s *Spectrum) Total() int {
+	var n int
+	for _, c := range s {
+		n += c
+	}
+	return n
+}
+
+// Proportions returns the proportion of substitutions in each context. All
+// proportions are zero if the spectrum is empty.
+func (s *Spectrum) Proportions() [NumContexts]float64 {
+	var p [NumContexts]float64
+	n := s.Total()
+	if n == 0 {
+		return p
+	}
+	for i, c := range s {
+		p[i] = float64(c) / float64(n)
+	}
+	return p
+}
+
+// Matrix returns the branches of t, identified by their child nodes in the
+// order of a walk of t, and the mutation spectrum of each branch from subs.
+// The spectra form a branch by context matrix for signature analysis.
+func Matrix(t *newick.Node, subs []Substitution) ([]*newick.Node, []Spectrum) {
+	var branches []*newick.Node
+	idx := make(map[*newick.Node]int)
+	t.Walk(func(v *newick.Node) bool {
+		if v.Parent != nil {
+			idx[v] = len(branches)
+			branches = append(branches, v)
+		}
+		return true
+	})
+	m := make([]Spectrum, len(branches))
+	for _, sub := range subs {
+		if i, ok := idx[sub.Branch]; ok {
+			m[i].Add(sub)
+		}
+	}
+	return branches, m
+}
+
+// Series returns the spectrum as a distribution over the mutation contexts
+// with the given name.
+func (s *Spectrum) Series(name string) *seriesio.Series {
+	cats := make([]string, NumContexts)
+	pts := make([]seriesio.Point, NumContexts)
+	for i, c := range s {
+		cats[i] = Context(i).String()
+		pts[i] = seriesio.Point{X: float64(i), Y: float64(c), Label: cats[i]}
+	}
+	return &seriesio.Series{
+		Name:   name,
+		Kind:   seriesio.Distribution,
+		X:      seriesio.Axis{Label: "mutation context", Categories: cats},
+		Y:      seriesio.Axis{Label: "substitutions"},
+		Points: pts,
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spectrum
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/treeio/newick"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestContextOf(c *check.C) {
+	for i, t := range []struct {
+		five, ref, alt, three alphabet.Letter
+		ok                    bool
+		ctx                   Context
+		str                   string
+	}{
+		{'A', 'C', 'A', 'A', true, 0, "A[C>A]A"},
+		{'T', 'T', 'G', 'T', true, 95, "T[T>G]T"},
+		{'a', 'c', 't', 'g', true, 2*16 + 2, "A[C>T]G"},
+		// G>T in AGC is C>A in GCT.
+		{'A', 'G', 'T', 'C', true, 0*16 + 2*4 + 3, "G[C>A]T"},
+		// A>G in CAT is T>C in ATG.
+		{'C', 'A', 'G', 'T', true, 4*16 + 0*4 + 2, "A[T>C]G"},
+		{'A', 'C', 'C', 'A', false, 0, ""},
+		{'N', 'C', 'T', 'A', false, 0, ""},
+		{'A', 'C', '-', 'A', false, 0, ""},
+	} {
+		ctx, ok := ContextOf(t.five, t.ref, t.alt, t.three)
+		c.Check(ok, check.Equals, t.ok, check.Commentf("Test %d", i))
+		if ok {
+			c.Check(ctx, check.Equals, t.ctx, check.Commentf("Test %d", i))
+			c.Check(ctx.String(), check.Equals, t.str, check.Commentf("Test %d", i))
+		}
+	}
+	seen := make(map[string]bool)
+	for ctx := Context(0); ctx < NumContexts; ctx++ {
+		seen[ctx.String()] = true
+	}
+	c.Check(len(seen), check.Equals, NumContexts)
+}
+
+func (s *S) TestMap(c *check.C) {
+	t, err := newick.Parse("((A,B)X,C)R;")
+	c.Assert(err, check.Equals, nil)
+	seqs := map[string]alphabet.Letters{
+		"R": alphabet.Letters("ACGTACGTAC"),
+		"X": alphabet.Letters("ACTTACGTAC"),
+		"A": alphabet.Letters("ACTTAAGTAC"),
+		"B": alphabet.Letters("ACTTACGT-G"),
+		"C": alphabet.Letters("GCGTACGNAC"),
+	}
+	subs, err := Map(t, seqs)
+	c.Assert(err, check.Equals, nil)
+
+	type sub struct {
+		branch     string
+		pos        int
+		ref, alt   alphabet.Letter
+		ctx        string
+		hasContext bool
+	}
+	var got []sub
+	for _, s := range subs {
+		g := sub{branch: s.Branch.Name, pos: s.Pos, ref: s.Ref, alt: s.Alt, hasContext: s.HasContext}
+		if s.HasContext {
+			g.ctx = s.Context.String()
+		}
+		got = append(got, g)
+	}
+	c.Check(got, check.DeepEquals, []sub{
+		// G>T in CGT is C>A in ACG.
+		{"X", 2, 'G', 'T', "A[C>A]G", true},
+		{"A", 5, 'C', 'A', "A[C>A]G", true},
+		{"B", 9, 'C', 'G', "", false},
+		{"C", 0, 'A', 'G', "", false},
+	})
+
+	branches, m := Matrix(t, subs)
+	c.Assert(len(branches), check.Equals, 4)
+	var total int
+	for i, b := range branches {
+		n := m[i].Total()
+		total += n
+		switch b.Name {
+		case "X", "A":
+			c.Check(n, check.Equals, 1, check.Commentf("branch %s", b.Name))
+		default:
+			c.Check(n, check.Equals, 0, check.Commentf("branch %s", b.Name))
+		}
+	}
+	c.Check(total, check.Equals, 2)
+
+	var sp Spectrum
+	sp.Add(subs...)
+	c.Check(sp.Total(), check.Equals, 2)
+	c.Check(sp[0*16+0*4+2], check.Equals, 2)
+	c.Check(sp.Proportions()[0*16+0*4+2], check.Equals, 1.0)
+	ss := sp.Series("all")
+	c.Check(ss.X.Categories[2], check.Equals, "A[C>A]G")
+	c.Check(ss.Points[2].Y, check.Equals, 2.)
+
+	delete(seqs, "X")
+	_, err = Map(t, seqs)
+	c.Check(err, check.ErrorMatches, `spectrum: missing sequence: "X"`)
+	seqs["X"] = alphabet.Letters("ACG")
+	_, err = Map(t, seqs)
+	c.Check(err, check.Equals, ErrLengthMismatch)
+}
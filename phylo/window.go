@@ -0,0 +1,174 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package phylo
+
+import (
+	"github.com/biogo/biogo/io/seriesio"
+	"github.com/biogo/biogo/io/treeio/newick"
+	"github.com/biogo/biogo/seq/multi"
+)
+
+// A Window is the result of the analysis of a window of an alignment.
+type Window struct {
+	Start, End int // Alignment columns of the window.
+
+	// Informative is the number of parsimony informative
+	// columns in the window, those with at least two states
+	// each present in at least two rows.
+	Informative int
+
+	// Tree is the neighbor-joining tree of the window.
+	Tree *newick.Node
+
+	// RF is the Robinson-Foulds distance of Tree from the
+	// reference tree, and Distance is RF normalized by its
+	// maximum for the number of taxa.
+	RF       int
+	Distance float64
+
+	// Changed indicates that the topology of Tree differs
+	// from that of the preceding window.
+	Changed bool
+}
+
+// Windows builds neighbor-joining trees from the p-distances between the rows
+// of m in windows of width columns, starting every step columns, and compares
+// each with the reference tree ref and with the tree of the preceding window.
+// If ref is nil, the neighbor-joining tree of the whole alignment is used. If
+// width is less than one, a single window covering the whole alignment is used,
+// and if step is less than one, windows do not overlap. Windows in which a pair
+// of rows have no comparable sites are omitted. The leaves of ref must be named
+// by the names of the rows of m.
+func Windows(m *multi.Multi, ref *newick.Node, width, step int) ([]Window, error) {
+	n := m.Rows()
+	if n == 0 {
+		return nil, ErrNoSequences
+	}
+	names := make([]string, n)
+	for i := range names {
+		names[i] = m.Row(i).Name()
+	}
+	if ref == nil {
+		d, err := PDistance(m, m.Start(), m.End())
+		if err != nil {
+			return nil, err
+		}
+		ref, err = NeighborJoining(names, d)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var max float64
+	if n > 3 {
+		max = float64(2 * (n - 3))
+	}
+
+	if width < 1 {
+		width = m.End() - m.Start()
+	}
+	if step < 1 {
+		step = width
+	}
+	var (
+		ws   []Window
+		prev *newick.Node
+	)
+	for start := m.Start(); start+width <= m.End(); start += step {
+		end := start + width
+		d, err := PDistance(m, start, end)
+		if err == ErrNoOverlap {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		t, err := NeighborJoining(names, d)
+		if err != nil {
+			return nil, err
+		}
+		w := Window{Start: start, End: end, Informative: informativeColumns(m, start, end), Tree: t}
+		w.RF, err = RobinsonFoulds(ref, t)
+		if err != nil {
+			return nil, err
+		}
+		if max != 0 {
+			w.Distance = float64(w.RF) / max
+		}
+		if prev != nil {
+			rf, err := RobinsonFoulds(prev, t)
+			if err != nil {
+				return nil, err
+			}
+			w.Changed = rf != 0
+		}
+		prev = t
+		ws = append(ws, w)
+	}
+	return ws, nil
+}
+
+// informativeColumns returns the number of parsimony informative columns of m
+// in [start, end).
+func informativeColumns(m *multi.Multi, start, end int) int {
+	var n int
+	count := make(map[byte]int)
+	for col := start; col < end; col++ {
+		for k := range count {
+			delete(count, k)
+		}
+		for i := 0; i < m.Rows(); i++ {
+			s := m.Row(i)
+			if col < s.Start() || s.End() <= col {
+				continue
+			}
+			l := upper(s.At(col).L)
+			if informative(l, m.Alpha) {
+				count[byte(l)]++
+			}
+		}
+		var shared int
+		for _, c := range count {
+			if c >= 2 {
+				shared++
+			}
+		}
+		if shared >= 2 {
+			n++
+		}
+	}
+	return n
+}
+
+// WindowSeries returns the informative column count, Robinson-Foulds distance,
+// normalized distance and topology change tracks of the windows ws of the
+// alignment named ref. Topology changes are given as one for a changed window
+// and zero otherwise.
+func WindowSeries(ref string, ws []Window) []*seriesio.Series {
+	track := func(name, label string, v func(Window) float64) *seriesio.Series {
+		s := &seriesio.Series{
+			Name:   name,
+			Kind:   seriesio.Track,
+			Ref:    ref,
+			X:      seriesio.Axis{Label: "alignment column"},
+			Y:      seriesio.Axis{Label: label},
+			Points: make([]seriesio.Point, len(ws)),
+		}
+		for i, w := range ws {
+			s.Points[i] = seriesio.Point{X: float64(w.Start), End: float64(w.End), Y: v(w)}
+		}
+		return s
+	}
+	return []*seriesio.Series{
+		track("Informative", "parsimony informative columns", func(w Window) float64 { return float64(w.Informative) }),
+		track("RF", "Robinson-Foulds distance", func(w Window) float64 { return float64(w.RF) }),
+		track("Distance", "normalized Robinson-Foulds distance", func(w Window) float64 { return w.Distance }),
+		track("Changed", "topology change", func(w Window) float64 {
+			if w.Changed {
+				return 1
+			}
+			return 0
+		}),
+	}
+}
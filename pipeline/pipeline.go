@@ -0,0 +1,495 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pipeline provides declarative description and concurrent execution of
+// multi-step batch analyses.
+//
+// A Pipeline is a set of named Stages, each consuming the outputs of earlier
+// stages and producing a single output. Stages are executed by a concurrent
+// Processor as soon as their inputs are available. Stage outputs may be held in
+// memory or written to files; file outputs persist between runs, so a pipeline
+// that fails may be run again to resume from the last completed file stages.
+package pipeline
+
+import (
+	"github.com/biogo/biogo/concurrent"
+
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+)
+
+var (
+	ErrNoName         = errors.New("pipeline: stage has no name")
+	ErrDuplicateStage = errors.New("pipeline: duplicate stage name")
+	ErrMissingInput   = errors.New("pipeline: input stage not defined")
+	ErrCycle          = errors.New("pipeline: stage dependencies form a cycle")
+	ErrTypeMismatch   = errors.New("pipeline: input type mismatch")
+	ErrNoType         = errors.New("pipeline: file storage requires an output type")
+	ErrNoDir          = errors.New("pipeline: file storage requires a directory")
+	ErrNoRun          = errors.New("pipeline: stage has no run function")
+	ErrTooMuchMemory  = errors.New("pipeline: stage memory exceeds scheduler limit")
+
+	errCancelled = errors.New("pipeline: stage cancelled")
+)
+
+// Storage specifies where a stage output is held.
+type Storage int
+
+const (
+	// Memory outputs are held in memory for the
+	// duration of a single run.
+	Memory Storage = iota
+
+	// File outputs are gob encoded to a file in
+	// the pipeline directory and persist between
+	// runs.
+	File
+)
+
+// A Stage is a step of a pipeline.
+type Stage struct {
+	Name string
+
+	// Inputs holds the names of the stages whose
+	// outputs are passed to Run, in order.
+	//
+	// InputTypes optionally holds the types of the
+	// inputs. If an input type and the output type
+	// of its stage are both given, the output type
+	// must be assignable to the input type.
+	Inputs     []string
+	InputTypes []reflect.Type
+
+	// Output is the type of the output of the stage.
+	// If it is not nil, the value returned by Run
+	// must be of this type. It is required for file
+	// storage.
+	Output reflect.Type
+
+	// Storage specifies where the output is held.
+	Storage Storage
+
+	// Threads is the number of threads the stage
+	// will use. The pipeline reserves this number
+	// of threads from its scheduler while the stage
+	// runs. Stages with Threads less than one use
+	// one thread, and stages with more threads than
+	// the scheduler limit use the limit.
+	Threads int
+
+	// Mem is the number of bytes of memory the
+	// stage will use. The pipeline reserves this
+	// amount of memory from its scheduler while
+	// the stage runs.
+	Mem int64
+
+	// Run performs the work of the stage. It is
+	// passed the number of threads reserved for
+	// the stage and the stage inputs.
+	Run func(threads int, in ...interface{}) (interface{}, error)
+}
+
+func (s *Stage) threads(max int) int {
+	t := s.Threads
+	if t < 1 {
+		t = 1
+	}
+	if t > max {
+		t = max
+	}
+	return t
+}
+
+// A StageError is an error associated with a stage.
+type StageError struct {
+	Stage string
+	Err   error
+}
+
+func (e *StageError) Error() string { return fmt.Sprintf("pipeline: stage %q: %v", e.Stage, e.Err) }
+
+// A Pipeline is a set of stages.
+type Pipeline struct {
+	Stages []*Stage
+
+	// Threads is the total number of threads
+	// available to concurrently running stages
+	// when Scheduler is nil. If Threads is less
+	// than one, one thread is used.
+	Threads int
+
+	// Scheduler allocates threads and memory to
+	// running stages. If Scheduler is nil, a
+	// scheduler with Threads threads and no
+	// memory limit is used for each run.
+	Scheduler *Scheduler
+
+	// Dir is the directory holding file stage
+	// outputs.
+	Dir string
+}
+
+// Validate checks that the stages of p are uniquely named, that all stage
+// inputs are defined and typed consistently, that file stages have an output
+// type and that the stages do not form a cycle. It returns the stages in an
+// order in which they may be executed.
+func (p *Pipeline) Validate() ([]*Stage, error) {
+	stages := make(map[string]*Stage, len(p.Stages))
+	for _, s := range p.Stages {
+		switch {
+		case s.Name == "":
+			return nil, ErrNoName
+		case stages[s.Name] != nil:
+			return nil, &StageError{Stage: s.Name, Err: ErrDuplicateStage}
+		case s.Run == nil:
+			return nil, &StageError{Stage: s.Name, Err: ErrNoRun}
+		case s.Storage == File && s.Output == nil:
+			return nil, &StageError{Stage: s.Name, Err: ErrNoType}
+		case s.Storage == File && p.Dir == "":
+			return nil, &StageError{Stage: s.Name, Err: ErrNoDir}
+		}
+		stages[s.Name] = s
+	}
+	for _, s := range p.Stages {
+		if s.InputTypes != nil && len(s.InputTypes) != len(s.Inputs) {
+			return nil, &StageError{Stage: s.Name, Err: ErrTypeMismatch}
+		}
+		for i, in := range s.Inputs {
+			src, ok := stages[in]
+			if !ok {
+				return nil, &StageError{Stage: s.Name, Err: fmt.Errorf("%v: %q", ErrMissingInput, in)}
+			}
+			if s.InputTypes != nil && s.InputTypes[i] != nil && src.Output != nil && !src.Output.AssignableTo(s.InputTypes[i]) {
+				return nil, &StageError{Stage: s.Name, Err: fmt.Errorf("%v: %v from %q is not %v", ErrTypeMismatch, src.Output, in, s.InputTypes[i])}
+			}
+		}
+	}
+
+	// Order stages by Kahn's algorithm, breaking ties
+	// by the order of definition.
+	indeg := make(map[string]int, len(p.Stages))
+	for _, s := range p.Stages {
+		indeg[s.Name] = len(s.Inputs)
+	}
+	var order []*Stage
+	done := make(map[string]bool, len(p.Stages))
+	for len(order) < len(p.Stages) {
+		n := len(order)
+		for _, s := range p.Stages {
+			if done[s.Name] || indeg[s.Name] != 0 {
+				continue
+			}
+			done[s.Name] = true
+			order = append(order, s)
+			for _, d := range p.Stages {
+				for _, in := range d.Inputs {
+					if in == s.Name {
+						indeg[d.Name]--
+					}
+				}
+			}
+		}
+		if len(order) == n {
+			return nil, ErrCycle
+		}
+	}
+	return order, nil
+}
+
+// path returns the output file path of s.
+func (p *Pipeline) path(s *Stage) string { return filepath.Join(p.Dir, s.Name+".gob") }
+
+// Complete returns the names of the file stages of p with outputs from an
+// earlier run, in definition order.
+func (p *Pipeline) Complete() []string {
+	var names []string
+	for _, s := range p.Stages {
+		if s.Storage != File {
+			continue
+		}
+		if _, err := os.Stat(p.path(s)); err == nil {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// Reset removes the outputs of the named file stages of p, or of all file
+// stages if no names are given, so that they are run again.
+func (p *Pipeline) Reset(names ...string) error {
+	reset := make(map[string]bool, len(names))
+	for _, n := range names {
+		reset[n] = true
+	}
+	for _, s := range p.Stages {
+		if s.Storage != File || (len(names) != 0 && !reset[s.Name]) {
+			continue
+		}
+		err := os.Remove(p.path(s))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// stageOp is a concurrent.Operator running a stage.
+type stageOp struct {
+	p       *Pipeline
+	s       *Stage
+	threads int
+	in      []interface{}
+
+	sched  *Scheduler
+	cancel <-chan struct{}
+}
+
+// stageResult is the value returned by a stageOp.
+type stageResult struct {
+	s   *Stage
+	v   interface{}
+	err error
+}
+
+func (o stageOp) Operation() (v interface{}, err error) {
+	r := stageResult{s: o.s}
+	defer func() {
+		if e := recover(); e != nil {
+			r.err = fmt.Errorf("panic: %v", e)
+		}
+		v = r
+	}()
+	if !o.sched.acquire(o.threads, o.s.Mem, o.cancel) {
+		r.err = errCancelled
+		return
+	}
+	defer o.sched.release(o.threads, o.s.Mem)
+	r.v, r.err = o.s.Run(o.threads, o.in...)
+	if r.err != nil {
+		return
+	}
+	if o.s.Output != nil && (r.v == nil || reflect.TypeOf(r.v) != o.s.Output) {
+		r.err = fmt.Errorf("%v: output is %T not %v", ErrTypeMismatch, r.v, o.s.Output)
+		return
+	}
+	if o.s.Storage == File {
+		r.err = o.p.store(o.s, r.v)
+	}
+	return
+}
+
+// store writes the output v of s to its file, replacing any existing file
+// only when the output is completely written.
+func (p *Pipeline) store(s *Stage, v interface{}) error {
+	f, err := ioutil.TempFile(p.Dir, s.Name+".tmp")
+	if err != nil {
+		return err
+	}
+	err = gob.NewEncoder(f).Encode(v)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), p.path(s))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// load returns the stored output of s.
+func (p *Pipeline) load(s *Stage) (interface{}, error) {
+	f, err := os.Open(p.path(s))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	v := reflect.New(s.Output)
+	err = gob.NewDecoder(f).Decode(v.Interface())
+	if err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface(), nil
+}
+
+// Run executes the stages of p, running each stage when its inputs are
+// available and its scheduler has allocated its threads and memory. File stages completed by an
+// earlier run are not run again, and stages are only run if their output is
+// needed by a stage that is run or is a final output of the pipeline. Run
+// returns the outputs of the final stages, those whose outputs are not inputs
+// to other stages, keyed by stage name.
+//
+// If a stage fails, no further stages are started and Run returns a
+// *StageError after the running stages finish. Run also returns a *StageError
+// without running any stage if a stage requires more memory than the scheduler
+// limit. The outputs of completed file
+// stages are retained, so a subsequent call to Run resumes the pipeline.
+func (p *Pipeline) Run() (map[string]interface{}, error) {
+	order, err := p.Validate()
+	if err != nil {
+		return nil, err
+	}
+	sched := p.Scheduler
+	if sched == nil {
+		sched = NewScheduler(p.Threads, 0)
+	}
+	threads, memory := sched.Limits()
+	if p.Dir != "" {
+		err = os.MkdirAll(p.Dir, 0755)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	complete := make(map[string]bool)
+	for _, n := range p.Complete() {
+		complete[n] = true
+	}
+	consumers := make(map[string][]*Stage)
+	for _, s := range order {
+		for _, in := range s.Inputs {
+			consumers[in] = append(consumers[in], s)
+		}
+	}
+
+	// A stage is needed if it is final or is an input
+	// to a needed stage that must run.
+	needed := make(map[string]bool)
+	run := make(map[string]bool)
+	for i := len(order) - 1; i >= 0; i-- {
+		s := order[i]
+		if len(consumers[s.Name]) == 0 {
+			needed[s.Name] = true
+		}
+		for _, c := range consumers[s.Name] {
+			if run[c.Name] {
+				needed[s.Name] = true
+			}
+		}
+		run[s.Name] = needed[s.Name] && !complete[s.Name]
+	}
+
+	outputs := make(map[string]interface{})
+	have := func(s *Stage) (bool, error) {
+		if _, ok := outputs[s.Name]; ok {
+			return true, nil
+		}
+		if !complete[s.Name] {
+			return false, nil
+		}
+		v, err := p.load(s)
+		if err != nil {
+			return false, &StageError{Stage: s.Name, Err: err}
+		}
+		outputs[s.Name] = v
+		return true, nil
+	}
+
+	var pending []*Stage
+	for _, s := range order {
+		if !run[s.Name] {
+			continue
+		}
+		if memory != 0 && s.Mem > memory {
+			return nil, &StageError{Stage: s.Name, Err: ErrTooMuchMemory}
+		}
+		pending = append(pending, s)
+	}
+	queue := make(chan concurrent.Operator, len(pending))
+	proc := concurrent.NewProcessor(queue, len(pending), len(pending))
+	defer func() {
+		proc.Close()
+		proc.Wait()
+	}()
+
+	// Stages wait for their resources within the
+	// processor, so that stages of other pipelines
+	// sharing the scheduler are allocated resources
+	// in turn. Waiting stages are cancelled when a
+	// stage fails.
+	cancel := make(chan struct{})
+	var (
+		running int
+		failed  error
+	)
+	fail := func(err error) {
+		if failed == nil {
+			failed = err
+			close(cancel)
+		}
+	}
+	for len(pending) != 0 || running != 0 {
+		if failed == nil {
+			var wait []*Stage
+			for _, s := range pending {
+				ready := true
+				for _, in := range s.Inputs {
+					ok, err := have(p.stage(in))
+					if err != nil {
+						fail(err)
+					}
+					ready = ready && ok
+				}
+				if !ready || failed != nil {
+					wait = append(wait, s)
+					continue
+				}
+				in := make([]interface{}, len(s.Inputs))
+				for i, n := range s.Inputs {
+					in[i] = outputs[n]
+				}
+				running++
+				queue <- stageOp{p: p, s: s, threads: s.threads(threads), in: in, sched: sched, cancel: cancel}
+			}
+			pending = wait
+		}
+		if running == 0 {
+			break
+		}
+		v, _ := proc.Result()
+		r := v.(stageResult)
+		running--
+		if r.err != nil {
+			if r.err != errCancelled {
+				fail(&StageError{Stage: r.s.Name, Err: r.err})
+			}
+			continue
+		}
+		outputs[r.s.Name] = r.v
+	}
+	if failed != nil {
+		return nil, failed
+	}
+
+	final := make(map[string]interface{})
+	for _, s := range order {
+		if len(consumers[s.Name]) != 0 {
+			continue
+		}
+		if _, err := have(s); err != nil {
+			return nil, err
+		}
+		final[s.Name] = outputs[s.Name]
+	}
+	return final, nil
+}
+
+// stage returns the stage of p with the given name.
+func (p *Pipeline) stage(name string) *Stage {
+	for _, s := range p.Stages {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
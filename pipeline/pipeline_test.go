@@ -0,0 +1,296 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+var (
+	intType    = reflect.TypeOf(0)
+	stringType = reflect.TypeOf("")
+)
+
+// diamond returns a pipeline splitting a string into words, counting
+// words and letters in parallel and joining the counts. The number of
+// runs of each stage is counted in runs, and the join stage fails if
+// fail is not nil and holds a non-zero value.
+func diamond(dir string, runs map[string]*int32, fail *int32) *Pipeline {
+	count := func(name string, f func(threads int, in ...interface{}) (interface{}, error)) func(int, ...interface{}) (interface{}, error) {
+		runs[name] = new(int32)
+		return func(threads int, in ...interface{}) (interface{}, error) {
+			atomic.AddInt32(runs[name], 1)
+			return f(threads, in...)
+		}
+	}
+	return &Pipeline{
+		Threads: 4,
+		Dir:     dir,
+		Stages: []*Stage{
+			{
+				Name:   "source",
+				Output: stringType,
+				Run: count("source", func(int, ...interface{}) (interface{}, error) {
+					return "the quick brown fox", nil
+				}),
+			},
+			{
+				Name:       "words",
+				Inputs:     []string{"source"},
+				InputTypes: []reflect.Type{stringType},
+				Output:     intType,
+				Storage:    File,
+				Threads:    2,
+				Run: count("words", func(threads int, in ...interface{}) (interface{}, error) {
+					if threads != 2 {
+						return nil, errors.New("wrong thread count")
+					}
+					return len(strings.Fields(in[0].(string))), nil
+				}),
+			},
+			{
+				Name:    "letters",
+				Inputs:  []string{"source"},
+				Output:  intType,
+				Storage: File,
+				Run: count("letters", func(_ int, in ...interface{}) (interface{}, error) {
+					return len(strings.Replace(in[0].(string), " ", "", -1)), nil
+				}),
+			},
+			{
+				Name:   "join",
+				Inputs: []string{"words", "letters"},
+				Output: reflect.TypeOf([]int(nil)),
+				Run: count("join", func(_ int, in ...interface{}) (interface{}, error) {
+					if fail != nil && atomic.LoadInt32(fail) != 0 {
+						return nil, errors.New("failed")
+					}
+					return []int{in[0].(int), in[1].(int)}, nil
+				}),
+			},
+		},
+	}
+}
+
+func (s *S) TestRun(c *check.C) {
+	runs := make(map[string]*int32)
+	p := diamond(c.MkDir(), runs, nil)
+	out, err := p.Run()
+	c.Assert(err, check.Equals, nil)
+	c.Check(out, check.DeepEquals, map[string]interface{}{"join": []int{4, 16}})
+	for name, n := range runs {
+		c.Check(*n, check.Equals, int32(1), check.Commentf("stage %s", name))
+	}
+	c.Check(p.Complete(), check.DeepEquals, []string{"words", "letters"})
+
+	// A completed pipeline only runs its
+	// final memory stage.
+	out, err = p.Run()
+	c.Assert(err, check.Equals, nil)
+	c.Check(out, check.DeepEquals, map[string]interface{}{"join": []int{4, 16}})
+	c.Check(*runs["source"], check.Equals, int32(1))
+	c.Check(*runs["join"], check.Equals, int32(2))
+
+	c.Assert(p.Reset("letters"), check.Equals, nil)
+	c.Check(p.Complete(), check.DeepEquals, []string{"words"})
+}
+
+func (s *S) TestResume(c *check.C) {
+	runs := make(map[string]*int32)
+	fail := int32(1)
+	p := diamond(c.MkDir(), runs, &fail)
+	_, err := p.Run()
+	c.Check(err, check.ErrorMatches, `pipeline: stage "join": failed`)
+	c.Check(p.Complete(), check.DeepEquals, []string{"words", "letters"})
+
+	fail = 0
+	out, err := p.Run()
+	c.Assert(err, check.Equals, nil)
+	c.Check(out, check.DeepEquals, map[string]interface{}{"join": []int{4, 16}})
+	c.Check(*runs["source"], check.Equals, int32(1))
+	c.Check(*runs["words"], check.Equals, int32(1))
+	c.Check(*runs["join"], check.Equals, int32(2))
+}
+
+func (s *S) TestValidate(c *check.C) {
+	run := func(int, ...interface{}) (interface{}, error) { return 0, nil }
+	for i, t := range []struct {
+		p   *Pipeline
+		err string
+	}{
+		{
+			p: &Pipeline{Stages: []*Stage{
+				{Name: "a", Inputs: []string{"b"}, Run: run},
+				{Name: "b", Inputs: []string{"a"}, Run: run},
+			}},
+			err: `pipeline: stage dependencies form a cycle`,
+		},
+		{
+			p: &Pipeline{Stages: []*Stage{
+				{Name: "a", Inputs: []string{"c"}, Run: run},
+			}},
+			err: `pipeline: stage "a": pipeline: input stage not defined: "c"`,
+		},
+		{
+			p: &Pipeline{Stages: []*Stage{
+				{Name: "a", Output: intType, Run: run},
+				{Name: "b", Inputs: []string{"a"}, InputTypes: []reflect.Type{stringType}, Run: run},
+			}},
+			err: `pipeline: stage "b": pipeline: input type mismatch: int from "a" is not string`,
+		},
+		{
+			p: &Pipeline{Stages: []*Stage{
+				{Name: "a", Storage: File, Output: intType, Run: run},
+			}},
+			err: `pipeline: stage "a": pipeline: file storage requires a directory`,
+		},
+		{
+			p: &Pipeline{Stages: []*Stage{
+				{Name: "a", Run: run},
+				{Name: "a", Run: run},
+			}},
+			err: `pipeline: stage "a": pipeline: duplicate stage name`,
+		},
+	} {
+		_, err := t.p.Validate()
+		c.Check(err, check.ErrorMatches, t.err, check.Commentf("Test %d", i))
+	}
+}
+
+func (s *S) TestScheduler(c *check.C) {
+	// Pipelines for several samples share a scheduler
+	// and together never exceed its limits.
+	sched := NewScheduler(3, 100)
+	var (
+		mu           sync.Mutex
+		threads, max int
+		memory, maxM int64
+	)
+	use := func(t int, m int64) func(int, ...interface{}) (interface{}, error) {
+		return func(got int, _ ...interface{}) (interface{}, error) {
+			mu.Lock()
+			threads += got
+			memory += m
+			if threads > max {
+				max = threads
+			}
+			if memory > maxM {
+				maxM = memory
+			}
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			threads -= got
+			memory -= m
+			mu.Unlock()
+			if got != t {
+				return nil, errors.New("wrong thread count")
+			}
+			return 0, nil
+		}
+	}
+	sample := func() *Pipeline {
+		return &Pipeline{
+			Scheduler: sched,
+			Stages: []*Stage{
+				{Name: "align", Threads: 2, Mem: 40, Run: use(2, 40)},
+				{Name: "qc", Threads: 1, Mem: 30, Run: use(1, 30)},
+				{Name: "call", Inputs: []string{"align", "qc"}, Threads: 5, Mem: 60, Run: use(3, 60)},
+			},
+		}
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = sample().Run()
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		c.Check(err, check.Equals, nil, check.Commentf("Test %d", i))
+	}
+	c.Check(max <= 3, check.Equals, true, check.Commentf("max threads %d", max))
+	c.Check(maxM <= 100, check.Equals, true, check.Commentf("max memory %d", maxM))
+	c.Check(sched.freeThread, check.Equals, 3)
+	c.Check(sched.freeMemory, check.Equals, int64(100))
+
+	p := sample()
+	p.Stages[2].Mem = 101
+	_, err := p.Run()
+	c.Check(err, check.ErrorMatches, `pipeline: stage "call": pipeline: stage memory exceeds scheduler limit`)
+
+	// A stage waiting for resources is cancelled
+	// when another stage fails, and no resources
+	// are held after the run.
+	p = &Pipeline{
+		Scheduler: NewScheduler(1, 0),
+		Stages: []*Stage{
+			{Name: "fail", Run: func(int, ...interface{}) (interface{}, error) {
+				time.Sleep(time.Millisecond)
+				return nil, errors.New("failed")
+			}},
+			{Name: "wait", Run: func(int, ...interface{}) (interface{}, error) {
+				time.Sleep(time.Millisecond)
+				return 0, nil
+			}},
+		},
+	}
+	_, err = p.Run()
+	c.Check(err, check.NotNil)
+	c.Check(p.Scheduler.freeThread, check.Equals, 1)
+	c.Check(len(p.Scheduler.waiting), check.Equals, 0)
+}
+
+func (s *S) TestSystemLimits(c *check.C) {
+	dir := c.MkDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		c.Assert(os.MkdirAll(filepath.Dir(path), 0755), check.Equals, nil)
+		c.Assert(ioutil.WriteFile(path, []byte(content), 0644), check.Equals, nil)
+	}
+
+	write("meminfo", "MemTotal:       16384000 kB\nMemFree:         1024000 kB\n")
+	c.Check(memTotal(filepath.Join(dir, "meminfo")), check.Equals, int64(16384000<<10))
+	c.Check(memTotal(filepath.Join(dir, "missing")), check.Equals, int64(0))
+
+	t, m := cgroupLimits(filepath.Join(dir, "none"))
+	c.Check([]int64{int64(t), m}, check.DeepEquals, []int64{0, 0})
+
+	write("v1/cpu/cpu.cfs_quota_us", "-1\n")
+	write("v1/cpu/cpu.cfs_period_us", "100000\n")
+	write("v1/memory/memory.limit_in_bytes", "1073741824\n")
+	t, m = cgroupLimits(filepath.Join(dir, "v1"))
+	c.Check([]int64{int64(t), m}, check.DeepEquals, []int64{0, 1 << 30})
+
+	write("v2/cpu.max", "250000 100000\n")
+	write("v2/memory.max", "max\n")
+	t, m = cgroupLimits(filepath.Join(dir, "v2"))
+	c.Check([]int64{int64(t), m}, check.DeepEquals, []int64{3, 0})
+
+	threads, memory := SystemScheduler(true).Limits()
+	c.Check(threads >= 1, check.Equals, true)
+	c.Check(memory >= 0, check.Equals, true)
+}
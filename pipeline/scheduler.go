@@ -0,0 +1,215 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pipeline
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A Scheduler allocates threads and memory to running stages. A Scheduler may
+// be shared by many pipelines running concurrently, for example one for each
+// sample of an analysis, so that together they do not oversubscribe the machine.
+// Stages that cannot be allocated their declared resources wait until running
+// stages release sufficient resources. Waiting stages are allocated resources
+// in the order they requested them, though a stage may be allocated resources
+// before an earlier waiting stage that requires more than is free.
+type Scheduler struct {
+	threads int
+	memory  int64
+
+	mu         sync.Mutex
+	freeThread int
+	freeMemory int64
+	waiting    []*request
+}
+
+// request is a waiting request for resources.
+type request struct {
+	threads int
+	memory  int64
+	granted chan struct{}
+}
+
+// NewScheduler returns a Scheduler allocating up to the given number of threads
+// and bytes of memory. If threads is less than one, one thread is used, and if
+// memory is less than one, memory is not limited.
+func NewScheduler(threads int, memory int64) *Scheduler {
+	if threads < 1 {
+		threads = 1
+	}
+	if memory < 1 {
+		memory = 0
+	}
+	return &Scheduler{threads: threads, memory: memory, freeThread: threads, freeMemory: memory}
+}
+
+// SystemScheduler returns a Scheduler allocating the CPUs and physical memory
+// of the machine. If cgroup is true, the CPU quota and memory limit of the
+// cgroup of the process are also respected, so that pipelines run in a
+// container or batch system job do not exceed their allocation. Limits that
+// cannot be determined are not applied.
+func SystemScheduler(cgroup bool) *Scheduler {
+	threads, memory := runtime.NumCPU(), memTotal("/proc/meminfo")
+	if cgroup {
+		t, m := cgroupLimits("/sys/fs/cgroup")
+		if t > 0 && t < threads {
+			threads = t
+		}
+		if m > 0 && (memory == 0 || m < memory) {
+			memory = m
+		}
+	}
+	return NewScheduler(threads, memory)
+}
+
+// Limits returns the number of threads and bytes of memory allocated by s. A
+// memory limit of zero indicates memory is not limited.
+func (s *Scheduler) Limits() (threads int, memory int64) { return s.threads, s.memory }
+
+// fits returns whether the request can be allocated from the free resources
+// of s. It must be called with s.mu held.
+func (s *Scheduler) fits(threads int, memory int64) bool {
+	return threads <= s.freeThread && (s.memory == 0 || memory <= s.freeMemory)
+}
+
+// take allocates the requested resources. It must be called with s.mu held.
+func (s *Scheduler) take(threads int, memory int64) {
+	s.freeThread -= threads
+	if s.memory != 0 {
+		s.freeMemory -= memory
+	}
+}
+
+// acquire waits until the requested resources are allocated, returning true,
+// or until cancel is closed, returning false. The request must not exceed the
+// limits of s.
+func (s *Scheduler) acquire(threads int, memory int64, cancel <-chan struct{}) bool {
+	s.mu.Lock()
+	if s.fits(threads, memory) {
+		s.take(threads, memory)
+		s.mu.Unlock()
+		return true
+	}
+	r := &request{threads: threads, memory: memory, granted: make(chan struct{})}
+	s.waiting = append(s.waiting, r)
+	s.mu.Unlock()
+
+	select {
+	case <-r.granted:
+		return true
+	case <-cancel:
+		s.mu.Lock()
+		for i, w := range s.waiting {
+			if w == r {
+				s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+				s.mu.Unlock()
+				return false
+			}
+		}
+		s.mu.Unlock()
+		// The request was granted while
+		// cancellation was being handled.
+		s.release(threads, memory)
+		return false
+	}
+}
+
+// release returns the given resources to s and allocates them to waiting
+// requests that fit.
+func (s *Scheduler) release(threads int, memory int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.freeThread += threads
+	if s.memory != 0 {
+		s.freeMemory += memory
+	}
+	waiting := s.waiting[:0]
+	for _, r := range s.waiting {
+		if s.fits(r.threads, r.memory) {
+			s.take(r.threads, r.memory)
+			close(r.granted)
+			continue
+		}
+		waiting = append(waiting, r)
+	}
+	for i := len(waiting); i < len(s.waiting); i++ {
+		s.waiting[i] = nil
+	}
+	s.waiting = waiting
+}
+
+// memTotal returns the total physical memory reported by the meminfo file at
+// path, or zero if it cannot be read.
+func memTotal(path string) int64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+		kb, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0
+		}
+		return kb << 10
+	}
+	return 0
+}
+
+// cgroupLimits returns the CPU and memory limits of the cgroup file system
+// mounted at root. Version 2 limits are used if present, and version 1 limits
+// otherwise. Absent limits are returned as zero.
+func cgroupLimits(root string) (threads int, memory int64) {
+	read := func(name string) []string {
+		b, err := ioutil.ReadFile(filepath.Join(root, name))
+		if err != nil {
+			return nil
+		}
+		return strings.Fields(string(b))
+	}
+	number := func(s string) int64 {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || n < 1 {
+			return 0
+		}
+		return n
+	}
+	cpus := func(quota, period int64) int {
+		if quota == 0 || period == 0 {
+			return 0
+		}
+		return int((quota + period - 1) / period)
+	}
+
+	if f := read("cpu.max"); len(f) == 2 {
+		threads = cpus(number(f[0]), number(f[1]))
+	} else {
+		q, p := read("cpu/cpu.cfs_quota_us"), read("cpu/cpu.cfs_period_us")
+		if len(q) == 1 && len(p) == 1 {
+			threads = cpus(number(q[0]), number(p[0]))
+		}
+	}
+	if f := read("memory.max"); len(f) == 1 {
+		memory = number(f[0])
+	} else if f := read("memory/memory.limit_in_bytes"); len(f) == 1 {
+		// Unlimited version 1 cgroups report a
+		// page-aligned maximum int64 which is
+		// larger than any physical memory.
+		memory = number(f[0])
+	}
+	return threads, memory
+}
@@ -0,0 +1,125 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pwm
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/io/featio/bed"
+	"github.com/biogo/biogo/io/seriesio"
+	"github.com/biogo/biogo/markov"
+	"github.com/biogo/biogo/seq"
+
+	"math/rand"
+)
+
+var (
+	_ feat.Feature = (*Bin)(nil)
+	_ bed.Valuer   = (*Bin)(nil)
+)
+
+// A Bin is an interval of a motif hit density track.
+type Bin struct {
+	Loc              feat.Feature
+	BinStart, BinEnd int
+
+	// Hits is the number of motif hits starting
+	// in the bin and Density is the number of hits
+	// per position.
+	Hits    int
+	Density float64
+
+	// Normalized is Density divided by the expected
+	// density under a background model, or Density
+	// if no background rate was given.
+	Normalized float64
+}
+
+func (b *Bin) Start() int             { return b.BinStart }
+func (b *Bin) End() int               { return b.BinEnd }
+func (b *Bin) Len() int               { return b.BinEnd - b.BinStart }
+func (b *Bin) Name() string           { return b.Loc.Name() }
+func (b *Bin) Description() string    { return "motif density" }
+func (b *Bin) Location() feat.Feature { return b.Loc }
+func (b *Bin) Value() float64         { return b.Normalized }
+
+// hits returns the start positions of windows of l with score at least
+// minScore, using index to convert letters.
+func (m *PWM) hits(l func(int) alphabet.Letter, n int, index alphabet.Index, minScore float64) []int {
+	var pos []int
+	length := len(m.matrix)
+LOOP:
+	for p := 0; p+length <= n; p++ {
+		var score float64
+		for i := 0; i < length; i++ {
+			base := index[l(p+i)]
+			if base < 0 || minScore-score > m.lookAhead[i] {
+				continue LOOP
+			}
+			score += m.matrix[i][base]
+		}
+		if score >= minScore {
+			pos = append(pos, p)
+		}
+	}
+	return pos
+}
+
+// Density returns the binned density of hits of the motif with score at least
+// minScore along s, in bins of width positions. The final bin may be shorter.
+// If rate is positive, it is taken as the expected number of hits per
+// position under a background model and used to normalize the densities.
+func (m *PWM) Density(s seq.Sequence, width int, minScore, rate float64) []*Bin {
+	if width < 1 {
+		width = s.Len()
+	}
+	var bins []*Bin
+	for start := 0; start < s.Len(); start += width {
+		end := start + width
+		if end > s.Len() {
+			end = s.Len()
+		}
+		bins = append(bins, &Bin{Loc: s, BinStart: start + s.Start(), BinEnd: end + s.Start()})
+	}
+	at := func(i int) alphabet.Letter { return s.At(i + s.Start()).L }
+	for _, p := range m.hits(at, s.Len(), s.Alphabet().LetterIndex(), minScore) {
+		bins[p/width].Hits++
+	}
+	for _, b := range bins {
+		b.Density = float64(b.Hits) / float64(b.Len())
+		b.Normalized = b.Density
+		if rate > 0 {
+			b.Normalized /= rate
+		}
+	}
+	return bins
+}
+
+// BackgroundRate returns the number of hits of the motif with score at least
+// minScore per position in n positions of sequence sampled from the
+// background model bg, using random numbers from rnd. If rnd is nil, the
+// default source of the math/rand package is used.
+func (m *PWM) BackgroundRate(bg *markov.Model, n int, minScore float64, rnd *rand.Rand) float64 {
+	s := bg.Sample(n, rnd)
+	at := func(i int) alphabet.Letter { return s[i] }
+	return float64(len(m.hits(at, n, bg.Alphabet().LetterIndex(), minScore))) / float64(n)
+}
+
+// DensitySeries returns the normalized density track of the bins of the
+// sequence named ref.
+func DensitySeries(ref string, bins []*Bin) *seriesio.Series {
+	s := &seriesio.Series{
+		Name:   "Motif density",
+		Kind:   seriesio.Track,
+		Ref:    ref,
+		X:      seriesio.Axis{Label: "position"},
+		Y:      seriesio.Axis{Label: "normalized hit density"},
+		Points: make([]seriesio.Point, len(bins)),
+	}
+	for i, b := range bins {
+		s.Points[i] = seriesio.Point{X: float64(b.BinStart), End: float64(b.BinEnd), Y: b.Normalized}
+	}
+	return s
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pwm
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/featio/bed"
+	"github.com/biogo/biogo/markov"
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestDensity(c *check.C) {
+	// A matrix matching GATA exactly.
+	m := New([][]float64{
+		{0, 0, 1, 0},
+		{1, 0, 0, 0},
+		{0, 0, 0, 1},
+		{1, 0, 0, 0},
+	})
+	sq := linear.NewSeq("chr1", alphabet.Letters(strings.Repeat("GATAcc", 5)+strings.Repeat("c", 30)+"GATA"), alphabet.DNA)
+	bins := m.Density(sq, 30, 1, 0)
+	c.Assert(bins, check.HasLen, 3)
+	for i, want := range []int{5, 0, 1} {
+		c.Check(bins[i].Hits, check.Equals, want, check.Commentf("Bin %d", i))
+	}
+	c.Check(bins[2].Len(), check.Equals, 4)
+	c.Check(bins[0].Density, check.Equals, 5./30)
+	c.Check(bins[2].Normalized, check.Equals, 1./4)
+
+	bins = m.Density(sq, 30, 1, 1./100)
+	c.Check(math.Abs(bins[0].Normalized-500./30) < 1e-9, check.Equals, true)
+
+	var b bytes.Buffer
+	w := bed.NewGraphWriter(&b)
+	w.Precision = 2
+	for _, bin := range bins {
+		_, err := w.Write(bin)
+		c.Assert(err, check.Equals, nil)
+	}
+	c.Check(b.String(), check.Equals, "chr1\t0\t30\t16.67\nchr1\t30\t60\t0.00\nchr1\t60\t64\t25.00\n")
+
+	ts := DensitySeries("chr1", bins)
+	c.Check(ts.Points, check.HasLen, 3)
+	c.Check(ts.Points[2].End, check.Equals, 64.)
+
+	bg, err := markov.Estimate(alphabet.DNA, 0, 0, alphabet.Letters("ACGT"))
+	c.Assert(err, check.Equals, nil)
+	rate := m.BackgroundRate(bg, 100000, 1, rand.New(rand.NewSource(1)))
+	c.Check(rate > 0.5/256 && rate < 1.5/256, check.Equals, true, check.Commentf("rate %v", rate))
+}
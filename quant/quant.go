@@ -0,0 +1,353 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package quant provides transcript abundance estimation from RNA-seq reads by
+// pseudo-alignment.
+//
+// A transcriptome index maps each canonical k-mer to the equivalence class of
+// transcripts containing it. Reads are pseudo-aligned by intersecting the
+// equivalence classes of their k-mers, and the counts of reads in each
+// resulting class are used to estimate transcript abundances by expectation
+// maximisation.
+package quant
+
+import (
+	"github.com/biogo/biogo/seq/linear"
+
+	"bytes"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+)
+
+var (
+	ErrBadK          = errors.New("quant: k must be odd and between 1 and 31")
+	ErrBadAlphabet   = errors.New("quant: alphabet size != 4")
+	ErrNoTranscripts = errors.New("quant: no transcripts")
+)
+
+// MaxK is the maximum k-mer length of an Index.
+const MaxK = 31
+
+// An Index is a transcriptome k-mer index.
+type Index struct {
+	k     int
+	mask  uint64
+	names []string
+	lens  []int
+
+	kmers map[uint64]int // k-mer to equivalence class.
+	ecs   [][]int        // Equivalence class transcript sets.
+	ecIDs map[string]int
+}
+
+// NewIndex returns a k-mer index of the provided transcripts. k must be odd so that
+// no k-mer is its own reverse complement, and no greater than MaxK. The alphabets of
+// the transcripts must be four letter alphabets with complementary letters at
+// indexes summing to 3, as is the case for alphabet.DNA.
+func NewIndex(k int, transcripts []*linear.Seq) (*Index, error) {
+	if k < 1 || k > MaxK || k%2 == 0 {
+		return nil, ErrBadK
+	}
+	if len(transcripts) == 0 {
+		return nil, ErrNoTranscripts
+	}
+	ix := &Index{
+		k:     k,
+		mask:  1<<(2*uint(k)) - 1,
+		names: make([]string, len(transcripts)),
+		lens:  make([]int, len(transcripts)),
+		ecIDs: make(map[string]int),
+	}
+
+	sets := make(map[uint64][]int)
+	for t, s := range transcripts {
+		if s.Alpha.Len() != 4 {
+			return nil, ErrBadAlphabet
+		}
+		ix.names[t] = s.Name()
+		ix.lens[t] = s.Len()
+		ix.forEachKmer(s, func(km uint64) {
+			set := sets[km]
+			if len(set) == 0 || set[len(set)-1] != t {
+				sets[km] = append(set, t)
+			}
+		})
+	}
+
+	ix.kmers = make(map[uint64]int, len(sets))
+	for km, set := range sets {
+		ix.kmers[km] = ix.class(set)
+	}
+
+	return ix, nil
+}
+
+// class returns the equivalence class ID of the sorted transcript set, adding
+// it to the index if necessary.
+func (ix *Index) class(set []int) int {
+	key := setKey(set)
+	id, ok := ix.ecIDs[key]
+	if !ok {
+		id = len(ix.ecs)
+		ix.ecIDs[key] = id
+		ix.ecs = append(ix.ecs, set)
+	}
+	return id
+}
+
+func setKey(set []int) string {
+	var b bytes.Buffer
+	for i, t := range set {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Itoa(t))
+	}
+	return b.String()
+}
+
+// forEachKmer calls fn with each canonical k-mer of s. K-mers containing letters
+// not in the alphabet are skipped.
+func (ix *Index) forEachKmer(s *linear.Seq, fn func(uint64)) {
+	var (
+		index    = s.Alpha.LetterIndex()
+		fwd, rev uint64
+		valid    int
+		shift    = 2 * uint(ix.k-1)
+	)
+	for _, l := range s.Seq {
+		i := index[l]
+		if i < 0 {
+			valid = 0
+			continue
+		}
+		fwd = (fwd<<2 | uint64(i)) & ix.mask
+		rev = rev>>2 | uint64(3-i)<<shift
+		if valid++; valid >= ix.k {
+			if rev < fwd {
+				fn(rev)
+			} else {
+				fn(fwd)
+			}
+		}
+	}
+}
+
+// K returns the k-mer length of the index.
+func (ix *Index) K() int { return ix.k }
+
+// Transcripts returns the number of indexed transcripts.
+func (ix *Index) Transcripts() int { return len(ix.names) }
+
+// Name returns the name of transcript t.
+func (ix *Index) Name(t int) string { return ix.names[t] }
+
+// Classes returns the number of equivalence classes in the index.
+func (ix *Index) Classes() int { return len(ix.ecs) }
+
+// Pseudoalign returns the indexes of the transcripts compatible with all the
+// indexed k-mers of read. K-mers of the read that are not found in the index are
+// ignored. If no k-mer of read is found in the index or the read's k-mers are not
+// jointly compatible with any transcript, Pseudoalign returns nil. The returned
+// slice must not be altered.
+func (ix *Index) Pseudoalign(read *linear.Seq) []int {
+	var (
+		set  []int
+		last = -1
+		hit  bool
+	)
+	ix.forEachKmer(read, func(km uint64) {
+		if hit && set == nil {
+			return
+		}
+		ec, ok := ix.kmers[km]
+		if !ok || ec == last {
+			return
+		}
+		last = ec
+		if !hit {
+			hit = true
+			set = ix.ecs[ec]
+			return
+		}
+		set = intersect(set, ix.ecs[ec])
+	})
+	if len(set) == 0 {
+		return nil
+	}
+	return set
+}
+
+// intersect returns the intersection of the sorted sets a and b.
+func intersect(a, b []int) []int {
+	var r []int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			r = append(r, a[i])
+			i++
+			j++
+		}
+	}
+	return r
+}
+
+// Counts holds the number of reads assigned to each equivalence class.
+type Counts struct {
+	ix      *Index
+	classes map[string]*classCount
+
+	// Unassigned is the number of reads
+	// that could not be pseudo-aligned.
+	Unassigned int
+}
+
+type classCount struct {
+	set []int
+	n   float64
+}
+
+// NewCounts returns a new Counts for reads pseudo-aligned to ix.
+func NewCounts(ix *Index) *Counts {
+	return &Counts{ix: ix, classes: make(map[string]*classCount)}
+}
+
+// Add pseudo-aligns read and adds it to the count for its equivalence class. It
+// returns whether the read was assigned. Both mates of a read pair may be
+// added by calling AddPair.
+func (c *Counts) Add(read *linear.Seq) bool {
+	return c.add(c.ix.Pseudoalign(read))
+}
+
+// AddPair pseudo-aligns a read pair and adds it as a single fragment to the count
+// for the intersection of the mates' equivalence classes.
+func (c *Counts) AddPair(r1, r2 *linear.Seq) bool {
+	s1, s2 := c.ix.Pseudoalign(r1), c.ix.Pseudoalign(r2)
+	switch {
+	case s1 == nil:
+		return c.add(s2)
+	case s2 == nil:
+		return c.add(s1)
+	}
+	return c.add(intersect(s1, s2))
+}
+
+func (c *Counts) add(set []int) bool {
+	if len(set) == 0 {
+		c.Unassigned++
+		return false
+	}
+	key := setKey(set)
+	cc, ok := c.classes[key]
+	if !ok {
+		cc = &classCount{set: set}
+		c.classes[key] = cc
+	}
+	cc.n++
+	return true
+}
+
+// EMParams describes the parameters of abundance estimation.
+type EMParams struct {
+	// FragmentLength is the mean fragment length
+	// used to calculate effective transcript lengths.
+	FragmentLength float64
+
+	MaxIter   int     // Maximum number of EM iterations.
+	Tolerance float64 // Convergence threshold on the change in estimated counts.
+}
+
+// DefaultEMParams are parameters suitable for typical short read libraries.
+var DefaultEMParams = EMParams{
+	FragmentLength: 200,
+	MaxIter:        10000,
+	Tolerance:      1e-8,
+}
+
+// An Abundance is the estimated abundance of a transcript.
+type Abundance struct {
+	Name      string
+	Length    int
+	EffLength float64
+	Count     float64 // Estimated number of fragments.
+	TPM       float64 // Transcripts per million.
+}
+
+// Estimate returns the estimated abundance of each indexed transcript, in index
+// order, and the number of EM iterations performed.
+func (c *Counts) Estimate(p EMParams) ([]Abundance, int) {
+	n := c.ix.Transcripts()
+	ab := make([]Abundance, n)
+	for t := range ab {
+		ab[t].Name = c.ix.names[t]
+		ab[t].Length = c.ix.lens[t]
+		ab[t].EffLength = math.Max(float64(c.ix.lens[t])-p.FragmentLength+1, 1)
+	}
+
+	// Iterate over classes in a deterministic order.
+	keys := make([]string, 0, len(c.classes))
+	var total float64
+	for k, cc := range c.classes {
+		keys = append(keys, k)
+		total += cc.n
+	}
+	sort.Strings(keys)
+	if total == 0 {
+		return ab, 0
+	}
+
+	alpha := make([]float64, n)
+	for t := range alpha {
+		alpha[t] = total / float64(n)
+	}
+	next := make([]float64, n)
+	var iter int
+	for iter = 1; iter <= p.MaxIter; iter++ {
+		for t := range next {
+			next[t] = 0
+		}
+		for _, k := range keys {
+			cc := c.classes[k]
+			var denom float64
+			for _, t := range cc.set {
+				denom += alpha[t] / ab[t].EffLength
+			}
+			if denom == 0 {
+				continue
+			}
+			for _, t := range cc.set {
+				next[t] += cc.n * alpha[t] / ab[t].EffLength / denom
+			}
+		}
+		var delta float64
+		for t := range alpha {
+			delta = math.Max(delta, math.Abs(next[t]-alpha[t]))
+		}
+		alpha, next = next, alpha
+		if delta < p.Tolerance {
+			break
+		}
+	}
+	if iter > p.MaxIter {
+		iter = p.MaxIter
+	}
+
+	var rate float64
+	for t := range ab {
+		ab[t].Count = alpha[t]
+		rate += alpha[t] / ab[t].EffLength
+	}
+	if rate > 0 {
+		for t := range ab {
+			ab[t].TPM = alpha[t] / ab[t].EffLength / rate * 1e6
+		}
+	}
+	return ab, iter
+}
@@ -0,0 +1,88 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quant
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func randSeq(rnd *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = "ACGT"[rnd.Intn(4)]
+	}
+	return b
+}
+
+func newSeq(name string, b []byte) *linear.Seq {
+	return linear.NewSeq(name, alphabet.BytesToLetters(b), alphabet.DNA)
+}
+
+func (s *S) TestIndex(c *check.C) {
+	_, err := NewIndex(16, nil)
+	c.Check(err, check.Equals, ErrBadK)
+
+	rnd := rand.New(rand.NewSource(1))
+	a, b, shared := randSeq(rnd, 300), randSeq(rnd, 300), randSeq(rnd, 300)
+	t1 := newSeq("t1", append(append([]byte(nil), a...), shared...))
+	t2 := newSeq("t2", append(append([]byte(nil), b...), shared...))
+	ix, err := NewIndex(21, []*linear.Seq{t1, t2})
+	c.Assert(err, check.Equals, nil)
+	c.Check(ix.Transcripts(), check.Equals, 2)
+	c.Check(ix.Classes(), check.Equals, 3)
+
+	read := newSeq("r", append([]byte(nil), a[100:175]...))
+	c.Check(ix.Pseudoalign(read), check.DeepEquals, []int{0})
+	read.RevComp()
+	c.Check(ix.Pseudoalign(read), check.DeepEquals, []int{0})
+	c.Check(ix.Pseudoalign(newSeq("r", shared[50:125])), check.DeepEquals, []int{0, 1})
+	c.Check(ix.Pseudoalign(newSeq("r", append(append([]byte(nil), a[200:250]...), b[0:50]...))), check.IsNil)
+	c.Check(ix.Pseudoalign(newSeq("r", randSeq(rnd, 75))), check.IsNil)
+}
+
+func (s *S) TestEstimate(c *check.C) {
+	rnd := rand.New(rand.NewSource(1))
+	a, b, shared := randSeq(rnd, 500), randSeq(rnd, 500), randSeq(rnd, 500)
+	t1 := newSeq("t1", append(append([]byte(nil), a...), shared...))
+	t2 := newSeq("t2", append(append([]byte(nil), b...), shared...))
+	ix, err := NewIndex(21, []*linear.Seq{t1, t2})
+	c.Assert(err, check.Equals, nil)
+
+	cnt := NewCounts(ix)
+	for i := 0; i < 30; i++ {
+		c.Check(cnt.Add(newSeq("r", a[i*10:i*10+75])), check.Equals, true)
+	}
+	for i := 0; i < 10; i++ {
+		c.Check(cnt.Add(newSeq("r", b[i*10:i*10+75])), check.Equals, true)
+	}
+	for i := 0; i < 40; i++ {
+		c.Check(cnt.Add(newSeq("r", shared[i*10:i*10+75])), check.Equals, true)
+	}
+	c.Check(cnt.Add(newSeq("r", randSeq(rnd, 75))), check.Equals, false)
+	c.Check(cnt.Unassigned, check.Equals, 1)
+
+	p := DefaultEMParams
+	p.FragmentLength = 100
+	ab, _ := cnt.Estimate(p)
+	c.Assert(len(ab), check.Equals, 2)
+	c.Check(math.Abs(ab[0].Count-60) < 1e-4, check.Equals, true, check.Commentf("got %v", ab[0].Count))
+	c.Check(math.Abs(ab[1].Count-20) < 1e-4, check.Equals, true, check.Commentf("got %v", ab[1].Count))
+	c.Check(math.Abs(ab[0].TPM+ab[1].TPM-1e6) < 1e-3, check.Equals, true)
+	c.Check(math.Abs(ab[0].TPM-750000) < 1e-1, check.Equals, true, check.Commentf("got %v", ab[0].TPM))
+}
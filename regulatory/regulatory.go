@@ -0,0 +1,535 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package regulatory provides preset scanners for prokaryotic transcriptional
+// regulatory elements.
+//
+// Sigma-70 promoters are identified by matches to the -35 (TTGACA) and -10
+// (TATAAT) consensus boxes separated by a spacer of near 17 bases. Rho-independent
+// terminators are identified as a GC-rich stem-loop immediately followed by a
+// T-rich tract. Terminator hairpins are detected using a simple base-pairing
+// stem-loop model rather than full secondary structure prediction: each stem
+// pair is scored 3 for G:C, 2 for A:T and 1 for a G:T wobble.
+//
+// Candidates may be found on both strands of a sequence, or associated with the
+// genes they are likely to regulate using Annotate.
+package regulatory
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrNotComplementor = errors.New("regulatory: alphabet is not a complementor")
+	ErrBadSpacer       = errors.New("regulatory: invalid spacer limits")
+	ErrBadStem         = errors.New("regulatory: invalid stem or loop limits")
+	ErrBadTract        = errors.New("regulatory: invalid T-tract parameters")
+)
+
+var (
+	_ feat.Feature  = (*Promoter)(nil)
+	_ feat.Orienter = (*Promoter)(nil)
+	_ feat.Feature  = (*Terminator)(nil)
+	_ feat.Orienter = (*Terminator)(nil)
+)
+
+// Consensus boxes for sigma-70 promoters.
+const (
+	Box35 = "TTGACA"
+	Box10 = "TATAAT"
+
+	boxLen = 6
+)
+
+// PromoterParams describes the constraints on sigma-70 promoter candidates.
+type PromoterParams struct {
+	Max35Mismatch int // Maximum number of mismatches in the -35 box.
+	Max10Mismatch int // Maximum number of mismatches in the -10 box.
+
+	MinSpacer int // Minimum spacer length between the boxes.
+	MaxSpacer int // Maximum spacer length between the boxes.
+
+	// SpacerPenalty is the score penalty per base
+	// difference from the optimal spacer length of 17.
+	SpacerPenalty float64
+
+	MinScore float64 // Minimum score of a reported promoter.
+
+	// Upstream is the distance upstream of a gene
+	// start searched for promoters by Annotate.
+	Upstream int
+}
+
+// DefaultPromoterParams are conservative parameters for sigma-70 promoter scanning.
+var DefaultPromoterParams = PromoterParams{
+	Max35Mismatch: 2,
+	Max10Mismatch: 2,
+	MinSpacer:     15,
+	MaxSpacer:     19,
+	SpacerPenalty: 0.5,
+	MinScore:      8,
+	Upstream:      150,
+}
+
+func (p PromoterParams) check() error {
+	if p.MinSpacer < 0 || p.MaxSpacer < p.MinSpacer {
+		return ErrBadSpacer
+	}
+	return nil
+}
+
+// TerminatorParams describes the constraints on rho-independent terminator candidates.
+type TerminatorParams struct {
+	MinStem int // Minimum number of stem pairs.
+	MaxStem int // Maximum number of stem pairs.
+	MinLoop int // Minimum loop length.
+	MaxLoop int // Maximum loop length.
+
+	MaxMismatch int // Maximum number of unpaired positions in the stem.
+	MinGC       int // Minimum number of G:C pairs in the stem.
+
+	// MaxGap is the maximum distance between the
+	// end of the stem and the start of the T-tract.
+	MaxGap int
+
+	// The T-tract must contain at least MinT
+	// Ts in the TractLen bases following the gap.
+	TractLen int
+	MinT     int
+
+	MinScore float64 // Minimum stem score of a reported terminator.
+
+	// Downstream is the distance downstream of a gene
+	// end searched for terminators by Annotate.
+	Downstream int
+}
+
+// DefaultTerminatorParams are parameters suitable for bacterial terminator scanning.
+var DefaultTerminatorParams = TerminatorParams{
+	MinStem:     4,
+	MaxStem:     20,
+	MinLoop:     3,
+	MaxLoop:     9,
+	MaxMismatch: 1,
+	MinGC:       3,
+	MaxGap:      2,
+	TractLen:    8,
+	MinT:        5,
+	MinScore:    14,
+	Downstream:  150,
+}
+
+func (p TerminatorParams) check() error {
+	switch {
+	case p.MinStem < 1, p.MaxStem < p.MinStem, p.MinLoop < 0, p.MaxLoop < p.MinLoop, p.MaxMismatch < 0:
+		return ErrBadStem
+	case p.TractLen < 1, p.MinT > p.TractLen, p.MaxGap < 0:
+		return ErrBadTract
+	}
+	return nil
+}
+
+// A Promoter is a candidate sigma-70 promoter.
+type Promoter struct {
+	Loc       feat.Feature
+	PromStart int
+	PromEnd   int
+	Orient    feat.Orientation
+
+	// Box35 and Box10 are the start positions of the
+	// -35 and -10 boxes in the forward strand coordinates
+	// of Loc.
+	Box35 int
+	Box10 int
+
+	Mismatch35 int
+	Mismatch10 int
+	Spacer     int
+	Score      float64
+
+	// Gene is the gene associated with the
+	// promoter by Annotate.
+	Gene feat.Feature
+}
+
+func (p *Promoter) Start() int { return p.PromStart }
+func (p *Promoter) End() int   { return p.PromEnd }
+func (p *Promoter) Len() int   { return p.PromEnd - p.PromStart }
+func (p *Promoter) Name() string {
+	return fmt.Sprintf("%s:[%d,%d)", p.Loc.Name(), p.PromStart, p.PromEnd)
+}
+func (p *Promoter) Description() string           { return "sigma-70 promoter" }
+func (p *Promoter) Location() feat.Feature        { return p.Loc }
+func (p *Promoter) Orientation() feat.Orientation { return p.Orient }
+
+// A Terminator is a candidate rho-independent terminator.
+type Terminator struct {
+	Loc       feat.Feature
+	TermStart int
+	TermEnd   int
+	Orient    feat.Orientation
+
+	Stem       int // Number of stem pairs.
+	Loop       int // Loop length.
+	Mismatches int // Number of unpaired positions in the stem.
+	Ts         int // Number of Ts in the T-tract.
+	Score      float64
+
+	// Gene is the gene associated with the
+	// terminator by Annotate.
+	Gene feat.Feature
+}
+
+func (t *Terminator) Start() int { return t.TermStart }
+func (t *Terminator) End() int   { return t.TermEnd }
+func (t *Terminator) Len() int   { return t.TermEnd - t.TermStart }
+func (t *Terminator) Name() string {
+	return fmt.Sprintf("%s:[%d,%d)", t.Loc.Name(), t.TermStart, t.TermEnd)
+}
+func (t *Terminator) Description() string           { return "rho-independent terminator" }
+func (t *Terminator) Location() feat.Feature        { return t.Loc }
+func (t *Terminator) Orientation() feat.Orientation { return t.Orient }
+
+// strands returns the upper case forward and reverse complement letters of s.
+func strands(s *linear.Seq) (fwd, rev []byte, err error) {
+	c, ok := s.Alpha.(alphabet.Complementor)
+	if !ok {
+		return nil, nil, ErrNotComplementor
+	}
+	comp := c.ComplementTable()
+	n := len(s.Seq)
+	fwd = make([]byte, n)
+	rev = make([]byte, n)
+	for i, l := range s.Seq {
+		fwd[i] = upper(byte(l))
+		rev[n-1-i] = upper(byte(comp[l]))
+	}
+	return fwd, rev, nil
+}
+
+func upper(b byte) byte {
+	if 'a' <= b && b <= 'z' {
+		b -= 'a' - 'A'
+	}
+	if b == 'U' {
+		b = 'T'
+	}
+	return b
+}
+
+// FindPromoters returns the sigma-70 promoter candidates on both strands of s
+// satisfying p. Overlapping candidates on the same strand are reduced to the
+// highest scoring candidate. Promoters are returned sorted by start position.
+func FindPromoters(s *linear.Seq, p PromoterParams) ([]*Promoter, error) {
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	fwd, rev, err := strands(s)
+	if err != nil {
+		return nil, err
+	}
+	n := len(fwd)
+	var ps []*Promoter
+	for _, o := range []feat.Orientation{feat.Forward, feat.Reverse} {
+		b := fwd
+		if o == feat.Reverse {
+			b = rev
+		}
+		var cand []*Promoter
+		for i := 0; i+2*boxLen+p.MinSpacer <= n; i++ {
+			mm35 := mismatches(b[i:i+boxLen], Box35)
+			if mm35 > p.Max35Mismatch {
+				continue
+			}
+			var best *Promoter
+			for sp := p.MinSpacer; sp <= p.MaxSpacer; sp++ {
+				j := i + boxLen + sp
+				if j+boxLen > n {
+					break
+				}
+				mm10 := mismatches(b[j:j+boxLen], Box10)
+				if mm10 > p.Max10Mismatch {
+					continue
+				}
+				score := float64(2*boxLen-mm35-mm10) - p.SpacerPenalty*abs(float64(sp-17))
+				if score < p.MinScore || (best != nil && score <= best.Score) {
+					continue
+				}
+				best = &Promoter{
+					Loc: s, PromStart: i, PromEnd: j + boxLen, Orient: o,
+					Box35: i, Box10: j,
+					Mismatch35: mm35, Mismatch10: mm10, Spacer: sp, Score: score,
+				}
+			}
+			if best != nil {
+				cand = append(cand, best)
+			}
+		}
+		for _, c := range bestPromoters(cand) {
+			if o == feat.Reverse {
+				c.PromStart, c.PromEnd = n-c.PromEnd, n-c.PromStart
+				c.Box35 = n - c.Box35 - boxLen
+				c.Box10 = n - c.Box10 - boxLen
+			}
+			ps = append(ps, c)
+		}
+	}
+	sort.Sort(promoters(ps))
+	return ps, nil
+}
+
+func mismatches(b []byte, cons string) int {
+	var mm int
+	for i, c := range b {
+		if c != cons[i] {
+			mm++
+		}
+	}
+	return mm
+}
+
+// FindTerminators returns the rho-independent terminator candidates on both
+// strands of s satisfying p. Overlapping candidates on the same strand are reduced
+// to the highest scoring candidate. Terminators are returned sorted by start
+// position.
+func FindTerminators(s *linear.Seq, p TerminatorParams) ([]*Terminator, error) {
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	fwd, rev, err := strands(s)
+	if err != nil {
+		return nil, err
+	}
+	n := len(fwd)
+	var ts []*Terminator
+	for _, o := range []feat.Orientation{feat.Forward, feat.Reverse} {
+		b := fwd
+		if o == feat.Reverse {
+			b = rev
+		}
+		var cand []*Terminator
+		for t := 0; t+p.TractLen <= n; t++ {
+			var nt int
+			for _, c := range b[t : t+p.TractLen] {
+				if c == 'T' {
+					nt++
+				}
+			}
+			if nt < p.MinT {
+				continue
+			}
+			var best *Terminator
+			for g := 0; g <= p.MaxGap; g++ {
+				if h := p.hairpin(b, t-g); h != nil && h.Score >= p.MinScore && (best == nil || h.Score > best.Score) {
+					best = h
+				}
+			}
+			if best != nil {
+				best.Loc, best.Orient, best.Ts = s, o, nt
+				best.TermEnd = t + p.TractLen
+				cand = append(cand, best)
+			}
+		}
+		for _, c := range bestTerminators(cand) {
+			if o == feat.Reverse {
+				c.TermStart, c.TermEnd = n-c.TermEnd, n-c.TermStart
+			}
+			ts = append(ts, c)
+		}
+	}
+	sort.Sort(terminators(ts))
+	return ts, nil
+}
+
+// hairpin returns the highest scoring stem-loop in b with its 3' arm ending at
+// end, or nil if none is found. Only the TermStart and stem description fields
+// of the returned Terminator are set.
+func (p TerminatorParams) hairpin(b []byte, end int) *Terminator {
+	var best *Terminator
+	for stem := p.MinStem; stem <= p.MaxStem; stem++ {
+		q := end - stem // Start of 3' arm.
+		for loop := p.MinLoop; loop <= p.MaxLoop; loop++ {
+			if q-loop-stem < 0 {
+				break
+			}
+			var (
+				score  float64
+				mm, gc int
+			)
+			for k := 0; k < stem; k++ {
+				e := pairScore(b[q-loop-1-k], b[q+k])
+				switch e {
+				case 3:
+					gc++
+				case 0:
+					mm++
+					e = -2
+				}
+				score += e
+			}
+			if mm > p.MaxMismatch || gc < p.MinGC || !pairs(b[q-loop-stem], b[end-1]) || !pairs(b[q-loop-1], b[q]) {
+				// Require a closing pair at both ends of the stem.
+				continue
+			}
+			if best == nil || score > best.Score {
+				best = &Terminator{TermStart: q - loop - stem, Stem: stem, Loop: loop, Mismatches: mm, Score: score}
+			}
+		}
+	}
+	return best
+}
+
+func pairs(a, b byte) bool { return pairScore(a, b) != 0 }
+
+// pairScore returns the score of pairing a with b in an RNA stem.
+func pairScore(a, b byte) float64 {
+	switch {
+	case a == 'G' && b == 'C', a == 'C' && b == 'G':
+		return 3
+	case a == 'A' && b == 'T', a == 'T' && b == 'A':
+		return 2
+	case a == 'G' && b == 'T', a == 'T' && b == 'G':
+		return 1
+	}
+	return 0
+}
+
+// bestPromoters returns the highest scoring members of overlapping groups in c.
+func bestPromoters(c []*Promoter) []*Promoter {
+	sort.Stable(promotersByScore(c))
+	var keep []*Promoter
+	for _, p := range c {
+		ok := true
+		for _, k := range keep {
+			if overlaps(p, k) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			keep = append(keep, p)
+		}
+	}
+	return keep
+}
+
+// bestTerminators returns the highest scoring members of overlapping groups in c.
+func bestTerminators(c []*Terminator) []*Terminator {
+	sort.Stable(terminatorsByScore(c))
+	var keep []*Terminator
+	for _, t := range c {
+		ok := true
+		for _, k := range keep {
+			if overlaps(t, k) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			keep = append(keep, t)
+		}
+	}
+	return keep
+}
+
+func overlaps(a, b feat.Feature) bool { return a.Start() < b.End() && b.Start() < a.End() }
+
+type promoters []*Promoter
+
+func (p promoters) Len() int           { return len(p) }
+func (p promoters) Less(i, j int) bool { return p[i].PromStart < p[j].PromStart }
+func (p promoters) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+type promotersByScore []*Promoter
+
+func (p promotersByScore) Len() int           { return len(p) }
+func (p promotersByScore) Less(i, j int) bool { return p[i].Score > p[j].Score }
+func (p promotersByScore) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+type terminators []*Terminator
+
+func (t terminators) Len() int           { return len(t) }
+func (t terminators) Less(i, j int) bool { return t[i].TermStart < t[j].TermStart }
+func (t terminators) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+type terminatorsByScore []*Terminator
+
+func (t terminatorsByScore) Len() int           { return len(t) }
+func (t terminatorsByScore) Less(i, j int) bool { return t[i].Score > t[j].Score }
+func (t terminatorsByScore) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+// Annotate finds promoter and terminator candidates in s and associates them with
+// the genes they may regulate. A promoter is associated with a gene when it lies
+// on the gene's strand and ends within pp.Upstream of the gene's 5' end; a
+// terminator is associated with a gene when it lies on the gene's strand and starts
+// within tp.Downstream of the gene's 3' end. Genes must be oriented and their
+// coordinates are taken to be relative to s. A candidate associated with more than
+// one gene is returned once for each gene.
+func Annotate(s *linear.Seq, genes []feat.Feature, pp PromoterParams, tp TerminatorParams) ([]*Promoter, []*Terminator, error) {
+	allP, err := FindPromoters(s, pp)
+	if err != nil {
+		return nil, nil, err
+	}
+	allT, err := FindTerminators(s, tp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		ps []*Promoter
+		ts []*Terminator
+	)
+	for _, g := range genes {
+		o, ok := g.(feat.Orienter)
+		if !ok || o.Orientation() == feat.NotOriented {
+			continue
+		}
+		switch o.Orientation() {
+		case feat.Forward:
+			for _, p := range allP {
+				if p.Orient == feat.Forward && p.PromEnd <= g.Start()+boxLen && p.PromEnd > g.Start()-pp.Upstream {
+					c := *p
+					c.Gene = g
+					ps = append(ps, &c)
+				}
+			}
+			for _, t := range allT {
+				if t.Orient == feat.Forward && t.TermStart >= g.End()-tp.MaxStem && t.TermStart < g.End()+tp.Downstream {
+					c := *t
+					c.Gene = g
+					ts = append(ts, &c)
+				}
+			}
+		case feat.Reverse:
+			for _, p := range allP {
+				if p.Orient == feat.Reverse && p.PromStart >= g.End()-boxLen && p.PromStart < g.End()+pp.Upstream {
+					c := *p
+					c.Gene = g
+					ps = append(ps, &c)
+				}
+			}
+			for _, t := range allT {
+				if t.Orient == feat.Reverse && t.TermEnd <= g.Start()+tp.MaxStem && t.TermEnd > g.Start()-tp.Downstream {
+					c := *t
+					c.Gene = g
+					ts = append(ts, &c)
+				}
+			}
+		}
+	}
+	return ps, ts, nil
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
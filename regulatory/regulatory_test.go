@@ -0,0 +1,99 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package regulatory
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq/linear"
+
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+type gene struct {
+	start, end int
+	orient     feat.Orientation
+}
+
+func (g *gene) Start() int                    { return g.start }
+func (g *gene) End() int                      { return g.end }
+func (g *gene) Len() int                      { return g.end - g.start }
+func (g *gene) Name() string                  { return "gene" }
+func (g *gene) Description() string           { return "gene" }
+func (g *gene) Location() feat.Feature        { return nil }
+func (g *gene) Orientation() feat.Orientation { return g.orient }
+
+func background(n int) string { return strings.Repeat("AC", n/2) }
+
+// operon returns a sequence with a promoter at 100, a gene at [200,500) and
+// a terminator at 510.
+func operon() string {
+	return background(100) +
+		Box35 + "ACACACACACACACACA" + Box10 + // [100,129)
+		background(71) + // [129,200)
+		background(300) + // gene [200,500)
+		background(10) +
+		"CCGCGGGC" + "GAAA" + "GCCCGCGG" + "TTTTTTTT" + // [510,538)
+		background(62)
+}
+
+func (s *S) TestFind(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte(operon())), alphabet.DNA)
+	n := sq.Len()
+
+	ps, err := FindPromoters(sq, DefaultPromoterParams)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(ps), check.Equals, 1)
+	c.Check(ps[0].Box35, check.Equals, 100)
+	c.Check(ps[0].Box10, check.Equals, 123)
+	c.Check(ps[0].End(), check.Equals, 129)
+	c.Check(ps[0].Score, check.Equals, 12.)
+	c.Check(ps[0].Orientation(), check.Equals, feat.Forward)
+
+	ts, err := FindTerminators(sq, DefaultTerminatorParams)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(ts), check.Equals, 1)
+	c.Check(ts[0].Start() <= 510 && ts[0].End() >= 530, check.Equals, true, check.Commentf("Got [%d,%d)", ts[0].Start(), ts[0].End()))
+	c.Check(ts[0].Stem >= 8, check.Equals, true)
+	c.Check(ts[0].Loop, check.Equals, 4)
+	start, end := ts[0].Start(), ts[0].End()
+	c.Check(ts[0].Orientation(), check.Equals, feat.Forward)
+
+	sq.RevComp()
+	ps, err = FindPromoters(sq, DefaultPromoterParams)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(ps), check.Equals, 1)
+	c.Check(ps[0].Box35, check.Equals, n-106)
+	c.Check(ps[0].Start(), check.Equals, n-129)
+	c.Check(ps[0].Orientation(), check.Equals, feat.Reverse)
+
+	ts, err = FindTerminators(sq, DefaultTerminatorParams)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(ts), check.Equals, 1)
+	c.Check(ts[0].Start(), check.Equals, n-end)
+	c.Check(ts[0].End(), check.Equals, n-start)
+	c.Check(ts[0].Orientation(), check.Equals, feat.Reverse)
+}
+
+func (s *S) TestAnnotate(c *check.C) {
+	sq := linear.NewSeq("test", alphabet.BytesToLetters([]byte(operon())), alphabet.DNA)
+	g := &gene{200, 500, feat.Forward}
+	ps, ts, err := Annotate(sq, []feat.Feature{g, &gene{200, 500, feat.Reverse}}, DefaultPromoterParams, DefaultTerminatorParams)
+	c.Assert(err, check.Equals, nil)
+	c.Assert(len(ps), check.Equals, 1)
+	c.Check(ps[0].Gene, check.Equals, feat.Feature(g))
+	c.Assert(len(ts), check.Equals, 1)
+	c.Check(ts[0].Gene, check.Equals, feat.Feature(g))
+}
@@ -0,0 +1,277 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sampleqc provides inference of sample sex from sex chromosome
+// coverage and estimation of cross-individual contamination from allele
+// fractions at common SNPs.
+//
+// Sex is inferred from the depth of the X and Y chromosomes relative to the
+// median autosomal depth; a typical female sample has an X ratio near 1 and a Y
+// ratio near 0, and a typical male sample has both ratios near 0.5.
+//
+// Contamination is estimated at common SNPs where the sample is homozygous. If
+// a fraction α of reads comes from another individual drawn from the same
+// population, the expected fraction of reads carrying the allele absent from
+// the sample at such a site is α times the population frequency of that allele.
+// α is estimated by the ratio of the observed number of such reads to the
+// number expected with α equal to one, summed over sites.
+package sampleqc
+
+import (
+	"github.com/biogo/biogo/pedigree"
+
+	"errors"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrNoAutosomes = errors.New("sampleqc: no autosomal depth")
+	ErrNoX         = errors.New("sampleqc: no X chromosome depth")
+)
+
+// Flags is a set of sample QC flags.
+type Flags uint
+
+const (
+	SexUnknown   Flags = 1 << iota // Sex could not be inferred.
+	SexMismatch                    // Inferred sex differs from the declared sex.
+	Contaminated                   // Contamination estimate exceeds the limit.
+)
+
+var flagNames = []string{"sex_unknown", "sex_mismatch", "contaminated"}
+
+// String returns a comma separated list of the flags set in f, or "pass" if no
+// flag is set.
+func (f Flags) String() string {
+	if f == 0 {
+		return "pass"
+	}
+	var names []string
+	for i, n := range flagNames {
+		if f&(1<<uint(i)) != 0 {
+			names = append(names, n)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// Params describes the parameters used for sample QC.
+type Params struct {
+	// X and Y are the accepted names of the sex chromosomes.
+	// Autosomes holds the names of the autosomes. If Autosomes
+	// is nil, all chromosomes not named in X or Y are used.
+	X, Y      []string
+	Autosomes []string
+
+	// FemaleMinX and FemaleMaxY are the minimum X ratio and
+	// maximum Y ratio for a female call, and MaleMaxX and
+	// MaleMinY are the maximum X ratio and minimum Y ratio
+	// for a male call.
+	FemaleMinX, FemaleMaxY float64
+	MaleMaxX, MaleMinY     float64
+
+	// MinDepth is the minimum read depth of a SNP used for
+	// contamination estimation, and MinAF and MaxAF are the
+	// limits of population allele frequency for a SNP to be
+	// considered common.
+	MinDepth     int
+	MinAF, MaxAF float64
+
+	// MaxHomFraction is the largest fraction of reads carrying
+	// the minor allele at a site for the sample to be considered
+	// homozygous at the site.
+	MaxHomFraction float64
+
+	// MaxContamination is the largest contamination estimate
+	// for a sample to pass QC.
+	MaxContamination float64
+}
+
+// DefaultParams are the default sample QC parameters.
+var DefaultParams = Params{
+	X: []string{"X", "chrX"},
+	Y: []string{"Y", "chrY"},
+
+	FemaleMinX: 0.8,
+	FemaleMaxY: 0.05,
+	MaleMaxX:   0.65,
+	MaleMinY:   0.2,
+
+	MinDepth: 10,
+	MinAF:    0.05,
+	MaxAF:    0.95,
+
+	MaxHomFraction: 0.2,
+
+	MaxContamination: 0.02,
+}
+
+// SexCall is the result of sex inference.
+type SexCall struct {
+	// XRatio and YRatio are the depths of the X and Y
+	// chromosomes relative to the median autosomal depth.
+	XRatio, YRatio float64
+
+	Sex pedigree.Sex
+}
+
+// InferSex infers the sex of a sample from the mean depth of each chromosome,
+// keyed by chromosome name. A missing Y chromosome is treated as having zero
+// depth. Samples with ratios outside the limits for both sexes, for example
+// those with sex chromosome aneuploidy, are called pedigree.UnknownSex.
+func InferSex(depth map[string]float64, p Params) (SexCall, error) {
+	isSex := make(map[string]bool)
+	for _, n := range p.X {
+		isSex[n] = true
+	}
+	for _, n := range p.Y {
+		isSex[n] = true
+	}
+	var auto []float64
+	if p.Autosomes == nil {
+		for n, d := range depth {
+			if !isSex[n] {
+				auto = append(auto, d)
+			}
+		}
+	} else {
+		for _, n := range p.Autosomes {
+			if d, ok := depth[n]; ok {
+				auto = append(auto, d)
+			}
+		}
+	}
+	if len(auto) == 0 {
+		return SexCall{}, ErrNoAutosomes
+	}
+	med := median(auto)
+	if med == 0 {
+		return SexCall{}, ErrNoAutosomes
+	}
+	x, ok := lookup(depth, p.X)
+	if !ok {
+		return SexCall{}, ErrNoX
+	}
+	y, _ := lookup(depth, p.Y)
+
+	c := SexCall{XRatio: x / med, YRatio: y / med}
+	switch {
+	case c.XRatio >= p.FemaleMinX && c.YRatio <= p.FemaleMaxY:
+		c.Sex = pedigree.Female
+	case c.XRatio <= p.MaleMaxX && c.YRatio >= p.MaleMinY:
+		c.Sex = pedigree.Male
+	default:
+		c.Sex = pedigree.UnknownSex
+	}
+	return c, nil
+}
+
+// lookup returns the depth of the first of names present in depth.
+func lookup(depth map[string]float64, names []string) (float64, bool) {
+	for _, n := range names {
+		if d, ok := depth[n]; ok {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+func median(v []float64) float64 {
+	sort.Float64s(v)
+	n := len(v)
+	if n%2 == 1 {
+		return v[n/2]
+	}
+	return (v[n/2-1] + v[n/2]) / 2
+}
+
+// A Site holds the read counts of a sample at a biallelic SNP and the
+// population frequency of the alternative allele.
+type Site struct {
+	Ref, Alt int
+	AF       float64
+}
+
+// Contamination is a contamination estimate.
+type Contamination struct {
+	// Alpha is the estimated fraction of
+	// reads from another individual.
+	Alpha float64
+
+	// Sites is the number of homozygous
+	// common SNPs used for the estimate.
+	Sites int
+}
+
+// EstimateContamination estimates the contamination of a sample from its read
+// counts at common SNPs.
+func EstimateContamination(sites []Site, p Params) Contamination {
+	var (
+		c             Contamination
+		obs, expected float64
+	)
+	for _, s := range sites {
+		d := s.Ref + s.Alt
+		if d == 0 || d < p.MinDepth || s.AF < p.MinAF || s.AF > p.MaxAF {
+			continue
+		}
+		altFrac := float64(s.Alt) / float64(d)
+		switch {
+		case altFrac <= p.MaxHomFraction:
+			// Homozygous reference; foreign reads carry alt.
+			obs += float64(s.Alt)
+			expected += float64(d) * s.AF
+		case 1-altFrac <= p.MaxHomFraction:
+			// Homozygous alternative; foreign reads carry ref.
+			obs += float64(s.Ref)
+			expected += float64(d) * (1 - s.AF)
+		default:
+			continue
+		}
+		c.Sites++
+	}
+	if expected != 0 {
+		c.Alpha = obs / expected
+		if c.Alpha > 1 {
+			c.Alpha = 1
+		}
+	}
+	return c
+}
+
+// A Sample holds the QC results for a sample.
+type Sample struct {
+	ID            string
+	Declared      pedigree.Sex
+	Sex           SexCall
+	Contamination Contamination
+	Flags         Flags
+}
+
+// Check performs sex inference and contamination estimation for the sample with
+// the given ID and declared sex, using the chromosome depths and SNP read counts
+// of the sample. A declared sex of pedigree.UnknownSex is not checked against
+// the inferred sex.
+func Check(id string, declared pedigree.Sex, depth map[string]float64, sites []Site, p Params) (*Sample, error) {
+	sex, err := InferSex(depth, p)
+	if err != nil {
+		return nil, err
+	}
+	s := &Sample{
+		ID:            id,
+		Declared:      declared,
+		Sex:           sex,
+		Contamination: EstimateContamination(sites, p),
+	}
+	if sex.Sex == pedigree.UnknownSex {
+		s.Flags |= SexUnknown
+	} else if declared != pedigree.UnknownSex && declared != sex.Sex {
+		s.Flags |= SexMismatch
+	}
+	if s.Contamination.Alpha > p.MaxContamination {
+		s.Flags |= Contaminated
+	}
+	return s, nil
+}
@@ -0,0 +1,80 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleqc
+
+import (
+	"github.com/biogo/biogo/pedigree"
+
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestInferSex(c *check.C) {
+	for i, t := range []struct {
+		depth  map[string]float64
+		expect pedigree.Sex
+		err    error
+	}{
+		{depth: map[string]float64{"1": 30, "2": 32, "3": 28, "X": 29, "Y": 0.3}, expect: pedigree.Female},
+		{depth: map[string]float64{"chr1": 30, "chr2": 32, "chr3": 28, "chrX": 15, "chrY": 14}, expect: pedigree.Male},
+		{depth: map[string]float64{"1": 30, "2": 32, "3": 28, "X": 29, "Y": 15}, expect: pedigree.UnknownSex},
+		{depth: map[string]float64{"1": 30, "2": 32, "3": 28, "X": 29}, expect: pedigree.Female},
+		{depth: map[string]float64{"1": 30, "Y": 15}, err: ErrNoX},
+		{depth: map[string]float64{"X": 30, "Y": 15}, err: ErrNoAutosomes},
+	} {
+		got, err := InferSex(t.depth, DefaultParams)
+		c.Check(err, check.Equals, t.err, check.Commentf("Test %d", i))
+		if err == nil {
+			c.Check(got.Sex, check.Equals, t.expect, check.Commentf("Test %d", i))
+		}
+	}
+
+	p := DefaultParams
+	p.Autosomes = []string{"1"}
+	got, err := InferSex(map[string]float64{"1": 20, "2": 100, "X": 10, "Y": 10}, p)
+	c.Assert(err, check.Equals, nil)
+	c.Check(got, check.Equals, SexCall{XRatio: 0.5, YRatio: 0.5, Sex: pedigree.Male})
+}
+
+var sites = []Site{
+	{Ref: 98, Alt: 2, AF: 0.5},   // Homozygous reference.
+	{Ref: 1, Alt: 99, AF: 0.5},   // Homozygous alternative.
+	{Ref: 50, Alt: 50, AF: 0.5},  // Heterozygous.
+	{Ref: 4, Alt: 1, AF: 0.5},    // Too shallow.
+	{Ref: 90, Alt: 10, AF: 0.01}, // Rare.
+}
+
+func (s *S) TestEstimateContamination(c *check.C) {
+	c.Check(EstimateContamination(sites, DefaultParams), check.Equals, Contamination{Alpha: 0.03, Sites: 2})
+	c.Check(EstimateContamination(nil, DefaultParams), check.Equals, Contamination{})
+}
+
+func (s *S) TestCheck(c *check.C) {
+	male := map[string]float64{"1": 30, "2": 32, "3": 28, "X": 15, "Y": 14}
+	for i, t := range []struct {
+		declared pedigree.Sex
+		sites    []Site
+		expect   Flags
+	}{
+		{declared: pedigree.Male, expect: 0},
+		{declared: pedigree.UnknownSex, expect: 0},
+		{declared: pedigree.Female, expect: SexMismatch},
+		{declared: pedigree.Female, sites: sites, expect: SexMismatch | Contaminated},
+	} {
+		got, err := Check("s", t.declared, male, t.sites, DefaultParams)
+		c.Assert(err, check.Equals, nil)
+		c.Check(got.Flags, check.Equals, t.expect, check.Commentf("Test %d", i))
+	}
+	c.Check(Flags(0).String(), check.Equals, "pass")
+	c.Check((SexMismatch | Contaminated).String(), check.Equals, "sex_mismatch,contaminated")
+}
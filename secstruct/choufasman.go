@@ -0,0 +1,183 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package secstruct
+
+import (
+	"github.com/biogo/biogo/seq"
+)
+
+// Conformational holds the Chou-Fasman helix, strand and turn conformational
+// parameters of a residue.
+type Conformational struct {
+	Helix, Strand, Turn float64
+}
+
+// ChouFasmanParams are the conformational parameters of Chou and Fasman (1978)
+// keyed by upper case residue letter. Residues not present are given neutral
+// parameters of 1.
+var ChouFasmanParams = map[byte]Conformational{
+	'A': {1.42, 0.83, 0.66},
+	'R': {0.98, 0.93, 0.95},
+	'N': {0.67, 0.89, 1.56},
+	'D': {1.01, 0.54, 1.46},
+	'C': {0.70, 1.19, 1.19},
+	'E': {1.51, 0.37, 0.74},
+	'Q': {1.11, 1.10, 0.98},
+	'G': {0.57, 0.75, 1.56},
+	'H': {1.00, 0.87, 0.95},
+	'I': {1.08, 1.60, 0.47},
+	'L': {1.21, 1.30, 0.59},
+	'K': {1.14, 0.74, 1.01},
+	'M': {1.45, 1.05, 0.60},
+	'F': {1.13, 1.38, 0.60},
+	'P': {0.57, 0.55, 1.52},
+	'S': {0.77, 0.75, 1.43},
+	'T': {0.83, 1.19, 0.96},
+	'W': {1.08, 1.37, 0.96},
+	'Y': {0.69, 1.47, 1.14},
+	'V': {1.06, 1.70, 0.50},
+}
+
+// Chou-Fasman nucleation and acceptance criteria.
+const (
+	helixNucleus  = 6    // Helix nucleus window length.
+	helixFormers  = 4    // Minimum helix formers in a nucleus.
+	strandNucleus = 5    // Strand nucleus window length.
+	strandFormers = 3    // Minimum strand formers in a nucleus.
+	breakWindow   = 4    // Extension terminating window length.
+	helixAccept   = 1.03 // Minimum mean helix parameter of a helix.
+	strandAccept  = 1.05 // Minimum mean strand parameter of a strand.
+
+	// confWindow is the half width of the window
+	// used to calculate prediction confidence.
+	confWindow = 3
+)
+
+// ChouFasman returns the Chou-Fasman secondary structure prediction for the
+// protein sequence s. The confidence of each residue is the share of the
+// predicted state in the sum of the helix, strand and turn parameters averaged
+// over a window of seven residues, with turn parameters standing for coil.
+func ChouFasman(s seq.Sequence) (*Prediction, error) {
+	r, err := residues(s)
+	if err != nil {
+		return nil, err
+	}
+	n := len(r)
+	pa := make([]float64, n)
+	pb := make([]float64, n)
+	pt := make([]float64, n)
+	for i, l := range r {
+		c, ok := ChouFasmanParams[byte(l)]
+		if !ok {
+			c = Conformational{1, 1, 1}
+		}
+		pa[i], pb[i], pt[i] = c.Helix, c.Strand, c.Turn
+	}
+
+	helix := regions(pa, pb, helixNucleus, helixFormers, helixAccept)
+	strand := regions(pb, pa, strandNucleus, strandFormers, strandAccept)
+
+	p := &Prediction{
+		Loc:        s,
+		Offset:     s.Start(),
+		States:     make([]State, n),
+		Confidence: make([]float64, n),
+	}
+	for i := 0; i < n; {
+		switch {
+		case helix[i] && strand[i]:
+			// Overlapping assignments are resolved
+			// by the mean parameters of the overlap.
+			j := i
+			for j < n && helix[j] && strand[j] {
+				j++
+			}
+			st := Helix
+			if mean(pb[i:j]) > mean(pa[i:j]) {
+				st = Strand
+			}
+			for ; i < j; i++ {
+				p.States[i] = st
+			}
+			continue
+		case helix[i]:
+			p.States[i] = Helix
+		case strand[i]:
+			p.States[i] = Strand
+		default:
+			p.States[i] = Coil
+		}
+		i++
+	}
+
+	for i, st := range p.States {
+		lo, hi := i-confWindow, i+confWindow+1
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > n {
+			hi = n
+		}
+		a, b, t := mean(pa[lo:hi]), mean(pb[lo:hi]), mean(pt[lo:hi])
+		var v float64
+		switch st {
+		case Helix:
+			v = a
+		case Strand:
+			v = b
+		default:
+			v = t
+		}
+		p.Confidence[i] = v / (a + b + t)
+	}
+	return p, nil
+}
+
+// regions returns a mask of the residues in regions of the state with
+// parameters p that nucleate in windows of nucleus residues with at least
+// formers residues with a parameter above 1, and extend until a window of
+// breakWindow residues has a mean parameter below 1. Regions are accepted
+// if their mean parameter is at least accept and greater than the mean of
+// the competing parameters q.
+func regions(p, q []float64, nucleus, formers int, accept float64) []bool {
+	n := len(p)
+	mask := make([]bool, n)
+	for i := 0; i+nucleus <= n; i++ {
+		if mask[i] {
+			continue
+		}
+		var c int
+		for _, v := range p[i : i+nucleus] {
+			if v > 1 {
+				c++
+			}
+		}
+		if c < formers {
+			continue
+		}
+		s, e := i, i+nucleus
+		for e < n && mean(p[e+1-breakWindow:e+1]) >= 1 {
+			e++
+		}
+		for s > 0 && mean(p[s-1:s-1+breakWindow]) >= 1 {
+			s--
+		}
+		if mean(p[s:e]) < accept || mean(p[s:e]) <= mean(q[s:e]) {
+			continue
+		}
+		for k := s; k < e; k++ {
+			mask[k] = true
+		}
+	}
+	return mask
+}
+
+func mean(v []float64) float64 {
+	var s float64
+	for _, x := range v {
+		s += x
+	}
+	return s / float64(len(v))
+}
@@ -0,0 +1,163 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package secstruct
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+
+	"math"
+)
+
+// residueOrder is the order of residues in GOR information tables. All other
+// letters share the final index.
+const residueOrder = "ACDEFGHIKLMNPQRSTVWY"
+
+var residueIndex = func() (idx [256]int) {
+	for i := range idx {
+		idx[i] = len(residueOrder)
+	}
+	for i, c := range []byte(residueOrder) {
+		idx[c] = i
+	}
+	return idx
+}()
+
+// GOR is a GOR secondary structure predictor.
+//
+// The state of a residue is predicted from the directional information
+// provided by each residue in a window about it, under the assumption of
+// independence of the window residues. The log-odds that residue j is in
+// state S given the window residues is
+//
+//	log(P(S)/P(¬S)) + Σ_m [log(P(S|R_{j+m})/P(¬S|R_{j+m})) - log(P(S)/P(¬S))]
+//
+// with m in [-Half, Half], where R_{j+m} is the residue at offset m.
+type GOR struct {
+	// Half is the half width of
+	// the prediction window.
+	Half int
+
+	// prior holds the log-odds of each state
+	// and info holds the information difference
+	// of each state, window offset and residue.
+	prior [3]float64
+	info  [3][][len(residueOrder) + 1]float64
+}
+
+// TrainGOR returns a GOR predictor with a window half width of half estimated
+// from the sequences seqs of known structure states. Residue counts are
+// increased by pseudo to avoid zero frequencies. A half width of 8, giving the
+// classic window of 17 residues, is commonly used.
+func TrainGOR(seqs []alphabet.Letters, states [][]State, half int, pseudo float64) (*GOR, error) {
+	if half < 0 {
+		return nil, ErrBadWindow
+	}
+	if len(seqs) != len(states) {
+		return nil, ErrLengthMismatch
+	}
+	const nr = len(residueOrder) + 1
+	var (
+		total [3]float64
+		count [3][][nr]float64
+	)
+	for k := range count {
+		count[k] = make([][nr]float64, 2*half+1)
+	}
+	for i, s := range seqs {
+		st := states[i]
+		if len(s) != len(st) {
+			return nil, ErrLengthMismatch
+		}
+		for j, sj := range st {
+			k := sj.index()
+			if k < 0 {
+				return nil, ErrBadState
+			}
+			total[k]++
+			for m := -half; m <= half; m++ {
+				if j+m < 0 || j+m >= len(s) {
+					continue
+				}
+				count[k][m+half][residueIndex[upper(s[j+m])]]++
+			}
+		}
+	}
+	var sum float64
+	for _, t := range total {
+		sum += t
+	}
+	if sum == 0 {
+		return nil, ErrNoData
+	}
+
+	g := &GOR{Half: half}
+	for k := range stateOrder {
+		g.prior[k] = math.Log((total[k] + pseudo) / (sum - total[k] + pseudo))
+		g.info[k] = make([][nr]float64, 2*half+1)
+		for m := range g.info[k] {
+			for r := 0; r < nr; r++ {
+				var not float64
+				for o := range stateOrder {
+					if o != k {
+						not += count[o][m][r]
+					}
+				}
+				g.info[k][m][r] = math.Log((count[k][m][r]+pseudo)/(not+pseudo)) - g.prior[k]
+			}
+		}
+	}
+	return g, nil
+}
+
+// Predict returns the GOR secondary structure prediction for the protein
+// sequence s. The confidence of each residue is the probability of the
+// predicted state, normalized over the three states.
+func (g *GOR) Predict(s seq.Sequence) (*Prediction, error) {
+	r, err := residues(s)
+	if err != nil {
+		return nil, err
+	}
+	n := len(r)
+	p := &Prediction{
+		Loc:        s,
+		Offset:     s.Start(),
+		States:     make([]State, n),
+		Confidence: make([]float64, n),
+	}
+	for j := range r {
+		var (
+			prob [3]float64
+			sum  float64
+		)
+		for k := range stateOrder {
+			lo := g.prior[k]
+			for m := -g.Half; m <= g.Half; m++ {
+				if j+m < 0 || j+m >= n {
+					continue
+				}
+				lo += g.info[k][m+g.Half][residueIndex[r[j+m]]]
+			}
+			prob[k] = 1 / (1 + math.Exp(-lo))
+			sum += prob[k]
+		}
+		best := 0
+		for k := range prob {
+			if prob[k] > prob[best] {
+				best = k
+			}
+		}
+		p.States[j] = stateOrder[best]
+		p.Confidence[j] = prob[best] / sum
+	}
+	return p, nil
+}
+
+func upper(l alphabet.Letter) alphabet.Letter {
+	if 'a' <= l && l <= 'z' {
+		return l - 'a' + 'A'
+	}
+	return l
+}
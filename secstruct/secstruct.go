@@ -0,0 +1,158 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package secstruct provides classic protein secondary structure predictors.
+//
+// Two methods are provided. The Chou-Fasman method (Adv Enzymol 47:45-148, 1978)
+// locates helix and strand nuclei using residue conformational parameters and
+// extends them while the local propensity is maintained. Turn prediction is not
+// performed, so turns are reported as coil. The GOR method (Garnier, Osguthorpe
+// and Robson, J Mol Biol 120:97-120, 1978) predicts the state of each residue
+// from the information carried by the residues in a window around it, using
+// information tables estimated from sequences of known structure.
+//
+// Predictions are made in the three state reduction of helix, strand and coil.
+// Neither method approaches the accuracy of modern profile-based predictors; they
+// are intended as dependency-free baselines.
+package secstruct
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotProtein     = errors.New("secstruct: sequence is not protein")
+	ErrBadState       = errors.New("secstruct: invalid structure state")
+	ErrLengthMismatch = errors.New("secstruct: sequence and structure lengths differ")
+	ErrNoData         = errors.New("secstruct: no training data")
+	ErrBadWindow      = errors.New("secstruct: invalid window half width")
+)
+
+var _ feat.Feature = (*Segment)(nil)
+
+// A State is a secondary structure state.
+type State byte
+
+// The three secondary structure states.
+const (
+	Helix  State = 'H'
+	Strand State = 'E'
+	Coil   State = 'C'
+)
+
+func (s State) String() string { return string(s) }
+
+// index returns the table index of s.
+func (s State) index() int {
+	switch s {
+	case Helix:
+		return 0
+	case Strand:
+		return 1
+	case Coil:
+		return 2
+	}
+	return -1
+}
+
+var stateOrder = [3]State{Helix, Strand, Coil}
+
+// ParseStates returns the states described by the structure string s. The eight
+// DSSP states are reduced to three: H, G and I are helix, E and B are strand, and
+// T, S, C, L, '-', '.' and ' ' are coil.
+func ParseStates(s string) ([]State, error) {
+	st := make([]State, len(s))
+	for i, c := range []byte(s) {
+		switch c {
+		case 'H', 'G', 'I':
+			st[i] = Helix
+		case 'E', 'B':
+			st[i] = Strand
+		case 'T', 'S', 'C', 'L', '-', '.', ' ':
+			st[i] = Coil
+		default:
+			return nil, fmt.Errorf("%v %q at position %d", ErrBadState, c, i)
+		}
+	}
+	return st, nil
+}
+
+// A Prediction is a per-residue secondary structure prediction.
+type Prediction struct {
+	// Loc is the predicted sequence and Offset
+	// is the position of the first residue.
+	Loc    feat.Feature
+	Offset int
+
+	// States holds the predicted state of each
+	// residue, and Confidence the confidence of
+	// each prediction in the range [0, 1].
+	States     []State
+	Confidence []float64
+}
+
+// String returns the predicted states as a string of H, E and C.
+func (p *Prediction) String() string {
+	b := make([]byte, len(p.States))
+	for i, s := range p.States {
+		b[i] = byte(s)
+	}
+	return string(b)
+}
+
+// A Segment is a run of residues with the same predicted state.
+type Segment struct {
+	Loc        feat.Feature
+	From, To   int
+	State      State
+	Confidence float64 // Mean confidence of the segment residues.
+}
+
+func (s *Segment) Start() int             { return s.From }
+func (s *Segment) End() int               { return s.To }
+func (s *Segment) Len() int               { return s.To - s.From }
+func (s *Segment) Name() string           { return s.Loc.Name() }
+func (s *Segment) Description() string    { return "secondary structure" }
+func (s *Segment) Location() feat.Feature { return s.Loc }
+
+// Segments returns the helix and strand segments of p with at least min residues.
+func (p *Prediction) Segments(min int) []*Segment {
+	var segs []*Segment
+	for i := 0; i < len(p.States); {
+		j := i
+		var sum float64
+		for ; j < len(p.States) && p.States[j] == p.States[i]; j++ {
+			sum += p.Confidence[j]
+		}
+		if p.States[i] != Coil && j-i >= min {
+			segs = append(segs, &Segment{
+				Loc:        p.Loc,
+				From:       p.Offset + i,
+				To:         p.Offset + j,
+				State:      p.States[i],
+				Confidence: sum / float64(j-i),
+			})
+		}
+		i = j
+	}
+	return segs
+}
+
+// residues returns the letters of s in upper case after checking that s is
+// a protein sequence.
+func residues(s seq.Sequence) ([]alphabet.Letter, error) {
+	if s.Alphabet().Moltype() != feat.Protein {
+		return nil, ErrNotProtein
+	}
+	r := make([]alphabet.Letter, 0, s.Len())
+	for i := s.Start(); i < s.End(); i++ {
+		r = append(r, upper(s.At(i).L))
+	}
+	return r, nil
+}
@@ -0,0 +1,78 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package secstruct
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/linear"
+
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestParseStates(c *check.C) {
+	st, err := ParseStates("HGIEBTS-C")
+	c.Assert(err, check.Equals, nil)
+	c.Check(st, check.DeepEquals, []State{Helix, Helix, Helix, Strand, Strand, Coil, Coil, Coil, Coil})
+	_, err = ParseStates("HX")
+	c.Check(err, check.ErrorMatches, `secstruct: invalid structure state 'X' at position 1`)
+}
+
+func (s *S) TestChouFasman(c *check.C) {
+	// A helix forming segment and a strand forming
+	// segment separated by a glycine and proline rich
+	// linker.
+	sq := linear.NewSeq("test", alphabet.Letters("AEELLKKAEEMLKA"+"GPNGSPDG"+"VIVTVYVIV"), alphabet.Protein)
+	p, err := ChouFasman(sq)
+	c.Assert(err, check.Equals, nil)
+	// Extension carries the helix and strand a
+	// short distance into the linker.
+	c.Check(p.String(), check.Equals, "HHHHHHHHHHHHHH"+"HCCCCCEE"+"EEEEEEEEE")
+	for i, v := range p.Confidence {
+		c.Check(v > 0 && v < 1, check.Equals, true, check.Commentf("Residue %d", i))
+	}
+	segs := p.Segments(4)
+	c.Assert(segs, check.HasLen, 2)
+	c.Check(segs[0].State, check.Equals, Helix)
+	c.Check(segs[1].State, check.Equals, Strand)
+	c.Check(segs[1].Start(), check.Equals, 20)
+	c.Check(segs[1].End(), check.Equals, 31)
+
+	_, err = ChouFasman(linear.NewSeq("dna", alphabet.Letters("acgt"), alphabet.DNA))
+	c.Check(err, check.Equals, ErrNotProtein)
+}
+
+func (s *S) TestGOR(c *check.C) {
+	var (
+		seqs   []alphabet.Letters
+		states [][]State
+	)
+	for i := 0; i < 10; i++ {
+		seqs = append(seqs, alphabet.Letters(strings.Repeat("AELK", 3)+"GPNG"+strings.Repeat("VIT", 4)))
+		st, err := ParseStates(strings.Repeat("H", 12) + "TTSS" + strings.Repeat("E", 12))
+		c.Assert(err, check.Equals, nil)
+		states = append(states, st)
+	}
+	g, err := TrainGOR(seqs, states, 2, 0.5)
+	c.Assert(err, check.Equals, nil)
+
+	p, err := g.Predict(linear.NewSeq("test", alphabet.Letters("KLEAELKAGNPGITVIVT"), alphabet.Protein))
+	c.Assert(err, check.Equals, nil)
+	c.Check(p.String(), check.Equals, "HHHHHHH"+"CCCC"+"EEEEEEE")
+
+	_, err = TrainGOR(seqs, states[:1], 2, 0.5)
+	c.Check(err, check.Equals, ErrLengthMismatch)
+	_, err = TrainGOR(nil, nil, 2, 0.5)
+	c.Check(err, check.Equals, ErrNoData)
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package codon provides genetic codes for translation of nucleotide sequences
+// and tools for checking, repairing and viewing codon alignments.
+package codon
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"errors"
+)
+
+var (
+	ErrBadTable  = errors.New("codon: translation table must have 64 entries")
+	ErrBadLength = errors.New("codon: alignment length not a multiple of three")
+)
+
+// A Code is a genetic code.
+type Code struct {
+	Name string
+	ID   int // NCBI translation table number.
+
+	// aa holds the amino acid of each codon
+	// in NCBI order, TCAG in each position.
+	aa [64]alphabet.Letter
+}
+
+// NewCode returns a genetic code with the given name and NCBI translation table
+// number. The amino acids are given as a 64 letter string in the order used by
+// NCBI, with the bases of each codon position ordered TCAG.
+func NewCode(name string, id int, aas string) (*Code, error) {
+	if len(aas) != 64 {
+		return nil, ErrBadTable
+	}
+	c := &Code{Name: name, ID: id}
+	for i := range c.aa {
+		c.aa[i] = alphabet.Letter(aas[i])
+	}
+	return c, nil
+}
+
+func mustCode(name string, id int, aas string) *Code {
+	c, err := NewCode(name, id, aas)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Genetic codes from the NCBI translation tables.
+var (
+	Standard                = mustCode("Standard", 1, "FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG")
+	VertebrateMitochondrial = mustCode("Vertebrate Mitochondrial", 2, "FFLLSSSSYY**CCWWLLLLPPPPHHQQRRRRIIMMTTTTNNKKSS**VVVVAAAADDEEGGGG")
+	Bacterial               = mustCode("Bacterial, Archaeal and Plant Plastid", 11, "FFLLSSSSYY**CC*WLLLLPPPPHHQQRRRRIIIMTTTTNNKKSSRRVVVVAAAADDEEGGGG")
+)
+
+// base returns the NCBI order index of the nucleotide l, or -1.
+func base(l alphabet.Letter) int {
+	switch l {
+	case 'T', 't', 'U', 'u':
+		return 0
+	case 'C', 'c':
+		return 1
+	case 'A', 'a':
+		return 2
+	case 'G', 'g':
+		return 3
+	}
+	return -1
+}
+
+// Codon returns the amino acid encoded by the codon a, b, c. Codons of three
+// gap letters, given by gap, translate to gap, and codons containing other
+// letters translate to 'X'.
+func (c *Code) Codon(a, b, d, gap alphabet.Letter) alphabet.Letter {
+	if a == gap && b == gap && d == gap {
+		return gap
+	}
+	i, j, k := base(a), base(b), base(d)
+	if i < 0 || j < 0 || k < 0 {
+		return 'X'
+	}
+	return c.aa[i<<4|j<<2|k]
+}
+
+// Translate returns the translation of the nucleotides s in frame from the
+// first letter. Trailing letters not forming a complete codon are ignored.
+func (c *Code) Translate(s alphabet.Letters, gap alphabet.Letter) alphabet.Letters {
+	p := make(alphabet.Letters, 0, len(s)/3)
+	for i := 0; i+3 <= len(s); i += 3 {
+		p = append(p, c.Codon(s[i], s[i+1], s[i+2], gap))
+	}
+	return p
+}
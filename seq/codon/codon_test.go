@@ -0,0 +1,103 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codon
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bytes"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+func (s *S) TestTranslate(c *check.C) {
+	for i, t := range []struct {
+		code *Code
+		nt   string
+		aa   string
+	}{
+		{Standard, "ATGGCCTGGTAAtga", "MAW**"},
+		{Standard, "AUGNNN---AC", "MX-"},
+		{VertebrateMitochondrial, "ATATGAAGA", "MW*"},
+	} {
+		c.Check(string(t.code.Translate(alphabet.Letters(t.nt), '-')), check.Equals, t.aa, check.Commentf("Test %d", i))
+	}
+	_, err := NewCode("short", 0, "FFLL")
+	c.Check(err, check.Equals, ErrBadTable)
+}
+
+func alignment(c *check.C, rows ...string) *multi.Multi {
+	var ss []seq.Sequence
+	for i, r := range rows {
+		ss = append(ss, linear.NewSeq(string(rune('a'+i)), alphabet.Letters(r), alphabet.DNAgapped))
+	}
+	m, err := multi.NewMulti("test", ss, seq.DefaultConsensus)
+	c.Assert(err, check.Equals, nil)
+	return m
+}
+
+func (s *S) TestCheckRepair(c *check.C) {
+	m := alignment(c,
+		"ATGAAACCCTTT",
+		"ATG---CCCTTT",
+		"ATGA---CCTTT",
+		"ATGAA---CTTT",
+		"ATGAA-CCCTTT",
+	)
+	vs, err := Check(m)
+	c.Assert(err, check.Equals, nil)
+	c.Check(vs, check.DeepEquals, []Violation{
+		{Row: 2, Start: 4, End: 7},
+		{Row: 3, Start: 5, End: 8},
+		{Row: 4, Start: 5, End: 6},
+	})
+	c.Check(vs[2].Frameshift(), check.Equals, true)
+
+	vs, err = Repair(m)
+	c.Assert(err, check.Equals, nil)
+	c.Check(vs, check.DeepEquals, []Violation{{Row: 4, Start: 5, End: 6}})
+	for i, want := range []string{
+		"ATGAAACCCTTT",
+		"ATG---CCCTTT",
+		"ATG---ACCTTT",
+		"ATGAAC---TTT",
+	} {
+		c.Check(m.Row(i).(*linear.Seq).Seq.String(), check.Equals, want, check.Commentf("Row %d", i))
+	}
+
+	_, err = Check(alignment(c, "ATGA"))
+	c.Check(err, check.Equals, ErrBadLength)
+}
+
+func (s *S) TestFprint(c *check.C) {
+	m := alignment(c,
+		"ATGAAACCCTTT",
+		"ATG---CCCTAA",
+	)
+	var b bytes.Buffer
+	err := Fprint(&b, m, Standard, 9)
+	c.Assert(err, check.Equals, nil)
+	c.Check(b.String(), check.Equals, `a  ATGAAACCC
+    M  K  P
+b  ATG---CCC
+    M  -  P
+
+a  TTT
+    F
+b  TAA
+    *
+`)
+}
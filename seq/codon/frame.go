@@ -0,0 +1,118 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codon
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/multi"
+)
+
+// A Violation is a run of gaps in a row of a codon alignment that does not
+// respect the reading frame of the alignment.
+type Violation struct {
+	Row        int
+	Start, End int // Alignment columns of the gap run.
+}
+
+// Frameshift returns whether the gap run of v is not a multiple of three
+// letters long, and so shifts the reading frame of the following sequence.
+// Violations that are not frameshifts split a codon and may be repairable.
+func (v Violation) Frameshift() bool { return (v.End-v.Start)%3 != 0 }
+
+// letter returns the letter of s at alignment column col, or gap if col is
+// outside s.
+func letter(s seq.Sequence, col int, gap alphabet.Letter) alphabet.Letter {
+	if col < s.Start() || col >= s.End() {
+		return gap
+	}
+	return s.At(col).L
+}
+
+// Check returns the gap runs of the rows of the codon alignment m that either
+// are not a multiple of three letters long or do not start at a codon boundary.
+// Codon boundaries are measured from the start of the alignment. Columns outside
+// the extent of a row are treated as gaps. Check returns ErrBadLength if the
+// length of the alignment is not a multiple of three.
+func Check(m *multi.Multi) ([]Violation, error) {
+	start, end := m.Start(), m.End()
+	if (end-start)%3 != 0 {
+		return nil, ErrBadLength
+	}
+	gap := m.Alpha.Gap()
+	var vs []Violation
+	for i := 0; i < m.Rows(); i++ {
+		s := m.Row(i)
+		for col := start; col < end; {
+			if letter(s, col, gap) != gap {
+				col++
+				continue
+			}
+			e := col
+			for e < end && letter(s, e, gap) == gap {
+				e++
+			}
+			if (col-start)%3 != 0 || (e-col)%3 != 0 {
+				vs = append(vs, Violation{Row: i, Start: col, End: e})
+			}
+			col = e
+		}
+	}
+	return vs, nil
+}
+
+// Repair moves gap runs of the codon alignment m that are a multiple of three
+// letters long but split a codon to the nearest codon boundary, exchanging the
+// gap run with the adjacent letters of the split codon. Runs are only moved
+// if the letters they are exchanged with are within the row and are not gaps.
+// Repair returns the violations that could not be repaired.
+func Repair(m *multi.Multi) ([]Violation, error) {
+	vs, err := Check(m)
+	if err != nil {
+		return nil, err
+	}
+	gap := m.Alpha.Gap()
+	start := m.Start()
+	var remain []Violation
+	for _, v := range vs {
+		if v.Frameshift() || !shift(m.Row(v.Row), v, start, gap) {
+			remain = append(remain, v)
+		}
+	}
+	return remain, nil
+}
+
+// shift moves the gap run of v in s to the nearest codon boundary, returning
+// whether the move was possible.
+func shift(s seq.Sequence, v Violation, start int, gap alphabet.Letter) bool {
+	d := (v.Start - start) % 3
+	if d == 1 {
+		// Move the gap left, placing the letter
+		// before it after the gap.
+		from, to := v.Start-1, v.End-1
+		if from < s.Start() || letter(s, from, gap) == gap || v.End > s.End() {
+			return false
+		}
+		l := s.At(from)
+		for col := from; col < to; col++ {
+			s.Set(col, s.At(col+1))
+		}
+		s.Set(to, l)
+		return true
+	}
+
+	// Move the gap right, placing the letter
+	// after it before the gap.
+	from, to := v.End, v.Start
+	if from >= s.End() || letter(s, from, gap) == gap || v.Start < s.Start() {
+		return false
+	}
+	l := s.At(from)
+	for col := from; col > to; col-- {
+		s.Set(col, s.At(col-1))
+	}
+	s.Set(to, l)
+	return true
+}
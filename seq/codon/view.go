@@ -0,0 +1,64 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codon
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq/multi"
+
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Fprint writes a text view of the codon alignment m to w. Each nucleotide row
+// is followed by its translation using the genetic code c, with each amino acid
+// written beneath the middle letter of its codon. The alignment is written in
+// blocks of width columns, rounded down to a multiple of three. If width is less
+// than three, the alignment is written in a single block.
+func Fprint(w io.Writer, m *multi.Multi, c *Code, width int) error {
+	start, end := m.Start(), m.End()
+	width -= width % 3
+	if width < 3 {
+		width = end - start
+	}
+	gap := m.Alpha.Gap()
+
+	var names int
+	for i := 0; i < m.Rows(); i++ {
+		if n := len(m.Row(i).Name()); n > names {
+			names = n
+		}
+	}
+
+	var buf bytes.Buffer
+	for b := start; b < end; b += width {
+		if b != start {
+			buf.WriteByte('\n')
+		}
+		e := b + width
+		if e > end {
+			e = end
+		}
+		for i := 0; i < m.Rows(); i++ {
+			s := m.Row(i)
+			nt := make(alphabet.Letters, e-b)
+			for col := b; col < e; col++ {
+				nt[col-b] = letter(s, col, gap)
+			}
+			fmt.Fprintf(&buf, "%-*s  %s\n", names, s.Name(), nt)
+			fmt.Fprintf(&buf, "%-*s  ", names, "")
+			for k, aa := range c.Translate(nt, gap) {
+				if k != 0 {
+					buf.WriteByte(' ')
+				}
+				fmt.Fprintf(&buf, " %c", aa)
+			}
+			buf.WriteByte('\n')
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,245 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lazy provides a linear sequence type that reads its letters from an
+// indexed store only when they are needed.
+//
+// A lazy Seq holds the annotation and length of a sequence and a Source for its
+// letters, such as the FAI indexed fasta Source provided by the io/seqio/fai
+// package. Letters are read in blocks by At, and single regions are read by
+// Subseq, so feature-centric work over large references reads only the regions
+// that are used. Operations that need or change the whole sequence, including
+// Slice, Set, RevComp and Reverse, load all of its letters into memory.
+package lazy
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+
+	"errors"
+	"fmt"
+)
+
+var ErrOutOfRange = errors.New("lazy: range out of bounds")
+
+// A Source provides the letters of a sequence.
+type Source interface {
+	// Len returns the length of the sequence.
+	Len() int
+
+	// ReadLetters fills dst with the letters
+	// of the sequence starting at position
+	// start.
+	ReadLetters(dst []alphabet.Letter, start int) error
+}
+
+// blockSize is the number of letters read by At.
+const blockSize = 4096
+
+// A Seq is a linear sequence whose letters are read from a Source on demand.
+type Seq struct {
+	seq.Annotation
+
+	src    Source
+	length int
+
+	// seq holds the letters of the sequence
+	// once they have been loaded.
+	seq    alphabet.Letters
+	loaded bool
+
+	// block holds the most recent block of
+	// letters read by At, starting at the
+	// sequence position blockStart.
+	block      alphabet.Letters
+	blockStart int
+}
+
+// Interface guarantees
+var (
+	_ feat.Feature = (*Seq)(nil)
+	_ seq.Sequence = (*Seq)(nil)
+)
+
+// NewSeq creates a new Seq with the given id and alphabet, reading its letters
+// from src.
+func NewSeq(id string, src Source, alpha alphabet.Alphabet) *Seq {
+	return &Seq{
+		Annotation: seq.Annotation{
+			ID:     id,
+			Alpha:  alpha,
+			Strand: seq.Plus,
+		},
+		src:    src,
+		length: src.Len(),
+	}
+}
+
+// Loaded returns whether the letters of the sequence have been loaded into memory.
+func (s *Seq) Loaded() bool { return s.loaded }
+
+// Load reads all the letters of the sequence into memory.
+func (s *Seq) Load() error {
+	if s.loaded {
+		return nil
+	}
+	l := make(alphabet.Letters, s.length)
+	err := s.src.ReadLetters(l, 0)
+	if err != nil {
+		return err
+	}
+	s.seq, s.loaded = l, true
+	s.block = nil
+	return nil
+}
+
+func (s *Seq) mustLoad() {
+	err := s.Load()
+	if err != nil {
+		panic(fmt.Sprintf("lazy: failed to load sequence: %v", err))
+	}
+}
+
+// Subseq returns a linear.Seq holding the letters of s in [start, end), where
+// start and end are in coordinates relative to the sequence location. Only the
+// letters in the range are read.
+func (s *Seq) Subseq(start, end int) (*linear.Seq, error) {
+	start -= s.Offset
+	end -= s.Offset
+	if start < 0 || end < start || s.Len() < end {
+		return nil, ErrOutOfRange
+	}
+	sub := &linear.Seq{Annotation: *s.CloneAnnotation()}
+	sub.Offset += start
+	if s.loaded {
+		sub.Seq = append(alphabet.Letters(nil), s.seq[start:end]...)
+		return sub, nil
+	}
+	sub.Seq = make(alphabet.Letters, end-start)
+	err := s.src.ReadLetters(sub.Seq, start)
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Slice returns the sequence data as a alphabet.Slice, loading the letters of the
+// sequence. Slice will panic if the letters cannot be read.
+func (s *Seq) Slice() alphabet.Slice {
+	s.mustLoad()
+	return s.seq
+}
+
+// SetSlice sets the sequence data represented by the sequence. SetSlice will panic if sl
+// is not a alphabet.Letters.
+func (s *Seq) SetSlice(sl alphabet.Slice) {
+	s.seq, s.loaded = sl.(alphabet.Letters), true
+	s.block = nil
+}
+
+// At returns the letter at position pos, reading the block of letters holding pos
+// if it has not been loaded. At will panic if the letters cannot be read.
+func (s *Seq) At(i int) alphabet.QLetter {
+	i -= s.Offset
+	if s.loaded {
+		return alphabet.QLetter{L: s.seq[i], Q: seq.DefaultQphred}
+	}
+	if i < 0 || s.length <= i {
+		panic("lazy: index out of range")
+	}
+	if i < s.blockStart || s.blockStart+len(s.block) <= i {
+		start := i - i%blockSize
+		end := start + blockSize
+		if end > s.length {
+			end = s.length
+		}
+		if cap(s.block) < end-start {
+			s.block = make(alphabet.Letters, end-start)
+		}
+		s.block = s.block[:end-start]
+		err := s.src.ReadLetters(s.block, start)
+		if err != nil {
+			s.block = nil
+			panic(fmt.Sprintf("lazy: failed to read letters: %v", err))
+		}
+		s.blockStart = start
+	}
+	return alphabet.QLetter{L: s.block[i-s.blockStart], Q: seq.DefaultQphred}
+}
+
+// Set sets the letter at position pos to l, loading the letters of the sequence.
+func (s *Seq) Set(i int, l alphabet.QLetter) error {
+	err := s.Load()
+	if err != nil {
+		return err
+	}
+	s.seq[i-s.Offset] = l.L
+	return nil
+}
+
+// Len returns the length of the sequence.
+func (s *Seq) Len() int {
+	if s.loaded {
+		return len(s.seq)
+	}
+	return s.length
+}
+
+// Start returns the start position of the sequence in coordinates relative to the sequence
+// location.
+func (s *Seq) Start() int { return s.Offset }
+
+// End returns the end position of the sequence in coordinates relative to the sequence
+// location.
+func (s *Seq) End() int { return s.Offset + s.Len() }
+
+// Clone returns a copy of the sequence. If the letters of s have not been loaded, the
+// copy shares its Source.
+func (s *Seq) Clone() seq.Sequence {
+	c := *s
+	c.block = nil
+	if s.loaded {
+		c.seq = append(alphabet.Letters(nil), s.seq...)
+	}
+	return &c
+}
+
+// New returns an empty *Seq sequence with the same alphabet.
+func (s *Seq) New() seq.Sequence {
+	return &Seq{Annotation: seq.Annotation{Alpha: s.Alpha}, loaded: true}
+}
+
+// RevComp reverse complements the sequence, loading its letters. RevComp will panic if
+// the alphabet used by the receiver is not a Complementor or the letters cannot be read.
+func (s *Seq) RevComp() {
+	s.mustLoad()
+	l, comp := s.seq, s.Alphabet().(alphabet.Complementor).ComplementTable()
+	i, j := 0, len(l)-1
+	for ; i < j; i, j = i+1, j-1 {
+		l[i], l[j] = comp[l[j]], comp[l[i]]
+	}
+	if i == j {
+		l[i] = comp[l[i]]
+	}
+	s.Strand = -s.Strand
+}
+
+// Reverse reverses the order of letters in the the sequence without complementing them,
+// loading its letters. Reverse will panic if the letters cannot be read.
+func (s *Seq) Reverse() {
+	s.mustLoad()
+	l := s.seq
+	for i, j := 0, len(l)-1; i < j; i, j = i+1, j-1 {
+		l[i], l[j] = l[j], l[i]
+	}
+	s.Strand = seq.None
+}
+
+// String returns a string representation of the sequence data only, loading its letters.
+func (s *Seq) String() string {
+	s.mustLoad()
+	return s.seq.String()
+}
@@ -0,0 +1,77 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lazy
+
+import (
+	"github.com/biogo/biogo/alphabet"
+
+	"strings"
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+// Tests
+func Test(t *testing.T) { check.TestingT(t) }
+
+type S struct{}
+
+var _ = check.Suite(&S{})
+
+// counter is a Source that counts the letters read from it.
+type counter struct {
+	letters alphabet.Letters
+	read    int
+}
+
+func (c *counter) Len() int { return len(c.letters) }
+func (c *counter) ReadLetters(dst []alphabet.Letter, start int) error {
+	if start < 0 || len(c.letters) < start+len(dst) {
+		return ErrOutOfRange
+	}
+	c.read += copy(dst, c.letters[start:])
+	return nil
+}
+
+func (s *S) TestSeq(c *check.C) {
+	src := &counter{letters: alphabet.Letters(strings.Repeat("ACGT", 3*blockSize))}
+	sq := NewSeq("chr", src, alphabet.DNA)
+	c.Check(sq.Len(), check.Equals, 4*3*blockSize)
+	c.Check(src.read, check.Equals, 0)
+
+	c.Check(sq.At(1).L, check.Equals, alphabet.Letter('C'))
+	c.Check(sq.At(blockSize-1).L, check.Equals, alphabet.Letter('T'))
+	c.Check(src.read, check.Equals, blockSize)
+	c.Check(sq.At(blockSize+2).L, check.Equals, alphabet.Letter('G'))
+	c.Check(src.read, check.Equals, 2*blockSize)
+
+	sub, err := sq.Subseq(6, 12)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sub.Name(), check.Equals, "chr")
+	c.Check(sub.Start(), check.Equals, 6)
+	c.Check(sub.Seq.String(), check.Equals, "GTACGT")
+	c.Check(src.read, check.Equals, 2*blockSize+6)
+	_, err = sq.Subseq(6, sq.Len()+1)
+	c.Check(err, check.Equals, ErrOutOfRange)
+
+	cl := sq.Clone().(*Seq)
+	c.Check(cl.Loaded(), check.Equals, false)
+
+	sq.SetOffset(10)
+	sub, err = sq.Subseq(10, 14)
+	c.Assert(err, check.Equals, nil)
+	c.Check(sub.Seq.String(), check.Equals, "ACGT")
+	c.Check(sq.At(11).L, check.Equals, alphabet.Letter('C'))
+	c.Check(sq.End(), check.Equals, 10+sq.Len())
+
+	c.Check(sq.Set(10, alphabet.QLetter{L: 'T'}), check.Equals, nil)
+	c.Check(sq.Loaded(), check.Equals, true)
+	c.Check(sq.At(10).L, check.Equals, alphabet.Letter('T'))
+	c.Check(cl.At(0).L, check.Equals, alphabet.Letter('A'))
+
+	sq.RevComp()
+	c.Check(sq.String()[:4], check.Equals, "ACGT")
+	c.Check(sq.At(sq.End()-1).L, check.Equals, alphabet.Letter('A'))
+}
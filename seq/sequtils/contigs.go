@@ -0,0 +1,161 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/feat"
+	"github.com/biogo/biogo/seq"
+
+	"errors"
+	"fmt"
+)
+
+// A Provenance is a feature recording the location of a contig on the scaffold it was
+// split from.
+type Provenance struct {
+	Contig       string       // Name of the contig.
+	Scaffold     feat.Feature // Scaffold feature spanning the whole scaffold.
+	PStart, PEnd int          // Location of the contig on the scaffold.
+}
+
+func (p *Provenance) Start() int             { return p.PStart }
+func (p *Provenance) End() int               { return p.PEnd }
+func (p *Provenance) Len() int               { return p.PEnd - p.PStart }
+func (p *Provenance) Name() string           { return p.Contig }
+func (p *Provenance) Description() string    { return "contig" }
+func (p *Provenance) Location() feat.Feature { return p.Scaffold }
+
+// scaffold is a feature describing a whole scaffold sequence.
+type scaffold struct {
+	name, desc string
+	length     int
+}
+
+func (s *scaffold) Start() int             { return 0 }
+func (s *scaffold) End() int               { return s.length }
+func (s *scaffold) Len() int               { return s.length }
+func (s *scaffold) Name() string           { return s.name }
+func (s *scaffold) Description() string    { return s.desc }
+func (s *scaffold) Location() feat.Feature { return nil }
+
+// An annotator can set its name and description.
+type annotator interface {
+	SetName(string) error
+	SetDescription(string) error
+}
+
+// SplitContigs splits the scaffold sequence s at runs of at least minRun ambiguous
+// letters into contigs. Ambiguous letters are the ambiguous letter of the alphabet
+// of s in either case. The contigs are named by the scaffold name followed by a dot
+// and their ordinal number from one, and are returned with a Provenance feature for
+// each contig recording its location on the scaffold. Runs shorter than minRun are
+// kept within contigs. If minRun is less than one, runs of any length are split.
+// The sequences created by s.New must allow their name and description to be set.
+func SplitContigs(s seq.Sequence, minRun int) ([]seq.Sequence, []*Provenance, error) {
+	if minRun < 1 {
+		minRun = 1
+	}
+	var (
+		sl  = s.Slice()
+		n   = sl.Len()
+		off = s.Start()
+		amb = s.Alphabet().Ambiguous()
+		sc  = &scaffold{name: s.Name(), desc: s.Description(), length: n}
+
+		contigs []seq.Sequence
+		prov    []*Provenance
+	)
+	isAmb := func(l alphabet.Letter) bool { return l|('a'-'A') == amb|('a'-'A') }
+	add := func(start, end int) error {
+		if start >= end {
+			return nil
+		}
+		c := s.New()
+		a, ok := c.(annotator)
+		if !ok {
+			return errors.New("sequtils: cannot annotate contig")
+		}
+		name := fmt.Sprintf("%s.%d", s.Name(), len(contigs)+1)
+		a.SetName(name)
+		a.SetDescription(s.Description())
+		c.SetSlice(sl.Make(0, end-start).Append(sl.Slice(start, end)))
+		contigs = append(contigs, c)
+		prov = append(prov, &Provenance{Contig: name, Scaffold: sc, PStart: start, PEnd: end})
+		return nil
+	}
+
+	start := 0
+	for i := 0; i < n; {
+		if !isAmb(s.At(off + i).L) {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < n && isAmb(s.At(off+j).L) {
+			j++
+		}
+		if j-i >= minRun {
+			err := add(start, i)
+			if err != nil {
+				return nil, nil, err
+			}
+			start = j
+		}
+		i = j
+	}
+	err := add(start, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return contigs, prov, nil
+}
+
+// JoinContigs reassembles the scaffolds that the contigs were split from using their
+// provenance features, the reverse of SplitContigs. The regions of each scaffold not
+// covered by a contig are filled with the ambiguous letter of the contig alphabet. The
+// scaffolds are returned in order of their first contig in contigs, and are given the
+// name and description of the provenance scaffold feature.
+func JoinContigs(contigs []seq.Sequence, prov []*Provenance) ([]seq.Sequence, error) {
+	if len(contigs) != len(prov) {
+		return nil, errors.New("sequtils: contig and provenance counts differ")
+	}
+	var (
+		scaffolds []seq.Sequence
+		slices    []alphabet.Slice
+		index     = make(map[string]int)
+	)
+	for i, c := range contigs {
+		p := prov[i]
+		if p.Scaffold == nil {
+			return nil, errors.New("sequtils: provenance has no scaffold")
+		}
+		if p.Len() != c.Len() || p.PStart < 0 || p.PEnd > p.Scaffold.Len() {
+			return nil, errors.New("sequtils: contig does not match provenance")
+		}
+		k, ok := index[p.Scaffold.Name()]
+		if !ok {
+			s := c.New()
+			a, ok := s.(annotator)
+			if !ok {
+				return nil, errors.New("sequtils: cannot annotate scaffold")
+			}
+			a.SetName(p.Scaffold.Name())
+			a.SetDescription(p.Scaffold.Description())
+			n := p.Scaffold.Len()
+			s.SetSlice(c.Slice().Make(n, n))
+			fill := alphabet.QLetter{L: s.Alphabet().Ambiguous()}
+			for j := 0; j < n; j++ {
+				s.Set(j, fill)
+			}
+			k = len(scaffolds)
+			index[p.Scaffold.Name()] = k
+			scaffolds = append(scaffolds, s)
+			slices = append(slices, s.Slice())
+		}
+		slices[k].Slice(p.PStart, p.PEnd).Copy(c.Slice())
+	}
+	return scaffolds, nil
+}
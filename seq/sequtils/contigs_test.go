@@ -0,0 +1,76 @@
+// Copyright ©2026 The bíogo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sequtils_test
+
+import (
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/biogo/biogo/seq/sequtils"
+
+	"fmt"
+
+	"gopkg.in/check.v1"
+)
+
+type C struct{}
+
+var _ = check.Suite(&C{})
+
+func (s *C) TestContigs(c *check.C) {
+	for i, t := range []struct {
+		scaffold string
+		minRun   int
+		contigs  []string
+		prov     []string
+		joined   string
+	}{
+		{
+			scaffold: "ACGTNNNNNGGCCNNAATTnnnnnnTTT",
+			minRun:   5,
+			contigs:  []string{"ACGT", "GGCCNNAATT", "TTT"},
+			prov:     []string{"scf.1[0,4)", "scf.2[9,19)", "scf.3[25,28)"},
+			joined:   "ACGTnnnnnGGCCNNAATTnnnnnnTTT",
+		},
+		{
+			scaffold: "NNACGTNAC",
+			minRun:   1,
+			contigs:  []string{"ACGT", "AC"},
+			prov:     []string{"scf.1[2,6)", "scf.2[7,9)"},
+			joined:   "nnACGTnAC",
+		},
+		{
+			scaffold: "ACGT",
+			contigs:  []string{"ACGT"},
+			prov:     []string{"scf.1[0,4)"},
+			joined:   "ACGT",
+		},
+	} {
+		scf := linear.NewSeq("scf", alphabet.BytesToLetters([]byte(t.scaffold)), alphabet.DNAredundant)
+		scf.Desc = "scaffold"
+		contigs, prov, err := sequtils.SplitContigs(scf, t.minRun)
+		c.Assert(err, check.Equals, nil)
+		var got, gotProv []string
+		for j, ctg := range contigs {
+			got = append(got, ctg.(*linear.Seq).Seq.String())
+			p := prov[j]
+			c.Check(p.Name(), check.Equals, ctg.Name())
+			c.Check(ctg.Description(), check.Equals, "scaffold")
+			gotProv = append(gotProv, fmt.Sprintf("%s[%d,%d)", p.Name(), p.Start(), p.End()))
+		}
+		c.Check(got, check.DeepEquals, t.contigs, check.Commentf("Test %d", i))
+		c.Check(gotProv, check.DeepEquals, t.prov, check.Commentf("Test %d", i))
+
+		joined, err := sequtils.JoinContigs(contigs, prov)
+		c.Assert(err, check.Equals, nil)
+		c.Assert(joined, check.HasLen, 1)
+		c.Check(joined[0].Name(), check.Equals, "scf")
+		c.Check(joined[0].Description(), check.Equals, "scaffold")
+		c.Check(joined[0].(*linear.Seq).Seq.String(), check.Equals, t.joined, check.Commentf("Test %d", i))
+	}
+
+	_, err := sequtils.JoinContigs([]seq.Sequence{linear.NewSeq("a", nil, alphabet.DNA)}, nil)
+	c.Check(err, check.ErrorMatches, "sequtils: contig and provenance counts differ")
+}